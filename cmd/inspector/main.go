@@ -71,6 +71,16 @@ var (
 		Args:  cobra.MinimumNArgs(4),
 		RunE:  SegmentHealth,
 	}
+	auditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "commands for querying audits of storage nodes",
+	}
+	nodeAuditStatsCmd = &cobra.Command{
+		Use:   "stats <node-id> [<node-id>...]",
+		Short: "Get the audit rate of storage nodes",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  NodeAuditStats,
+	}
 )
 
 // Inspector gives access to overlay.
@@ -78,6 +88,7 @@ type Inspector struct {
 	conn         *rpc.Conn
 	identity     *identity.FullIdentity
 	healthclient internalpb.DRPCHealthInspectorClient
+	auditclient  internalpb.DRPCAuditInspectorClient
 }
 
 // NewInspector creates a new inspector client for access to overlay.
@@ -99,6 +110,7 @@ func NewInspector(ctx context.Context, address, path string) (*Inspector, error)
 		conn:         conn,
 		identity:     id,
 		healthclient: internalpb.NewDRPCHealthInspectorClient(conn),
+		auditclient:  internalpb.NewDRPCAuditInspectorClient(conn),
 	}, nil
 }
 
@@ -243,6 +255,72 @@ func SegmentHealth(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// NodeAuditStats gets the audit rate of storage nodes.
+func NodeAuditStats(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	i, err := NewInspector(ctx, *Addr, *IdentityPath)
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, i.Close()) }()
+
+	req := &internalpb.NodeAuditStatsRequest{}
+	for _, arg := range args {
+		nodeID, err := storj.NodeIDFromString(arg)
+		if err != nil {
+			return ErrArgs.Wrap(err)
+		}
+		req.NodeIds = append(req.NodeIds, nodeID.Bytes())
+	}
+
+	resp, err := i.auditclient.NodeAuditStats(ctx, req)
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	f, err := csvOutput()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := f.Close()
+		if err != nil {
+			fmt.Printf("error closing file: %+v\n", err)
+		}
+	}()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	header := []string{
+		"Node ID", "Vetted", "Total Audits", "Successful Audits", "Audits Per Day", "Target Audits Per Day",
+	}
+	if err := w.Write(header); err != nil {
+		return fmt.Errorf("error writing record to csv: %w", err)
+	}
+
+	for _, stats := range resp.GetStats() {
+		nodeID, err := storj.NodeIDFromBytes(stats.NodeId)
+		if err != nil {
+			return ErrRequest.Wrap(err)
+		}
+
+		row := []string{
+			nodeID.String(),
+			strconv.FormatBool(stats.Vetted),
+			strconv.FormatInt(stats.TotalAuditCount, 10),
+			strconv.FormatInt(stats.AuditSuccessCount, 10),
+			strconv.FormatFloat(stats.AuditsPerDay, 'f', 2, 64),
+			strconv.FormatFloat(stats.TargetAuditsPerDay, 'f', 2, 64),
+		}
+		if err := w.Write(row); err != nil {
+			return fmt.Errorf("error writing record to csv: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func csvOutput() (*os.File, error) {
 	if CSVPath == "stdout" {
 		return os.Stdout, nil
@@ -353,11 +431,15 @@ func printRedundancyTable(w *csv.Writer, redundancy eestream.RedundancyStrategy)
 func init() {
 	rootCmd.AddCommand(statsCmd)
 	rootCmd.AddCommand(healthCmd)
+	rootCmd.AddCommand(auditCmd)
 
 	healthCmd.AddCommand(objectHealthCmd)
 	healthCmd.AddCommand(segmentHealthCmd)
 
+	auditCmd.AddCommand(nodeAuditStatsCmd)
+
 	objectHealthCmd.Flags().StringVar(&CSVPath, "csv-path", "stdout", "csv path where command output is written")
+	nodeAuditStatsCmd.Flags().StringVar(&CSVPath, "csv-path", "stdout", "csv path where command output is written")
 
 	flag.Parse()
 }
@@ -64,6 +64,7 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripecoinpayments.Servic
 		db.Billing(),
 		db.Console().Projects(),
 		db.ProjectAccounting(),
+		db.PricePlans(),
 		pc.StorageTBPrice,
 		pc.EgressTBPrice,
 		pc.SegmentPrice,
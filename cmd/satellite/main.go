@@ -44,6 +44,8 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/satellitedb"
 )
 
@@ -219,6 +221,12 @@ var (
 		Long:  "Creates stripe invoice line items for unapplied token balances.",
 		RunE:  cmdCreateCustomerTokenInvoiceItems,
 	}
+	createCustomerPrepaidInvoiceItemsCmd = &cobra.Command{
+		Use:   "create-prepaid-invoice-items",
+		Short: "Creates stripe invoice line items for prepaid balance payments",
+		Long:  "Creates stripe invoice line items which pay the pending invoice items from the prepaid balances and tops up the balances with auto top-up enabled.",
+		RunE:  cmdCreateCustomerPrepaidInvoiceItems,
+	}
 	createCustomerInvoicesCmd = &cobra.Command{
 		Use:   "create-invoices [period]",
 		Short: "Creates stripe invoices from pending invoice items",
@@ -269,10 +277,103 @@ var (
 		Args:  cobra.ExactArgs(3),
 		RunE:  cmdFetchPieces,
 	}
+	reencodeSegmentsCmd = &cobra.Command{
+		Use:   "reencode-segments",
+		Short: "Re-encode segments from one redundancy scheme to another",
+		Long: "Re-encode remote segments matching the source redundancy scheme to the target " +
+			"redundancy scheme. With --reencode.dry-run only the cost estimate is printed.",
+		Args: cobra.NoArgs,
+		RunE: cmdReencodeSegments,
+	}
+	metabaseCmd = &cobra.Command{
+		Use:   "metabase",
+		Short: "Metabase maintenance tools",
+	}
+	metabaseExportCmd = &cobra.Command{
+		Use:   "export",
+		Short: "Export the metabase metadata of a project",
+		Long: "Export the objects, segments and server-side copies of a project from the metabase " +
+			"for importing them into the metabase of another satellite. The copies of objects " +
+			"in other projects are exported with the pieces of their ancestors.",
+		Args: cobra.NoArgs,
+		RunE: cmdMetabaseExport,
+	}
+	metabaseImportCmd = &cobra.Command{
+		Use:   "import",
+		Short: "Import the metabase metadata of a project",
+		Long: "Verify and import the export of a project into the metabase. The project " +
+			"must not have any objects in the metabase. When importing into another project, " +
+			"the objects get new stream ids, so the export can be imported into the metabase, " +
+			"which holds the exported project.",
+		Args: cobra.NoArgs,
+		RunE: cmdMetabaseImport,
+	}
+	metabaseStreamIDIndexCmd = &cobra.Command{
+		Use:   "create-stream-id-index",
+		Short: "Create the index on the stream id of the objects",
+		Long: "Create the index on the stream id of the objects without blocking the writes. " +
+			"The index is needed by the consistency checks and by attributing the injured " +
+			"segments to projects in the repair checker.",
+		Args: cobra.NoArgs,
+		RunE: cmdMetabaseCreateStreamIDIndex,
+	}
+	reputationCmd = &cobra.Command{
+		Use:   "reputation",
+		Short: "Tools for tuning the node reputation model",
+	}
+	reputationSimulateCmd = &cobra.Command{
+		Use:   "simulate",
+		Short: "Replay audit outcomes through the reputation model under alternative configs",
+		Long: "Replay audit outcomes from --events, or synthetic failure patterns when no events are given, " +
+			"through the reputation model for the configured reputation values and every --variants entry, " +
+			"reporting when each node would be vetted, suspended and disqualified.",
+		Args: cobra.NoArgs,
+		RunE: cmdReputationSimulate,
+	}
+	reputationExportCmd = &cobra.Command{
+		Use:   "export <node-id>...",
+		Short: "Export the audit history of nodes as events for the simulate command",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  cmdReputationExport,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
 
+	reencodeCfg struct {
+		Satellite
+		Reencode repairer.ReencodeConfig
+	}
+
+	reputationSimulateCfg struct {
+		Reputation reputation.Config
+		Synthetic  reputation.SyntheticConfig
+		Events     string `help:"CSV file with node,time,outcome audit events to replay; synthetic audits are generated when empty" default:""`
+		Variants   string `help:"alternative reputation configs to simulate, e.g. 'strict:audit-dq=0.98,audit-lambda=0.99;lenient:audit-history.offline-threshold=0.5'" default:""`
+		Format     string `help:"output format, csv or json" default:"csv"`
+		Output     string `help:"destination of report output" default:""`
+	}
+	reputationExportCfg struct {
+		Database   string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Reputation reputation.Config
+		Output     string `help:"destination of report output" default:""`
+	}
+
+	metabaseExportCfg struct {
+		MetabaseDB string `help:"metabase database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Project    string `help:"id of the exported project (required)" default:""`
+		Output     string `help:"destination of the export" default:""`
+	}
+	metabaseImportCfg struct {
+		MetabaseDB string `help:"metabase database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Project    string `help:"id of the project to import into, the project of the export when empty. The stream ids are replaced, when it differs from the exported project" default:""`
+		Input      string `help:"source of the export (required)" default:""`
+		BatchSize  int    `help:"number of objects imported in a single transaction" default:"100"`
+	}
+	metabaseStreamIDIndexCfg struct {
+		MetabaseDB string `help:"metabase database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+	}
+
 	qdiagCfg struct {
 		Database   string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		QListLimit int    `help:"maximum segments that can be requested" default:"1000"`
@@ -335,6 +436,14 @@ func init() {
 	rootCmd.AddCommand(restoreTrashCmd)
 	rootCmd.AddCommand(registerLostSegments)
 	rootCmd.AddCommand(fetchPiecesCmd)
+	rootCmd.AddCommand(reencodeSegmentsCmd)
+	rootCmd.AddCommand(metabaseCmd)
+	metabaseCmd.AddCommand(metabaseExportCmd)
+	metabaseCmd.AddCommand(metabaseImportCmd)
+	metabaseCmd.AddCommand(metabaseStreamIDIndexCmd)
+	rootCmd.AddCommand(reputationCmd)
+	reputationCmd.AddCommand(reputationSimulateCmd)
+	reputationCmd.AddCommand(reputationExportCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
 	reportsCmd.AddCommand(reportsGracefulExitCmd)
@@ -346,6 +455,7 @@ func init() {
 	billingCmd.AddCommand(prepareCustomerInvoiceRecordsCmd)
 	billingCmd.AddCommand(createCustomerProjectInvoiceItemsCmd)
 	billingCmd.AddCommand(createCustomerTokenInvoiceItemsCmd)
+	billingCmd.AddCommand(createCustomerPrepaidInvoiceItemsCmd)
 	billingCmd.AddCommand(createCustomerInvoicesCmd)
 	billingCmd.AddCommand(finalizeCustomerInvoicesCmd)
 	billingCmd.AddCommand(stripeCustomerCmd)
@@ -360,6 +470,12 @@ func init() {
 	process.Bind(restoreTrashCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(registerLostSegments, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(fetchPiecesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(reencodeSegmentsCmd, &reencodeCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(metabaseExportCmd, &metabaseExportCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(metabaseImportCmd, &metabaseImportCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(metabaseStreamIDIndexCmd, &metabaseStreamIDIndexCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(reputationSimulateCmd, &reputationSimulateCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(reputationExportCmd, &reputationExportCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(qdiagCmd, &qdiagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(nodeUsageCmd, &nodeUsageCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -373,6 +489,7 @@ func init() {
 	process.Bind(prepareCustomerInvoiceRecordsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(createCustomerProjectInvoiceItemsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(createCustomerTokenInvoiceItemsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(createCustomerPrepaidInvoiceItemsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(createCustomerInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(finalizeCustomerInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(stripeCustomerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -381,6 +498,12 @@ func init() {
 	if err := consistencyGECleanupCmd.MarkFlagRequired("before"); err != nil {
 		panic(err)
 	}
+	if err := metabaseExportCmd.MarkFlagRequired("project"); err != nil {
+		panic(err)
+	}
+	if err := metabaseImportCmd.MarkFlagRequired("input"); err != nil {
+		panic(err)
+	}
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -755,6 +878,14 @@ func cmdCreateCustomerTokenInvoiceItems(cmd *cobra.Command, args []string) (err
 	})
 }
 
+func cmdCreateCustomerPrepaidInvoiceItems(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	return runBillingCmd(ctx, func(ctx context.Context, payments *stripecoinpayments.Service, _ satellite.DB) error {
+		return payments.InvoiceApplyPrepaidBalance(ctx)
+	})
+}
+
 func cmdCreateCustomerInvoices(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 
@@ -0,0 +1,180 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/private/process"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/projectexport"
+)
+
+func cmdMetabaseExport(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	projectID, err := uuid.FromString(metabaseExportCfg.Project)
+	if err != nil {
+		return errs.New("invalid project id %q: %v", metabaseExportCfg.Project, err)
+	}
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), metabaseExportCfg.MetabaseDB, metabase.Config{
+		ApplicationName: "satellite-metabase-export",
+	})
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	var summary projectexport.Summary
+	err = runWithOutput(metabaseExportCfg.Output, func(w io.Writer) (err error) {
+		summary, err = projectexport.Export(ctx, metabaseDB, projectID, w)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Project exported",
+		zap.Stringer("Project ID", projectID),
+		zap.Int64("Objects", summary.Objects),
+		zap.Int64("Segments", summary.Segments),
+		zap.Int64("Copies", summary.Copies))
+	return nil
+}
+
+func cmdMetabaseImport(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	var projectID uuid.UUID
+	if metabaseImportCfg.Project != "" {
+		projectID, err = uuid.FromString(metabaseImportCfg.Project)
+		if err != nil {
+			return errs.New("invalid project id %q: %v", metabaseImportCfg.Project, err)
+		}
+	}
+
+	input, err := os.Open(metabaseImportCfg.Input)
+	if err != nil {
+		return errs.New("unable to open input: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, input.Close())
+	}()
+
+	reader, err := projectexport.NewReader(input)
+	if err != nil {
+		return err
+	}
+	if projectID.IsZero() {
+		projectID = reader.Header().ProjectID
+	}
+
+	// the whole export is verified before importing anything.
+	if _, err := input.Seek(0, io.SeekStart); err != nil {
+		return errs.New("unable to read input: %v", err)
+	}
+	expected, err := projectexport.Verify(ctx, input)
+	if err != nil {
+		return errs.New("invalid export: %v", err)
+	}
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), metabaseImportCfg.MetabaseDB, metabase.Config{
+		ApplicationName: "satellite-metabase-import",
+	})
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	empty, err := projectEmpty(ctx, metabaseDB, projectID)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return errs.New("project %s has objects already", projectID)
+	}
+
+	if _, err := input.Seek(0, io.SeekStart); err != nil {
+		return errs.New("unable to read input: %v", err)
+	}
+	imported, err := projectexport.Import(ctx, metabaseDB, input, projectexport.ImportOptions{
+		ProjectID: projectID,
+		BatchSize: metabaseImportCfg.BatchSize,
+	})
+	if err != nil {
+		return errs.New("import failed after %d objects: %v", imported.Objects, err)
+	}
+
+	// exporting the imported project verifies that everything was imported.
+	actual, err := projectexport.Export(ctx, metabaseDB, projectID, io.Discard)
+	if err != nil {
+		return err
+	}
+	if actual != expected {
+		return errs.New("imported %+v, but the project contains %+v", expected, actual)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(actual)
+}
+
+func cmdMetabaseCreateStreamIDIndex(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), metabaseStreamIDIndexCfg.MetabaseDB, metabase.Config{
+		ApplicationName: "satellite-metabase-stream-id-index",
+	})
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	if err := metabaseDB.CreateStreamIDIndex(ctx); err != nil {
+		return err
+	}
+
+	log.Info("Stream id index created")
+	return nil
+}
+
+// errProjectNotEmpty stops the export of a project when it has any objects.
+var errProjectNotEmpty = errs.New("project not empty")
+
+type emptyProjectCheck struct{}
+
+func (emptyProjectCheck) Object(context.Context, metabase.RawObject) error   { return errProjectNotEmpty }
+func (emptyProjectCheck) Segment(context.Context, metabase.RawSegment) error { return nil }
+func (emptyProjectCheck) Copy(context.Context, metabase.RawCopy) error       { return nil }
+
+// projectEmpty returns whether the project has no objects.
+func projectEmpty(ctx context.Context, metabaseDB *metabase.DB, projectID uuid.UUID) (bool, error) {
+	err := metabaseDB.ExportProject(ctx, metabase.ExportProject{
+		ProjectID: projectID,
+		BatchSize: 1,
+	}, emptyProjectCheck{})
+	if errors.Is(err, errProjectNotEmpty) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/context2"
+	"storj.io/private/process"
+	"storj.io/private/version"
+	"storj.io/storj/private/revocation"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdReencodeSegments(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	identity, err := reencodeCfg.Identity.Load()
+	if err != nil {
+		log.Error("Failed to load identity.", zap.Error(err))
+		return errs.New("Failed to load identity: %+v", err)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), reencodeCfg.Database, satellitedb.Options{ApplicationName: "satellite-reencode"})
+	if err != nil {
+		return errs.New("Error starting master database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), reencodeCfg.Metainfo.DatabaseURL, metabase.Config{
+		ApplicationName:  "satellite-reencode",
+		MinPartSize:      reencodeCfg.Config.Metainfo.MinPartSize,
+		MaxNumberOfParts: reencodeCfg.Config.Metainfo.MaxNumberOfParts,
+	})
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	revocationDB, err := revocation.OpenDBFromCfg(ctx, reencodeCfg.Server.Config)
+	if err != nil {
+		return errs.New("Error creating revocation database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, revocationDB.Close())
+	}()
+
+	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), reencodeCfg.Orders.FlushBatchSize)
+	defer func() {
+		err = errs.Combine(err, rollupsWriteCache.CloseAndFlush(context2.WithoutCancellation(ctx)))
+	}()
+
+	peer, err := satellite.NewRepairer(
+		log,
+		identity,
+		metabaseDB,
+		revocationDB,
+		db.RepairQueue(),
+		db.NodeEvacuations(),
+		db.Buckets(),
+		db.OverlayCache(),
+		db.Reputation(),
+		db.Containment(),
+		rollupsWriteCache,
+		version.Build,
+		&reencodeCfg.Config,
+		process.AtomicLevel(cmd),
+	)
+	if err != nil {
+		return err
+	}
+
+	reencoder, err := repairer.NewReencoder(
+		log.Named("reencoder"),
+		metabaseDB,
+		peer.Orders.Service,
+		peer.Overlay,
+		peer.EcRepairer,
+		reencodeCfg.Reencode,
+		reencodeCfg.Repairer.Timeout,
+		reencodeCfg.Repairer.MaxExcessRateOptimalThreshold,
+	)
+	if err != nil {
+		return err
+	}
+
+	stats, runErr := reencoder.Run(ctx)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return errs.Combine(runErr, encoder.Encode(stats))
+}
@@ -71,6 +71,7 @@ func cmdRepairerRun(cmd *cobra.Command, args []string) (err error) {
 		metabaseDB,
 		revocationDB,
 		db.RepairQueue(),
+		db.NodeEvacuations(),
 		db.Buckets(),
 		db.OverlayCache(),
 		db.Reputation(),
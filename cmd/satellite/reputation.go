@@ -0,0 +1,286 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/private/process"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// auditOutcomes are the names of the audit outcomes in the events files.
+var auditOutcomes = map[string]reputation.AuditType{
+	"success":           reputation.AuditSuccess,
+	"failure":           reputation.AuditFailure,
+	"unknown":           reputation.AuditUnknown,
+	"offline":           reputation.AuditOffline,
+	"existence-success": reputation.AuditExistenceSuccess,
+	"existence-failure": reputation.AuditExistenceFailure,
+}
+
+func auditOutcomeName(outcome reputation.AuditType) string {
+	for name, auditType := range auditOutcomes {
+		if auditType == outcome {
+			return name
+		}
+	}
+	return outcome.String()
+}
+
+func disqualificationReasonName(reason overlay.DisqualificationReason) string {
+	switch reason {
+	case overlay.DisqualificationReasonAuditFailure:
+		return "audit-failure"
+	case overlay.DisqualificationReasonSuspension:
+		return "suspension"
+	case overlay.DisqualificationReasonNodeOffline:
+		return "node-offline"
+	}
+	return "unknown"
+}
+
+// simulatedNodeResult is a row of the simulation report.
+type simulatedNodeResult struct {
+	Variant                  string     `json:"variant"`
+	Node                     string     `json:"node"`
+	Audits                   int        `json:"audits"`
+	VettedAt                 *time.Time `json:"vettedAt"`
+	UnknownAuditSuspendedAt  *time.Time `json:"unknownAuditSuspendedAt"`
+	OfflineSuspendedAt       *time.Time `json:"offlineSuspendedAt"`
+	UnderReviewAt            *time.Time `json:"underReviewAt"`
+	DisqualifiedAt           *time.Time `json:"disqualifiedAt"`
+	DisqualificationReason   string     `json:"disqualificationReason,omitempty"`
+	AuditScore               float64    `json:"auditScore"`
+	UnknownAuditScore        float64    `json:"unknownAuditScore"`
+	OnlineScore              float64    `json:"onlineScore"`
+	UnknownAuditSuspendedNow bool       `json:"unknownAuditSuspendedNow"`
+	OfflineSuspendedNow      bool       `json:"offlineSuspendedNow"`
+}
+
+func cmdReputationSimulate(cmd *cobra.Command, args []string) (err error) {
+	variants, err := reputation.ParseSimulationVariants(reputationSimulateCfg.Reputation, reputationSimulateCfg.Variants)
+	if err != nil {
+		return err
+	}
+
+	var audits []reputation.SimulatedAudit
+	if reputationSimulateCfg.Events != "" {
+		audits, err = readAuditEvents(reputationSimulateCfg.Events)
+	} else {
+		start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -reputationSimulateCfg.Synthetic.Days)
+		audits, err = reputation.SyntheticAudits(reputationSimulateCfg.Synthetic, start)
+	}
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(audits, func(i, k int) bool {
+		return audits[i].Time.Before(audits[k].Time)
+	})
+
+	var results []simulatedNodeResult
+	for _, variant := range variants {
+		sim := reputation.NewSimulator(variant.Config)
+		for _, audit := range audits {
+			if err := sim.Apply(audit); err != nil {
+				return err
+			}
+		}
+		for _, node := range sim.Nodes() {
+			result := simulatedNodeResult{
+				Variant:                  variant.Name,
+				Node:                     node.Node,
+				Audits:                   node.Audits,
+				VettedAt:                 node.VettedAt,
+				UnknownAuditSuspendedAt:  node.FirstUnknownAuditSuspended,
+				OfflineSuspendedAt:       node.FirstOfflineSuspended,
+				UnderReviewAt:            node.FirstUnderReview,
+				DisqualifiedAt:           node.Disqualified,
+				AuditScore:               node.AuditReputationAlpha / (node.AuditReputationAlpha + node.AuditReputationBeta),
+				UnknownAuditScore:        node.UnknownAuditReputationAlpha / (node.UnknownAuditReputationAlpha + node.UnknownAuditReputationBeta),
+				OnlineScore:              node.OnlineScore,
+				UnknownAuditSuspendedNow: node.UnknownAuditSuspended != nil,
+				OfflineSuspendedNow:      node.OfflineSuspended != nil,
+			}
+			if node.Disqualified != nil {
+				result.DisqualificationReason = disqualificationReasonName(node.DisqualificationReason)
+			}
+			results = append(results, result)
+		}
+	}
+
+	return runWithOutput(reputationSimulateCfg.Output, func(w io.Writer) error {
+		switch reputationSimulateCfg.Format {
+		case "json":
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "\t")
+			return enc.Encode(results)
+		case "csv":
+			return writeSimulationCSV(w, results)
+		default:
+			return errs.New("unknown format %q", reputationSimulateCfg.Format)
+		}
+	})
+}
+
+func writeSimulationCSV(w io.Writer, results []simulatedNodeResult) error {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	formatFloat := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 6, 64)
+	}
+
+	c := csv.NewWriter(w)
+	if err := c.Write([]string{
+		"variant", "node", "audits", "vetted_at",
+		"unknown_audit_suspended_at", "offline_suspended_at", "under_review_at",
+		"disqualified_at", "disqualification_reason",
+		"audit_score", "unknown_audit_score", "online_score",
+		"unknown_audit_suspended_now", "offline_suspended_now",
+	}); err != nil {
+		return err
+	}
+	for _, result := range results {
+		if err := c.Write([]string{
+			result.Variant, result.Node, strconv.Itoa(result.Audits), formatTime(result.VettedAt),
+			formatTime(result.UnknownAuditSuspendedAt), formatTime(result.OfflineSuspendedAt), formatTime(result.UnderReviewAt),
+			formatTime(result.DisqualifiedAt), result.DisqualificationReason,
+			formatFloat(result.AuditScore), formatFloat(result.UnknownAuditScore), formatFloat(result.OnlineScore),
+			strconv.FormatBool(result.UnknownAuditSuspendedNow), strconv.FormatBool(result.OfflineSuspendedNow),
+		}); err != nil {
+			return err
+		}
+	}
+	c.Flush()
+	return c.Error()
+}
+
+// readAuditEvents reads audit events from a CSV file with node, time and
+// outcome columns. A header row is skipped.
+func readAuditEvents(path string) (_ []reputation.SimulatedAudit, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errs.New("unable to open events file: %v", err)
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	r := csv.NewReader(file)
+	r.FieldsPerRecord = 3
+	r.TrimLeadingSpace = true
+
+	var audits []reputation.SimulatedAudit
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if errs.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errs.New("unable to read events file: %v", err)
+		}
+		if line == 1 && record[0] == "node" {
+			continue
+		}
+
+		auditTime, err := time.Parse(time.RFC3339, record[1])
+		if err != nil {
+			return nil, errs.New("line %d: invalid time: %v", line, err)
+		}
+		outcome, ok := auditOutcomes[strings.ToLower(record[2])]
+		if !ok {
+			return nil, errs.New("line %d: unknown outcome %q", line, record[2])
+		}
+		audits = append(audits, reputation.SimulatedAudit{
+			Node:    record[0],
+			Time:    auditTime,
+			Outcome: outcome,
+		})
+	}
+	return audits, nil
+}
+
+// cmdReputationExport writes the audit history of the nodes as events which
+// can be replayed by the simulate command. The audit history only keeps the
+// number of online and offline audits per window for the tracking period, so
+// the audits are spread evenly within every window and the nodes which were
+// online are recorded as successful.
+func cmdReputationExport(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	nodeIDs := make([]storj.NodeID, 0, len(args))
+	for _, arg := range args {
+		nodeID, err := storj.NodeIDFromString(arg)
+		if err != nil {
+			return errs.New("invalid node id %q: %v", arg, err)
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), reputationExportCfg.Database, satellitedb.Options{ApplicationName: "satellite-reputation"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	windowSize := reputationExportCfg.Reputation.AuditHistory.WindowSize
+
+	return runWithOutput(reputationExportCfg.Output, func(w io.Writer) error {
+		c := csv.NewWriter(w)
+		if err := c.Write([]string{"node", "time", "outcome"}); err != nil {
+			return err
+		}
+		for _, nodeID := range nodeIDs {
+			info, err := db.Reputation().Get(ctx, nodeID)
+			if err != nil {
+				return errs.New("unable to get reputation of %v: %v", nodeID, err)
+			}
+			if info.AuditHistory == nil {
+				continue
+			}
+			for _, window := range info.AuditHistory.Windows {
+				if window.TotalCount <= 0 {
+					continue
+				}
+				offline := window.TotalCount - window.OnlineCount
+				interval := windowSize / time.Duration(window.TotalCount)
+				for i := int32(0); i < window.TotalCount; i++ {
+					// interleave the offline audits with the online ones.
+					outcome := reputation.AuditSuccess
+					if (i+1)*offline/window.TotalCount > i*offline/window.TotalCount {
+						outcome = reputation.AuditOffline
+					}
+					if err := c.Write([]string{
+						nodeID.String(),
+						window.WindowStart.Add(time.Duration(i) * interval).UTC().Format(time.RFC3339),
+						auditOutcomeName(outcome),
+					}); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		c.Flush()
+		return c.Error()
+	})
+}
@@ -0,0 +1,9 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package metainfoextpb contains protobuf definitions for the metainfo
+// requests, which need fields that the common protobuf definitions don't
+// have yet.
+package metainfoextpb
+
+//go:generate go run gen.go
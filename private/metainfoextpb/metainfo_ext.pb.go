@@ -0,0 +1,1002 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: metainfo_ext.proto
+
+package metainfoextpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ObjectTag struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectTag) Reset()         { *m = ObjectTag{} }
+func (m *ObjectTag) String() string { return proto.CompactTextString(m) }
+func (*ObjectTag) ProtoMessage()    {}
+func (*ObjectTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{0}
+}
+func (m *ObjectTag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectTag.Unmarshal(m, b)
+}
+func (m *ObjectTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectTag.Marshal(b, m, deterministic)
+}
+func (m *ObjectTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectTag.Merge(m, src)
+}
+func (m *ObjectTag) XXX_Size() int {
+	return xxx_messageInfo_ObjectTag.Size(m)
+}
+func (m *ObjectTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectTag proto.InternalMessageInfo
+
+func (m *ObjectTag) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ObjectTag) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// ObjectTags are the unencrypted tags of an object. The keys must be unique.
+type ObjectTags struct {
+	Tags                 []*ObjectTag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ObjectTags) Reset()         { *m = ObjectTags{} }
+func (m *ObjectTags) String() string { return proto.CompactTextString(m) }
+func (*ObjectTags) ProtoMessage()    {}
+func (*ObjectTags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{1}
+}
+func (m *ObjectTags) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectTags.Unmarshal(m, b)
+}
+func (m *ObjectTags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectTags.Marshal(b, m, deterministic)
+}
+func (m *ObjectTags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectTags.Merge(m, src)
+}
+func (m *ObjectTags) XXX_Size() int {
+	return xxx_messageInfo_ObjectTags.Size(m)
+}
+func (m *ObjectTags) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectTags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectTags proto.InternalMessageInfo
+
+func (m *ObjectTags) GetTags() []*ObjectTag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ObjectCommitRequest struct {
+	Request *pb.ObjectCommitRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// tags of the committed object.
+	Tags                 *ObjectTags `protobuf:"bytes,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ObjectCommitRequest) Reset()         { *m = ObjectCommitRequest{} }
+func (m *ObjectCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectCommitRequest) ProtoMessage()    {}
+func (*ObjectCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{2}
+}
+func (m *ObjectCommitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectCommitRequest.Unmarshal(m, b)
+}
+func (m *ObjectCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectCommitRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectCommitRequest.Merge(m, src)
+}
+func (m *ObjectCommitRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectCommitRequest.Size(m)
+}
+func (m *ObjectCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectCommitRequest proto.InternalMessageInfo
+
+func (m *ObjectCommitRequest) GetRequest() *pb.ObjectCommitRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ObjectCommitRequest) GetTags() *ObjectTags {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ObjectUpdateMetadataRequest struct {
+	Request *pb.ObjectUpdateMetadataRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// tags replace the tags of the object. The tags of the object are kept,
+	// when they are missing, and removed, when they are empty.
+	Tags                 *ObjectTags `protobuf:"bytes,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ObjectUpdateMetadataRequest) Reset()         { *m = ObjectUpdateMetadataRequest{} }
+func (m *ObjectUpdateMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectUpdateMetadataRequest) ProtoMessage()    {}
+func (*ObjectUpdateMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{3}
+}
+func (m *ObjectUpdateMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectUpdateMetadataRequest.Unmarshal(m, b)
+}
+func (m *ObjectUpdateMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectUpdateMetadataRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectUpdateMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectUpdateMetadataRequest.Merge(m, src)
+}
+func (m *ObjectUpdateMetadataRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectUpdateMetadataRequest.Size(m)
+}
+func (m *ObjectUpdateMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectUpdateMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectUpdateMetadataRequest proto.InternalMessageInfo
+
+func (m *ObjectUpdateMetadataRequest) GetRequest() *pb.ObjectUpdateMetadataRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ObjectUpdateMetadataRequest) GetTags() *ObjectTags {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ObjectGetResponse struct {
+	Response             *pb.ObjectGetResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Tags                 *ObjectTags           `protobuf:"bytes,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ObjectGetResponse) Reset()         { *m = ObjectGetResponse{} }
+func (m *ObjectGetResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectGetResponse) ProtoMessage()    {}
+func (*ObjectGetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{4}
+}
+func (m *ObjectGetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectGetResponse.Unmarshal(m, b)
+}
+func (m *ObjectGetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectGetResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectGetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectGetResponse.Merge(m, src)
+}
+func (m *ObjectGetResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectGetResponse.Size(m)
+}
+func (m *ObjectGetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectGetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectGetResponse proto.InternalMessageInfo
+
+func (m *ObjectGetResponse) GetResponse() *pb.ObjectGetResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *ObjectGetResponse) GetTags() *ObjectTags {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ObjectListRequest struct {
+	Request *pb.ObjectListRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// tag_filter limits the listing to the objects, which have all the tags.
+	// The filter requires an encrypted prefix, because the tags aren't
+	// indexed.
+	TagFilter            *ObjectTags        `protobuf:"bytes,2,opt,name=tag_filter,json=tagFilter,proto3" json:"tag_filter,omitempty"`
+	Options              *ObjectListOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ObjectListRequest) Reset()         { *m = ObjectListRequest{} }
+func (m *ObjectListRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectListRequest) ProtoMessage()    {}
+func (*ObjectListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{5}
+}
+func (m *ObjectListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListRequest.Unmarshal(m, b)
+}
+func (m *ObjectListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListRequest.Merge(m, src)
+}
+func (m *ObjectListRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectListRequest.Size(m)
+}
+func (m *ObjectListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListRequest proto.InternalMessageInfo
+
+func (m *ObjectListRequest) GetRequest() *pb.ObjectListRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ObjectListRequest) GetTagFilter() *ObjectTags {
+	if m != nil {
+		return m.TagFilter
+	}
+	return nil
+}
+
+func (m *ObjectListRequest) GetOptions() *ObjectListOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// ObjectListOptions are the options of an object listing, which the common
+// list request doesn't have.
+type ObjectListOptions struct {
+	// start_after limits the listing to the keys after it. It's relative to
+	// the prefix, like the cursor.
+	StartAfter []byte `protobuf:"bytes,1,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	// delimiter separates the prefixes of a non-recursive listing. Zero value
+	// uses '/'. Delimiters other than '/' are useful only for unencrypted
+	// object keys, since the encryption keeps only '/'. 0xFF isn't a valid
+	// delimiter.
+	Delimiter uint32 `protobuf:"varint,2,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	// reverse lists the objects in descending order.
+	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectListOptions) Reset()         { *m = ObjectListOptions{} }
+func (m *ObjectListOptions) String() string { return proto.CompactTextString(m) }
+func (*ObjectListOptions) ProtoMessage()    {}
+func (*ObjectListOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{6}
+}
+func (m *ObjectListOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListOptions.Unmarshal(m, b)
+}
+func (m *ObjectListOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListOptions.Marshal(b, m, deterministic)
+}
+func (m *ObjectListOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListOptions.Merge(m, src)
+}
+func (m *ObjectListOptions) XXX_Size() int {
+	return xxx_messageInfo_ObjectListOptions.Size(m)
+}
+func (m *ObjectListOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListOptions proto.InternalMessageInfo
+
+func (m *ObjectListOptions) GetStartAfter() []byte {
+	if m != nil {
+		return m.StartAfter
+	}
+	return nil
+}
+
+func (m *ObjectListOptions) GetDelimiter() uint32 {
+	if m != nil {
+		return m.Delimiter
+	}
+	return 0
+}
+
+func (m *ObjectListOptions) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+type ObjectListItem struct {
+	Item *pb.ObjectListItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// tags are returned only with the custom metadata.
+	Tags                 *ObjectTags `protobuf:"bytes,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ObjectListItem) Reset()         { *m = ObjectListItem{} }
+func (m *ObjectListItem) String() string { return proto.CompactTextString(m) }
+func (*ObjectListItem) ProtoMessage()    {}
+func (*ObjectListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{7}
+}
+func (m *ObjectListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListItem.Unmarshal(m, b)
+}
+func (m *ObjectListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListItem.Marshal(b, m, deterministic)
+}
+func (m *ObjectListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListItem.Merge(m, src)
+}
+func (m *ObjectListItem) XXX_Size() int {
+	return xxx_messageInfo_ObjectListItem.Size(m)
+}
+func (m *ObjectListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListItem proto.InternalMessageInfo
+
+func (m *ObjectListItem) GetItem() *pb.ObjectListItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *ObjectListItem) GetTags() *ObjectTags {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ObjectListResponse struct {
+	Items                []*ObjectListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	More                 bool              `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectListResponse) Reset()         { *m = ObjectListResponse{} }
+func (m *ObjectListResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectListResponse) ProtoMessage()    {}
+func (*ObjectListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{8}
+}
+func (m *ObjectListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListResponse.Unmarshal(m, b)
+}
+func (m *ObjectListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListResponse.Merge(m, src)
+}
+func (m *ObjectListResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectListResponse.Size(m)
+}
+func (m *ObjectListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListResponse proto.InternalMessageInfo
+
+func (m *ObjectListResponse) GetItems() []*ObjectListItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ObjectListResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type BucketGetResponse struct {
+	Response             *pb.BucketGetResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Stats                *BucketStats          `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BucketGetResponse) Reset()         { *m = BucketGetResponse{} }
+func (m *BucketGetResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketGetResponse) ProtoMessage()    {}
+func (*BucketGetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{9}
+}
+func (m *BucketGetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketGetResponse.Unmarshal(m, b)
+}
+func (m *BucketGetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketGetResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketGetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketGetResponse.Merge(m, src)
+}
+func (m *BucketGetResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketGetResponse.Size(m)
+}
+func (m *BucketGetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketGetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketGetResponse proto.InternalMessageInfo
+
+func (m *BucketGetResponse) GetResponse() *pb.BucketGetResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *BucketGetResponse) GetStats() *BucketStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// BucketStats are the statistics of the committed and pending objects of a
+// bucket.
+type BucketStats struct {
+	ObjectCount          int64    `protobuf:"varint,1,opt,name=object_count,json=objectCount,proto3" json:"object_count,omitempty"`
+	PendingObjectCount   int64    `protobuf:"varint,2,opt,name=pending_object_count,json=pendingObjectCount,proto3" json:"pending_object_count,omitempty"`
+	SegmentCount         int64    `protobuf:"varint,3,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count,omitempty"`
+	TotalEncryptedSize   int64    `protobuf:"varint,4,opt,name=total_encrypted_size,json=totalEncryptedSize,proto3" json:"total_encrypted_size,omitempty"`
+	MetadataSize         int64    `protobuf:"varint,5,opt,name=metadata_size,json=metadataSize,proto3" json:"metadata_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketStats) Reset()         { *m = BucketStats{} }
+func (m *BucketStats) String() string { return proto.CompactTextString(m) }
+func (*BucketStats) ProtoMessage()    {}
+func (*BucketStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{10}
+}
+func (m *BucketStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketStats.Unmarshal(m, b)
+}
+func (m *BucketStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketStats.Marshal(b, m, deterministic)
+}
+func (m *BucketStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketStats.Merge(m, src)
+}
+func (m *BucketStats) XXX_Size() int {
+	return xxx_messageInfo_BucketStats.Size(m)
+}
+func (m *BucketStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketStats proto.InternalMessageInfo
+
+func (m *BucketStats) GetObjectCount() int64 {
+	if m != nil {
+		return m.ObjectCount
+	}
+	return 0
+}
+
+func (m *BucketStats) GetPendingObjectCount() int64 {
+	if m != nil {
+		return m.PendingObjectCount
+	}
+	return 0
+}
+
+func (m *BucketStats) GetSegmentCount() int64 {
+	if m != nil {
+		return m.SegmentCount
+	}
+	return 0
+}
+
+func (m *BucketStats) GetTotalEncryptedSize() int64 {
+	if m != nil {
+		return m.TotalEncryptedSize
+	}
+	return 0
+}
+
+func (m *BucketStats) GetMetadataSize() int64 {
+	if m != nil {
+		return m.MetadataSize
+	}
+	return 0
+}
+
+type BucketMoveRequest struct {
+	// header authorizes writing the new bucket.
+	Header *pb.RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// name of the new bucket.
+	Name       []byte `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SourceName []byte `protobuf:"bytes,3,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	// source_api_key authorizes reading, listing and deleting the source
+	// bucket. When it's empty, the API key of the header is used, and the
+	// bucket is renamed within the project.
+	SourceApiKey         []byte   `protobuf:"bytes,4,opt,name=source_api_key,json=sourceApiKey,proto3" json:"source_api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketMoveRequest) Reset()         { *m = BucketMoveRequest{} }
+func (m *BucketMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketMoveRequest) ProtoMessage()    {}
+func (*BucketMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{11}
+}
+func (m *BucketMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketMoveRequest.Unmarshal(m, b)
+}
+func (m *BucketMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketMoveRequest.Merge(m, src)
+}
+func (m *BucketMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketMoveRequest.Size(m)
+}
+func (m *BucketMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketMoveRequest proto.InternalMessageInfo
+
+func (m *BucketMoveRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BucketMoveRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketMoveRequest) GetSourceName() []byte {
+	if m != nil {
+		return m.SourceName
+	}
+	return nil
+}
+
+func (m *BucketMoveRequest) GetSourceApiKey() []byte {
+	if m != nil {
+		return m.SourceApiKey
+	}
+	return nil
+}
+
+type BucketMoveResponse struct {
+	Bucket               *pb.Bucket `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	MovedObjectCount     int64      `protobuf:"varint,2,opt,name=moved_object_count,json=movedObjectCount,proto3" json:"moved_object_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BucketMoveResponse) Reset()         { *m = BucketMoveResponse{} }
+func (m *BucketMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketMoveResponse) ProtoMessage()    {}
+func (*BucketMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{12}
+}
+func (m *BucketMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketMoveResponse.Unmarshal(m, b)
+}
+func (m *BucketMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketMoveResponse.Merge(m, src)
+}
+func (m *BucketMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketMoveResponse.Size(m)
+}
+func (m *BucketMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketMoveResponse proto.InternalMessageInfo
+
+func (m *BucketMoveResponse) GetBucket() *pb.Bucket {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *BucketMoveResponse) GetMovedObjectCount() int64 {
+	if m != nil {
+		return m.MovedObjectCount
+	}
+	return 0
+}
+
+type ObjectDeleteByPrefixRequest struct {
+	Header          *pb.RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket          []byte            `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPrefix []byte            `protobuf:"bytes,3,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	// limit is the maximum number of objects deleted by the request. Zero
+	// uses the limit of the satellite.
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectDeleteByPrefixRequest) Reset()         { *m = ObjectDeleteByPrefixRequest{} }
+func (m *ObjectDeleteByPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectDeleteByPrefixRequest) ProtoMessage()    {}
+func (*ObjectDeleteByPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{13}
+}
+func (m *ObjectDeleteByPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectDeleteByPrefixRequest.Unmarshal(m, b)
+}
+func (m *ObjectDeleteByPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectDeleteByPrefixRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectDeleteByPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectDeleteByPrefixRequest.Merge(m, src)
+}
+func (m *ObjectDeleteByPrefixRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectDeleteByPrefixRequest.Size(m)
+}
+func (m *ObjectDeleteByPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectDeleteByPrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectDeleteByPrefixRequest proto.InternalMessageInfo
+
+func (m *ObjectDeleteByPrefixRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectDeleteByPrefixRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectDeleteByPrefixRequest) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *ObjectDeleteByPrefixRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ObjectDeleteByPrefixResponse struct {
+	DeletedObjectCount  int64 `protobuf:"varint,1,opt,name=deleted_object_count,json=deletedObjectCount,proto3" json:"deleted_object_count,omitempty"`
+	DeletedSegmentCount int64 `protobuf:"varint,2,opt,name=deleted_segment_count,json=deletedSegmentCount,proto3" json:"deleted_segment_count,omitempty"`
+	// more is set when there are objects left, which need to be deleted with
+	// another request.
+	More                 bool     `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectDeleteByPrefixResponse) Reset()         { *m = ObjectDeleteByPrefixResponse{} }
+func (m *ObjectDeleteByPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectDeleteByPrefixResponse) ProtoMessage()    {}
+func (*ObjectDeleteByPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{14}
+}
+func (m *ObjectDeleteByPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectDeleteByPrefixResponse.Unmarshal(m, b)
+}
+func (m *ObjectDeleteByPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectDeleteByPrefixResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectDeleteByPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectDeleteByPrefixResponse.Merge(m, src)
+}
+func (m *ObjectDeleteByPrefixResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectDeleteByPrefixResponse.Size(m)
+}
+func (m *ObjectDeleteByPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectDeleteByPrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectDeleteByPrefixResponse proto.InternalMessageInfo
+
+func (m *ObjectDeleteByPrefixResponse) GetDeletedObjectCount() int64 {
+	if m != nil {
+		return m.DeletedObjectCount
+	}
+	return 0
+}
+
+func (m *ObjectDeleteByPrefixResponse) GetDeletedSegmentCount() int64 {
+	if m != nil {
+		return m.DeletedSegmentCount
+	}
+	return 0
+}
+
+func (m *ObjectDeleteByPrefixResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ObjectBeginCopyRequest struct {
+	Request *pb.ObjectBeginCopyRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// source_api_key authorizes reading the source object in another
+	// project. The API key of the request must allow writing the copy, and
+	// its project is charged for the copy. When it's empty, the object is
+	// copied within the project of the request.
+	SourceApiKey         []byte   `protobuf:"bytes,2,opt,name=source_api_key,json=sourceApiKey,proto3" json:"source_api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectBeginCopyRequest) Reset()         { *m = ObjectBeginCopyRequest{} }
+func (m *ObjectBeginCopyRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginCopyRequest) ProtoMessage()    {}
+func (*ObjectBeginCopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{15}
+}
+func (m *ObjectBeginCopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginCopyRequest.Unmarshal(m, b)
+}
+func (m *ObjectBeginCopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginCopyRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginCopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginCopyRequest.Merge(m, src)
+}
+func (m *ObjectBeginCopyRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginCopyRequest.Size(m)
+}
+func (m *ObjectBeginCopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginCopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginCopyRequest proto.InternalMessageInfo
+
+func (m *ObjectBeginCopyRequest) GetRequest() *pb.ObjectBeginCopyRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyRequest) GetSourceApiKey() []byte {
+	if m != nil {
+		return m.SourceApiKey
+	}
+	return nil
+}
+
+type ObjectFinishCopyRequest struct {
+	Request *pb.ObjectFinishCopyRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// source_api_key must be the same as in the begin copy request.
+	SourceApiKey         []byte   `protobuf:"bytes,2,opt,name=source_api_key,json=sourceApiKey,proto3" json:"source_api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectFinishCopyRequest) Reset()         { *m = ObjectFinishCopyRequest{} }
+func (m *ObjectFinishCopyRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishCopyRequest) ProtoMessage()    {}
+func (*ObjectFinishCopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{16}
+}
+func (m *ObjectFinishCopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishCopyRequest.Unmarshal(m, b)
+}
+func (m *ObjectFinishCopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishCopyRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishCopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishCopyRequest.Merge(m, src)
+}
+func (m *ObjectFinishCopyRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishCopyRequest.Size(m)
+}
+func (m *ObjectFinishCopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishCopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishCopyRequest proto.InternalMessageInfo
+
+func (m *ObjectFinishCopyRequest) GetRequest() *pb.ObjectFinishCopyRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetSourceApiKey() []byte {
+	if m != nil {
+		return m.SourceApiKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ObjectTag)(nil), "metainfo_ext.ObjectTag")
+	proto.RegisterType((*ObjectTags)(nil), "metainfo_ext.ObjectTags")
+	proto.RegisterType((*ObjectCommitRequest)(nil), "metainfo_ext.ObjectCommitRequest")
+	proto.RegisterType((*ObjectUpdateMetadataRequest)(nil), "metainfo_ext.ObjectUpdateMetadataRequest")
+	proto.RegisterType((*ObjectGetResponse)(nil), "metainfo_ext.ObjectGetResponse")
+	proto.RegisterType((*ObjectListRequest)(nil), "metainfo_ext.ObjectListRequest")
+	proto.RegisterType((*ObjectListOptions)(nil), "metainfo_ext.ObjectListOptions")
+	proto.RegisterType((*ObjectListItem)(nil), "metainfo_ext.ObjectListItem")
+	proto.RegisterType((*ObjectListResponse)(nil), "metainfo_ext.ObjectListResponse")
+	proto.RegisterType((*BucketGetResponse)(nil), "metainfo_ext.BucketGetResponse")
+	proto.RegisterType((*BucketStats)(nil), "metainfo_ext.BucketStats")
+	proto.RegisterType((*BucketMoveRequest)(nil), "metainfo_ext.BucketMoveRequest")
+	proto.RegisterType((*BucketMoveResponse)(nil), "metainfo_ext.BucketMoveResponse")
+	proto.RegisterType((*ObjectDeleteByPrefixRequest)(nil), "metainfo_ext.ObjectDeleteByPrefixRequest")
+	proto.RegisterType((*ObjectDeleteByPrefixResponse)(nil), "metainfo_ext.ObjectDeleteByPrefixResponse")
+	proto.RegisterType((*ObjectBeginCopyRequest)(nil), "metainfo_ext.ObjectBeginCopyRequest")
+	proto.RegisterType((*ObjectFinishCopyRequest)(nil), "metainfo_ext.ObjectFinishCopyRequest")
+}
+
+func init() { proto.RegisterFile("metainfo_ext.proto", fileDescriptor_d8cdca9bebb3074f) }
+
+var fileDescriptor_d8cdca9bebb3074f = []byte{
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0x8e, 0x62, 0x27, 0x8d, 0x8f, 0xdd, 0xd4, 0xd9, 0xa6, 0xad, 0x71, 0x03, 0x75, 0x44, 0xda,
+	0x49, 0xa1, 0x63, 0x77, 0xdc, 0x61, 0x32, 0x85, 0x0b, 0xa6, 0x29, 0x6d, 0xf9, 0x0b, 0x01, 0x05,
+	0x6e, 0x98, 0xce, 0x68, 0x36, 0xf6, 0x89, 0xbb, 0x8d, 0xa5, 0x15, 0xd2, 0xda, 0x13, 0x67, 0xca,
+	0x1b, 0xf0, 0x00, 0xdc, 0x71, 0xc9, 0x5b, 0xc0, 0x9b, 0xf0, 0x2c, 0x8c, 0xf6, 0x47, 0x5e, 0xcb,
+	0x72, 0xdb, 0xe4, 0x6e, 0x7d, 0xce, 0x77, 0xce, 0xf9, 0xf6, 0xfc, 0xad, 0x0c, 0x24, 0x40, 0x41,
+	0x59, 0x78, 0xc2, 0x7d, 0x3c, 0x13, 0xed, 0x28, 0xe6, 0x82, 0x93, 0x9a, 0x2d, 0x6b, 0xae, 0x9b,
+	0x5f, 0x4a, 0xeb, 0x3e, 0x82, 0xca, 0xe1, 0xf1, 0x6b, 0xec, 0x89, 0x9f, 0xe9, 0x80, 0xd4, 0xa1,
+	0x74, 0x8a, 0x93, 0x86, 0xd3, 0x72, 0x76, 0x2b, 0x5e, 0x7a, 0x24, 0x9b, 0xb0, 0x32, 0xa6, 0xc3,
+	0x11, 0x36, 0x96, 0xa5, 0x4c, 0xfd, 0x70, 0x1f, 0x03, 0x64, 0x46, 0x09, 0xf9, 0x14, 0xca, 0x82,
+	0x0e, 0x92, 0x86, 0xd3, 0x2a, 0xed, 0x56, 0xbb, 0xb7, 0xda, 0x33, 0x1c, 0x32, 0x9c, 0x27, 0x41,
+	0xee, 0x1b, 0xb8, 0xae, 0x44, 0x4f, 0x79, 0x10, 0x30, 0xe1, 0xe1, 0x6f, 0x23, 0x4c, 0x04, 0xd9,
+	0x83, 0x2b, 0xb1, 0x3a, 0xca, 0xe8, 0xd5, 0xee, 0x87, 0x99, 0x9b, 0x76, 0x01, 0xde, 0x33, 0x68,
+	0xf2, 0x40, 0x07, 0x5f, 0x96, 0x56, 0x8d, 0x05, 0xc1, 0x13, 0x1d, 0xfd, 0x0f, 0x07, 0x6e, 0x2b,
+	0xe1, 0x2f, 0x51, 0x9f, 0x0a, 0x3c, 0x40, 0x41, 0xfb, 0x54, 0x50, 0x43, 0xe3, 0xcb, 0x3c, 0x8d,
+	0xbb, 0x79, 0x1a, 0x85, 0x76, 0x97, 0xa5, 0x73, 0x0e, 0x1b, 0x4a, 0xf6, 0x02, 0x85, 0x87, 0x49,
+	0xc4, 0xc3, 0x04, 0xc9, 0x1e, 0xac, 0xc5, 0xfa, 0xac, 0x49, 0xdc, 0xce, 0x93, 0xb0, 0xe0, 0x5e,
+	0x06, 0xbe, 0x60, 0xec, 0x7f, 0x1c, 0x13, 0xfc, 0x7b, 0x96, 0x64, 0x75, 0xf8, 0x2c, 0x9f, 0x80,
+	0xb9, 0xd8, 0x16, 0x7a, 0x7a, 0xed, 0x3d, 0x00, 0x41, 0x07, 0xfe, 0x09, 0x1b, 0x0a, 0x8c, 0xdf,
+	0x49, 0xa0, 0x22, 0xe8, 0xe0, 0xb9, 0x84, 0x92, 0xc7, 0x70, 0x85, 0x47, 0x82, 0xf1, 0x30, 0x69,
+	0x94, 0xa4, 0xd5, 0x9d, 0x22, 0xab, 0x34, 0xe6, 0xa1, 0x82, 0x79, 0x06, 0xef, 0x0e, 0x61, 0x63,
+	0x4e, 0x4b, 0xee, 0x40, 0x35, 0x11, 0x34, 0x16, 0x3e, 0x3d, 0x49, 0x99, 0xa4, 0x77, 0xa8, 0x79,
+	0x20, 0x45, 0x4f, 0x52, 0x09, 0xd9, 0x82, 0x4a, 0x1f, 0x87, 0x2c, 0x60, 0x86, 0xe8, 0x55, 0x6f,
+	0x2a, 0x20, 0x8d, 0xf4, 0xfa, 0x63, 0x8c, 0x13, 0x94, 0x74, 0xd6, 0x3c, 0xf3, 0xd3, 0x1d, 0xc2,
+	0xfa, 0x34, 0xda, 0x37, 0x02, 0x83, 0x34, 0xdd, 0x4c, 0x60, 0xd0, 0x70, 0x72, 0xb7, 0x6d, 0xcf,
+	0xe2, 0xbc, 0x32, 0xd3, 0xe8, 0x0b, 0x14, 0xe7, 0x25, 0x10, 0x3b, 0xdb, 0xba, 0xc0, 0x5d, 0x58,
+	0x49, 0x7d, 0x99, 0x49, 0xdb, 0x5a, 0x94, 0x2a, 0x19, 0x56, 0x41, 0x09, 0x81, 0x72, 0xc0, 0x63,
+	0x35, 0xbf, 0x6b, 0x9e, 0x3c, 0xbb, 0xbf, 0xc3, 0xc6, 0xfe, 0xa8, 0x77, 0x8a, 0xef, 0xdf, 0x76,
+	0x73, 0x70, 0xab, 0xed, 0x3a, 0xb0, 0x92, 0x08, 0x2a, 0xcc, 0xd5, 0x3e, 0x98, 0x65, 0xa5, 0x2c,
+	0x8f, 0x52, 0x80, 0xa7, 0x70, 0xee, 0x7f, 0x0e, 0x54, 0x2d, 0x31, 0xd9, 0x86, 0x1a, 0x97, 0xdc,
+	0xfd, 0x1e, 0x1f, 0x85, 0xaa, 0xf1, 0x4a, 0x5e, 0x95, 0xeb, 0xb1, 0x1f, 0x85, 0x82, 0x3c, 0x84,
+	0xcd, 0x08, 0xc3, 0x3e, 0x0b, 0x07, 0xfe, 0x0c, 0x74, 0x59, 0x42, 0x89, 0xd6, 0x1d, 0x5a, 0x16,
+	0x1f, 0xc3, 0xd5, 0x04, 0x07, 0x01, 0x86, 0x06, 0x5a, 0x92, 0xd0, 0x9a, 0x16, 0x66, 0x6e, 0x05,
+	0x17, 0x74, 0xe8, 0x63, 0xd8, 0x8b, 0x27, 0x91, 0xc0, 0xbe, 0x9f, 0xb0, 0x73, 0x6c, 0x94, 0x95,
+	0x5b, 0xa9, 0x7b, 0x66, 0x54, 0x47, 0xec, 0x1c, 0x53, 0xb7, 0x81, 0x9e, 0x7d, 0x05, 0x5d, 0x51,
+	0x6e, 0x8d, 0x30, 0x05, 0xb9, 0x7f, 0x39, 0x26, 0xc1, 0x07, 0x7c, 0x8c, 0x66, 0xb4, 0x3a, 0xb0,
+	0xfa, 0x0a, 0x69, 0x5f, 0x77, 0xa5, 0xbd, 0x28, 0xdb, 0x1a, 0xf2, 0xb5, 0x54, 0x7b, 0x1a, 0x96,
+	0x96, 0x2e, 0xa4, 0x81, 0x2a, 0x5d, 0xcd, 0x93, 0x67, 0xd9, 0xdf, 0x7c, 0x14, 0xf7, 0xd0, 0x97,
+	0xaa, 0x92, 0xee, 0x6f, 0x29, 0xfa, 0x21, 0x05, 0xec, 0xc0, 0xba, 0x06, 0xd0, 0x88, 0xf9, 0xe9,
+	0x36, 0x2f, 0x4b, 0x4c, 0x4d, 0x49, 0x9f, 0x44, 0xec, 0x3b, 0x9c, 0xb8, 0x43, 0x20, 0x36, 0x41,
+	0x5d, 0xc9, 0x5d, 0x58, 0x3d, 0x96, 0x52, 0xcd, 0xb0, 0x9e, 0x6f, 0x00, 0x4f, 0xeb, 0xc9, 0x03,
+	0x20, 0x01, 0x1f, 0x63, 0xbf, 0xa8, 0x1a, 0x75, 0xa9, 0xb1, 0x6a, 0xe1, 0xfe, 0x9d, 0x6d, 0xdd,
+	0xaf, 0x70, 0x88, 0x02, 0xf7, 0x27, 0x3f, 0xc6, 0x78, 0xc2, 0xce, 0x2e, 0x9d, 0x99, 0x9b, 0x19,
+	0x51, 0x95, 0x1b, 0x43, 0xeb, 0x3e, 0xd4, 0xa7, 0x95, 0x8c, 0x64, 0x0c, 0x9d, 0xa2, 0x6b, 0x99,
+	0x5c, 0x85, 0x4e, 0x1f, 0x36, 0x39, 0xf4, 0xba, 0xd6, 0xea, 0x87, 0xfb, 0xa7, 0x03, 0x5b, 0xc5,
+	0x4c, 0x75, 0x8a, 0x1e, 0xc2, 0x66, 0x5f, 0x6a, 0x72, 0x57, 0x57, 0x3d, 0x4b, 0xb4, 0xce, 0x6e,
+	0xc4, 0x2e, 0xdc, 0x30, 0x16, 0xb3, 0x0d, 0xa9, 0xb2, 0x75, 0x5d, 0x2b, 0x8f, 0xec, 0xbe, 0x34,
+	0x43, 0x5b, 0xb2, 0x86, 0xf6, 0x1c, 0x6e, 0x2a, 0xb7, 0xfb, 0x38, 0x60, 0xe1, 0x53, 0x1e, 0x4d,
+	0x4c, 0xfa, 0x3e, 0xcf, 0xef, 0xec, 0x56, 0x7e, 0x17, 0xe5, 0x4d, 0xa6, 0x8b, 0x7b, 0xbe, 0x5d,
+	0x96, 0x0b, 0xda, 0xe5, 0x0d, 0xdc, 0x52, 0x8e, 0x9e, 0xb3, 0x90, 0x25, 0xaf, 0xec, 0xe0, 0x5f,
+	0xe4, 0x83, 0x6f, 0xe7, 0x83, 0xcf, 0xd9, 0x5c, 0x30, 0x7a, 0xf7, 0xdf, 0x55, 0xa8, 0x1e, 0x68,
+	0x9f, 0xcf, 0xce, 0x04, 0x39, 0x82, 0x9a, 0xfa, 0x18, 0x50, 0xfe, 0xc9, 0x76, 0xd1, 0x1e, 0x9c,
+	0xf9, 0x5c, 0x68, 0x7e, 0xb4, 0xe8, 0x6b, 0x42, 0x95, 0xd5, 0x5d, 0x22, 0xa7, 0xb0, 0xa9, 0x9e,
+	0x76, 0xa5, 0x37, 0x0f, 0x3c, 0xb9, 0x5f, 0xe4, 0xbc, 0xf0, 0x23, 0xa0, 0x79, 0xef, 0x5d, 0xdf,
+	0x0a, 0x59, 0xb0, 0x6f, 0xa1, 0xf2, 0x02, 0x0d, 0xfd, 0x66, 0xe1, 0xeb, 0xae, 0x5c, 0x16, 0xbe,
+	0x86, 0xd6, 0x1a, 0x76, 0x97, 0x88, 0x07, 0x55, 0xf9, 0x00, 0x4a, 0x55, 0x42, 0x16, 0xbe, 0x9f,
+	0xc6, 0x65, 0x6b, 0x31, 0x20, 0xc7, 0x4f, 0xcd, 0xbc, 0xcd, 0xcf, 0x7a, 0x06, 0x0a, 0xf9, 0xcd,
+	0x3d, 0x13, 0xee, 0x12, 0xf9, 0x09, 0x20, 0x5d, 0x32, 0xda, 0x59, 0xa1, 0x81, 0xb5, 0x25, 0x9b,
+	0xad, 0xc5, 0x80, 0xcc, 0x65, 0x04, 0x37, 0xd4, 0x78, 0xea, 0x4b, 0x9b, 0x29, 0x2d, 0x2e, 0x56,
+	0xe1, 0xce, 0x69, 0x7e, 0xf2, 0x3e, 0xd0, 0x2c, 0xe2, 0x4b, 0xb8, 0x96, 0xcd, 0x90, 0x2e, 0xdb,
+	0x4e, 0x91, 0x83, 0xfc, 0xa0, 0x35, 0xb7, 0xdf, 0x32, 0x8a, 0x99, 0x77, 0x1f, 0xea, 0xd3, 0x21,
+	0xd1, 0xee, 0xef, 0x16, 0xb9, 0x9f, 0x1b, 0xa5, 0xa6, 0xfb, 0xb6, 0x69, 0x33, 0x01, 0xf6, 0xef,
+	0xfd, 0xba, 0x93, 0x08, 0x1e, 0xbf, 0x6e, 0x33, 0xde, 0x91, 0x87, 0x4e, 0x14, 0xb3, 0x31, 0x15,
+	0xd8, 0x31, 0xd6, 0x78, 0x26, 0xa2, 0xe3, 0xe3, 0x55, 0xf9, 0x9f, 0xe0, 0xd1, 0xff, 0x03, 0x00,
+	0x00, 0xca, 0xfc, 0x03, 0x47, 0x0c, 0x00, 0x00,
+}
@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.32
+// source: metainfo_ext.proto
+
+package metainfoextpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_metainfo_ext_proto struct{}
+
+func (drpcEncoding_File_metainfo_ext_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCMetainfoExtClient interface {
+	DRPCConn() drpc.Conn
+
+	CommitObject(ctx context.Context, in *ObjectCommitRequest) (*pb.ObjectCommitResponse, error)
+	UpdateObjectMetadata(ctx context.Context, in *ObjectUpdateMetadataRequest) (*pb.ObjectUpdateMetadataResponse, error)
+	GetObject(ctx context.Context, in *pb.ObjectGetRequest) (*ObjectGetResponse, error)
+	ListObjects(ctx context.Context, in *ObjectListRequest) (*ObjectListResponse, error)
+	GetBucket(ctx context.Context, in *pb.BucketGetRequest) (*BucketGetResponse, error)
+	MoveBucket(ctx context.Context, in *BucketMoveRequest) (*BucketMoveResponse, error)
+	DeleteObjectsByPrefix(ctx context.Context, in *ObjectDeleteByPrefixRequest) (*ObjectDeleteByPrefixResponse, error)
+	BeginCopyObject(ctx context.Context, in *ObjectBeginCopyRequest) (*pb.ObjectBeginCopyResponse, error)
+	FinishCopyObject(ctx context.Context, in *ObjectFinishCopyRequest) (*pb.ObjectFinishCopyResponse, error)
+}
+
+type drpcMetainfoExtClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCMetainfoExtClient(cc drpc.Conn) DRPCMetainfoExtClient {
+	return &drpcMetainfoExtClient{cc}
+}
+
+func (c *drpcMetainfoExtClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcMetainfoExtClient) CommitObject(ctx context.Context, in *ObjectCommitRequest) (*pb.ObjectCommitResponse, error) {
+	out := new(pb.ObjectCommitResponse)
+	err := c.cc.Invoke(ctx, "/metainfo_ext.MetainfoExt/CommitObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) UpdateObjectMetadata(ctx context.Context, in *ObjectUpdateMetadataRequest) (*pb.ObjectUpdateMetadataResponse, error) {
+	out := new(pb.ObjectUpdateMetadataResponse)
+	err := c.cc.Invoke(ctx, "/metainfo_ext.MetainfoExt/UpdateObjectMetadata", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) GetObject(ctx context.Context, in *pb.ObjectGetRequest) (*ObjectGetResponse, error) {
+	out := new(ObjectGetResponse)
+	err := c.cc.Invoke(ctx, "/metainfo_ext.MetainfoExt/GetObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) ListObjects(ctx context.Context, in *ObjectListRequest) (*ObjectListResponse, error) {
+	out := new(ObjectListResponse)
+	err := c.cc.Invoke(ctx, "/metainfo_ext.MetainfoExt/ListObjects", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) GetBucket(ctx context.Context, in *pb.BucketGetRequest) (*BucketGetResponse, error) {
+	out := new(BucketGetResponse)
+	err := c.cc.Invoke(ctx, "/metainfo_ext.MetainfoExt/GetBucket", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) MoveBucket(ctx context.Context, in *BucketMoveRequest) (*BucketMoveResponse, error) {
+	out := new(BucketMoveResponse)
+	err := c.cc.Invoke(ctx, "/metainfo_ext.MetainfoExt/MoveBucket", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) DeleteObjectsByPrefix(ctx context.Context, in *ObjectDeleteByPrefixRequest) (*ObjectDeleteByPrefixResponse, error) {
+	out := new(ObjectDeleteByPrefixResponse)
+	err := c.cc.Invoke(ctx, "/metainfo_ext.MetainfoExt/DeleteObjectsByPrefix", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) BeginCopyObject(ctx context.Context, in *ObjectBeginCopyRequest) (*pb.ObjectBeginCopyResponse, error) {
+	out := new(pb.ObjectBeginCopyResponse)
+	err := c.cc.Invoke(ctx, "/metainfo_ext.MetainfoExt/BeginCopyObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) FinishCopyObject(ctx context.Context, in *ObjectFinishCopyRequest) (*pb.ObjectFinishCopyResponse, error) {
+	out := new(pb.ObjectFinishCopyResponse)
+	err := c.cc.Invoke(ctx, "/metainfo_ext.MetainfoExt/FinishCopyObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCMetainfoExtServer interface {
+	CommitObject(context.Context, *ObjectCommitRequest) (*pb.ObjectCommitResponse, error)
+	UpdateObjectMetadata(context.Context, *ObjectUpdateMetadataRequest) (*pb.ObjectUpdateMetadataResponse, error)
+	GetObject(context.Context, *pb.ObjectGetRequest) (*ObjectGetResponse, error)
+	ListObjects(context.Context, *ObjectListRequest) (*ObjectListResponse, error)
+	GetBucket(context.Context, *pb.BucketGetRequest) (*BucketGetResponse, error)
+	MoveBucket(context.Context, *BucketMoveRequest) (*BucketMoveResponse, error)
+	DeleteObjectsByPrefix(context.Context, *ObjectDeleteByPrefixRequest) (*ObjectDeleteByPrefixResponse, error)
+	BeginCopyObject(context.Context, *ObjectBeginCopyRequest) (*pb.ObjectBeginCopyResponse, error)
+	FinishCopyObject(context.Context, *ObjectFinishCopyRequest) (*pb.ObjectFinishCopyResponse, error)
+}
+
+type DRPCMetainfoExtUnimplementedServer struct{}
+
+func (s *DRPCMetainfoExtUnimplementedServer) CommitObject(context.Context, *ObjectCommitRequest) (*pb.ObjectCommitResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) UpdateObjectMetadata(context.Context, *ObjectUpdateMetadataRequest) (*pb.ObjectUpdateMetadataResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) GetObject(context.Context, *pb.ObjectGetRequest) (*ObjectGetResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) ListObjects(context.Context, *ObjectListRequest) (*ObjectListResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) GetBucket(context.Context, *pb.BucketGetRequest) (*BucketGetResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) MoveBucket(context.Context, *BucketMoveRequest) (*BucketMoveResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) DeleteObjectsByPrefix(context.Context, *ObjectDeleteByPrefixRequest) (*ObjectDeleteByPrefixResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) BeginCopyObject(context.Context, *ObjectBeginCopyRequest) (*pb.ObjectBeginCopyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) FinishCopyObject(context.Context, *ObjectFinishCopyRequest) (*pb.ObjectFinishCopyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCMetainfoExtDescription struct{}
+
+func (DRPCMetainfoExtDescription) NumMethods() int { return 9 }
+
+func (DRPCMetainfoExtDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/metainfo_ext.MetainfoExt/CommitObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					CommitObject(
+						ctx,
+						in1.(*ObjectCommitRequest),
+					)
+			}, DRPCMetainfoExtServer.CommitObject, true
+	case 1:
+		return "/metainfo_ext.MetainfoExt/UpdateObjectMetadata", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					UpdateObjectMetadata(
+						ctx,
+						in1.(*ObjectUpdateMetadataRequest),
+					)
+			}, DRPCMetainfoExtServer.UpdateObjectMetadata, true
+	case 2:
+		return "/metainfo_ext.MetainfoExt/GetObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					GetObject(
+						ctx,
+						in1.(*pb.ObjectGetRequest),
+					)
+			}, DRPCMetainfoExtServer.GetObject, true
+	case 3:
+		return "/metainfo_ext.MetainfoExt/ListObjects", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					ListObjects(
+						ctx,
+						in1.(*ObjectListRequest),
+					)
+			}, DRPCMetainfoExtServer.ListObjects, true
+	case 4:
+		return "/metainfo_ext.MetainfoExt/GetBucket", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					GetBucket(
+						ctx,
+						in1.(*pb.BucketGetRequest),
+					)
+			}, DRPCMetainfoExtServer.GetBucket, true
+	case 5:
+		return "/metainfo_ext.MetainfoExt/MoveBucket", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					MoveBucket(
+						ctx,
+						in1.(*BucketMoveRequest),
+					)
+			}, DRPCMetainfoExtServer.MoveBucket, true
+	case 6:
+		return "/metainfo_ext.MetainfoExt/DeleteObjectsByPrefix", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					DeleteObjectsByPrefix(
+						ctx,
+						in1.(*ObjectDeleteByPrefixRequest),
+					)
+			}, DRPCMetainfoExtServer.DeleteObjectsByPrefix, true
+	case 7:
+		return "/metainfo_ext.MetainfoExt/BeginCopyObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					BeginCopyObject(
+						ctx,
+						in1.(*ObjectBeginCopyRequest),
+					)
+			}, DRPCMetainfoExtServer.BeginCopyObject, true
+	case 8:
+		return "/metainfo_ext.MetainfoExt/FinishCopyObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					FinishCopyObject(
+						ctx,
+						in1.(*ObjectFinishCopyRequest),
+					)
+			}, DRPCMetainfoExtServer.FinishCopyObject, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterMetainfoExt(mux drpc.Mux, impl DRPCMetainfoExtServer) error {
+	return mux.Register(impl, DRPCMetainfoExtDescription{})
+}
+
+type DRPCMetainfoExt_CommitObjectStream interface {
+	drpc.Stream
+	SendAndClose(*pb.ObjectCommitResponse) error
+}
+
+type drpcMetainfoExt_CommitObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_CommitObjectStream) SendAndClose(m *pb.ObjectCommitResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_UpdateObjectMetadataStream interface {
+	drpc.Stream
+	SendAndClose(*pb.ObjectUpdateMetadataResponse) error
+}
+
+type drpcMetainfoExt_UpdateObjectMetadataStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_UpdateObjectMetadataStream) SendAndClose(m *pb.ObjectUpdateMetadataResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_GetObjectStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectGetResponse) error
+}
+
+type drpcMetainfoExt_GetObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_GetObjectStream) SendAndClose(m *ObjectGetResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_ListObjectsStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectListResponse) error
+}
+
+type drpcMetainfoExt_ListObjectsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_ListObjectsStream) SendAndClose(m *ObjectListResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_GetBucketStream interface {
+	drpc.Stream
+	SendAndClose(*BucketGetResponse) error
+}
+
+type drpcMetainfoExt_GetBucketStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_GetBucketStream) SendAndClose(m *BucketGetResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_MoveBucketStream interface {
+	drpc.Stream
+	SendAndClose(*BucketMoveResponse) error
+}
+
+type drpcMetainfoExt_MoveBucketStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_MoveBucketStream) SendAndClose(m *BucketMoveResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_DeleteObjectsByPrefixStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectDeleteByPrefixResponse) error
+}
+
+type drpcMetainfoExt_DeleteObjectsByPrefixStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_DeleteObjectsByPrefixStream) SendAndClose(m *ObjectDeleteByPrefixResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_BeginCopyObjectStream interface {
+	drpc.Stream
+	SendAndClose(*pb.ObjectBeginCopyResponse) error
+}
+
+type drpcMetainfoExt_BeginCopyObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_BeginCopyObjectStream) SendAndClose(m *pb.ObjectBeginCopyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_FinishCopyObjectStream interface {
+	drpc.Stream
+	SendAndClose(*pb.ObjectFinishCopyResponse) error
+}
+
+type drpcMetainfoExt_FinishCopyObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_FinishCopyObjectStream) SendAndClose(m *pb.ObjectFinishCopyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
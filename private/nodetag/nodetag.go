@@ -0,0 +1,248 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodetag implements the key/value tags, which storage node operators
+// attach to their nodes. The tags are signed by the node identity, so the
+// satellite can verify that they were set by the operator of the node.
+package nodetag
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/private/protofield"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for node tags.
+	Error = errs.Class("nodetag")
+)
+
+const (
+	// MaxTags is the maximum number of tags of a node.
+	MaxTags = 32
+	// MaxNameLength is the maximum length of a tag name.
+	MaxNameLength = 64
+	// MaxValueLength is the maximum length of a tag value.
+	MaxValueLength = 256
+
+	// CheckInField is the field number of the signed tags in the check-in
+	// request. The common protobuf definitions don't have the tags yet, so
+	// they are sent as an unknown field, which older satellites ignore.
+	CheckInField = 100
+)
+
+// Tag is a key/value pair describing a node.
+type Tag struct {
+	Name  string
+	Value string
+}
+
+// TagSet is the set of tags signed by a node.
+type TagSet struct {
+	NodeID   storj.NodeID
+	SignedAt time.Time
+	Tags     []Tag
+}
+
+// SignedTagSet is a serialized tag set with the signature of the node.
+type SignedTagSet struct {
+	SerializedTags []byte
+	Signature      []byte
+}
+
+// ParseTags parses tags in the form of "name=value,name2=value2".
+func ParseTags(s string) ([]Tag, error) {
+	var tags []Tag
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		equal := strings.IndexByte(pair, '=')
+		if equal < 0 {
+			return nil, Error.New("tag %q has no value", pair)
+		}
+		tags = append(tags, Tag{
+			Name:  strings.TrimSpace(pair[:equal]),
+			Value: strings.TrimSpace(pair[equal+1:]),
+		})
+	}
+	if err := Validate(tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// Validate checks that the tags are within the limits and that the names are
+// unique.
+func Validate(tags []Tag) error {
+	if len(tags) > MaxTags {
+		return Error.New("too many tags: %d > %d", len(tags), MaxTags)
+	}
+	names := map[string]struct{}{}
+	for _, tag := range tags {
+		if tag.Name == "" {
+			return Error.New("tag name is empty")
+		}
+		if len(tag.Name) > MaxNameLength {
+			return Error.New("tag name %q is longer than %d", tag.Name, MaxNameLength)
+		}
+		if len(tag.Value) > MaxValueLength {
+			return Error.New("value of tag %q is longer than %d", tag.Name, MaxValueLength)
+		}
+		if _, ok := names[tag.Name]; ok {
+			return Error.New("tag %q is defined more than once", tag.Name)
+		}
+		names[tag.Name] = struct{}{}
+	}
+	return nil
+}
+
+// Sign signs the tag set with the node identity.
+func Sign(ctx context.Context, set *TagSet, signer signing.Signer) (_ *SignedTagSet, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if set.NodeID != signer.ID() {
+		return nil, Error.New("tags of node %s can't be signed by %s", set.NodeID, signer.ID())
+	}
+	if err := Validate(set.Tags); err != nil {
+		return nil, err
+	}
+
+	serialized := set.marshal()
+	signature, err := signer.HashAndSign(ctx, serialized)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &SignedTagSet{
+		SerializedTags: serialized,
+		Signature:      signature,
+	}, nil
+}
+
+// Verify verifies the signature of the tags against the node identity and
+// returns the tag set.
+func Verify(ctx context.Context, signed *SignedTagSet, signee signing.Signee) (_ *TagSet, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := signee.HashAndVerifySignature(ctx, signed.SerializedTags, signed.Signature); err != nil {
+		return nil, Error.New("invalid signature: %v", err)
+	}
+	set, err := unmarshalTagSet(signed.SerializedTags)
+	if err != nil {
+		return nil, err
+	}
+	if set.NodeID != signee.ID() {
+		return nil, Error.New("tags of node %s are signed by %s", set.NodeID, signee.ID())
+	}
+	if err := Validate(set.Tags); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// Attach adds the signed tags to the check-in request.
+func Attach(req *pb.CheckInRequest, signed *SignedTagSet) {
+	message := proto.NewBuffer(nil)
+	_ = message.EncodeVarint(1<<3 | proto.WireBytes)
+	_ = message.EncodeRawBytes(signed.SerializedTags)
+	_ = message.EncodeVarint(2<<3 | proto.WireBytes)
+	_ = message.EncodeRawBytes(signed.Signature)
+
+	buffer := proto.NewBuffer(req.XXX_unrecognized)
+	_ = buffer.EncodeVarint(CheckInField<<3 | proto.WireBytes)
+	_ = buffer.EncodeRawBytes(message.Bytes())
+
+	req.XXX_unrecognized = buffer.Bytes()
+}
+
+// FromCheckIn returns the signed tags of the check-in request. It returns nil
+// when the node didn't send any tags.
+func FromCheckIn(req *pb.CheckInRequest) (_ *SignedTagSet, err error) {
+	var signed *SignedTagSet
+	err = protofield.Decode(req.XXX_unrecognized, func(f protofield.Field) error {
+		if f.Number != CheckInField {
+			return nil
+		}
+		signed = &SignedTagSet{}
+		return protofield.Decode(f.Bytes, func(f protofield.Field) error {
+			switch f.Number {
+			case 1:
+				signed.SerializedTags = append([]byte(nil), f.Bytes...)
+			case 2:
+				signed.Signature = append([]byte(nil), f.Bytes...)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return signed, nil
+}
+
+// marshal serializes the tag set deterministically, the tags are sorted by
+// name.
+func (set *TagSet) marshal() []byte {
+	tags := append([]Tag(nil), set.Tags...)
+	sort.Slice(tags, func(i, k int) bool { return tags[i].Name < tags[k].Name })
+
+	buffer := proto.NewBuffer(nil)
+	_ = buffer.EncodeVarint(1<<3 | proto.WireBytes)
+	_ = buffer.EncodeRawBytes(set.NodeID.Bytes())
+	_ = buffer.EncodeVarint(2<<3 | proto.WireVarint)
+	_ = buffer.EncodeVarint(uint64(set.SignedAt.Unix()))
+	for _, tag := range tags {
+		entry := proto.NewBuffer(nil)
+		_ = entry.EncodeVarint(1<<3 | proto.WireBytes)
+		_ = entry.EncodeStringBytes(tag.Name)
+		_ = entry.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = entry.EncodeStringBytes(tag.Value)
+
+		_ = buffer.EncodeVarint(3<<3 | proto.WireBytes)
+		_ = buffer.EncodeRawBytes(entry.Bytes())
+	}
+	return buffer.Bytes()
+}
+
+// unmarshalTagSet parses a tag set serialized with marshal.
+func unmarshalTagSet(data []byte) (_ *TagSet, err error) {
+	set := &TagSet{}
+	err = protofield.Decode(data, func(f protofield.Field) (err error) {
+		switch f.Number {
+		case 1:
+			set.NodeID, err = storj.NodeIDFromBytes(f.Bytes)
+		case 2:
+			set.SignedAt = time.Unix(int64(f.Varint), 0).UTC()
+		case 3:
+			var tag Tag
+			err = protofield.Decode(f.Bytes, func(f protofield.Field) error {
+				switch f.Number {
+				case 1:
+					tag.Name = string(f.Bytes)
+				case 2:
+					tag.Value = string(f.Bytes)
+				}
+				return nil
+			})
+			set.Tags = append(set.Tags, tag)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, Error.New("invalid tags: %v", err)
+	}
+	return set, nil
+}
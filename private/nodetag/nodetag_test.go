@@ -0,0 +1,134 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodetag_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/nodetag"
+)
+
+func TestParseTags(t *testing.T) {
+	tags, err := nodetag.ParseTags(" datacenter=true, region = eu-west,empty=,")
+	require.NoError(t, err)
+	require.Equal(t, []nodetag.Tag{
+		{Name: "datacenter", Value: "true"},
+		{Name: "region", Value: "eu-west"},
+		{Name: "empty", Value: ""},
+	}, tags)
+
+	tags, err = nodetag.ParseTags("")
+	require.NoError(t, err)
+	require.Empty(t, tags)
+
+	for _, invalid := range []string{
+		"datacenter",
+		"=true",
+		"a=1,a=2",
+		"name=" + strings.Repeat("x", nodetag.MaxValueLength+1),
+		strings.Repeat("x", nodetag.MaxNameLength+1) + "=1",
+	} {
+		_, err := nodetag.ParseTags(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	node, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+	other, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	set := &nodetag.TagSet{
+		NodeID:   node.ID,
+		SignedAt: time.Date(2022, 9, 1, 10, 0, 0, 0, time.UTC),
+		Tags: []nodetag.Tag{
+			{Name: "region", Value: "eu-west"},
+			{Name: "datacenter", Value: "true"},
+		},
+	}
+
+	signed, err := nodetag.Sign(ctx, set, signing.SignerFromFullIdentity(node))
+	require.NoError(t, err)
+
+	verified, err := nodetag.Verify(ctx, signed, signing.SigneeFromPeerIdentity(node.PeerIdentity()))
+	require.NoError(t, err)
+	require.Equal(t, set.NodeID, verified.NodeID)
+	require.Equal(t, set.SignedAt, verified.SignedAt)
+	require.ElementsMatch(t, set.Tags, verified.Tags)
+
+	// the tags of a node can't be signed or used by another node.
+	_, err = nodetag.Sign(ctx, set, signing.SignerFromFullIdentity(other))
+	require.Error(t, err)
+	_, err = nodetag.Verify(ctx, signed, signing.SigneeFromPeerIdentity(other.PeerIdentity()))
+	require.Error(t, err)
+
+	otherSigned, err := nodetag.Sign(ctx, &nodetag.TagSet{NodeID: other.ID, Tags: set.Tags}, signing.SignerFromFullIdentity(other))
+	require.NoError(t, err)
+	_, err = nodetag.Verify(ctx, &nodetag.SignedTagSet{
+		SerializedTags: otherSigned.SerializedTags,
+		Signature:      signed.Signature,
+	}, signing.SigneeFromPeerIdentity(node.PeerIdentity()))
+	require.Error(t, err)
+
+	// tampered tags are rejected.
+	tampered := *signed
+	tampered.SerializedTags = append([]byte(nil), signed.SerializedTags...)
+	tampered.SerializedTags[len(tampered.SerializedTags)-1] ^= 1
+	_, err = nodetag.Verify(ctx, &tampered, signing.SigneeFromPeerIdentity(node.PeerIdentity()))
+	require.Error(t, err)
+}
+
+func TestCheckIn(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	node, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	// requests without tags.
+	signed, err := nodetag.FromCheckIn(&pb.CheckInRequest{Address: "127.0.0.1:1000"})
+	require.NoError(t, err)
+	require.Nil(t, signed)
+
+	signed, err = nodetag.Sign(ctx, &nodetag.TagSet{
+		NodeID:   node.ID,
+		SignedAt: time.Now(),
+		Tags:     []nodetag.Tag{{Name: "owner", Value: "acme"}},
+	}, signing.SignerFromFullIdentity(node))
+	require.NoError(t, err)
+
+	req := &pb.CheckInRequest{Address: "127.0.0.1:1000"}
+	nodetag.Attach(req, signed)
+
+	// the tags survive the encoding of the request.
+	data, err := pb.Marshal(req)
+	require.NoError(t, err)
+	var received pb.CheckInRequest
+	require.NoError(t, pb.Unmarshal(data, &received))
+	require.Equal(t, "127.0.0.1:1000", received.Address)
+
+	receivedTags, err := nodetag.FromCheckIn(&received)
+	require.NoError(t, err)
+	require.Equal(t, signed, receivedTags)
+
+	verified, err := nodetag.Verify(ctx, receivedTags, signing.SigneeFromPeerIdentity(node.PeerIdentity()))
+	require.NoError(t, err)
+	require.Equal(t, []nodetag.Tag{{Name: "owner", Value: "acme"}}, verified.Tags)
+
+	// truncated tags.
+	_, err = nodetag.FromCheckIn(&pb.CheckInRequest{XXX_unrecognized: []byte{0xa2, 0x06, 10}})
+	require.Error(t, err)
+}
@@ -0,0 +1,7 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package pieceauditpb contains protobuf definitions for piece existence audits.
+package pieceauditpb
+
+//go:generate go run gen.go
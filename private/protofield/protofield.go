@@ -0,0 +1,102 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package protofield implements encoding and decoding of protobuf fields,
+// which aren't part of the generated messages yet. Such fields are sent as
+// unknown fields, which older peers ignore.
+package protofield
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for protofield.
+var Error = errs.Class("protofield")
+
+// Field is a decoded protobuf field. Only the varint and the length delimited
+// values are kept.
+type Field struct {
+	Number uint64
+	Varint uint64
+	Bytes  []byte
+}
+
+// AppendBytes appends a length delimited field to the encoded message.
+func AppendBytes(message []byte, number uint64, value []byte) []byte {
+	buffer := proto.NewBuffer(message)
+	_ = buffer.EncodeVarint(number<<3 | proto.WireBytes)
+	_ = buffer.EncodeRawBytes(value)
+	return buffer.Bytes()
+}
+
+// AppendString appends a string field to the encoded message.
+func AppendString(message []byte, number uint64, value string) []byte {
+	buffer := proto.NewBuffer(message)
+	_ = buffer.EncodeVarint(number<<3 | proto.WireBytes)
+	_ = buffer.EncodeStringBytes(value)
+	return buffer.Bytes()
+}
+
+// AppendVarint appends a varint field to the encoded message.
+func AppendVarint(message []byte, number uint64, value uint64) []byte {
+	buffer := proto.NewBuffer(message)
+	_ = buffer.EncodeVarint(number<<3 | proto.WireVarint)
+	_ = buffer.EncodeVarint(value)
+	return buffer.Bytes()
+}
+
+// Decode calls fn for every field of the protobuf message in data.
+func Decode(data []byte, fn func(f Field) error) error {
+	for len(data) > 0 {
+		size, f, err := next(data)
+		if err != nil {
+			return err
+		}
+		data = data[size:]
+
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// next decodes the first field of data and returns its encoded size.
+func next(data []byte) (size int, f Field, err error) {
+	key, n := proto.DecodeVarint(data)
+	if n == 0 {
+		return 0, Field{}, Error.New("invalid field key")
+	}
+	size = n
+
+	f.Number = key >> 3
+	switch key & 7 {
+	case proto.WireVarint:
+		f.Varint, n = proto.DecodeVarint(data[size:])
+		if n == 0 {
+			return 0, Field{}, Error.New("invalid varint of field %d", f.Number)
+		}
+		size += n
+	case proto.WireFixed64, proto.WireFixed32:
+		length := 8
+		if key&7 == proto.WireFixed32 {
+			length = 4
+		}
+		if len(data)-size < length {
+			return 0, Field{}, Error.New("truncated field %d", f.Number)
+		}
+		size += length
+	case proto.WireBytes:
+		length, n := proto.DecodeVarint(data[size:])
+		if n == 0 || length > uint64(len(data)-size-n) {
+			return 0, Field{}, Error.New("truncated field %d", f.Number)
+		}
+		size += n
+		f.Bytes = data[size : size+int(length)]
+		size += int(length)
+	default:
+		return 0, Field{}, Error.New("unsupported wire type %d of field %d", key&7, f.Number)
+	}
+	return size, f, nil
+}
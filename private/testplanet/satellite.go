@@ -46,6 +46,7 @@ import (
 	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/consistency"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
@@ -108,7 +109,8 @@ type Satellite struct {
 	}
 
 	Inspector struct {
-		Endpoint *inspector.Endpoint
+		Endpoint      *inspector.Endpoint
+		AuditEndpoint *inspector.AuditEndpoint
 	}
 
 	Orders struct {
@@ -129,6 +131,11 @@ type Satellite struct {
 		Chore    *audit.Chore
 		Verifier *audit.Verifier
 		Reporter audit.Reporter
+
+		ExistenceQueues   *audit.Queues
+		ExistenceVerifier *audit.ExistenceVerifier
+		ExistenceWorker   *audit.ExistenceWorker
+		ExistenceChore    *audit.Chore
 	}
 
 	Reputation struct {
@@ -148,6 +155,10 @@ type Satellite struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	MetabaseConsistency struct {
+		Chore *consistency.Chore
+	}
+
 	Accounting struct {
 		Tally            *tally.Service
 		NodeTally        *nodetally.Service
@@ -514,6 +525,11 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 		return nil, err
 	}
 
+	err = metabaseDB.CreateStreamIDIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	api, err := planet.newAPI(ctx, index, identity, db, metabaseDB, config, versionInfo)
 	if err != nil {
 		return nil, err
@@ -584,6 +600,7 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.Metabase.SegmentLoop = peer.Metainfo.SegmentLoop
 
 	system.Inspector.Endpoint = api.Inspector.Endpoint
+	system.Inspector.AuditEndpoint = api.Inspector.AuditEndpoint
 
 	system.Orders.DB = api.Orders.DB
 	system.Orders.Endpoint = api.Orders.Endpoint
@@ -598,12 +615,17 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.Audit.Chore = peer.Audit.Chore
 	system.Audit.Verifier = peer.Audit.Verifier
 	system.Audit.Reporter = peer.Audit.Reporter
+	system.Audit.ExistenceQueues = peer.Audit.ExistenceQueues
+	system.Audit.ExistenceVerifier = peer.Audit.ExistenceVerifier
+	system.Audit.ExistenceWorker = peer.Audit.ExistenceWorker
+	system.Audit.ExistenceChore = peer.Audit.ExistenceChore
 
 	system.GarbageCollection.Service = gcPeer.GarbageCollection.Service
 	system.GarbageCollection.BloomFilters = gcBFPeer.GarbageCollection.Service
 
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
 	system.ZombieDeletion.Chore = peer.ZombieDeletion.Chore
+	system.MetabaseConsistency.Chore = peer.MetabaseConsistency.Chore
 
 	system.Accounting.Tally = peer.Accounting.Tally
 	system.Accounting.NodeTally = peer.Accounting.NodeTally
@@ -672,7 +694,7 @@ func (planet *Planet) newRepairer(ctx context.Context, index int, identity *iden
 	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), config.Orders.FlushBatchSize)
 	planet.databases = append(planet.databases, rollupsWriteCacheCloser{rollupsWriteCache})
 
-	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.Reputation(), db.Containment(), rollupsWriteCache, versionInfo, &config, nil)
+	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.NodeEvacuations(), db.Buckets(), db.OverlayCache(), db.Reputation(), db.Containment(), rollupsWriteCache, versionInfo, &config, nil)
 }
 
 type rollupsWriteCacheCloser struct {
@@ -5,6 +5,8 @@ package nodetally
 
 import (
 	"context"
+	"encoding/binary"
+	"math"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -117,7 +119,11 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 
 var remoteSegmentFunc = mon.Task()
 
-var _ segmentloop.Observer = (*Observer)(nil)
+var _ segmentloop.CheckpointedObserver = (*Observer)(nil)
+
+// tallyStateChunkNodes is the number of nodes saved in a single chunk of the
+// observer state.
+const tallyStateChunkNodes = 1000
 
 // Observer observes metainfo and adds up tallies for nodes and buckets.
 type Observer struct {
@@ -171,3 +177,57 @@ func (observer *Observer) RemoteSegment(ctx context.Context, segment *segmentloo
 func (observer *Observer) InlineSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
 	return nil
 }
+
+// SaveState serializes the tallies, so the loop can be resumed after a
+// restart. The first chunk contains the time of the tally and the other
+// chunks contain the tallies of up to tallyStateChunkNodes nodes.
+func (observer *Observer) SaveState(ctx context.Context) ([][]byte, error) {
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint64(header, uint64(observer.now.UnixNano()))
+	state := [][]byte{header}
+
+	const entrySize = len(storj.NodeID{}) + 8
+	var chunk []byte
+	for nodeID, tally := range observer.Node {
+		if chunk == nil {
+			chunk = make([]byte, 0, tallyStateChunkNodes*entrySize)
+		}
+		chunk = append(chunk, nodeID[:]...)
+		chunk = append(chunk, make([]byte, 8)...)
+		binary.BigEndian.PutUint64(chunk[len(chunk)-8:], math.Float64bits(tally))
+
+		if len(chunk) == cap(chunk) {
+			state = append(state, chunk)
+			chunk = nil
+		}
+	}
+	if len(chunk) > 0 {
+		state = append(state, chunk)
+	}
+	return state, nil
+}
+
+// RestoreState restores the tallies serialized with SaveState.
+func (observer *Observer) RestoreState(ctx context.Context, state [][]byte) error {
+	if len(state) == 0 || len(state[0]) != 8 {
+		return Error.New("invalid tally state")
+	}
+	now := time.Unix(0, int64(binary.BigEndian.Uint64(state[0])))
+
+	const entrySize = len(storj.NodeID{}) + 8
+	nodes := make(map[storj.NodeID]float64)
+	for _, chunk := range state[1:] {
+		if len(chunk)%entrySize != 0 {
+			return Error.New("invalid tally state")
+		}
+		for ; len(chunk) > 0; chunk = chunk[entrySize:] {
+			var nodeID storj.NodeID
+			copy(nodeID[:], chunk)
+			nodes[nodeID] = math.Float64frombits(binary.BigEndian.Uint64(chunk[len(nodeID):]))
+		}
+	}
+
+	observer.now = now
+	observer.Node = nodes
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/encryption"
 	"storj.io/common/memory"
@@ -78,3 +79,24 @@ func satelliteRS(t *testing.T, satellite *testplanet.Satellite) storj.Redundancy
 		ShareSize:      rs.ErasureShareSize.Int32(),
 	}
 }
+
+func TestObserverState(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	observer := nodetally.NewObserver(zaptest.NewLogger(t), time.Now())
+	for i := 0; i < 1500; i++ {
+		observer.Node[testrand.NodeID()] = float64(i) + 0.5
+	}
+
+	state, err := observer.SaveState(ctx)
+	require.NoError(t, err)
+	// the tallies are split into chunks.
+	require.Len(t, state, 3)
+
+	restored := nodetally.NewObserver(zaptest.NewLogger(t), time.Now().Add(time.Hour))
+	require.NoError(t, restored.RestoreState(ctx, state))
+	require.Equal(t, observer.Node, restored.Node)
+
+	require.Error(t, restored.RestoreState(ctx, nil))
+	require.Error(t, restored.RestoreState(ctx, [][]byte{state[0], state[1][1:]}))
+}
@@ -31,6 +31,9 @@ type Config struct {
 
 	ListLimit          int           `help:"how many objects to query in a batch" default:"2500"`
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+
+	UseBucketStats       bool `help:"use the incrementally maintained bucket statistics instead of iterating all objects, it should only be enabled after all buckets were reconciled once" default:"false"`
+	ReconcileBucketStats int  `help:"how many buckets are recounted in every cycle to populate and correct the bucket statistics, zero disables it" default:"100"`
 }
 
 // Service is the tally service for data stored on each storage node.
@@ -46,6 +49,9 @@ type Service struct {
 	storagenodeAccountingDB accounting.StoragenodeAccounting
 	projectAccountingDB     accounting.ProjectAccounting
 	nowFn                   func() time.Time
+
+	// reconcileCursor is the last bucket, whose statistics were reconciled.
+	reconcileCursor metabase.BucketLocation
 }
 
 // New creates a new tally Service.
@@ -188,6 +194,8 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 		}
 	}
 
+	service.reconcileBucketStats(ctx)
+
 	// add up all buckets
 	collector := NewBucketTallyCollector(service.log.Named("observer"), service.nowFn(), service.metabase, service.config)
 	err = collector.Run(ctx)
@@ -235,6 +243,29 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 	return errAtRest
 }
 
+// reconcileBucketStats recounts the next buckets to populate and correct the
+// bucket statistics. Failures are only logged, since the statistics are
+// corrected again in the next round.
+func (service *Service) reconcileBucketStats(ctx context.Context) {
+	if service.config.ReconcileBucketStats <= 0 {
+		return
+	}
+
+	result, err := service.metabase.ReconcileBucketStats(ctx, metabase.ReconcileBucketStats{
+		Cursor: service.reconcileCursor,
+		Limit:  service.config.ReconcileBucketStats,
+	})
+	service.reconcileCursor = result.Cursor
+	if err != nil {
+		service.log.Warn("unable to reconcile bucket stats", zap.Error(err))
+	}
+	if result.Corrected > 0 {
+		service.log.Info("corrected bucket stats",
+			zap.Int("reconciled", result.Reconciled),
+			zap.Int("corrected", result.Corrected))
+	}
+}
+
 // BucketTallyCollector collects and adds up tallies for buckets.
 type BucketTallyCollector struct {
 	Now    time.Time
@@ -262,6 +293,22 @@ func NewBucketTallyCollector(log *zap.Logger, now time.Time, db *metabase.DB, co
 func (observer *BucketTallyCollector) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	// the deltas of the bucket statistics are compacted in every cycle, so
+	// they don't pile up even when the statistics aren't used.
+	_, err = observer.metabase.CompactBucketStats(ctx, metabase.CompactBucketStats{
+		BatchSize: observer.config.ListLimit,
+	})
+	if err != nil {
+		if observer.config.UseBucketStats {
+			return err
+		}
+		observer.Log.Warn("unable to compact bucket stats", zap.Error(err))
+	}
+
+	if observer.config.UseBucketStats {
+		return observer.collectBucketStats(ctx)
+	}
+
 	startingTime, err := observer.metabase.Now(ctx)
 	if err != nil {
 		return err
@@ -283,6 +330,30 @@ func (observer *BucketTallyCollector) Run(ctx context.Context) (err error) {
 	})
 }
 
+// collectBucketStats collects the tallies from the bucket statistics, which
+// are maintained incrementally by metabase. Unlike iterating the objects, the
+// statistics include the expired objects, which weren't deleted yet.
+func (observer *BucketTallyCollector) collectBucketStats(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return observer.metabase.IterateBucketStats(ctx, metabase.IterateBucketStats{
+		BatchSize: observer.config.ListLimit,
+	}, func(ctx context.Context, location metabase.BucketLocation, stats metabase.BucketStats) error {
+		observer.Bucket[location] = &accounting.BucketTally{
+			BucketLocation: location,
+
+			ObjectCount:        stats.ObjectCount + stats.PendingObjectCount,
+			PendingObjectCount: stats.PendingObjectCount,
+
+			TotalSegments: stats.SegmentCount,
+			TotalBytes:    stats.TotalEncryptedSize,
+
+			MetadataSize: stats.MetadataSize,
+		}
+		return nil
+	})
+}
+
 // ensureBucket returns bucket corresponding to the passed in path.
 func (observer *BucketTallyCollector) ensureBucket(location metabase.ObjectLocation) *accounting.BucketTally {
 	bucketLocation := location.Bucket()
@@ -26,6 +26,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/reputation"
 )
 
 // Admin is the satellite core process that runs chores.
@@ -69,6 +70,10 @@ type Admin struct {
 	REST struct {
 		Keys *restkeys.Service
 	}
+
+	Reputation struct {
+		Service *reputation.Service
+	}
 }
 
 // NewAdmin creates a new satellite admin peer.
@@ -154,6 +159,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			peer.DB.Billing(),
 			peer.DB.Console().Projects(),
 			peer.DB.ProjectAccounting(),
+			peer.DB.PricePlans(),
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
@@ -167,6 +173,14 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		peer.Payments.Accounts = peer.Payments.Service.Accounts()
 	}
 
+	{ // setup reputation
+		peer.Reputation.Service = reputation.NewService(log.Named("reputation:service"),
+			peer.DB.OverlayCache(),
+			peer.DB.Reputation(),
+			config.Reputation,
+		)
+	}
+
 	{ // setup admin endpoint
 		var err error
 		peer.Admin.Listener, err = net.Listen("tcp", config.Admin.Address)
@@ -176,8 +190,9 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 
 		adminConfig := config.Admin
 		adminConfig.AuthorizationToken = config.Console.AuthToken
+		adminConfig.Placement = config.Overlay.Placement
 
-		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Buckets.Service, peer.REST.Keys, peer.Payments.Accounts, config.Console, adminConfig)
+		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Buckets.Service, peer.REST.Keys, peer.Reputation.Service, peer.Payments.Accounts, config.Console, adminConfig)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "admin",
 			Run:   peer.Admin.Server.Run,
@@ -4,15 +4,22 @@
 package admin
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
 
 func validateBucketPathParameters(vars map[string]string) (project uuid.NullUUID, bucket []byte, err error) {
@@ -90,7 +97,40 @@ func (server *Server) updateBucket(w http.ResponseWriter, r *http.Request, place
 	w.WriteHeader(http.StatusOK)
 }
 
+// parsePlacementID parses the ID of a predefined or a custom placement.
+func parsePlacementID(value string, rules uploadselection.PlacementRules) (storj.PlacementConstraint, error) {
+	id, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return storj.EveryCountry, fmt.Errorf("invalid placement parameter: %s", value)
+	}
+	placement := storj.PlacementConstraint(id)
+	if placement < storj.InvalidPlacement {
+		return placement, nil
+	}
+	if _, ok := rules[placement]; !ok {
+		return storj.EveryCountry, fmt.Errorf("unknown placement: %d", placement)
+	}
+	return placement, nil
+}
+
 func (server *Server) createGeofenceForBucket(w http.ResponseWriter, r *http.Request) {
+	if value := r.URL.Query().Get("placement"); value != "" {
+		rules, err := uploadselection.ParsePlacementRules(server.config.Placement)
+		if err != nil {
+			sendJSONError(w, "invalid placement rules", err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		placement, err := parsePlacementID(value, rules)
+		if err != nil {
+			sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+			return
+		}
+
+		server.updateBucket(w, r, placement)
+		return
+	}
+
 	placement, err := parsePlacementConstraint(r.URL.Query().Get("region"))
 	if err != nil {
 		sendJSONError(w, err.Error(), "available: EU, EEA, US, DE", http.StatusBadRequest)
@@ -130,3 +170,80 @@ func (server *Server) getBucketInfo(w http.ResponseWriter, r *http.Request) {
 		sendJSONData(w, http.StatusOK, data)
 	}
 }
+
+func (server *Server) moveBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		ProjectID  string `json:"projectId"`
+		BucketName string `json:"bucketName"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	newBucket := metabase.BucketLocation{
+		ProjectID:  project.UUID,
+		BucketName: string(bucket),
+	}
+	if input.BucketName != "" {
+		newBucket.BucketName = input.BucketName
+	}
+	if input.ProjectID != "" {
+		newBucket.ProjectID, err = uuid.FromString(input.ProjectID)
+		if err != nil {
+			sendJSONError(w, "projectId is not a valid uuid", err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if err := metainfo.ValidateBucketName([]byte(newBucket.BucketName)); err != nil {
+		sendJSONError(w, "invalid bucket name", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, err = server.db.Console().Projects().Get(ctx, newBucket.ProjectID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendJSONError(w, "project with specified uuid does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "error getting project", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	_, err = server.buckets.MoveBucket(ctx, metabase.BucketLocation{
+		ProjectID:  project.UUID,
+		BucketName: string(bucket),
+	}, newBucket)
+	if err != nil {
+		switch {
+		case storj.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		case buckets.ErrBucketAlreadyExists.Has(err):
+			sendJSONError(w, "bucket already exists", "", http.StatusConflict)
+		case buckets.ErrBucketNotEmpty.Has(err), metabase.ErrConflict.Has(err), metabase.ErrPendingObjects.Has(err):
+			sendJSONError(w, "unable to move bucket", err.Error(), http.StatusConflict)
+		default:
+			sendJSONError(w, "unable to move bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/consistency"
+)
+
+const defaultConsistencyFindingsLimit = 100
+
+type consistencyFindingInfo struct {
+	Kind        consistency.Kind `json:"kind"`
+	StreamID    uuid.UUID        `json:"streamId"`
+	Part        uint32           `json:"part"`
+	Index       uint32           `json:"index"`
+	Details     string           `json:"details"`
+	FirstSeenAt time.Time        `json:"firstSeenAt"`
+	LastSeenAt  time.Time        `json:"lastSeenAt"`
+}
+
+func (server *Server) listConsistencyFindings(w http.ResponseWriter, r *http.Request) {
+	opts := consistency.ListFindings{
+		Kind:  consistency.Kind(r.URL.Query().Get("kind")),
+		Limit: defaultConsistencyFindingsLimit,
+	}
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			sendJSONError(w, "invalid limit",
+				value, http.StatusBadRequest)
+			return
+		}
+		opts.Limit = limit
+	}
+
+	findings, err := server.db.MetabaseConsistencyFindings().List(r.Context(), opts)
+	if err != nil {
+		sendJSONError(w, "failed to list consistency findings",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := []consistencyFindingInfo{}
+	for _, finding := range findings {
+		output = append(output, consistencyFindingInfo{
+			Kind:        finding.Kind,
+			StreamID:    finding.StreamID,
+			Part:        finding.Position.Part,
+			Index:       finding.Position.Index,
+			Details:     finding.Details,
+			FirstSeenAt: finding.FirstSeenAt,
+			LastSeenAt:  finding.LastSeenAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			fmt.Sprintf("failed to marshal consistency findings: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) deleteConsistencyFinding(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	streamID, err := uuid.FromString(vars["streamid"])
+	if err != nil {
+		sendJSONError(w, "invalid stream-id",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	part, err := strconv.ParseUint(vars["part"], 10, 32)
+	if err != nil {
+		sendJSONError(w, "invalid part",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+	index, err := strconv.ParseUint(vars["index"], 10, 32)
+	if err != nil {
+		sendJSONError(w, "invalid index",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.MetabaseConsistencyFindings().Delete(r.Context(),
+		consistency.Kind(vars["kind"]), streamID,
+		metabase.SegmentPosition{Part: uint32(part), Index: uint32(index)})
+	if err != nil {
+		if consistency.ErrNotFound.Has(err) {
+			sendJSONError(w, "consistency finding not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to delete consistency finding",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
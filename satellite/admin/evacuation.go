@@ -0,0 +1,137 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/repair/evacuation"
+)
+
+type evacuationInfo struct {
+	NodeID            storj.NodeID `json:"nodeId"`
+	Status            string       `json:"status"`
+	SegmentsProcessed int64        `json:"segmentsProcessed"`
+	SegmentsFailed    int64        `json:"segmentsFailed"`
+	CreatedAt         time.Time    `json:"createdAt"`
+	UpdatedAt         time.Time    `json:"updatedAt"`
+	CancelledAt       *time.Time   `json:"cancelledAt"`
+	FinishedAt        *time.Time   `json:"finishedAt"`
+}
+
+func newEvacuationInfo(evac evacuation.Evacuation) evacuationInfo {
+	status := "active"
+	switch {
+	case evac.CancelledAt != nil:
+		status = "cancelled"
+	case evac.FinishedAt != nil:
+		status = "finished"
+	}
+
+	return evacuationInfo{
+		NodeID:            evac.NodeID,
+		Status:            status,
+		SegmentsProcessed: evac.SegmentsProcessed,
+		SegmentsFailed:    evac.SegmentsFailed,
+		CreatedAt:         evac.CreatedAt,
+		UpdatedAt:         evac.UpdatedAt,
+		CancelledAt:       evac.CancelledAt,
+		FinishedAt:        evac.FinishedAt,
+	}
+}
+
+func (server *Server) startEvacuation(w http.ResponseWriter, r *http.Request) {
+	nodeID, ok := nodeIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	evac, err := server.db.NodeEvacuations().Start(r.Context(), nodeID)
+	if err != nil {
+		if evacuation.ErrActive.Has(err) {
+			sendJSONError(w, "node is already being evacuated",
+				err.Error(), http.StatusConflict)
+			return
+		}
+		sendJSONError(w, "failed to start evacuation",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendEvacuation(w, evac)
+}
+
+func (server *Server) getEvacuation(w http.ResponseWriter, r *http.Request) {
+	nodeID, ok := nodeIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	evac, err := server.db.NodeEvacuations().Get(r.Context(), nodeID)
+	if err != nil {
+		if evacuation.ErrNotFound.Has(err) {
+			sendJSONError(w, "evacuation not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get evacuation",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendEvacuation(w, evac)
+}
+
+func (server *Server) cancelEvacuation(w http.ResponseWriter, r *http.Request) {
+	nodeID, ok := nodeIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.db.NodeEvacuations().Cancel(r.Context(), nodeID)
+	if err != nil {
+		if evacuation.ErrNotFound.Has(err) {
+			sendJSONError(w, "no active evacuation",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to cancel evacuation",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func nodeIDFromRequest(w http.ResponseWriter, r *http.Request) (storj.NodeID, bool) {
+	nodeIDString, ok := mux.Vars(r)["nodeid"]
+	if !ok {
+		sendJSONError(w, "node-id missing",
+			"", http.StatusBadRequest)
+		return storj.NodeID{}, false
+	}
+
+	nodeID, err := storj.NodeIDFromString(nodeIDString)
+	if err != nil {
+		sendJSONError(w, "invalid node-id",
+			err.Error(), http.StatusBadRequest)
+		return storj.NodeID{}, false
+	}
+	return nodeID, true
+}
+
+func sendEvacuation(w http.ResponseWriter, evac evacuation.Evacuation) {
+	data, err := json.Marshal(newEvacuationInfo(evac))
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			fmt.Sprintf("failed to marshal evacuation: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestNodeEvacuation(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		sat.Repairer.Evacuation.Chore.Loop.Pause()
+
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		nodeID := testrand.NodeID()
+		link := "http://" + address.String() + "/api/nodes/" + nodeID.String() + "/evacuation"
+
+		assertReq(ctx, t, "http://"+address.String()+"/api/nodes/invalid/evacuation", http.MethodPost, "",
+			http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusNotFound, "", authToken)
+
+		body := assertReq(ctx, t, link, http.MethodPost, "", http.StatusOK, "", authToken)
+		var info struct {
+			Status string `json:"status"`
+		}
+		require.NoError(t, json.Unmarshal(body, &info))
+		require.Equal(t, "active", info.Status)
+
+		assertReq(ctx, t, link, http.MethodPost, "", http.StatusConflict, "", authToken)
+
+		assertReq(ctx, t, link, http.MethodDelete, "", http.StatusOK, "", authToken)
+		assertReq(ctx, t, link, http.MethodDelete, "", http.StatusNotFound, "", authToken)
+
+		body = assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &info))
+		require.Equal(t, "cancelled", info.Status)
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+type nodeTagInfo struct {
+	Name     string    `json:"name"`
+	Value    string    `json:"value"`
+	SignedAt time.Time `json:"signedAt"`
+}
+
+type nodeTagsInfo struct {
+	NodeID storj.NodeID  `json:"nodeId"`
+	Tags   []nodeTagInfo `json:"tags"`
+}
+
+func (server *Server) getNodeTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, ok := nodeIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	tags, err := server.db.OverlayCache().GetNodeTags(ctx, nodeID)
+	if err != nil {
+		sendJSONError(w, "failed to get node tags",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := nodeTagsInfo{
+		NodeID: nodeID,
+		Tags:   []nodeTagInfo{},
+	}
+	for _, tag := range tags {
+		output.Tags = append(output.Tags, nodeTagInfo{
+			Name:     tag.Name,
+			Value:    tag.Value,
+			SignedAt: tag.SignedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			fmt.Sprintf("failed to marshal node tags: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
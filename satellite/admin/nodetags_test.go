@@ -0,0 +1,63 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/nodetag"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestGetNodeTags(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 1,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		nodeID := planet.StorageNodes[0].ID()
+
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		link := "http://" + address.String() + "/api/nodes/" + nodeID.String() + "/tags"
+
+		var info struct {
+			Tags []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"tags"`
+		}
+		body := assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &info))
+		require.Empty(t, info.Tags)
+
+		require.NoError(t, sat.Overlay.Service.UpdateNodeTags(ctx, &nodetag.TagSet{
+			NodeID:   nodeID,
+			SignedAt: time.Now(),
+			Tags:     []nodetag.Tag{{Name: "owner", Value: "acme"}},
+		}))
+
+		body = assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &info))
+		require.Len(t, info.Tags, 1)
+		require.Equal(t, "owner", info.Tags[0].Name)
+		require.Equal(t, "acme", info.Tags[0].Value)
+
+		assertReq(ctx, t, "http://"+address.String()+"/api/nodes/invalid/tags", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+	})
+}
@@ -0,0 +1,244 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments/pricing"
+)
+
+func (server *Server) listPricePlans(w http.ResponseWriter, r *http.Request) {
+	plans, err := server.db.PricePlans().List(r.Context())
+	if err != nil {
+		sendJSONError(w, "failed to list price plans",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if plans == nil {
+		plans = []pricing.Plan{}
+	}
+
+	data, err := json.Marshal(plans)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) createPricePlan(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		Name   string         `json:"name"`
+		Prices pricing.Prices `json:"prices"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "invalid json",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.Name == "" {
+		sendJSONError(w, "name is required",
+			"", http.StatusBadRequest)
+		return
+	}
+	if err := input.Prices.Validate(); err != nil {
+		sendJSONError(w, "invalid prices",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		sendJSONError(w, "failed to generate price plan id",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	plan, err := server.db.PricePlans().Insert(r.Context(), pricing.Plan{
+		ID:     id,
+		Name:   input.Name,
+		Prices: input.Prices,
+	})
+	if err != nil {
+		sendJSONError(w, "failed to create price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(plan)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) deletePricePlan(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "invalid price plan id",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.PricePlans().Delete(r.Context(), id)
+	if pricing.ErrPlanNotFound.Has(err) {
+		sendJSONError(w, "price plan does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to delete price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *Server) assignUserPricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userEmail, ok := mux.Vars(r)["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	planID, ok := server.decodePricePlanID(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := server.db.PricePlans().AssignUser(ctx, user.ID, planID); err != nil {
+		sendJSONError(w, "failed to assign price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *Server) unassignUserPricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userEmail, ok := mux.Vars(r)["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := server.db.PricePlans().UnassignUser(ctx, user.ID); err != nil {
+		sendJSONError(w, "failed to unassign price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *Server) assignPartnerPricePlan(w http.ResponseWriter, r *http.Request) {
+	partner := mux.Vars(r)["partner"]
+	if partner == "" {
+		sendJSONError(w, "partner missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	planID, ok := server.decodePricePlanID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := server.db.PricePlans().AssignPartner(r.Context(), partner, planID); err != nil {
+		sendJSONError(w, "failed to assign price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *Server) unassignPartnerPricePlan(w http.ResponseWriter, r *http.Request) {
+	partner := mux.Vars(r)["partner"]
+	if partner == "" {
+		sendJSONError(w, "partner missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	if err := server.db.PricePlans().UnassignPartner(r.Context(), partner); err != nil {
+		sendJSONError(w, "failed to unassign price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// decodePricePlanID decodes the id of an existing price plan from the request
+// body and sends an error response when it's invalid.
+func (server *Server) decodePricePlanID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	var input struct {
+		PlanID uuid.UUID `json:"planId"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "invalid json",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	_, err := server.db.PricePlans().Get(r.Context(), input.PlanID)
+	if pricing.ErrPlanNotFound.Has(err) {
+		sendJSONError(w, "price plan does not exist",
+			"", http.StatusNotFound)
+		return uuid.UUID{}, false
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get price plan",
+			err.Error(), http.StatusInternalServerError)
+		return uuid.UUID{}, false
+	}
+
+	return input.PlanID, true
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/payments/pricing"
+)
+
+func TestPricePlans(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		owner := planet.Uplinks[0].Projects[0].Owner
+
+		baseURL := "http://" + address.String() + "/api"
+
+		// the first tier must start from 0.
+		assertReq(ctx, t, baseURL+"/price-plans", http.MethodPost,
+			`{"name":"invalid","prices":{"storage":[{"from":1,"price":"4"}],"egress":[{"price":"7"}],"segment":[{"price":"0"}]}}`,
+			http.StatusBadRequest, "", authToken)
+
+		body := assertReq(ctx, t, baseURL+"/price-plans", http.MethodPost,
+			`{"name":"volume","prices":{"storage":[{"from":0,"price":"4"},{"from":100,"price":"3"}],"egress":[{"price":"7"}],"segment":[{"price":"0.0000088"}]}}`,
+			http.StatusOK, "", authToken)
+
+		var plan pricing.Plan
+		require.NoError(t, json.Unmarshal(body, &plan))
+		require.Equal(t, "volume", plan.Name)
+		require.Len(t, plan.Prices.Storage, 2)
+
+		body = assertReq(ctx, t, baseURL+"/price-plans", http.MethodGet, "", http.StatusOK, "", authToken)
+		var plans []pricing.Plan
+		require.NoError(t, json.Unmarshal(body, &plans))
+		require.Len(t, plans, 1)
+		require.Equal(t, plan.ID, plans[0].ID)
+
+		assignment := fmt.Sprintf(`{"planId":%q}`, plan.ID)
+
+		assertReq(ctx, t, baseURL+"/users/"+owner.Email+"/price-plan", http.MethodPut, assignment, http.StatusOK, "", authToken)
+		assertReq(ctx, t, baseURL+"/users/unknown@storj.test/price-plan", http.MethodPut, assignment, http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, baseURL+"/users/"+owner.Email+"/price-plan", http.MethodPut, `{"planId":"00000000-0000-0000-0000-000000000001"}`, http.StatusNotFound, "", authToken)
+
+		prices, err := sat.API.Payments.Accounts.Prices(ctx, owner.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, prices.Storage, 2)
+
+		assertReq(ctx, t, baseURL+"/partners/Zenko/price-plan", http.MethodPut, assignment, http.StatusOK, "", authToken)
+		_, err = sat.DB.PricePlans().GetByPartner(ctx, "zenko")
+		require.NoError(t, err)
+		assertReq(ctx, t, baseURL+"/partners/Zenko/price-plan", http.MethodDelete, "", http.StatusOK, "", authToken)
+		_, err = sat.DB.PricePlans().GetByPartner(ctx, "zenko")
+		require.True(t, pricing.ErrPlanNotFound.Has(err))
+
+		assertReq(ctx, t, baseURL+"/users/"+owner.Email+"/price-plan", http.MethodDelete, "", http.StatusOK, "", authToken)
+		prices, err = sat.API.Payments.Accounts.Prices(ctx, owner.ID, nil)
+		require.NoError(t, err)
+		require.True(t, prices.IsFlat())
+
+		assertReq(ctx, t, baseURL+"/price-plans/"+plan.ID.String(), http.MethodDelete, "", http.StatusOK, "", authToken)
+		assertReq(ctx, t, baseURL+"/price-plans/"+plan.ID.String(), http.MethodDelete, "", http.StatusNotFound, "", authToken)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"storj.io/common/storj"
+)
+
+// defaultHealthBuckets are the segment health buckets used for the repair
+// queue stats when none are requested.
+var defaultHealthBuckets = []float64{1, 7, 30, 365}
+
+func (server *Server) repairQueueStats(w http.ResponseWriter, r *http.Request) {
+	buckets := defaultHealthBuckets
+	if value := r.URL.Query().Get("buckets"); value != "" {
+		buckets = nil
+		for _, bucket := range strings.Split(value, ",") {
+			health, err := strconv.ParseFloat(strings.TrimSpace(bucket), 64)
+			if err != nil || math.IsNaN(health) || math.IsInf(health, 0) {
+				sendJSONError(w, "invalid health bucket",
+					bucket, http.StatusBadRequest)
+				return
+			}
+			buckets = append(buckets, health)
+		}
+		sort.Float64s(buckets)
+	}
+
+	stats, err := server.db.RepairQueue().Stats(r.Context(), buckets)
+	if err != nil {
+		sendJSONError(w, "failed to get repair queue stats",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type stat struct {
+		Placement storj.PlacementConstraint `json:"placement"`
+		MaxHealth *float64                  `json:"maxHealth"`
+		Count     int                       `json:"count"`
+		MinHealth float64                   `json:"minHealth"`
+	}
+	output := []stat{}
+	for _, s := range stats {
+		out := stat{
+			Placement: s.Placement,
+			Count:     s.Count,
+			MinHealth: s.MinHealth,
+		}
+		if !math.IsInf(s.MaxHealth, 1) {
+			maxHealth := s.MaxHealth
+			out.MaxHealth = &maxHealth
+		}
+		output = append(output, out)
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestRepairQueueStats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		baseURL := "http://" + address.String() + "/api"
+
+		_, err := sat.DB.RepairQueue().InsertBatch(ctx, []*queue.InjuredSegment{
+			{StreamID: testrand.UUID(), SegmentHealth: 0.5, Placement: storj.EU},
+			{StreamID: testrand.UUID(), SegmentHealth: 0.7, Placement: storj.EU},
+			{StreamID: testrand.UUID(), SegmentHealth: 50},
+		})
+		require.NoError(t, err)
+
+		assertReq(ctx, t, baseURL+"/repair-queue/stats?buckets=1,x", http.MethodGet, "",
+			http.StatusBadRequest, "", authToken)
+
+		assertReq(ctx, t, baseURL+"/repair-queue/stats?buckets=10,1", http.MethodGet, "",
+			http.StatusOK,
+			`[{"placement":0,"maxHealth":null,"count":1,"minHealth":50},{"placement":1,"maxHealth":1,"count":2,"minHealth":0.5}]`,
+			authToken)
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+type auditWindowInfo struct {
+	WindowStart time.Time `json:"windowStart"`
+	TotalCount  int32     `json:"totalCount"`
+	OnlineCount int32     `json:"onlineCount"`
+}
+
+type reputationChangeInfo struct {
+	ID        uuid.UUID               `json:"id"`
+	Action    reputation.ChangeAction `json:"action"`
+	Reason    string                  `json:"reason"`
+	Previous  reputation.ChangeState  `json:"previous"`
+	CreatedAt time.Time               `json:"createdAt"`
+}
+
+type nodeReputationInfo struct {
+	NodeID                 storj.NodeID                    `json:"nodeId"`
+	AuditSuccessCount      int64                           `json:"auditSuccessCount"`
+	TotalAuditCount        int64                           `json:"totalAuditCount"`
+	VettedAt               *time.Time                      `json:"vettedAt"`
+	Disqualified           *time.Time                      `json:"disqualified"`
+	DisqualificationReason *overlay.DisqualificationReason `json:"disqualificationReason"`
+	UnknownAuditSuspended  *time.Time                      `json:"unknownAuditSuspended"`
+	OfflineSuspended       *time.Time                      `json:"offlineSuspended"`
+	UnderReview            *time.Time                      `json:"underReview"`
+	AuditScore             float64                         `json:"auditScore"`
+	UnknownAuditScore      float64                         `json:"unknownAuditScore"`
+	OnlineScore            float64                         `json:"onlineScore"`
+	AuditHistory           []auditWindowInfo               `json:"auditHistory"`
+	Changes                []reputationChangeInfo          `json:"changes"`
+}
+
+func (server *Server) getNodeReputation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, ok := nodeIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	info, err := server.reputation.Get(ctx, nodeID)
+	if err != nil {
+		sendJSONError(w, "failed to get reputation",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	changes, err := server.reputation.ListChanges(ctx, nodeID)
+	if err != nil {
+		sendJSONError(w, "failed to list reputation changes",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := nodeReputationInfo{
+		NodeID:                nodeID,
+		AuditSuccessCount:     info.AuditSuccessCount,
+		TotalAuditCount:       info.TotalAuditCount,
+		VettedAt:              info.VettedAt,
+		Disqualified:          info.Disqualified,
+		UnknownAuditSuspended: info.UnknownAuditSuspended,
+		OfflineSuspended:      info.OfflineSuspended,
+		UnderReview:           info.UnderReview,
+		AuditScore:            info.AuditReputationAlpha / (info.AuditReputationAlpha + info.AuditReputationBeta),
+		UnknownAuditScore:     info.UnknownAuditReputationAlpha / (info.UnknownAuditReputationAlpha + info.UnknownAuditReputationBeta),
+		OnlineScore:           info.OnlineScore,
+		AuditHistory:          []auditWindowInfo{},
+		Changes:               []reputationChangeInfo{},
+	}
+	if info.Disqualified != nil {
+		output.DisqualificationReason = &info.DisqualificationReason
+	}
+	if info.AuditHistory != nil {
+		for _, window := range info.AuditHistory.Windows {
+			output.AuditHistory = append(output.AuditHistory, auditWindowInfo{
+				WindowStart: window.WindowStart,
+				TotalCount:  window.TotalCount,
+				OnlineCount: window.OnlineCount,
+			})
+		}
+	}
+	for _, change := range changes {
+		output.Changes = append(output.Changes, newReputationChangeInfo(change))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			fmt.Sprintf("failed to marshal reputation: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) reinstateNode(w http.ResponseWriter, r *http.Request) {
+	server.changeNodeReputation(w, r, server.reputation.Reinstate)
+}
+
+func (server *Server) unsuspendNode(w http.ResponseWriter, r *http.Request) {
+	server.changeNodeReputation(w, r, server.reputation.Unsuspend)
+}
+
+func (server *Server) changeNodeReputation(w http.ResponseWriter, r *http.Request,
+	apply func(ctx context.Context, nodeID storj.NodeID, reason string) (reputation.Change, error)) {
+	nodeID, ok := nodeIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input struct {
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "invalid json",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+	if input.Reason == "" {
+		sendJSONError(w, "reason missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	change, err := apply(r.Context(), nodeID, input.Reason)
+	if err != nil {
+		switch {
+		case overlay.ErrNodeNotFound.Has(err):
+			sendJSONError(w, "node not found",
+				"", http.StatusNotFound)
+		case reputation.ErrNodeDisqualified.Has(err):
+			sendJSONError(w, "node is disqualified, it needs to be reinstated",
+				"", http.StatusConflict)
+		default:
+			sendJSONError(w, "failed to change reputation",
+				err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(newReputationChangeInfo(change))
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			fmt.Sprintf("failed to marshal reputation change: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func newReputationChangeInfo(change reputation.Change) reputationChangeInfo {
+	return reputationChangeInfo{
+		ID:        change.ID,
+		Action:    change.Action,
+		Reason:    change.Reason,
+		Previous:  change.Previous,
+		CreatedAt: change.CreatedAt,
+	}
+}
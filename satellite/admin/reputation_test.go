@@ -0,0 +1,95 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestNodeReinstatement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 1,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		nodeID := planet.StorageNodes[0].ID()
+
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		link := "http://" + address.String() + "/api/nodes/" + nodeID.String()
+
+		require.NoError(t, sat.Reputation.Service.TestDisqualifyNode(ctx, nodeID, overlay.DisqualificationReasonAuditFailure))
+
+		var info struct {
+			Disqualified           *time.Time                      `json:"disqualified"`
+			DisqualificationReason *overlay.DisqualificationReason `json:"disqualificationReason"`
+			UnknownAuditSuspended  *time.Time                      `json:"unknownAuditSuspended"`
+			Changes                []struct {
+				Action   string `json:"action"`
+				Reason   string `json:"reason"`
+				Previous struct {
+					Disqualified *time.Time `json:"disqualified"`
+				} `json:"previous"`
+			} `json:"changes"`
+		}
+		body := assertReq(ctx, t, link+"/reputation", http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &info))
+		require.NotNil(t, info.Disqualified)
+		require.Equal(t, overlay.DisqualificationReasonAuditFailure, *info.DisqualificationReason)
+		require.Empty(t, info.Changes)
+
+		// a disqualified node can't be unsuspended.
+		assertReq(ctx, t, link+"/unsuspend", http.MethodPost, `{"reason":"test"}`, http.StatusConflict, "", authToken)
+
+		assertReq(ctx, t, link+"/reinstate", http.MethodPost, `{}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, "http://"+address.String()+"/api/nodes/"+testrand.NodeID().String()+"/reinstate",
+			http.MethodPost, `{"reason":"test"}`, http.StatusNotFound, "", authToken)
+
+		assertReq(ctx, t, link+"/reinstate", http.MethodPost, `{"reason":"disqualified by a bug"}`, http.StatusOK, "", authToken)
+
+		dossier, err := sat.Overlay.Service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.Nil(t, dossier.Disqualified)
+
+		body = assertReq(ctx, t, link+"/reputation", http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &info))
+		require.Nil(t, info.Disqualified)
+		require.Len(t, info.Changes, 1)
+		require.Equal(t, "reinstate", info.Changes[0].Action)
+		require.Equal(t, "disqualified by a bug", info.Changes[0].Reason)
+		require.NotNil(t, info.Changes[0].Previous.Disqualified)
+
+		require.NoError(t, sat.Reputation.Service.TestSuspendNodeUnknownAudit(ctx, nodeID, time.Now()))
+
+		assertReq(ctx, t, link+"/unsuspend", http.MethodPost, `{"reason":"unknown audits caused by a bug"}`, http.StatusOK, "", authToken)
+
+		dossier, err = sat.Overlay.Service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.Nil(t, dossier.UnknownAuditSuspended)
+
+		body = assertReq(ctx, t, link+"/reputation", http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &info))
+		require.Nil(t, info.UnknownAuditSuspended)
+		require.Len(t, info.Changes, 2)
+		require.Equal(t, "unsuspend", info.Changes[0].Action)
+	})
+}
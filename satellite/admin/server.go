@@ -23,9 +23,15 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/metabase/consistency"
 	"storj.io/storj/satellite/oidc"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/pricing"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair/evacuation"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/reputation"
 )
 
 // Config defines configuration for debug server.
@@ -34,6 +40,8 @@ type Config struct {
 	StaticDir string `help:"an alternate directory path which contains the static assets to serve. When empty, it uses the embedded assets" releaseDefault:"" devDefault:""`
 
 	AuthorizationToken string `internal:"true"`
+	// Placement are the custom placement rules of the satellite.
+	Placement string `internal:"true"`
 }
 
 // DB is databases needed for the admin server.
@@ -46,6 +54,16 @@ type DB interface {
 	OIDC() oidc.DB
 	// StripeCoinPayments returns database for satellite stripe coin payments
 	StripeCoinPayments() stripecoinpayments.DB
+	// PricePlans returns database for price plans and their assignments.
+	PricePlans() pricing.DB
+	// NodeEvacuations tracks the progress of moving all pieces off nodes.
+	NodeEvacuations() evacuation.DB
+	// RepairQueue returns the queue of segments waiting for repair.
+	RepairQueue() queue.RepairQueue
+	// OverlayCache returns the database for the node information.
+	OverlayCache() overlay.DB
+	// MetabaseConsistencyFindings returns the inconsistencies found in the metabase.
+	MetabaseConsistencyFindings() consistency.DB
 }
 
 // Server provides endpoints for administrative tasks.
@@ -60,6 +78,8 @@ type Server struct {
 	buckets  *buckets.Service
 	restKeys *restkeys.Service
 
+	reputation *reputation.Service
+
 	nowFn func() time.Time
 
 	console consoleweb.Config
@@ -67,7 +87,7 @@ type Server struct {
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, accounts payments.Accounts, console consoleweb.Config, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, reputation *reputation.Service, accounts payments.Accounts, console consoleweb.Config, config Config) *Server {
 	server := &Server{
 		log: log,
 
@@ -78,6 +98,8 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 		buckets:  buckets,
 		restKeys: restKeys,
 
+		reputation: reputation,
+
 		nowFn: time.Now,
 
 		console: console,
@@ -95,6 +117,9 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	api.HandleFunc("/users/{useremail}", server.userInfo).Methods("GET")
 	api.HandleFunc("/users/{useremail}", server.deleteUser).Methods("DELETE")
 	api.HandleFunc("/users/{useremail}/mfa", server.disableUserMFA).Methods("DELETE")
+	api.HandleFunc("/users/{useremail}/invoice-report/{period}", server.userInvoiceReport).Methods("GET")
+	api.HandleFunc("/users/{useremail}/price-plan", server.assignUserPricePlan).Methods("PUT")
+	api.HandleFunc("/users/{useremail}/price-plan", server.unassignUserPricePlan).Methods("DELETE")
 	api.HandleFunc("/oauth/clients", server.createOAuthClient).Methods("POST")
 	api.HandleFunc("/oauth/clients/{id}", server.updateOAuthClient).Methods("PUT")
 	api.HandleFunc("/oauth/clients/{id}", server.deleteOAuthClient).Methods("DELETE")
@@ -111,7 +136,23 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	api.HandleFunc("/projects/{project}/buckets/{bucket}", server.getBucketInfo).Methods("GET")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/move", server.moveBucket).Methods("POST")
 	api.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
+	api.HandleFunc("/price-plans", server.listPricePlans).Methods("GET")
+	api.HandleFunc("/price-plans", server.createPricePlan).Methods("POST")
+	api.HandleFunc("/price-plans/{id}", server.deletePricePlan).Methods("DELETE")
+	api.HandleFunc("/partners/{partner}/price-plan", server.assignPartnerPricePlan).Methods("PUT")
+	api.HandleFunc("/partners/{partner}/price-plan", server.unassignPartnerPricePlan).Methods("DELETE")
+	api.HandleFunc("/nodes/{nodeid}/evacuation", server.startEvacuation).Methods("POST")
+	api.HandleFunc("/nodes/{nodeid}/evacuation", server.getEvacuation).Methods("GET")
+	api.HandleFunc("/nodes/{nodeid}/evacuation", server.cancelEvacuation).Methods("DELETE")
+	api.HandleFunc("/nodes/{nodeid}/reputation", server.getNodeReputation).Methods("GET")
+	api.HandleFunc("/nodes/{nodeid}/reinstate", server.reinstateNode).Methods("POST")
+	api.HandleFunc("/nodes/{nodeid}/unsuspend", server.unsuspendNode).Methods("POST")
+	api.HandleFunc("/nodes/{nodeid}/tags", server.getNodeTags).Methods("GET")
+	api.HandleFunc("/repair-queue/stats", server.repairQueueStats).Methods("GET")
+	api.HandleFunc("/metabase/consistency-findings", server.listConsistencyFindings).Methods("GET")
+	api.HandleFunc("/metabase/consistency-findings/{kind}/{streamid}/{part}/{index}", server.deleteConsistencyFinding).Methods("DELETE")
 	api.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
 	api.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
 
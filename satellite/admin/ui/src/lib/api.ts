@@ -42,6 +42,27 @@ export class Admin {
 					return this.fetch('GET', `projects/${projectId}/buckets/${bucketName}`);
 				}
 			},
+			{
+				name: 'move',
+				desc: 'Rename the specified bucket or move it to another project',
+				params: [
+					['Project ID', new InputText('text', true)],
+					['Bucket name', new InputText('text', true)],
+					['New project ID', new InputText('text', false)],
+					['New bucket name', new InputText('text', false)]
+				],
+				func: async (
+					projectId: string,
+					bucketName: string,
+					newProjectId: string,
+					newBucketName: string
+				): Promise<null> => {
+					return this.fetch('POST', `projects/${projectId}/buckets/${bucketName}/move`, null, {
+						projectId: newProjectId,
+						bucketName: newBucketName
+					}) as Promise<null>;
+				}
+			},
 			{
 				name: 'delete geofencing',
 				desc: 'Delete the geofencing configuration of the specified bucket. The bucket MUST be empty',
@@ -379,6 +400,91 @@ Blank fields will not be updated.`,
 				func: async (email: string): Promise<null> => {
 					return this.fetch('DELETE', `users/${email}/mfa`) as Promise<null>;
 				}
+			},
+			{
+				name: 'invoice report',
+				desc: 'Get the per-bucket usage and charges of the projects the user owns for an invoice period',
+				params: [
+					['email', new InputText('email', true)],
+					['period (YYYY-MM)', new InputText('text', true)]
+				],
+				func: async (email: string, period: string): Promise<Record<string, unknown>> => {
+					return this.fetch('GET', `users/${email}/invoice-report/${period}`);
+				}
+			},
+			{
+				name: 'assign price plan',
+				desc: 'Assign a price plan to the user',
+				params: [
+					['email', new InputText('email', true)],
+					['price plan ID', new InputText('text', true)]
+				],
+				func: async (email: string, planId: string): Promise<null> => {
+					return this.fetch('PUT', `users/${email}/price-plan`, null, {
+						planId
+					}) as Promise<null>;
+				}
+			},
+			{
+				name: 'unassign price plan',
+				desc: 'Remove the price plan assignment of the user',
+				params: [['email', new InputText('email', true)]],
+				func: async (email: string): Promise<null> => {
+					return this.fetch('DELETE', `users/${email}/price-plan`) as Promise<null>;
+				}
+			}
+		],
+		price_plans: [
+			{
+				name: 'list',
+				desc: 'List all price plans',
+				params: [],
+				func: async (): Promise<Record<string, unknown>> => {
+					return this.fetch('GET', 'price-plans');
+				}
+			},
+			{
+				name: 'create',
+				desc: 'Create a price plan with volume tiers',
+				params: [
+					['name', new InputText('text', true)],
+					['prices (JSON)', new InputText('text', true)]
+				],
+				func: async (name: string, prices: string): Promise<Record<string, unknown>> => {
+					return this.fetch('POST', 'price-plans', null, {
+						name,
+						prices: JSON.parse(prices)
+					});
+				}
+			},
+			{
+				name: 'delete',
+				desc: 'Delete a price plan together with its assignments',
+				params: [['price plan ID', new InputText('text', true)]],
+				func: async (id: string): Promise<null> => {
+					return this.fetch('DELETE', `price-plans/${id}`) as Promise<null>;
+				}
+			},
+			{
+				name: 'assign to partner',
+				desc: 'Assign a price plan to a partner by the product name of its user agent',
+				params: [
+					['partner', new InputText('text', true)],
+					['price plan ID', new InputText('text', true)]
+				],
+				func: async (partner: string, planId: string): Promise<null> => {
+					return this.fetch('PUT', `partners/${partner}/price-plan`, null, {
+						planId
+					}) as Promise<null>;
+				}
+			},
+			{
+				name: 'unassign from partner',
+				desc: 'Remove the price plan assignment of a partner',
+				params: [['partner', new InputText('text', true)]],
+				func: async (partner: string): Promise<null> => {
+					return this.fetch('DELETE', `partners/${partner}/price-plan`) as Promise<null>;
+				}
 			}
 		],
 		rest_api_keys: [
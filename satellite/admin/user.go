@@ -17,6 +17,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments/invoicereport"
 )
 
 func (server *Server) addUser(w http.ResponseWriter, r *http.Request) {
@@ -197,6 +198,65 @@ func (server *Server) userInfo(w http.ResponseWriter, r *http.Request) {
 	sendJSONData(w, http.StatusOK, data)
 }
 
+func (server *Server) userInvoiceReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	since, before, err := invoicereport.ParsePeriod(vars["period"])
+	if err != nil {
+		sendJSONError(w, "invalid period",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	charges, err := server.payments.BucketCharges(ctx, user.ID, since, before)
+	if err != nil {
+		sendJSONError(w, "failed to get bucket charges",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	report := invoicereport.New(since, before, charges)
+
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "json":
+		data, err := json.Marshal(report.Charges)
+		if err != nil {
+			sendJSONError(w, "json encoding failed",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		sendJSONData(w, http.StatusOK, data)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", "attachment; filename=\"storj-usage-"+report.Period()+".csv\"")
+		_ = invoicereport.WriteCSV(w, report)
+	default:
+		sendJSONError(w, "unsupported format",
+			fmt.Sprintf("format %q is not one of json, csv", format), http.StatusBadRequest)
+	}
+}
+
 func (server *Server) updateUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
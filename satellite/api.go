@@ -25,6 +25,7 @@ import (
 	"storj.io/private/debug"
 	"storj.io/private/version"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/metainfoextpb"
 	"storj.io/storj/private/server"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/accounting"
@@ -105,10 +106,12 @@ type API struct {
 		Metabase      *metabase.DB
 		PieceDeletion *piecedeletion.Service
 		Endpoint      *metainfo.Endpoint
+		ExtEndpoint   *metainfo.ExtEndpoint
 	}
 
 	Inspector struct {
-		Endpoint *inspector.Endpoint
+		Endpoint      *inspector.Endpoint
+		AuditEndpoint *inspector.AuditEndpoint
 	}
 
 	Accounting struct {
@@ -457,6 +460,11 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		peer.Metainfo.ExtEndpoint = metainfo.NewExtEndpoint(peer.Metainfo.Endpoint)
+		if err := metainfoextpb.DRPCRegisterMetainfoExt(peer.Server.DRPC(), peer.Metainfo.ExtEndpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
 			Close: peer.Metainfo.Endpoint.Close,
@@ -472,6 +480,15 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := internalpb.DRPCRegisterHealthInspector(peer.Server.PrivateDRPC(), peer.Inspector.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		peer.Inspector.AuditEndpoint = inspector.NewAuditEndpoint(
+			peer.Log.Named("inspector:audit"),
+			peer.Reputation.Service,
+			config.Audit,
+		)
+		if err := internalpb.DRPCRegisterAuditInspector(peer.Server.PrivateDRPC(), peer.Inspector.AuditEndpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup mailservice
@@ -510,6 +527,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.Billing(),
 			peer.DB.Console().Projects(),
 			peer.DB.ProjectAccounting(),
+			peer.DB.PricePlans(),
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
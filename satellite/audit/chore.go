@@ -10,8 +10,10 @@ import (
 
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/overlay"
 )
 
 // Chore populates reservoirs and the audit queue.
@@ -24,11 +26,12 @@ type Chore struct {
 	Loop   *sync2.Cycle
 
 	segmentLoop *segmentloop.Service
+	overlay     *overlay.Service
 	config      Config
 }
 
 // NewChore instantiates Chore.
-func NewChore(log *zap.Logger, queues *Queues, loop *segmentloop.Service, config Config) *Chore {
+func NewChore(log *zap.Logger, queues *Queues, loop *segmentloop.Service, overlay *overlay.Service, config Config) *Chore {
 	return &Chore{
 		log:    log,
 		rand:   rand.New(rand.NewSource(time.Now().Unix())),
@@ -36,6 +39,7 @@ func NewChore(log *zap.Logger, queues *Queues, loop *segmentloop.Service, config
 		Loop:   sync2.NewCycle(config.ChoreInterval),
 
 		segmentLoop: loop,
+		overlay:     overlay,
 		config:      config,
 	}
 }
@@ -52,7 +56,12 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			return err
 		}
 
-		collector := NewCollector(chore.config.Slots, chore.rand)
+		collector, slots, err := chore.newCollector(ctx)
+		if err != nil {
+			chore.log.Error("error creating collector", zap.Error(err))
+			return nil
+		}
+
 		err = chore.segmentLoop.Join(ctx, collector)
 		if err != nil {
 			chore.log.Error("error joining segmentloop", zap.Error(err))
@@ -63,7 +72,7 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 		queueSegments := make(map[Segment]struct{})
 
 		// Add reservoir segments to queue in pseudorandom order.
-		for i := 0; i < chore.config.Slots; i++ {
+		for i := 0; i < slots; i++ {
 			for _, res := range collector.Reservoirs {
 				// Skip reservoir if no segment at this index.
 				if len(res.Segments) <= i {
@@ -85,6 +94,37 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 	})
 }
 
+// newCollector creates the collector for the next segment loop and returns
+// it with the largest number of slots a node reservoir can have.
+func (chore *Chore) newCollector(ctx context.Context) (_ *Collector, slots int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.NodeScheduling {
+		return NewCollector(chore.config.Slots, chore.rand), chore.config.Slots, nil
+	}
+
+	unvetted, err := chore.overlay.Unvetted(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	vettedSlots, unvettedSlots := chore.config.NodeSlots(true), chore.config.NodeSlots(false)
+
+	nodeSlots := make(map[storj.NodeID]int, len(unvetted))
+	for _, nodeID := range unvetted {
+		nodeSlots[nodeID] = unvettedSlots
+	}
+
+	slots = vettedSlots
+	if len(unvetted) > 0 && unvettedSlots > slots {
+		slots = unvettedSlots
+	}
+
+	mon.IntVal("audit_unvetted_nodes").Observe(int64(len(unvetted)))
+
+	return NewNodeCollector(vettedSlots, nodeSlots, chore.rand), slots, nil
+}
+
 // Close closes chore.
 func (chore *Chore) Close() error {
 	chore.Loop.Close()
@@ -5,20 +5,25 @@ package audit
 
 import (
 	"context"
+	"encoding/binary"
 	"math/rand"
+	"time"
 
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
 var remoteSegmentFunc = mon.Task()
 
-var _ segmentloop.Observer = (*Collector)(nil)
+var _ segmentloop.CheckpointedObserver = (*Collector)(nil)
 
 // Collector uses the segment loop to add segments to node reservoirs.
 type Collector struct {
 	Reservoirs map[storj.NodeID]*Reservoir
 	slotCount  int
+	nodeSlots  map[storj.NodeID]int
+	maxSlots   int
 	rand       *rand.Rand
 }
 
@@ -27,6 +32,20 @@ func NewCollector(reservoirSlots int, r *rand.Rand) *Collector {
 	return &Collector{
 		Reservoirs: make(map[storj.NodeID]*Reservoir),
 		slotCount:  reservoirSlots,
+		maxSlots:   maxReservoirSize,
+		rand:       r,
+	}
+}
+
+// NewNodeCollector instantiates a segment collector where the reservoir of
+// every node listed in nodeSlots has its own size. Other nodes get
+// reservoirSlots slots.
+func NewNodeCollector(reservoirSlots int, nodeSlots map[storj.NodeID]int, r *rand.Rand) *Collector {
+	return &Collector{
+		Reservoirs: make(map[storj.NodeID]*Reservoir),
+		slotCount:  reservoirSlots,
+		nodeSlots:  nodeSlots,
+		maxSlots:   maxNodeReservoirSize,
 		rand:       r,
 	}
 }
@@ -43,7 +62,11 @@ func (collector *Collector) RemoteSegment(ctx context.Context, segment *segmentl
 	for _, piece := range segment.Pieces {
 		res, ok := collector.Reservoirs[piece.StorageNode]
 		if !ok {
-			res = NewReservoir(collector.slotCount)
+			slots, ok := collector.nodeSlots[piece.StorageNode]
+			if !ok {
+				slots = collector.slotCount
+			}
+			res = newReservoir(slots, collector.maxSlots)
 			collector.Reservoirs[piece.StorageNode] = res
 		}
 		res.Sample(collector.rand, NewSegment(segment))
@@ -55,3 +78,94 @@ func (collector *Collector) RemoteSegment(ctx context.Context, segment *segmentl
 func (collector *Collector) InlineSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
 	return nil
 }
+
+const (
+	// reservoirStateSize is the size of a saved reservoir without segments.
+	reservoirStateSize = len(storj.NodeID{}) + 2 + 8 + 8
+	// segmentStateSize is the size of a saved segment of a reservoir.
+	segmentStateSize = 16 + 8 + 8 + 4
+)
+
+// SaveState serializes the reservoirs, so the loop can be resumed after a
+// restart. The first chunk contains the number of reservoirs and every other
+// chunk contains the reservoir of a single node.
+func (collector *Collector) SaveState(ctx context.Context) ([][]byte, error) {
+	state := make([][]byte, 0, len(collector.Reservoirs)+1)
+
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint64(header, uint64(len(collector.Reservoirs)))
+	state = append(state, header)
+
+	for nodeID, res := range collector.Reservoirs {
+		segments := res.Segments
+		if res.index < int64(len(segments)) {
+			segments = segments[:res.index]
+		}
+
+		chunk := make([]byte, reservoirStateSize+len(segments)*segmentStateSize)
+		copy(chunk, nodeID[:])
+		binary.BigEndian.PutUint16(chunk[len(nodeID):], uint16(res.size))
+		binary.BigEndian.PutUint64(chunk[len(nodeID)+2:], uint64(res.index))
+		binary.BigEndian.PutUint64(chunk[len(nodeID)+10:], uint64(res.wSum))
+
+		data := chunk[reservoirStateSize:]
+		for _, segment := range segments {
+			copy(data, segment.StreamID[:])
+			binary.BigEndian.PutUint64(data[16:], segment.Position.Encode())
+			if segment.ExpiresAt != nil {
+				binary.BigEndian.PutUint64(data[24:], uint64(segment.ExpiresAt.UnixNano()))
+			}
+			binary.BigEndian.PutUint32(data[32:], uint32(segment.EncryptedSize))
+			data = data[segmentStateSize:]
+		}
+		state = append(state, chunk)
+	}
+	return state, nil
+}
+
+// RestoreState restores the reservoirs serialized with SaveState.
+func (collector *Collector) RestoreState(ctx context.Context, state [][]byte) error {
+	if len(state) == 0 || len(state[0]) != 8 || binary.BigEndian.Uint64(state[0]) != uint64(len(state)-1) {
+		return Error.New("invalid collector state")
+	}
+
+	reservoirs := make(map[storj.NodeID]*Reservoir, len(state)-1)
+	for _, chunk := range state[1:] {
+		if len(chunk) < reservoirStateSize || (len(chunk)-reservoirStateSize)%segmentStateSize != 0 {
+			return Error.New("invalid collector state")
+		}
+
+		var nodeID storj.NodeID
+		copy(nodeID[:], chunk)
+		size := int16(binary.BigEndian.Uint16(chunk[len(nodeID):]))
+		index := int64(binary.BigEndian.Uint64(chunk[len(nodeID)+2:]))
+		wSum := int64(binary.BigEndian.Uint64(chunk[len(nodeID)+10:]))
+
+		data := chunk[reservoirStateSize:]
+		if size < 1 || len(data)/segmentStateSize > int(size) {
+			return Error.New("invalid collector state")
+		}
+
+		res := &Reservoir{
+			Segments: make([]Segment, size),
+			size:     size,
+			index:    index,
+			wSum:     wSum,
+		}
+		for i := 0; len(data) > 0; i++ {
+			segment := &res.Segments[i]
+			copy(segment.StreamID[:], data)
+			segment.Position = metabase.SegmentPositionFromEncoded(binary.BigEndian.Uint64(data[16:]))
+			if expiresAt := int64(binary.BigEndian.Uint64(data[24:])); expiresAt != 0 {
+				t := time.Unix(0, expiresAt).UTC()
+				segment.ExpiresAt = &t
+			}
+			segment.EncryptedSize = int32(binary.BigEndian.Uint32(data[32:]))
+			data = data[segmentStateSize:]
+		}
+		reservoirs[nodeID] = res
+	}
+
+	collector.Reservoirs = reservoirs
+	return nil
+}
@@ -13,10 +13,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
 // TestAuditCollector does the following:
@@ -71,3 +74,41 @@ func TestAuditCollector(t *testing.T) {
 		}
 	})
 }
+
+func TestAuditCollectorState(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	collector := audit.NewCollector(3, rand.New(rand.NewSource(time.Now().Unix())))
+	require.NoError(t, collector.LoopStarted(ctx, segmentloop.LoopInfo{Started: time.Now()}))
+
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	nodeIDs := []storj.NodeID{testrand.NodeID(), testrand.NodeID()}
+	for i := 0; i < 5; i++ {
+		segment := &segmentloop.Segment{
+			StreamID:      testrand.UUID(),
+			Position:      metabase.SegmentPosition{Part: 1, Index: uint32(i)},
+			EncryptedSize: int32(100 * (i + 1)),
+			Pieces:        metabase.Pieces{{Number: 0, StorageNode: nodeIDs[0]}},
+		}
+		if i%2 == 0 {
+			segment.ExpiresAt = &expiresAt
+		}
+		// the second node has a partially filled reservoir.
+		if i == 0 {
+			segment.Pieces = append(segment.Pieces, metabase.Piece{Number: 1, StorageNode: nodeIDs[1]})
+		}
+		require.NoError(t, collector.RemoteSegment(ctx, segment))
+	}
+
+	state, err := collector.SaveState(ctx)
+	require.NoError(t, err)
+	// every node is saved in a separate chunk.
+	require.Len(t, state, len(nodeIDs)+1)
+
+	restored := audit.NewCollector(1, rand.New(rand.NewSource(time.Now().Unix())))
+	require.NoError(t, restored.RestoreState(ctx, state))
+	require.Equal(t, collector.Reservoirs, restored.Reservoirs)
+
+	require.Error(t, restored.RestoreState(ctx, state[:len(state)-1]))
+	require.Error(t, restored.RestoreState(ctx, nil))
+}
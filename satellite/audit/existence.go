@@ -0,0 +1,601 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	mathrand "math/rand"
+	"time"
+
+	"github.com/vivint/infectious"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/identity"
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/private/pieceauditpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+	"storj.io/uplink/private/eestream"
+)
+
+// ErrInvalidProof is the errs class for piece existence proofs which don't
+// match the audited segment.
+var ErrInvalidProof = errs.Class("invalid piece existence proof")
+
+// existenceNonceSize is the number of random bytes the node has to hash
+// together with the challenged range.
+const existenceNonceSize = 32
+
+// existenceReferenceExtraShares is the number of shares downloaded for the
+// reference stripe on top of the required ones.
+const existenceReferenceExtraShares = 2
+
+// ExistenceVerifier audits segments by asking nodes to prove that they hold
+// their pieces, without downloading the stripe from every node.
+//
+// A node answers a challenge with the hash of a byte range of the piece
+// prefixed with a random nonce, together with the piece hash and the order
+// limit it received on upload. All nodes are challenged on the same random
+// stripe. The satellite downloads that stripe only from a few more nodes than
+// required to decode it, reconstructs every node's share and checks the range
+// hashes against it. It also checks the piece size and the piece id derived
+// from the segment's root piece id and verifies the signatures of the piece
+// hash and the order limit.
+//
+// architecture: Worker
+type ExistenceVerifier struct {
+	log                *zap.Logger
+	metabase           *metabase.DB
+	orders             *orders.Service
+	shares             *Verifier
+	satellite          signing.Signee
+	dialer             rpc.Dialer
+	challengeSize      memory.Size
+	minDownloadTimeout time.Duration
+
+	nowFn func() time.Time
+}
+
+// NewExistenceVerifier creates an ExistenceVerifier. The shares verifier is
+// used for downloading the reference stripe.
+func NewExistenceVerifier(log *zap.Logger, metabase *metabase.DB, dialer rpc.Dialer, orders *orders.Service, shares *Verifier, id *identity.FullIdentity, config Config) *ExistenceVerifier {
+	return &ExistenceVerifier{
+		log:                log,
+		metabase:           metabase,
+		orders:             orders,
+		shares:             shares,
+		satellite:          signing.SigneeFromPeerIdentity(id.PeerIdentity()),
+		dialer:             dialer,
+		challengeSize:      config.ExistenceChallengeSize,
+		minDownloadTimeout: config.MinDownloadTimeout,
+		nowFn:              time.Now,
+	}
+}
+
+// existenceChallenge is the byte range of the pieces the nodes are asked to
+// hash. It's the same for all pieces of the segment and lies within a single
+// share.
+type existenceChallenge struct {
+	StripeIndex int32
+	Offset      int64
+	Length      int64
+}
+
+// existenceProof is the verified answer of a single node.
+type existenceProof struct {
+	Nonce     []byte
+	RangeHash []byte
+}
+
+// existenceResult is the outcome of challenging a single node.
+type existenceResult struct {
+	NodeID   storj.NodeID
+	PieceNum int
+	Proof    existenceProof
+	Err      error
+}
+
+// Verify challenges all the nodes holding pieces of the segment.
+func (verifier *ExistenceVerifier) Verify(ctx context.Context, segment Segment) (report Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if segment.Expired(verifier.nowFn()) {
+		verifier.log.Debug("segment expired before existence audit")
+		return Report{}, nil
+	}
+
+	segmentInfo, err := verifier.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			verifier.log.Debug("segment deleted before existence audit")
+			return Report{}, nil
+		}
+		return Report{}, err
+	}
+	if segmentInfo.Inline() {
+		return Report{}, nil
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segmentInfo.Redundancy)
+	if err != nil {
+		return Report{}, Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(int64(segmentInfo.EncryptedSize), redundancy)
+
+	challenge, err := verifier.newChallenge(ctx, segmentInfo)
+	if err != nil {
+		return Report{}, Error.Wrap(err)
+	}
+
+	orderLimits, _, cachedNodesInfo, err := verifier.orders.CreateAuditOrderLimits(ctx, segmentInfo, nil)
+	if err != nil {
+		if orders.ErrDownloadFailedNotEnoughPieces.Has(err) {
+			mon.Counter("not_enough_shares_for_existence_audit").Inc(1)
+			err = ErrNotEnoughShares.Wrap(err)
+		}
+		return Report{}, err
+	}
+
+	report.NodesReputation = make(map[storj.NodeID]overlay.ReputationStatus, len(cachedNodesInfo))
+	for id, info := range cachedNodesInfo {
+		report.NodesReputation[id] = info.Reputation
+	}
+	report.Offlines = getOfflineNodes(segmentInfo, orderLimits, nil)
+
+	type referenceResult struct {
+		stripe []byte
+		err    error
+	}
+	reference := make(chan referenceResult, 1)
+	go func() {
+		stripe, err := verifier.referenceStripe(ctx, segmentInfo, challenge.StripeIndex)
+		reference <- referenceResult{stripe: stripe, err: err}
+	}()
+
+	results := make(chan existenceResult, len(orderLimits))
+	var started int
+	for pieceNum, limit := range orderLimits {
+		if limit == nil {
+			continue
+		}
+		started++
+
+		go func(pieceNum int, limit *pb.AddressedOrderLimit) {
+			nodeID := limit.GetLimit().StorageNodeId
+			proof, err := verifier.challenge(ctx, limit, cachedNodesInfo[nodeID].LastIPPort,
+				segmentInfo.RootPieceID.Derive(nodeID, int32(pieceNum)), pieceSize, challenge)
+			results <- existenceResult{
+				NodeID:   nodeID,
+				PieceNum: pieceNum,
+				Proof:    proof,
+				Err:      err,
+			}
+		}(pieceNum, limit)
+	}
+
+	collected := make([]existenceResult, 0, started)
+	for i := 0; i < started; i++ {
+		collected = append(collected, <-results)
+	}
+
+	ref := <-reference
+	if ref.err != nil {
+		// without the reference stripe the range hashes can't be checked, so
+		// the nodes which answered don't get any credit.
+		mon.Counter("existence_audit_unverified").Inc(1)
+		verifier.log.Debug("existence audit: unable to reconstruct reference stripe",
+			zap.String("Segment", segmentInfoString(segment)),
+			zap.Error(ref.err))
+	}
+
+	var failed storj.NodeIDList
+	for _, result := range collected {
+		if result.Err == nil {
+			if ref.err != nil {
+				continue
+			}
+			result.Err = verifier.verifyRange(ctx, segmentInfo, ref.stripe, result.PieceNum, challenge, result.Proof)
+		}
+
+		switch {
+		case result.Err == nil:
+			report.Successes = append(report.Successes, result.NodeID)
+		case rpc.Error.Has(result.Err):
+			if errs.Is(result.Err, context.DeadlineExceeded) || errs2.IsRPC(result.Err, rpcstatus.Unknown) {
+				report.Offlines = append(report.Offlines, result.NodeID)
+				verifier.log.Debug("existence audit: dial failed (offline)",
+					zap.Stringer("Node ID", result.NodeID),
+					zap.String("Segment", segmentInfoString(segment)),
+					zap.Error(result.Err))
+				continue
+			}
+			report.Unknown = append(report.Unknown, result.NodeID)
+			verifier.log.Info("existence audit: unknown transport error (skipped)",
+				zap.Stringer("Node ID", result.NodeID),
+				zap.String("Segment", segmentInfoString(segment)),
+				zap.Error(result.Err))
+		case errs2.IsRPC(result.Err, rpcstatus.Unimplemented):
+			// the node doesn't support existence audits yet, it will be
+			// covered by the stripe audits.
+			mon.Counter("existence_audit_unimplemented").Inc(1)
+		case errs2.IsRPC(result.Err, rpcstatus.NotFound), ErrInvalidProof.Has(result.Err):
+			failed = append(failed, result.NodeID)
+			verifier.log.Info("existence audit: piece not proven (audit failed)",
+				zap.Stringer("Node ID", result.NodeID),
+				zap.String("Segment", segmentInfoString(segment)),
+				zap.Error(result.Err))
+		default:
+			report.Unknown = append(report.Unknown, result.NodeID)
+			verifier.log.Info("existence audit: unknown error (skipped)",
+				zap.Stringer("Node ID", result.NodeID),
+				zap.String("Segment", segmentInfoString(segment)),
+				zap.Error(result.Err))
+		}
+	}
+
+	if len(failed) > 0 {
+		// don't fail nodes for pieces which were removed or replaced in the meantime.
+		newSegment, err := verifier.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+			StreamID: segment.StreamID,
+			Position: segment.Position,
+		})
+		if err != nil {
+			if metabase.ErrSegmentNotFound.Has(err) {
+				verifier.log.Debug("segment deleted during existence audit")
+				return Report{}, nil
+			}
+			return report, err
+		}
+		if newSegment.RootPieceID != segmentInfo.RootPieceID || !newSegment.Pieces.Equal(segmentInfo.Pieces) {
+			verifier.log.Debug("segment modified during existence audit")
+			return Report{}, nil
+		}
+		report.Fails = failed
+	}
+
+	mon.IntVal("existence_audit_success_count").Observe(int64(len(report.Successes)))
+	mon.IntVal("existence_audit_fail_count").Observe(int64(len(report.Fails)))
+	mon.IntVal("existence_audit_offline_count").Observe(int64(len(report.Offlines)))
+	mon.IntVal("existence_audit_unknown_count").Observe(int64(len(report.Unknown)))
+
+	return report, nil
+}
+
+// newChallenge picks a random range within a random stripe of the segment.
+func (verifier *ExistenceVerifier) newChallenge(ctx context.Context, segmentInfo metabase.Segment) (_ existenceChallenge, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stripeIndex, err := GetRandomStripe(ctx, segmentInfo)
+	if err != nil {
+		return existenceChallenge{}, err
+	}
+
+	shareSize := int64(segmentInfo.Redundancy.ShareSize)
+	length := verifier.challengeSize.Int64()
+	if length > shareSize {
+		length = shareSize
+	}
+
+	offset, err := rand.Int(rand.Reader, big.NewInt(shareSize-length+1))
+	if err != nil {
+		return existenceChallenge{}, err
+	}
+
+	return existenceChallenge{
+		StripeIndex: stripeIndex,
+		Offset:      int64(stripeIndex)*shareSize + offset.Int64(),
+		Length:      length,
+	}, nil
+}
+
+// referenceStripe downloads the stripe from a few more nodes than needed to
+// decode it and returns the corrected stripe.
+func (verifier *ExistenceVerifier) referenceStripe(ctx context.Context, segmentInfo metabase.Segment, stripeIndex int32) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	required := int(segmentInfo.Redundancy.RequiredShares)
+	total := int(segmentInfo.Redundancy.TotalShares)
+	shareSize := segmentInfo.Redundancy.ShareSize
+
+	limits, privateKey, cachedNodesInfo, err := verifier.orders.CreateAuditOrderLimits(ctx, segmentInfo, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the extra shares allow correcting a single bad share.
+	needed := required + existenceReferenceExtraShares
+	var available []int
+	for pieceNum, limit := range limits {
+		if limit != nil {
+			available = append(available, pieceNum)
+		}
+	}
+	if len(available) < needed {
+		return nil, ErrNotEnoughShares.New("got %d, required %d", len(available), needed)
+	}
+
+	rnd := mathrand.New(cryptoSource{})
+	rnd.Shuffle(len(available), func(i, k int) { available[i], available[k] = available[k], available[i] })
+	for _, pieceNum := range available[needed:] {
+		limits[pieceNum] = nil
+	}
+
+	shares, err := verifier.shares.DownloadShares(ctx, limits, privateKey, cachedNodesInfo, stripeIndex, shareSize)
+	if err != nil {
+		return nil, err
+	}
+
+	originals := make(map[int]Share, len(shares))
+	for pieceNum, share := range shares {
+		if share.Error == nil {
+			originals[pieceNum] = share
+		}
+	}
+	// with a single extra share a bad share is still detected, but it
+	// can't be corrected anymore.
+	if len(originals) <= required {
+		return nil, ErrNotEnoughShares.New("downloaded %d, required more than %d", len(originals), required)
+	}
+
+	_, corrected, err := auditShares(ctx, int16(required), int16(total), originals)
+	if err != nil {
+		return nil, err
+	}
+
+	fec, err := infectious.NewFEC(required, total)
+	if err != nil {
+		return nil, err
+	}
+	return rebuildStripe(ctx, fec, corrected, int(shareSize))
+}
+
+// verifyRange checks the node's range hash against the share reconstructed
+// from the reference stripe.
+func (verifier *ExistenceVerifier) verifyRange(ctx context.Context, segmentInfo metabase.Segment, stripe []byte, pieceNum int, challenge existenceChallenge, proof existenceProof) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	shareSize := int64(segmentInfo.Redundancy.ShareSize)
+
+	fec, err := infectious.NewFEC(int(segmentInfo.Redundancy.RequiredShares), int(segmentInfo.Redundancy.TotalShares))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	share := make([]byte, shareSize)
+	if err := fec.EncodeSingle(stripe, share, pieceNum); err != nil {
+		return Error.Wrap(err)
+	}
+
+	start := challenge.Offset - int64(challenge.StripeIndex)*shareSize
+	hasher := sha256.New()
+	_, _ = hasher.Write(proof.Nonce)
+	_, _ = hasher.Write(share[start : start+challenge.Length])
+
+	if !bytes.Equal(hasher.Sum(nil), proof.RangeHash) {
+		return ErrInvalidProof.New("range hash doesn't match the reconstructed share")
+	}
+	return nil
+}
+
+// challenge asks a single node to prove that it holds the piece.
+func (verifier *ExistenceVerifier) challenge(ctx context.Context, limit *pb.AddressedOrderLimit, cachedIPAndPort string, pieceID storj.PieceID, pieceSize int64, challenge existenceChallenge) (_ existenceProof, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	timedCtx, cancel := context.WithTimeout(ctx, verifier.minDownloadTimeout)
+	defer cancel()
+
+	nodeID := limit.GetLimit().StorageNodeId
+
+	var conn *rpc.Conn
+	if cachedIPAndPort != "" {
+		conn, err = verifier.dialer.DialNodeURL(timedCtx, storj.NodeURL{ID: nodeID, Address: cachedIPAndPort})
+		if err != nil {
+			verifier.log.Debug("failed to connect to existence audit target node at cached IP",
+				zap.Stringer("Node ID", nodeID), zap.String("cached-ip-and-port", cachedIPAndPort), zap.Error(err))
+		}
+	}
+	if conn == nil {
+		conn, err = verifier.dialer.DialNodeURL(timedCtx, storj.NodeURL{ID: nodeID, Address: limit.GetStorageNodeAddress().Address})
+		if err != nil {
+			return existenceProof{}, Error.Wrap(err)
+		}
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	nonce := make([]byte, existenceNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return existenceProof{}, Error.Wrap(err)
+	}
+
+	resp, err := pieceauditpb.NewDRPCPieceAuditClient(conn).ProvePiece(timedCtx, &pieceauditpb.ProvePieceRequest{
+		Limit:  limit.GetLimit(),
+		Nonce:  nonce,
+		Offset: challenge.Offset,
+		Length: challenge.Length,
+	})
+	if err != nil {
+		return existenceProof{}, err
+	}
+
+	if err := verifier.verifyProof(ctx, pieceID, pieceSize, resp); err != nil {
+		return existenceProof{}, err
+	}
+	return existenceProof{Nonce: nonce, RangeHash: resp.RangeHash}, nil
+}
+
+// verifyProof checks the node's answer against what is known about the piece.
+func (verifier *ExistenceVerifier) verifyProof(ctx context.Context, pieceID storj.PieceID, pieceSize int64, resp *pieceauditpb.ProvePieceResponse) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case resp.PieceSize != pieceSize:
+		return ErrInvalidProof.New("piece size mismatch: expected %d, got %d", pieceSize, resp.PieceSize)
+	case len(resp.RangeHash) != sha256.Size:
+		return ErrInvalidProof.New("invalid range hash length %d", len(resp.RangeHash))
+	case resp.PieceHash == nil:
+		return ErrInvalidProof.New("missing piece hash")
+	case resp.OriginalLimit == nil:
+		return ErrInvalidProof.New("missing original order limit")
+	case resp.PieceHash.PieceId != pieceID:
+		return ErrInvalidProof.New("piece hash is for piece %s, expected %s", resp.PieceHash.PieceId, pieceID)
+	case resp.OriginalLimit.PieceId != pieceID:
+		return ErrInvalidProof.New("order limit is for piece %s, expected %s", resp.OriginalLimit.PieceId, pieceID)
+	case resp.PieceHash.PieceSize != 0 && resp.PieceHash.PieceSize != pieceSize:
+		return ErrInvalidProof.New("piece hash size mismatch: expected %d, got %d", pieceSize, resp.PieceHash.PieceSize)
+	case resp.OriginalLimit.SatelliteId != verifier.satellite.ID():
+		return ErrInvalidProof.New("order limit was issued by satellite %s", resp.OriginalLimit.SatelliteId)
+	}
+
+	if err := signing.VerifyOrderLimitSignature(ctx, verifier.satellite, resp.OriginalLimit); err != nil {
+		return ErrInvalidProof.Wrap(err)
+	}
+	if err := signing.VerifyUplinkPieceHashSignature(ctx, resp.OriginalLimit.UplinkPublicKey, resp.PieceHash); err != nil {
+		return ErrInvalidProof.Wrap(err)
+	}
+
+	return nil
+}
+
+// SetNow allows tests to have the verifier act as if the current time is whatever they want.
+func (verifier *ExistenceVerifier) SetNow(nowFn func() time.Time) {
+	verifier.nowFn = nowFn
+}
+
+// NewExistenceChore instantiates a Chore which fills the queues for the
+// existence audits. It runs more often and collects more segments per node
+// than the stripe audit chore.
+func NewExistenceChore(log *zap.Logger, queues *Queues, loop *segmentloop.Service, config Config) *Chore {
+	config.ChoreInterval = config.ExistenceChoreInterval
+	config.Slots = config.ExistenceSlots
+	config.NodeScheduling = false
+	return NewChore(log, queues, loop, nil, config)
+}
+
+// ExistenceWorker processes the existence audit queue.
+//
+// architecture: Worker
+type ExistenceWorker struct {
+	log         *zap.Logger
+	queues      *Queues
+	verifier    *ExistenceVerifier
+	reputations *reputation.Service
+	Loop        *sync2.Cycle
+	concurrency int
+}
+
+// NewExistenceWorker instantiates ExistenceWorker.
+func NewExistenceWorker(log *zap.Logger, queues *Queues, verifier *ExistenceVerifier, reputations *reputation.Service, config Config) *ExistenceWorker {
+	return &ExistenceWorker{
+		log: log,
+
+		queues:      queues,
+		verifier:    verifier,
+		reputations: reputations,
+		Loop:        sync2.NewCycle(config.ExistenceQueueInterval),
+		concurrency: config.ExistenceWorkerConcurrency,
+	}
+}
+
+// Run runs the existence audits.
+func (worker *ExistenceWorker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return worker.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+		err = worker.process(ctx)
+		if err != nil {
+			worker.log.Error("process", zap.Error(Error.Wrap(err)))
+		}
+		return nil
+	})
+}
+
+// Close halts the worker.
+func (worker *ExistenceWorker) Close() error {
+	worker.Loop.Close()
+	return nil
+}
+
+// process repeatedly removes an item from the queue and runs an existence audit.
+func (worker *ExistenceWorker) process(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	queue := worker.queues.Fetch()
+
+	limiter := sync2.NewLimiter(worker.concurrency)
+	defer limiter.Wait()
+
+	for {
+		segment, err := queue.Next()
+		if err != nil {
+			if ErrEmptyQueue.Has(err) {
+				queue = worker.queues.Fetch()
+				if queue.Size() == 0 {
+					return nil
+				}
+				continue
+			}
+			return err
+		}
+
+		started := limiter.Go(ctx, func() {
+			err := worker.work(ctx, segment)
+			if err != nil {
+				worker.log.Error("error(s) during existence audit",
+					zap.String("Segment StreamID", segment.StreamID.String()),
+					zap.Uint64("Segment Position", segment.Position.Encode()),
+					zap.Error(err))
+			}
+		})
+		if !started {
+			return ctx.Err()
+		}
+	}
+}
+
+func (worker *ExistenceWorker) work(ctx context.Context, segment Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report, err := worker.verifier.Verify(ctx, segment)
+	return errs.Combine(err, worker.record(ctx, report))
+}
+
+// record applies the results of an existence audit to the node reputations.
+func (worker *ExistenceWorker) record(ctx context.Context, report Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errlist errs.Group
+	apply := func(nodeIDs storj.NodeIDList, outcome reputation.AuditType) {
+		for _, nodeID := range nodeIDs {
+			err := worker.reputations.ApplyAudit(ctx, nodeID, report.NodesReputation[nodeID], outcome)
+			if err != nil {
+				errlist.Add(Error.New("failed to record audit status %s for node %s: %w", outcome.String(), nodeID.String(), err))
+			}
+		}
+	}
+
+	apply(report.Successes, reputation.AuditExistenceSuccess)
+	apply(report.Fails, reputation.AuditExistenceFailure)
+	apply(report.Unknown, reputation.AuditUnknown)
+	apply(report.Offlines, reputation.AuditOffline)
+
+	return errlist.Err()
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode/pieces"
+)
+
+func TestExistenceVerifier(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 10, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Audit.ExistenceEnabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+		audits.ExistenceWorker.Loop.Pause()
+		audits.ExistenceChore.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		audits.ExistenceChore.Loop.TriggerWait()
+		queue := audits.ExistenceQueues.Fetch()
+		queueSegment, err := queue.Next()
+		require.NoError(t, err)
+
+		segment, err := satellite.Metabase.DB.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+			StreamID: queueSegment.StreamID,
+			Position: queueSegment.Position,
+		})
+		require.NoError(t, err)
+
+		report, err := audits.ExistenceVerifier.Verify(ctx, queueSegment)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, len(segment.Pieces))
+		require.Len(t, report.Fails, 0)
+		require.Len(t, report.Offlines, 0)
+		require.Len(t, report.Unknown, 0)
+
+		// delete the piece from the first node
+		piece := segment.Pieces[0]
+		pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+		node := planet.FindNode(piece.StorageNode)
+		err = node.Storage2.Store.Delete(ctx, satellite.ID(), pieceID)
+		require.NoError(t, err)
+
+		report, err = audits.ExistenceVerifier.Verify(ctx, queueSegment)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, len(segment.Pieces)-1)
+		require.Equal(t, []storj.NodeID{piece.StorageNode}, []storj.NodeID(report.Fails))
+
+		// the worker records the failure in the node's reputation.
+		audits.ExistenceChore.Loop.TriggerWait()
+		audits.ExistenceWorker.Loop.TriggerWait()
+
+		info, err := satellite.Reputation.Service.Get(ctx, piece.StorageNode)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, info.TotalAuditCount)
+		require.EqualValues(t, 0, info.AuditSuccessCount)
+
+		// successes only count as being online.
+		info, err = satellite.Reputation.Service.Get(ctx, segment.Pieces[1].StorageNode)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, info.TotalAuditCount)
+		require.EqualValues(t, 0, info.AuditSuccessCount)
+
+		// stop the second node in the segment
+		err = planet.StopNodeAndUpdate(ctx, planet.FindNode(segment.Pieces[1].StorageNode))
+		require.NoError(t, err)
+
+		report, err = audits.ExistenceVerifier.Verify(ctx, queueSegment)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, len(segment.Pieces)-2)
+		require.Len(t, report.Fails, 1)
+		require.Len(t, report.Offlines, 1)
+	})
+}
+
+func TestExistenceVerifierCorruptedPiece(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 10, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Audit.ExistenceEnabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+		audits.ExistenceWorker.Loop.Pause()
+		audits.ExistenceChore.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		audits.ExistenceChore.Loop.TriggerWait()
+		queue := audits.ExistenceQueues.Fetch()
+		queueSegment, err := queue.Next()
+		require.NoError(t, err)
+
+		segment, err := satellite.Metabase.DB.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+			StreamID: queueSegment.StreamID,
+			Position: queueSegment.Position,
+		})
+		require.NoError(t, err)
+
+		// keep the piece header intact, so that only the range hash is wrong.
+		piece := segment.Pieces[0]
+		pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+		node := planet.FindNode(piece.StorageNode)
+
+		blobRef := storage.BlobRef{
+			Namespace: satellite.ID().Bytes(),
+			Key:       pieceID.Bytes(),
+		}
+		reader, err := node.Storage2.BlobsCache.Open(ctx, blobRef)
+		require.NoError(t, err)
+		pieceData, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		for i := pieces.V1PieceHeaderReservedArea; i < len(pieceData); i++ {
+			pieceData[i]++
+		}
+
+		require.NoError(t, node.Storage2.BlobsCache.Delete(ctx, blobRef))
+		writer, err := node.Storage2.BlobsCache.Create(ctx, blobRef, int64(len(pieceData)))
+		require.NoError(t, err)
+		_, err = writer.Write(pieceData)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		report, err := audits.ExistenceVerifier.Verify(ctx, queueSegment)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, len(segment.Pieces)-1)
+		require.Equal(t, []storj.NodeID{piece.StorageNode}, []storj.NodeID(report.Fails))
+	})
+}
@@ -12,26 +12,38 @@ import (
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
-const maxReservoirSize = 3
+const (
+	maxReservoirSize = 3
+
+	// maxNodeReservoirSize is the reservoir size limit when the reservoirs
+	// are sized per node to reach a target number of audits.
+	maxNodeReservoirSize = 100
+)
 
 // Reservoir holds a certain number of segments to reflect a random sample.
 type Reservoir struct {
-	Segments [maxReservoirSize]Segment
-	size     int8
+	Segments []Segment
+	size     int16
 	index    int64
 	wSum     int64
 }
 
 // NewReservoir instantiates a Reservoir.
 func NewReservoir(size int) *Reservoir {
+	return newReservoir(size, maxReservoirSize)
+}
+
+// newReservoir instantiates a Reservoir with the size capped at max.
+func newReservoir(size, max int) *Reservoir {
 	if size < 1 {
 		size = 1
-	} else if size > maxReservoirSize {
-		size = maxReservoirSize
+	} else if size > max {
+		size = max
 	}
 	return &Reservoir{
-		size:  int8(size),
-		index: 0,
+		Segments: make([]Segment, size),
+		size:     int16(size),
+		index:    0,
 	}
 }
 
@@ -0,0 +1,59 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"math"
+	"time"
+
+	"storj.io/common/pb"
+)
+
+// TargetAuditsPerDay returns how many audits per day a node should get when
+// the audits are scheduled by node.
+func (config Config) TargetAuditsPerDay(vetted bool) float64 {
+	if vetted {
+		return config.VettedAuditsPerDay
+	}
+	return config.UnvettedAuditsPerDay
+}
+
+// NodeSlots returns the number of reservoir slots a node needs to reach its
+// target audits per day, given that the reservoirs are collected every
+// ChoreInterval.
+func (config Config) NodeSlots(vetted bool) int {
+	slots := int(math.Ceil(config.TargetAuditsPerDay(vetted) * config.ChoreInterval.Hours() / 24))
+	if slots < 1 {
+		return 1
+	}
+	if slots > maxNodeReservoirSize {
+		return maxNodeReservoirSize
+	}
+	return slots
+}
+
+// AuditsPerDay calculates the average number of audits per day a node got
+// according to its audit history. The history is considered to span at least
+// a day, so that a node isn't reported with a high rate right after its first
+// audit.
+func AuditsPerDay(history *pb.AuditHistory, now time.Time) float64 {
+	if history == nil || len(history.Windows) == 0 {
+		return 0
+	}
+
+	var total int64
+	first := now
+	for _, window := range history.Windows {
+		total += int64(window.TotalCount)
+		if window.WindowStart.Before(first) {
+			first = window.WindowStart
+		}
+	}
+
+	days := now.Sub(first).Hours() / 24
+	if days < 1 {
+		days = 1
+	}
+	return float64(total) / days
+}
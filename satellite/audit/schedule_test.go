@@ -0,0 +1,88 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+func TestNodeSlots(t *testing.T) {
+	config := Config{
+		ChoreInterval:        24 * time.Hour,
+		VettedAuditsPerDay:   3,
+		UnvettedAuditsPerDay: 20,
+	}
+	require.Equal(t, 3, config.NodeSlots(true))
+	require.Equal(t, 20, config.NodeSlots(false))
+
+	// collecting twice a day needs half of the slots.
+	config.ChoreInterval = 12 * time.Hour
+	require.Equal(t, 2, config.NodeSlots(true))
+	require.Equal(t, 10, config.NodeSlots(false))
+
+	// at least one slot is always collected.
+	config.VettedAuditsPerDay = 0
+	require.Equal(t, 1, config.NodeSlots(true))
+
+	config.UnvettedAuditsPerDay = 10000
+	require.Equal(t, maxNodeReservoirSize, config.NodeSlots(false))
+}
+
+func TestNodeCollector(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	vetted, unvetted := testrand.NodeID(), testrand.NodeID()
+
+	collector := NewNodeCollector(2, map[storj.NodeID]int{unvetted: 10}, rand.New(rand.NewSource(0)))
+	for i := 0; i < 20; i++ {
+		err := collector.RemoteSegment(ctx, &segmentloop.Segment{
+			StreamID:      testrand.UUID(),
+			EncryptedSize: 1024,
+			Pieces: metabase.Pieces{
+				{Number: 0, StorageNode: vetted},
+				{Number: 1, StorageNode: unvetted},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, collector.Reservoirs[vetted].Segments, 2)
+	require.Len(t, collector.Reservoirs[unvetted].Segments, 10)
+	for _, segment := range collector.Reservoirs[unvetted].Segments {
+		require.NotEqual(t, Segment{}, segment)
+	}
+}
+
+func TestAuditsPerDay(t *testing.T) {
+	now := time.Now()
+
+	require.Zero(t, AuditsPerDay(nil, now))
+	require.Zero(t, AuditsPerDay(&pb.AuditHistory{}, now))
+
+	// a history shorter than a day is averaged over a day.
+	require.Equal(t, 6.0, AuditsPerDay(&pb.AuditHistory{
+		Windows: []*pb.AuditWindow{
+			{WindowStart: now.Add(-12 * time.Hour), TotalCount: 4},
+			{WindowStart: now.Add(-time.Hour), TotalCount: 2},
+		},
+	}, now))
+
+	require.Equal(t, 5.0, AuditsPerDay(&pb.AuditHistory{
+		Windows: []*pb.AuditWindow{
+			{WindowStart: now.Add(-48 * time.Hour), TotalCount: 4},
+			{WindowStart: now.Add(-24 * time.Hour), TotalCount: 6},
+		},
+	}, now))
+}
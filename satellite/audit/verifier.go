@@ -645,6 +645,7 @@ func (verifier *Verifier) GetShare(ctx context.Context, limit *pb.AddressedOrder
 
 	offset := int64(shareSize) * int64(stripeIndex)
 
+	start := time.Now()
 	downloader, err := ps.Download(timedCtx, limit.GetLimit(), piecePrivateKey, offset, int64(shareSize))
 	if err != nil {
 		return Share{}, err
@@ -656,6 +657,9 @@ func (verifier *Verifier) GetShare(ctx context.Context, limit *pb.AddressedOrder
 	if err != nil {
 		return Share{}, err
 	}
+	// shares are small, so reading the whole share is close to the time to
+	// first byte.
+	verifier.overlay.DownloadFeedback.TimeToFirstByte(targetNodeID, time.Since(start))
 
 	return Share{
 		Error:    nil,
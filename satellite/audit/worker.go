@@ -29,6 +29,17 @@ type Config struct {
 	QueueInterval     time.Duration `help:"how often to recheck an empty audit queue" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
 	Slots             int           `help:"number of reservoir slots allotted for nodes, currently capped at 3" default:"3"`
 	WorkerConcurrency int           `help:"number of workers to run audits on segments" default:"2"`
+
+	NodeScheduling       bool    `help:"whether to size the reservoir of every node so that it gets a target number of audits per day" default:"false"`
+	VettedAuditsPerDay   float64 `help:"the target number of audits per day for a vetted node when scheduling audits by node" default:"3"`
+	UnvettedAuditsPerDay float64 `help:"the target number of audits per day for an unvetted node when scheduling audits by node" default:"20"`
+
+	ExistenceEnabled           bool          `help:"whether to run piece existence audits, which download a stripe only from a few nodes" default:"false"`
+	ExistenceChoreInterval     time.Duration `help:"how often to run the reservoir chore for piece existence audits" releaseDefault:"4h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	ExistenceQueueInterval     time.Duration `help:"how often to recheck an empty piece existence audit queue" releaseDefault:"10m" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	ExistenceSlots             int           `help:"number of reservoir slots allotted for nodes for piece existence audits" default:"3"`
+	ExistenceWorkerConcurrency int           `help:"number of workers to run piece existence audits on segments" default:"5"`
+	ExistenceChallengeSize     memory.Size   `help:"the size of the piece range nodes have to hash for a piece existence audit, capped at the share size" default:"256B"`
 }
 
 // Worker contains information for populating audit queue and processing audits.
@@ -39,6 +39,8 @@ type DB interface {
 	UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error)
 	// DeleteBucket deletes a bucket
 	DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error)
+	// MoveBucket renames the bucket and moves it to another project, together with its attribution. The usage rollups are only moved within the project.
+	MoveBucket(ctx context.Context, bucket, newBucket metabase.BucketLocation) (err error)
 	// ListBuckets returns all buckets for a project
 	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
 	// CountBuckets returns the number of buckets a project currently has
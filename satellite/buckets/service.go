@@ -6,6 +6,7 @@ package buckets
 import (
 	"context"
 
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
@@ -13,8 +14,12 @@ import (
 )
 
 var (
+	mon = monkit.Package()
+
 	// ErrBucketNotEmpty is returned when a caller attempts to change placement constraints.
 	ErrBucketNotEmpty = errs.Class("bucket must be empty")
+	// ErrBucketAlreadyExists is returned when a bucket is moved to an existing bucket.
+	ErrBucketAlreadyExists = errs.Class("bucket already exists")
 )
 
 // NewService converts the provided db and metabase calls into a single DB interface.
@@ -56,3 +61,87 @@ func (buckets *Service) UpdateBucket(ctx context.Context, bucket storj.Bucket) (
 
 	return buckets.DB.UpdateBucket(ctx, bucket)
 }
+
+// MoveBucket renames the bucket or moves it to another project.
+//
+// Both buckets are fenced while the objects are moved, so no new objects
+// can be uploaded to either of them. Buckets with uploads in progress are
+// refused with metabase.ErrPendingObjects. The usage of the projects in the
+// live accounting cache is corrected by the next tally.
+//
+// The object keys stay encrypted with the key derived from the original bucket
+// name, so clients need to override the encryption key of the new bucket.
+func (buckets *Service) MoveBucket(ctx context.Context, bucket, newBucket metabase.BucketLocation) (movedObjectCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket == newBucket {
+		return 0, ErrBucketAlreadyExists.New("%s", newBucket.BucketName)
+	}
+
+	_, err = buckets.GetBucket(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	exists, err := buckets.HasBucket(ctx, []byte(newBucket.BucketName), newBucket.ProjectID)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		return 0, ErrBucketAlreadyExists.New("%s", newBucket.BucketName)
+	}
+
+	// objects of a bucket are deleted before the bucket, however, the
+	// deletion may have been interrupted.
+	empty, err := buckets.metabase.BucketEmpty(ctx, metabase.BucketEmpty{
+		ProjectID:  newBucket.ProjectID,
+		BucketName: newBucket.BucketName,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !empty {
+		return 0, ErrBucketNotEmpty.New("cannot move to a bucket with leftover objects")
+	}
+
+	if err := buckets.metabase.FenceBucket(ctx, bucket); err != nil {
+		return 0, err
+	}
+	defer func() {
+		err = errs.Combine(err, buckets.metabase.UnfenceBucket(ctx, bucket))
+	}()
+
+	if err := buckets.metabase.FenceBucket(ctx, newBucket); err != nil {
+		return 0, err
+	}
+	defer func() {
+		err = errs.Combine(err, buckets.metabase.UnfenceBucket(ctx, newBucket))
+	}()
+
+	movedObjectCount, err = buckets.metabase.MoveBucketObjects(ctx, metabase.MoveBucketObjects{
+		Bucket:    bucket,
+		NewBucket: newBucket,
+	})
+	if err != nil {
+		return 0, errs.Combine(err, buckets.moveBucketObjectsBack(ctx, bucket, newBucket))
+	}
+
+	err = buckets.DB.MoveBucket(ctx, bucket, newBucket)
+	if err != nil {
+		return 0, errs.Combine(err, buckets.moveBucketObjectsBack(ctx, bucket, newBucket))
+	}
+
+	return movedObjectCount, nil
+}
+
+// moveBucketObjectsBack moves the objects back to the original bucket after
+// a failed move.
+func (buckets *Service) moveBucketObjectsBack(ctx context.Context, bucket, newBucket metabase.BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = buckets.metabase.MoveBucketObjects(ctx, metabase.MoveBucketObjects{
+		Bucket:    newBucket,
+		NewBucket: bucket,
+	})
+	return err
+}
@@ -5,13 +5,21 @@ package buckets_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/attribution"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
 )
 
 const TestBucket = "testbucket"
@@ -247,3 +255,144 @@ func TestBucketPlacement_PendingObject(t *testing.T) {
 		},
 	)
 }
+
+func TestMoveBucket(t *testing.T) {
+	testplanet.Run(t,
+		testplanet.Config{
+			SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			satellite := planet.Satellites[0]
+			service := satellite.API.Buckets.Service
+			uplink := planet.Uplinks[0]
+			projectID := uplink.Projects[0].ID
+
+			newProject, err := satellite.DB.Console().Projects().Insert(ctx, &console.Project{
+				Name:    "new project",
+				OwnerID: uplink.Projects[0].Owner.ID,
+			})
+			require.NoError(t, err)
+
+			bucket := metabase.BucketLocation{ProjectID: projectID, BucketName: TestBucket}
+			renamed := metabase.BucketLocation{ProjectID: projectID, BucketName: "renamed"}
+			transferred := metabase.BucketLocation{ProjectID: newProject.ID, BucketName: "transferred"}
+
+			err = uplink.CreateBucket(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+
+			current, err := service.GetBucket(ctx, []byte(TestBucket), projectID)
+			require.NoError(t, err)
+			current.Placement = storj.EU
+			_, err = service.UpdateBucket(ctx, current)
+			require.NoError(t, err)
+
+			err = uplink.Upload(ctx, satellite, TestBucket, TestObject, []byte("data"))
+			require.NoError(t, err)
+
+			_, err = satellite.DB.Attribution().Insert(ctx, &attribution.Info{
+				ProjectID:  projectID,
+				BucketName: []byte(TestBucket),
+				UserAgent:  []byte("agent"),
+			})
+			require.NoError(t, err)
+
+			now := time.Now()
+			intervalStart := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location())
+
+			// a deleted bucket with the new name left its usage behind.
+			for _, location := range []metabase.BucketLocation{bucket, transferred} {
+				err = satellite.DB.Orders().UpdateBucketBandwidthAllocation(ctx, location.ProjectID, []byte(location.BucketName), pb.PieceAction_GET, 100, intervalStart)
+				require.NoError(t, err)
+			}
+
+			err = satellite.DB.ProjectAccounting().SaveTallies(ctx, intervalStart, map[metabase.BucketLocation]*accounting.BucketTally{
+				bucket: {BucketLocation: bucket, ObjectCount: 1, TotalBytes: 4},
+			})
+			require.NoError(t, err)
+
+			// the new bucket exists.
+			err = uplink.CreateBucket(ctx, satellite, "renamed")
+			require.NoError(t, err)
+			_, err = service.MoveBucket(ctx, bucket, renamed)
+			require.True(t, buckets.ErrBucketAlreadyExists.Has(err), err)
+			err = uplink.DeleteBucket(ctx, satellite, "renamed")
+			require.NoError(t, err)
+
+			// the bucket doesn't exist.
+			_, err = service.MoveBucket(ctx, metabase.BucketLocation{ProjectID: projectID, BucketName: "missing"}, renamed)
+			require.True(t, storj.ErrBucketNotFound.Has(err), err)
+
+			// the bucket has an upload in progress.
+			pending := metabase.ObjectStream{
+				ProjectID:  bucket.ProjectID,
+				BucketName: bucket.BucketName,
+				ObjectKey:  "pending",
+				StreamID:   testrand.UUID(),
+				Version:    1,
+			}
+			_, err = satellite.Metabase.DB.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+				ObjectStream: pending,
+				Encryption: storj.EncryptionParameters{
+					CipherSuite: storj.EncAESGCM,
+					BlockSize:   256,
+				},
+			})
+			require.NoError(t, err)
+			_, err = service.MoveBucket(ctx, bucket, renamed)
+			require.True(t, metabase.ErrPendingObjects.Has(err), err)
+			_, err = satellite.Metabase.DB.DeletePendingObject(ctx, metabase.DeletePendingObject{
+				ObjectStream: pending,
+			})
+			require.NoError(t, err)
+
+			movedObjects, err := service.MoveBucket(ctx, bucket, renamed)
+			require.NoError(t, err)
+			require.EqualValues(t, 1, movedObjects)
+			_, err = service.MoveBucket(ctx, renamed, transferred)
+			require.NoError(t, err)
+
+			for _, location := range []metabase.BucketLocation{bucket, renamed} {
+				_, err = service.GetBucket(ctx, []byte(location.BucketName), location.ProjectID)
+				require.True(t, storj.ErrBucketNotFound.Has(err), err)
+			}
+
+			moved, err := service.GetBucket(ctx, []byte(transferred.BucketName), transferred.ProjectID)
+			require.NoError(t, err)
+			require.Equal(t, current.ID, moved.ID)
+			require.Equal(t, storj.EU, moved.Placement)
+
+			objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+			require.Equal(t, transferred, objects[0].Location().Bucket())
+
+			info, err := satellite.DB.Attribution().Get(ctx, transferred.ProjectID, []byte(transferred.BucketName))
+			require.NoError(t, err)
+			require.Equal(t, []byte("agent"), info.UserAgent)
+
+			// the usage moves with the rename, however, it stays with the
+			// original project on the transfer.
+			for location, expected := range map[metabase.BucketLocation]int64{
+				bucket:      0,
+				renamed:     100,
+				transferred: 100,
+			} {
+				bandwidth, err := satellite.DB.Orders().GetBucketBandwidth(ctx, location.ProjectID, []byte(location.BucketName), intervalStart.Add(-time.Hour), now.Add(time.Hour))
+				require.NoError(t, err)
+				require.Equal(t, expected, bandwidth, location.BucketName)
+			}
+
+			tallies, err := satellite.DB.ProjectAccounting().GetTallies(ctx)
+			require.NoError(t, err)
+			require.Len(t, tallies, 1)
+			require.Equal(t, renamed, tallies[0].BucketLocation)
+			require.EqualValues(t, 4, tallies[0].TotalBytes)
+
+			// the buckets are not fenced anymore.
+			err = uplink.CreateBucket(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+			err = uplink.Upload(ctx, satellite, TestBucket, TestObject, []byte("data"))
+			require.NoError(t, err)
+		},
+	)
+}
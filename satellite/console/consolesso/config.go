@@ -0,0 +1,90 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consolesso
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Config contains configuration for logging in through external identity providers.
+type Config struct {
+	Enabled        bool            `help:"whether users can log in through external identity providers" default:"false"`
+	Providers      ProviderConfigs `help:"identity providers in JSON list format: [{\"name\":\"\",\"domains\":[\"\"],\"issuer\":\"\",\"clientID\":\"\",\"clientSecret\":\"\"}]" default:""`
+	RequestTimeout time.Duration   `help:"timeout for requests made to identity providers" default:"10s"`
+}
+
+// ProviderConfig describes a single OpenID Connect identity provider.
+type ProviderConfig struct {
+	// Name identifies the provider in login and callback URLs.
+	Name string `json:"name"`
+	// Domains lists the email domains whose users must log in through this provider.
+	Domains []string `json:"domains"`
+	// Issuer is the OpenID Connect issuer URL used for discovery.
+	Issuer       string `json:"issuer"`
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"`
+}
+
+// ProviderConfigs is a configuration value that contains a list of identity providers.
+// Format should be [{"name":"","domains":[""],"issuer":"","clientID":"","clientSecret":""},...] in valid JSON format.
+//
+// Can be used as a flag.
+type ProviderConfigs []ProviderConfig
+
+// Type implements pflag.Value.
+func (ProviderConfigs) Type() string { return "consolesso.ProviderConfigs" }
+
+// String is required for pflag.Value.
+func (providers *ProviderConfigs) String() string {
+	if len(*providers) == 0 {
+		return ""
+	}
+
+	data, err := json.Marshal(*providers)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// Set does validation on the configured JSON.
+func (providers *ProviderConfigs) Set(s string) (err error) {
+	if strings.TrimSpace(s) == "" {
+		*providers = nil
+		return nil
+	}
+
+	var list []ProviderConfig
+	err = json.Unmarshal([]byte(s), &list)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	names := make(map[string]bool, len(list))
+	for _, provider := range list {
+		if provider.Name == "" {
+			return Error.New("provider name is required")
+		}
+		if names[provider.Name] {
+			return Error.New("duplicate provider name %q", provider.Name)
+		}
+		names[provider.Name] = true
+
+		if provider.Issuer == "" {
+			return Error.New("issuer is required for provider %q", provider.Name)
+		}
+		if provider.ClientID == "" {
+			return Error.New("client ID is required for provider %q", provider.Name)
+		}
+		if len(provider.Domains) == 0 {
+			return Error.New("at least one email domain is required for provider %q", provider.Name)
+		}
+	}
+
+	*providers = list
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package consolessotest implements a stub OpenID Connect identity provider for tests.
+package consolessotest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+)
+
+// Claims are the userinfo claims returned by the stub provider.
+type Claims struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+// IdP is a stub OpenID Connect identity provider.
+//
+// Authorization codes registered with AddCode can be exchanged for an access
+// token exactly once, and the access token returns the registered claims.
+type IdP struct {
+	Server *httptest.Server
+
+	ClientID     string
+	ClientSecret string
+
+	mu     sync.Mutex
+	codes  map[string]Claims
+	tokens map[string]Claims
+}
+
+// NewIdP starts a new stub identity provider.
+func NewIdP(clientID, clientSecret string) *IdP {
+	idp := &IdP{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		codes:        make(map[string]Claims),
+		tokens:       make(map[string]Claims),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("/authorize", idp.authorize)
+	mux.HandleFunc("/token", idp.token)
+	mux.HandleFunc("/userinfo", idp.userinfo)
+	idp.Server = httptest.NewServer(mux)
+
+	return idp
+}
+
+// Issuer returns the issuer URL of the provider.
+func (idp *IdP) Issuer() string { return idp.Server.URL }
+
+// AddCode registers an authorization code which resolves to the claims.
+func (idp *IdP) AddCode(code string, claims Claims) {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.codes[code] = claims
+}
+
+// Close stops the provider.
+func (idp *IdP) Close() { idp.Server.Close() }
+
+func (idp *IdP) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]string{
+		"issuer":                 idp.Issuer(),
+		"authorization_endpoint": idp.Issuer() + "/authorize",
+		"token_endpoint":         idp.Issuer() + "/token",
+		"userinfo_endpoint":      idp.Issuer() + "/userinfo",
+	})
+}
+
+// authorize immediately redirects back with code "authorized" and the request state.
+func (idp *IdP) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != idp.ClientID {
+		http.Error(w, "unknown client", http.StatusBadRequest)
+		return
+	}
+
+	redirect, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	values := redirect.Query()
+	values.Set("code", "authorized")
+	values.Set("state", query.Get("state"))
+	redirect.RawQuery = values.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (idp *IdP) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != idp.ClientID || clientSecret != idp.ClientSecret {
+		http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+		return
+	}
+
+	idp.mu.Lock()
+	code := r.PostForm.Get("code")
+	claims, ok := idp.codes[code]
+	delete(idp.codes, code)
+	if ok {
+		idp.tokens["token-"+code] = claims
+	}
+	idp.mu.Unlock()
+
+	if !ok {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"access_token": "token-" + code,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (idp *IdP) userinfo(w http.ResponseWriter, r *http.Request) {
+	const prefix = "Bearer "
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) <= len(prefix) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	idp.mu.Lock()
+	claims, ok := idp.tokens[authorization[len(prefix):]]
+	idp.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	writeJSON(w, claims)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,223 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consolesso
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/mail"
+	"strings"
+	"sync"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/oauth2"
+)
+
+// ErrIdentity is error class for identities rejected by the satellite.
+var ErrIdentity = errs.Class("sso identity")
+
+// maxResponseSize limits how much of a provider response is read.
+const maxResponseSize = 1 << 20
+
+// Identity is a user identity asserted by an identity provider.
+type Identity struct {
+	Provider string
+	Subject  string
+	Email    string
+	FullName string
+}
+
+// discovery contains the parts of an OpenID Connect discovery document used by the satellite.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// userInfo is the response of an OpenID Connect userinfo endpoint.
+type userInfo struct {
+	Subject       string       `json:"sub"`
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	Name          string       `json:"name"`
+}
+
+// flexibleBool decodes booleans which some providers send as strings.
+type flexibleBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "true":
+		*b = true
+	case "false", "null":
+		*b = false
+	default:
+		return Error.New("invalid boolean %s", data)
+	}
+	return nil
+}
+
+// Provider is a client of a single OpenID Connect identity provider.
+type Provider struct {
+	config ProviderConfig
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *discovery
+}
+
+// NewProvider creates a new client for the configured identity provider.
+func NewProvider(config ProviderConfig, client *http.Client) *Provider {
+	return &Provider{
+		config: config,
+		client: client,
+	}
+}
+
+// Name returns the name of the provider.
+func (provider *Provider) Name() string { return provider.config.Name }
+
+// AuthCodeURL returns the URL the user should be redirected to in order to log in.
+func (provider *Provider) AuthCodeURL(ctx context.Context, redirectURL, state string) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	oauthConfig, err := provider.oauthConfig(ctx, redirectURL)
+	if err != nil {
+		return "", err
+	}
+
+	return oauthConfig.AuthCodeURL(state), nil
+}
+
+// Identify exchanges the authorization code for an access token and returns the identity
+// of the user it belongs to.
+func (provider *Provider) Identify(ctx context.Context, redirectURL, code string) (_ Identity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	oauthConfig, err := provider.oauthConfig(ctx, redirectURL)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	token, err := oauthConfig.Exchange(context.WithValue(ctx, oauth2.HTTPClient, provider.client), code)
+	if err != nil {
+		return Identity{}, Error.Wrap(err)
+	}
+
+	provider.mu.Lock()
+	userinfoEndpoint := provider.discovery.UserinfoEndpoint
+	provider.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userinfoEndpoint, nil)
+	if err != nil {
+		return Identity{}, Error.Wrap(err)
+	}
+	token.SetAuthHeader(req)
+
+	var info userInfo
+	if err := provider.getJSON(req, &info); err != nil {
+		return Identity{}, err
+	}
+
+	if info.Subject == "" {
+		return Identity{}, ErrIdentity.New("missing subject")
+	}
+	if !info.EmailVerified {
+		return Identity{}, ErrIdentity.New("email is not verified by the provider")
+	}
+
+	address, err := mail.ParseAddress(info.Email)
+	if err != nil {
+		return Identity{}, ErrIdentity.Wrap(err)
+	}
+	if !provider.HasDomain(emailDomain(address.Address)) {
+		return Identity{}, ErrIdentity.New("provider %q is not allowed to assert email %q", provider.config.Name, address.Address)
+	}
+
+	return Identity{
+		Provider: provider.config.Name,
+		Subject:  info.Subject,
+		Email:    address.Address,
+		FullName: info.Name,
+	}, nil
+}
+
+// HasDomain returns whether the provider is responsible for the email domain.
+func (provider *Provider) HasDomain(domain string) bool {
+	for _, d := range provider.config.Domains {
+		if strings.EqualFold(d, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// oauthConfig returns the OAuth2 configuration for the provider, performing discovery if needed.
+func (provider *Provider) oauthConfig(ctx context.Context, redirectURL string) (*oauth2.Config, error) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.discovery == nil {
+		issuer := strings.TrimSuffix(provider.config.Issuer, "/")
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		var doc discovery
+		if err := provider.getJSON(req, &doc); err != nil {
+			return nil, err
+		}
+		if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+			return nil, Error.New("discovery issuer %q does not match configured issuer %q", doc.Issuer, provider.config.Issuer)
+		}
+		if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.UserinfoEndpoint == "" {
+			return nil, Error.New("discovery document of %q is missing endpoints", provider.config.Issuer)
+		}
+
+		provider.discovery = &doc
+	}
+
+	return &oauth2.Config{
+		ClientID:     provider.config.ClientID,
+		ClientSecret: provider.config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  provider.discovery.AuthorizationEndpoint,
+			TokenURL: provider.discovery.TokenEndpoint,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      []string{"openid", "email", "profile"},
+	}, nil
+}
+
+// getJSON executes the request and decodes the JSON response into v.
+func (provider *Provider) getJSON(req *http.Request, v interface{}) (err error) {
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := provider.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return Error.New("unexpected status code %d from %s", resp.StatusCode, req.URL.Redacted())
+	}
+
+	return Error.Wrap(json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v))
+}
+
+// emailDomain returns the lowercased domain part of an email address.
+func emailDomain(email string) string {
+	i := strings.LastIndexByte(email, '@')
+	if i < 0 {
+		return ""
+	}
+	return strings.ToLower(email[i+1:])
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consolesso_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/console/consolesso"
+	"storj.io/storj/satellite/console/consolesso/consolessotest"
+)
+
+func TestProviderConfigs(t *testing.T) {
+	var providers consolesso.ProviderConfigs
+
+	require.NoError(t, providers.Set(""))
+	require.Empty(t, providers)
+
+	require.NoError(t, providers.Set(`[{"name":"acme","domains":["acme.test"],"issuer":"https://idp.acme.test","clientID":"id","clientSecret":"secret"}]`))
+	require.Len(t, providers, 1)
+	require.Equal(t, "acme", providers[0].Name)
+	require.Equal(t, []string{"acme.test"}, providers[0].Domains)
+
+	var parsed consolesso.ProviderConfigs
+	require.NoError(t, parsed.Set(providers.String()))
+	require.Equal(t, providers, parsed)
+
+	require.Error(t, providers.Set(`[{"domains":["acme.test"],"issuer":"https://idp.acme.test","clientID":"id"}]`))
+	require.Error(t, providers.Set(`[{"name":"acme","issuer":"https://idp.acme.test","clientID":"id"}]`))
+	require.Error(t, providers.Set(`[{"name":"acme","domains":["a.test"],"issuer":"i","clientID":"id"},{"name":"acme","domains":["b.test"],"issuer":"i","clientID":"id"}]`))
+	require.Error(t, providers.Set(`{`))
+}
+
+func TestService(t *testing.T) {
+	config := consolesso.Config{
+		Enabled: true,
+		Providers: consolesso.ProviderConfigs{
+			{Name: "acme", Domains: []string{"Acme.Test"}, Issuer: "https://idp.acme.test", ClientID: "id"},
+			{Name: "other", Domains: []string{"other.test", "other.example"}, Issuer: "https://idp.other.test", ClientID: "id"},
+		},
+	}
+
+	service, err := consolesso.NewService(config, nil)
+	require.NoError(t, err)
+
+	provider, ok := service.ProviderForEmail("someone@acme.test")
+	require.True(t, ok)
+	require.Equal(t, "acme", provider.Name())
+
+	provider, ok = service.ProviderForEmail("someone@OTHER.example")
+	require.True(t, ok)
+	require.Equal(t, "other", provider.Name())
+
+	_, ok = service.ProviderForEmail("someone@storj.test")
+	require.False(t, ok)
+
+	_, ok = service.Provider("other")
+	require.True(t, ok)
+
+	config.Providers[1].Domains = []string{"acme.test"}
+	_, err = consolesso.NewService(config, nil)
+	require.Error(t, err)
+
+	config.Enabled = false
+	service, err = consolesso.NewService(config, nil)
+	require.NoError(t, err)
+	_, ok = service.ProviderForEmail("someone@acme.test")
+	require.False(t, ok)
+}
+
+func TestProviderIdentify(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	idp := consolessotest.NewIdP("client", "secret")
+	defer idp.Close()
+
+	const redirectURL = "http://satellite.test/api/v0/auth/sso/callback/acme"
+
+	provider := consolesso.NewProvider(consolesso.ProviderConfig{
+		Name:         "acme",
+		Domains:      []string{"acme.test"},
+		Issuer:       idp.Issuer(),
+		ClientID:     "client",
+		ClientSecret: "secret",
+	}, http.DefaultClient)
+
+	t.Run("auth code url", func(t *testing.T) {
+		authURL, err := provider.AuthCodeURL(ctx, redirectURL, "state")
+		require.NoError(t, err)
+
+		parsed, err := url.Parse(authURL)
+		require.NoError(t, err)
+		require.Equal(t, idp.Issuer()+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+		require.Equal(t, "client", parsed.Query().Get("client_id"))
+		require.Equal(t, redirectURL, parsed.Query().Get("redirect_uri"))
+		require.Equal(t, "state", parsed.Query().Get("state"))
+	})
+
+	t.Run("verified identity", func(t *testing.T) {
+		idp.AddCode("good", consolessotest.Claims{
+			Subject:       "subject",
+			Email:         "Alice@acme.test",
+			EmailVerified: true,
+			Name:          "Alice",
+		})
+
+		identity, err := provider.Identify(ctx, redirectURL, "good")
+		require.NoError(t, err)
+		require.Equal(t, consolesso.Identity{
+			Provider: "acme",
+			Subject:  "subject",
+			Email:    "Alice@acme.test",
+			FullName: "Alice",
+		}, identity)
+
+		// codes can only be used once
+		_, err = provider.Identify(ctx, redirectURL, "good")
+		require.Error(t, err)
+	})
+
+	t.Run("unverified email", func(t *testing.T) {
+		idp.AddCode("unverified", consolessotest.Claims{
+			Subject: "subject",
+			Email:   "alice@acme.test",
+		})
+
+		_, err := provider.Identify(ctx, redirectURL, "unverified")
+		require.True(t, consolesso.ErrIdentity.Has(err))
+	})
+
+	t.Run("foreign domain", func(t *testing.T) {
+		idp.AddCode("foreign", consolessotest.Claims{
+			Subject:       "subject",
+			Email:         "mallory@storj.test",
+			EmailVerified: true,
+		})
+
+		_, err := provider.Identify(ctx, redirectURL, "foreign")
+		require.True(t, consolesso.ErrIdentity.Has(err))
+	})
+
+	t.Run("issuer mismatch", func(t *testing.T) {
+		mismatched := consolesso.NewProvider(consolesso.ProviderConfig{
+			Name:     "acme",
+			Domains:  []string{"acme.test"},
+			Issuer:   idp.Issuer() + "/other",
+			ClientID: "client",
+		}, http.DefaultClient)
+
+		_, err := mismatched.AuthCodeURL(ctx, redirectURL, "state")
+		require.Error(t, err)
+	})
+}
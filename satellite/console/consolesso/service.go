@@ -0,0 +1,70 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package consolesso implements satellite console login through external
+// OpenID Connect identity providers.
+package consolesso
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the package.
+	Error = errs.Class("sso")
+)
+
+// Service keeps track of the configured identity providers.
+type Service struct {
+	providers map[string]*Provider
+	domains   map[string]*Provider
+}
+
+// NewService creates a new service from the configuration.
+// When SSO is disabled the returned service has no providers.
+func NewService(config Config, client *http.Client) (*Service, error) {
+	service := &Service{
+		providers: make(map[string]*Provider),
+		domains:   make(map[string]*Provider),
+	}
+	if !config.Enabled {
+		return service, nil
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: config.RequestTimeout}
+	}
+
+	for _, providerConfig := range config.Providers {
+		provider := NewProvider(providerConfig, client)
+		service.providers[providerConfig.Name] = provider
+
+		for _, domain := range providerConfig.Domains {
+			domain = strings.ToLower(domain)
+			if existing, ok := service.domains[domain]; ok {
+				return nil, Error.New("domain %q is configured for both %q and %q", domain, existing.Name(), provider.Name())
+			}
+			service.domains[domain] = provider
+		}
+	}
+
+	return service, nil
+}
+
+// Provider returns the provider with the given name.
+func (service *Service) Provider(name string) (*Provider, bool) {
+	provider, ok := service.providers[name]
+	return provider, ok
+}
+
+// ProviderForEmail returns the provider users with the given email must log in through.
+func (service *Service) ProviderForEmail(email string) (*Provider, bool) {
+	provider, ok := service.domains[emailDomain(email)]
+	return provider, ok
+}
@@ -835,6 +835,10 @@ func (a *Auth) getStatusCode(err error) int {
 		return http.StatusNotImplemented
 	case console.ErrMFAPasscode.Has(err), console.ErrMFARecoveryCode.Has(err):
 		return http.StatusBadRequest
+	case console.ErrSSORequired.Has(err):
+		return http.StatusForbidden
+	case console.ErrSSOProvider.Has(err):
+		return http.StatusNotFound
 	default:
 		return http.StatusInternalServerError
 	}
@@ -868,6 +872,10 @@ func (a *Auth) getUserErrorMessage(err error) string {
 		return "Your login credentials are incorrect. You have just used up one of your login attempts"
 	case console.ErrValidation.Has(err):
 		return err.Error()
+	case console.ErrSSORequired.Has(err):
+		return "Your organization requires you to log in through its identity provider"
+	case console.ErrSSOProvider.Has(err):
+		return "The identity provider is not configured on this satellite"
 	case errors.Is(err, errNotImplemented):
 		return "The server is incapable of fulfilling the request"
 	default:
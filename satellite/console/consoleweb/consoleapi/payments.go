@@ -17,6 +17,8 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/invoicereport"
 )
 
 var (
@@ -88,6 +90,117 @@ func (p *Payments) AccountBalance(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// TopUpBalance charges the default credit card and adds the amount to the prepaid balance.
+func (p *Payments) TopUpBalance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var request struct {
+		Amount int64 `json:"amount"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	tx, err := p.service.Payments().TopUpBalance(ctx, request.Amount)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+		case payments.ErrInvalidTopUp.Has(err):
+			p.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			p.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(tx)
+	if err != nil {
+		p.log.Error("failed to write json top-up response", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
+// GetAutoTopUp returns the auto top-up settings of the prepaid balance.
+func (p *Payments) GetAutoTopUp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	settings, err := p.service.Payments().GetAutoTopUp(ctx)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(settings)
+	if err != nil {
+		p.log.Error("failed to write json auto top-up response", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
+// GetPrices returns the prices which apply to the user.
+func (p *Payments) GetPrices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	prices, err := p.service.Payments().GetPrices(ctx)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(prices)
+	if err != nil {
+		p.log.Error("failed to write json prices response", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
+// SetAutoTopUp updates the auto top-up settings of the prepaid balance.
+func (p *Payments) SetAutoTopUp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var settings payments.AutoTopUp
+	if err = json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = p.service.Payments().SetAutoTopUp(ctx, settings)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+		case payments.ErrInvalidTopUp.Has(err):
+			p.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			p.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+}
+
 // ProjectsCharges returns how much money current user will be charged for each project which he owns.
 func (p *Payments) ProjectsCharges(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -127,6 +240,54 @@ func (p *Payments) ProjectsCharges(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// InvoiceReport returns the per-bucket breakdown of the usage in an invoice period
+// as JSON, or as a downloadable CSV or PDF document.
+func (p *Payments) InvoiceReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	period := mux.Vars(r)["period"]
+	since, before, err := invoicereport.ParsePeriod(period)
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	charges, err := p.service.Payments().BucketsCharges(ctx, since, before)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	report := invoicereport.New(since, before, charges)
+
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "json":
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(report.Charges)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", "attachment; filename=\"storj-usage-"+report.Period()+".csv\"")
+		err = invoicereport.WriteCSV(w, report)
+	case "pdf":
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", "attachment; filename=\"storj-usage-"+report.Period()+".pdf\"")
+		err = invoicereport.WritePDF(w, report)
+	default:
+		p.serveJSONError(w, http.StatusBadRequest, errs.New("unsupported format %q", format))
+		return
+	}
+	if err != nil {
+		p.log.Error("failed to write invoice report response", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
 // AddCreditCard is used to save new credit card and attach it to payment account.
 func (p *Payments) AddCreditCard(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -0,0 +1,141 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/web"
+)
+
+const (
+	// ssoStateCookie is the name of the cookie used to protect the SSO
+	// callback against cross-site request forgery.
+	ssoStateCookie = "_ssoState"
+
+	// ssoStateExpiration is how long the user has to complete the login at the identity provider.
+	ssoStateExpiration = 10 * time.Minute
+)
+
+// SSOProvider returns the identity provider the user with the given email must log in through.
+func (a *Auth) SSOProvider(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var response struct {
+		Required bool   `json:"required"`
+		Provider string `json:"provider,omitempty"`
+		LoginURL string `json:"loginURL,omitempty"`
+	}
+
+	response.Provider, response.Required = a.service.SSOProviderForEmail(r.URL.Query().Get("email"))
+	if response.Required {
+		response.LoginURL = a.ExternalAddress + "api/v0/auth/sso/login/" + url.PathEscape(response.Provider)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		a.log.Error("could not encode sso provider", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// SSOLogin redirects the user to the login page of the identity provider.
+func (a *Auth) SSOLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider := mux.Vars(r)["provider"]
+
+	stateBytes := make([]byte, 32)
+	if _, err = rand.Read(stateBytes); err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+	state := base64.RawURLEncoding.EncodeToString(stateBytes)
+
+	loginURL, err := a.service.SSOLoginURL(ctx, provider, a.ssoRedirectURL(provider), state)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	// The identity provider redirects back with a top-level navigation,
+	// so the state cookie must not be strict.
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    state,
+		Path:     "/api/v0/auth/sso/",
+		Expires:  time.Now().Add(ssoStateExpiration),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, loginURL, http.StatusFound)
+}
+
+// SSOCallback finishes the login at the identity provider and redirects the user to the satellite UI.
+func (a *Auth) SSOCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider := mux.Vars(r)["provider"]
+	query := r.URL.Query()
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    "",
+		Path:     "/api/v0/auth/sso/",
+		Expires:  time.Unix(0, 0),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	stateCookie, err := r.Cookie(ssoStateCookie)
+	if err != nil || query.Get("state") == "" || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(query.Get("state"))) != 1 {
+		a.log.Info("sso callback with invalid state", zap.String("provider", provider))
+		http.Redirect(w, r, a.ExternalAddress+"login?sso=failed", http.StatusFound)
+		return
+	}
+
+	if query.Get("error") != "" {
+		a.log.Info("identity provider returned error", zap.String("provider", provider), zap.String("error", query.Get("error")))
+		http.Redirect(w, r, a.ExternalAddress+"login?sso=failed", http.StatusFound)
+		return
+	}
+
+	ip, err := web.GetRequestIP(r)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	tokenInfo, err := a.service.SSOToken(ctx, provider, a.ssoRedirectURL(provider), query.Get("code"), ip, r.UserAgent())
+	if err != nil {
+		a.log.Info("sso login failed", zap.String("provider", provider), zap.Error(ErrAuthAPI.Wrap(err)))
+		http.Redirect(w, r, a.ExternalAddress+"login?sso=failed", http.StatusFound)
+		return
+	}
+
+	a.cookieAuth.SetTokenCookie(w, *tokenInfo)
+
+	http.Redirect(w, r, a.ExternalAddress, http.StatusFound)
+}
+
+// ssoRedirectURL returns the callback URL registered at the identity provider.
+func (a *Auth) ssoRedirectURL(provider string) string {
+	return a.ExternalAddress + "api/v0/auth/sso/callback/" + url.PathEscape(provider)
+}
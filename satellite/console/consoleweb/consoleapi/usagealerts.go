@@ -0,0 +1,184 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrUsageAlertsAPI - console usage alerts api error type.
+	ErrUsageAlertsAPI = errs.Class("console api usage alerts")
+)
+
+// UsageAlerts is an api controller that exposes project usage alerts.
+type UsageAlerts struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewUsageAlerts is a constructor for api usage alerts controller.
+func NewUsageAlerts(log *zap.Logger, service *console.Service) *UsageAlerts {
+	return &UsageAlerts{
+		log:     log,
+		service: service,
+	}
+}
+
+// List returns the usage alerts of the project.
+func (ua *UsageAlerts) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		ua.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	alerts, err := ua.service.GetUsageAlerts(ctx, projectID)
+	if err != nil {
+		ua.serveError(w, err)
+		return
+	}
+	if alerts == nil {
+		alerts = []console.UsageAlert{}
+	}
+
+	err = json.NewEncoder(w).Encode(alerts)
+	if err != nil {
+		ua.log.Error("failed to write json usage alerts response", zap.Error(ErrUsageAlertsAPI.Wrap(err)))
+	}
+}
+
+// Create creates a new usage alert for the project.
+func (ua *UsageAlerts) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		ua.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	var request console.UsageAlertRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ua.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	alert, err := ua.service.CreateUsageAlert(ctx, projectID, request)
+	if err != nil {
+		ua.serveError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(alert)
+	if err != nil {
+		ua.log.Error("failed to write json usage alert response", zap.Error(ErrUsageAlertsAPI.Wrap(err)))
+	}
+}
+
+// Delete deletes a usage alert of the project.
+func (ua *UsageAlerts) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		ua.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	alertID, err := uuid.FromString(mux.Vars(r)["alertID"])
+	if err != nil {
+		ua.serveJSONError(w, http.StatusBadRequest, errs.New("invalid alert id: %v", err))
+		return
+	}
+
+	err = ua.service.DeleteUsageAlert(ctx, projectID, alertID)
+	if err != nil {
+		ua.serveError(w, err)
+		return
+	}
+}
+
+// ListNotifications returns the usage alert notifications of the user.
+func (ua *UsageAlerts) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	notifications, err := ua.service.GetUsageAlertNotifications(ctx)
+	if err != nil {
+		ua.serveError(w, err)
+		return
+	}
+	if notifications == nil {
+		notifications = []console.UsageAlertNotification{}
+	}
+
+	err = json.NewEncoder(w).Encode(notifications)
+	if err != nil {
+		ua.log.Error("failed to write json usage alert notifications response", zap.Error(ErrUsageAlertsAPI.Wrap(err)))
+	}
+}
+
+// ReadNotification marks a usage alert notification of the user as read.
+func (ua *UsageAlerts) ReadNotification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	notificationID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		ua.serveJSONError(w, http.StatusBadRequest, errs.New("invalid notification id: %v", err))
+		return
+	}
+
+	err = ua.service.ReadUsageAlertNotification(ctx, notificationID)
+	if err != nil {
+		ua.serveError(w, err)
+		return
+	}
+}
+
+// serveError writes JSON error matching the service error to response output stream.
+func (ua *UsageAlerts) serveError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		ua.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrUsageAlert.Has(err):
+		ua.serveJSONError(w, http.StatusBadRequest, err)
+	default:
+		ua.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (ua *UsageAlerts) serveJSONError(w http.ResponseWriter, status int, err error) {
+	ServeJSONError(ua.log, w, status, err)
+}
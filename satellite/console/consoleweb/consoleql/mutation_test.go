@@ -91,6 +91,7 @@ func TestGraphqlMutation(t *testing.T) {
 			db.Billing(),
 			db.Console().Projects(),
 			db.ProjectAccounting(),
+			db.PricePlans(),
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
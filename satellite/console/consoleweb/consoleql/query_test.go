@@ -75,6 +75,7 @@ func TestGraphqlQuery(t *testing.T) {
 			db.Billing(),
 			db.Console().Projects(),
 			db.ProjectAccounting(),
+			db.PricePlans(),
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
@@ -267,6 +267,21 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		server.withAuth(http.HandlerFunc(usageLimitsController.DailyUsage)),
 	).Methods(http.MethodGet)
 
+	usageAlertsController := consoleapi.NewUsageAlerts(logger, service)
+	usageAlertsRouter := router.PathPrefix("/api/v0/projects/{id}/usage-alerts").Subrouter()
+	usageAlertsRouter.Use(server.withAuth)
+	usageAlertsRouter.HandleFunc("", usageAlertsController.List).Methods(http.MethodGet)
+	usageAlertsRouter.HandleFunc("", usageAlertsController.Create).Methods(http.MethodPost)
+	usageAlertsRouter.HandleFunc("/{alertID}", usageAlertsController.Delete).Methods(http.MethodDelete)
+	router.Handle(
+		"/api/v0/usage-alert-notifications",
+		server.withAuth(http.HandlerFunc(usageAlertsController.ListNotifications)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/usage-alert-notifications/{id}/read",
+		server.withAuth(http.HandlerFunc(usageAlertsController.ReadNotification)),
+	).Methods(http.MethodPost)
+
 	authController := consoleapi.NewAuth(logger, service, mailService, server.cookieAuth, partners, server.analytics, config.SatelliteName, server.config.ExternalAddress, config.LetUsKnowURL, config.TermsAndConditionsURL, config.ContactInfoURL, config.GeneralRequestURL)
 	authRouter := router.PathPrefix("/api/v0/auth").Subrouter()
 	authRouter.Handle("/account", server.withAuth(http.HandlerFunc(authController.GetAccount))).Methods(http.MethodGet)
@@ -285,6 +300,9 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/resend-email/{email}", server.ipRateLimiter.Limit(http.HandlerFunc(authController.ResendEmail))).Methods(http.MethodPost)
 	authRouter.Handle("/reset-password", server.ipRateLimiter.Limit(http.HandlerFunc(authController.ResetPassword))).Methods(http.MethodPost)
 	authRouter.Handle("/refresh-session", server.withAuth(http.HandlerFunc(authController.RefreshSession))).Methods(http.MethodPost)
+	authRouter.Handle("/sso/provider", server.ipRateLimiter.Limit(http.HandlerFunc(authController.SSOProvider))).Methods(http.MethodGet)
+	authRouter.Handle("/sso/login/{provider}", server.ipRateLimiter.Limit(http.HandlerFunc(authController.SSOLogin))).Methods(http.MethodGet)
+	authRouter.Handle("/sso/callback/{provider}", server.ipRateLimiter.Limit(http.HandlerFunc(authController.SSOCallback))).Methods(http.MethodGet)
 
 	paymentController := consoleapi.NewPayments(logger, service)
 	paymentsRouter := router.PathPrefix("/api/v0/payments").Subrouter()
@@ -295,6 +313,11 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	paymentsRouter.HandleFunc("/cards/{cardId}", paymentController.RemoveCreditCard).Methods(http.MethodDelete)
 	paymentsRouter.HandleFunc("/account/charges", paymentController.ProjectsCharges).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/account/balance", paymentController.AccountBalance).Methods(http.MethodGet)
+	paymentsRouter.Handle("/account/balance/top-up", server.userIDRateLimiter.Limit(http.HandlerFunc(paymentController.TopUpBalance))).Methods(http.MethodPost)
+	paymentsRouter.HandleFunc("/account/balance/auto-top-up", paymentController.GetAutoTopUp).Methods(http.MethodGet)
+	paymentsRouter.HandleFunc("/account/balance/auto-top-up", paymentController.SetAutoTopUp).Methods(http.MethodPut)
+	paymentsRouter.HandleFunc("/pricing", paymentController.GetPrices).Methods(http.MethodGet)
+	paymentsRouter.HandleFunc("/invoice-report/{period}", paymentController.InvoiceReport).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/account", paymentController.SetupAccount).Methods(http.MethodPost)
 	paymentsRouter.HandleFunc("/wallet", paymentController.GetWallet).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/wallet", paymentController.ClaimWallet).Methods(http.MethodPost)
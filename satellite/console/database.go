@@ -27,6 +27,10 @@ type DB interface {
 	ResetPasswordTokens() ResetPasswordTokens
 	// WebappSessions is a getter for WebappSessions repository.
 	WebappSessions() consoleauth.WebappSessions
+	// UsageAlerts is a getter for UsageAlerts repository.
+	UsageAlerts() UsageAlerts
+	// SSOIdentities is a getter for SSOIdentities repository.
+	SSOIdentities() SSOIdentities
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
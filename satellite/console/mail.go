@@ -103,3 +103,22 @@ func (*LockAccountEmail) Template() string { return "LockAccount" }
 
 // Subject gets email subject.
 func (*LockAccountEmail) Subject() string { return "Account Lock" }
+
+// UsageAlertEmail is mailservice template for a triggered usage alert.
+type UsageAlertEmail struct {
+	UserName             string
+	ProjectName          string
+	BucketName           string
+	Usage                string
+	Current              string
+	Threshold            string
+	ProjectDashboardLink string
+}
+
+// Template returns email template name.
+func (*UsageAlertEmail) Template() string { return "UsageAlert" }
+
+// Subject gets email subject.
+func (email *UsageAlertEmail) Subject() string {
+	return "Usage alert for the Project " + email.ProjectName
+}
@@ -32,10 +32,12 @@ import (
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consolesso"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/monetary"
+	"storj.io/storj/satellite/payments/pricing"
 	"storj.io/storj/satellite/rewards"
 )
 
@@ -136,6 +138,7 @@ type Service struct {
 	analytics                  *analytics.Service
 	tokens                     *consoleauth.Service
 	mailService                *mailservice.Service
+	sso                        *consolesso.Service
 
 	satelliteAddress string
 
@@ -162,9 +165,11 @@ type Config struct {
 	AsOfSystemTimeDuration      time.Duration `help:"default duration for AS OF SYSTEM TIME" devDefault:"-5m" releaseDefault:"-5m" testDefault:"0"`
 	LoginAttemptsWithoutPenalty int           `help:"number of times user can try to login without penalty" default:"3"`
 	FailedLoginPenalty          float64       `help:"incremental duration of penalty for failed login attempts in minutes" default:"2.0"`
+	UsageAlertsLimit            int           `help:"maximum number of usage alerts per project" default:"20"`
 	UsageLimits                 UsageLimitsConfig
 	Captcha                     CaptchaConfig
 	Session                     SessionConfig
+	SSO                         consolesso.Config
 }
 
 // CaptchaConfig contains configurations for login/registration captcha system.
@@ -228,6 +233,11 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		loginCaptchaHandler = NewDefaultCaptcha(Hcaptcha, config.Captcha.Login.Hcaptcha.SecretKey)
 	}
 
+	sso, err := consolesso.NewService(config.SSO, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
 	return &Service{
 		log:                        log,
 		auditLogger:                log.Named("auditlog"),
@@ -245,6 +255,7 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		analytics:                  analytics,
 		tokens:                     tokens,
 		mailService:                mailService,
+		sso:                        sso,
 		satelliteAddress:           satelliteAddress,
 		config:                     config,
 	}, nil
@@ -322,6 +333,71 @@ func (payment Payments) AccountBalance(ctx context.Context) (balance payments.Ba
 	return payment.service.accounts.Balance(ctx, user.ID)
 }
 
+// TopUpBalance charges the default credit card of the user and adds the amount in cents to the prepaid balance.
+func (payment Payments) TopUpBalance(ctx context.Context, amount int64) (tx payments.BalanceTransaction, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "top up balance", zap.Int64("amount", amount))
+	if err != nil {
+		return payments.BalanceTransaction{}, Error.Wrap(err)
+	}
+
+	tx, err = payment.service.accounts.Prepaid().TopUp(ctx, user.ID, amount)
+	if err != nil {
+		return payments.BalanceTransaction{}, Error.Wrap(err)
+	}
+
+	return tx, nil
+}
+
+// GetAutoTopUp returns the auto top-up settings of the prepaid balance.
+func (payment Payments) GetAutoTopUp(ctx context.Context) (settings payments.AutoTopUp, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "get auto top-up")
+	if err != nil {
+		return payments.AutoTopUp{}, Error.Wrap(err)
+	}
+
+	settings, err = payment.service.accounts.Prepaid().GetAutoTopUp(ctx, user.ID)
+	if err != nil {
+		return payments.AutoTopUp{}, Error.Wrap(err)
+	}
+
+	return settings, nil
+}
+
+// GetPrices returns the prices which apply to the user, including volume tiers
+// of an assigned price plan.
+func (payment Payments) GetPrices(ctx context.Context) (prices pricing.Prices, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "get prices")
+	if err != nil {
+		return pricing.Prices{}, Error.Wrap(err)
+	}
+
+	prices, err = payment.service.accounts.Prices(ctx, user.ID, user.UserAgent)
+	if err != nil {
+		return pricing.Prices{}, Error.Wrap(err)
+	}
+
+	return prices, nil
+}
+
+// SetAutoTopUp updates the auto top-up settings of the prepaid balance.
+func (payment Payments) SetAutoTopUp(ctx context.Context, settings payments.AutoTopUp) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "set auto top-up",
+		zap.Bool("enabled", settings.Enabled), zap.Int64("threshold", settings.Threshold), zap.Int64("amount", settings.Amount))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(payment.service.accounts.Prepaid().SetAutoTopUp(ctx, user.ID, settings))
+}
+
 // AddCreditCard is used to save new credit card and attach it to payment account.
 func (payment Payments) AddCreditCard(ctx context.Context, creditCardToken string) (err error) {
 	defer mon.Task()(&ctx, creditCardToken)(&err)
@@ -400,6 +476,18 @@ func (payment Payments) ProjectsCharges(ctx context.Context, since, before time.
 	return payment.service.accounts.ProjectCharges(ctx, user.ID, since, before)
 }
 
+// BucketsCharges returns how much money current user will be charged for each bucket of the projects they own.
+func (payment Payments) BucketsCharges(ctx context.Context, since, before time.Time) (_ []payments.BucketCharge, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "bucket charges")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return payment.service.accounts.BucketCharges(ctx, user.ID, since, before)
+}
+
 // ListCreditCards returns a list of credit cards for a given payment account.
 func (payment Payments) ListCreditCards(ctx context.Context) (_ []payments.CreditCard, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -690,6 +778,11 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 		return nil, err
 	}
 
+	if _, ok := s.sso.ProviderForEmail(user.Email); ok {
+		mon.Counter("create_user_sso_required").Inc(1)
+		return nil, ErrSSORequired.New(ssoRequiredErrMsg)
+	}
+
 	registrationToken, err := s.checkRegistrationSecret(ctx, tokenSecret)
 	if err != nil {
 		return nil, ErrRegToken.Wrap(err)
@@ -1017,6 +1110,12 @@ func (s *Service) Token(ctx context.Context, request AuthUser) (response *TokenI
 		}
 	}
 
+	if _, ok := s.sso.ProviderForEmail(request.Email); ok {
+		mon.Counter("login_sso_required").Inc(1)
+		s.auditLog(ctx, "login: failed sso required", nil, request.Email)
+		return nil, ErrSSORequired.New(ssoRequiredErrMsg)
+	}
+
 	user, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, request.Email)
 	if user == nil {
 		if len(unverified) > 0 {
@@ -0,0 +1,244 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console/consolesso"
+)
+
+// Error messages.
+const (
+	ssoRequiredErrMsg        = "Your organization requires you to log in through its identity provider"
+	ssoUnknownProviderErrMsg = "The identity provider is not configured on this satellite"
+	ssoAccountErrMsg         = "The account of this identity can't log in through the identity provider"
+)
+
+var (
+	// ErrSSORequired occurs when a user whose email domain is handled by
+	// an identity provider attempts to log in with a password.
+	ErrSSORequired = errs.Class("sso required")
+
+	// ErrSSOProvider occurs when the requested identity provider does not exist.
+	ErrSSOProvider = errs.Class("sso provider")
+)
+
+// SSOProviderForEmail returns the name of the identity provider the user with
+// the given email must log in through.
+func (s *Service) SSOProviderForEmail(email string) (name string, ok bool) {
+	provider, ok := s.sso.ProviderForEmail(email)
+	if !ok {
+		return "", false
+	}
+	return provider.Name(), true
+}
+
+// SSOLoginURL returns the URL of the identity provider login page.
+func (s *Service) SSOLoginURL(ctx context.Context, providerName, redirectURL, state string) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.sso.Provider(providerName)
+	if !ok {
+		return "", ErrSSOProvider.New(ssoUnknownProviderErrMsg)
+	}
+
+	loginURL, err := provider.AuthCodeURL(ctx, redirectURL, state)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	return loginURL, nil
+}
+
+// SSOToken authenticates a user through the identity provider using the
+// authorization code returned to the callback and creates a new session.
+//
+// The identity logs in to the account it's linked to. On the first login an
+// existing account with the asserted email is linked to the identity, unless
+// it's linked to another identity of the provider already. Otherwise a new
+// account is provisioned and activated.
+func (s *Service) SSOToken(ctx context.Context, providerName, redirectURL, code, ip, userAgent string) (_ *TokenInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mon.Counter("sso_login_attempt").Inc(1)
+
+	provider, ok := s.sso.Provider(providerName)
+	if !ok {
+		return nil, ErrSSOProvider.New(ssoUnknownProviderErrMsg)
+	}
+
+	identity, err := provider.Identify(ctx, redirectURL, code)
+	if err != nil {
+		mon.Counter("sso_login_identity_failed").Inc(1)
+		s.auditLog(ctx, "sso login: failed identity", nil, "", zap.String("provider", providerName), zap.Error(err))
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	user, err := s.ssoUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	mon.Counter("sso_login_success").Inc(1)
+
+	return s.GenerateSessionToken(ctx, user.ID, user.Email, ip, userAgent)
+}
+
+// ssoUser returns the account belonging to the identity, linking, activating
+// or provisioning it when needed.
+func (s *Service) ssoUser(ctx context.Context, identity consolesso.Identity) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	linked, err := s.store.SSOIdentities().Get(ctx, identity.Provider, identity.Subject)
+	switch {
+	case err == nil:
+		user, err := s.store.Users().Get(ctx, linked.UserID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if user.Status != Active {
+			s.auditLog(ctx, "sso login: account not active", &user.ID, user.Email, zap.String("provider", identity.Provider))
+			return nil, ErrUnauthorized.New(ssoAccountErrMsg)
+		}
+		return user, nil
+	case !errs.Is(err, sql.ErrNoRows):
+		return nil, Error.Wrap(err)
+	}
+
+	verified, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, identity.Email)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if verified != nil {
+		// The email of an account linked to another subject was reassigned
+		// at the provider, so it belongs to someone else now.
+		_, err := s.store.SSOIdentities().GetByUserID(ctx, verified.ID, identity.Provider)
+		switch {
+		case err == nil:
+			s.auditLog(ctx, "sso login: account linked to another identity", &verified.ID, verified.Email, zap.String("provider", identity.Provider))
+			return nil, ErrUnauthorized.New(ssoAccountErrMsg)
+		case !errs.Is(err, sql.ErrNoRows):
+			return nil, Error.Wrap(err)
+		}
+
+		if err := s.linkSSOIdentity(ctx, verified.ID, identity); err != nil {
+			return nil, err
+		}
+
+		s.auditLog(ctx, "sso login: linked existing account", &verified.ID, verified.Email, zap.String("provider", identity.Provider))
+		return verified, nil
+	}
+
+	// The identity provider vouches for the email address, so a pending
+	// registration for it can be activated directly. Deleted accounts must
+	// never be reactivated.
+	if len(unverified) > 0 {
+		var pending *User
+		for i := range unverified {
+			if unverified[i].Status == Inactive {
+				pending = &unverified[i]
+				break
+			}
+		}
+		if pending == nil {
+			s.auditLog(ctx, "sso login: account not inactive", &unverified[0].ID, unverified[0].Email, zap.String("provider", identity.Provider))
+			return nil, ErrUnauthorized.New(ssoAccountErrMsg)
+		}
+
+		status := Active
+		err = s.store.Users().Update(ctx, pending.ID, UpdateUserRequest{
+			Status: &status,
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		pending.Status = Active
+
+		if err := s.linkSSOIdentity(ctx, pending.ID, identity); err != nil {
+			return nil, err
+		}
+
+		s.auditLog(ctx, "sso login: activated account", &pending.ID, pending.Email, zap.String("provider", identity.Provider))
+		s.analytics.TrackAccountVerified(pending.ID, pending.Email)
+
+		return pending, nil
+	}
+
+	// Users provisioned through an identity provider never log in with a
+	// password, so the stored hash is derived from random bytes.
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	hash, err := bcrypt.GenerateFromPassword(password, s.config.PasswordCost)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	userID, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	fullName := identity.FullName
+	if fullName == "" {
+		fullName = identity.Email
+	}
+
+	user, err := s.store.Users().Insert(ctx, &User{
+		ID:                    userID,
+		Email:                 identity.Email,
+		FullName:              fullName,
+		PasswordHash:          hash,
+		Status:                Active,
+		ProjectLimit:          s.config.UsageLimits.Project.Free,
+		ProjectStorageLimit:   s.config.UsageLimits.Storage.Free.Int64(),
+		ProjectBandwidthLimit: s.config.UsageLimits.Bandwidth.Free.Int64(),
+		ProjectSegmentLimit:   s.config.UsageLimits.Segment.Free,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// Insert stores new users as inactive.
+	status := Active
+	err = s.store.Users().Update(ctx, user.ID, UpdateUserRequest{
+		Status: &status,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	user.Status = Active
+
+	if err := s.linkSSOIdentity(ctx, user.ID, identity); err != nil {
+		return nil, err
+	}
+
+	s.auditLog(ctx, "sso login: provisioned account", &user.ID, user.Email, zap.String("provider", identity.Provider))
+	mon.Counter("sso_user_provisioned").Inc(1)
+
+	return user, nil
+}
+
+// linkSSOIdentity links the identity to the user, so that its later logins
+// don't depend on the email asserted by the provider.
+func (s *Service) linkSSOIdentity(ctx context.Context, userID uuid.UUID, identity consolesso.Identity) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.store.SSOIdentities().Insert(ctx, SSOIdentity{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		UserID:   userID,
+	})
+	return Error.Wrap(err)
+}
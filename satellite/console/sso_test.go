@@ -0,0 +1,210 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consolesso"
+	"storj.io/storj/satellite/console/consolesso/consolessotest"
+)
+
+func TestSSOLogin(t *testing.T) {
+	idp := consolessotest.NewIdP("client", "secret")
+	defer idp.Close()
+
+	const redirectURL = "http://satellite.test/api/v0/auth/sso/callback/acme"
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.SSO = consolesso.Config{
+					Enabled: true,
+					Providers: consolesso.ProviderConfigs{{
+						Name:         "acme",
+						Domains:      []string{"acme.test"},
+						Issuer:       idp.Issuer(),
+						ClientID:     "client",
+						ClientSecret: "secret",
+					}},
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		users := sat.DB.Console().Users()
+
+		provider, ok := service.SSOProviderForEmail("alice@ACME.test")
+		require.True(t, ok)
+		require.Equal(t, "acme", provider)
+
+		_, ok = service.SSOProviderForEmail("bob@storj.test")
+		require.False(t, ok)
+
+		t.Run("password login is rejected", func(t *testing.T) {
+			_, err := service.Token(ctx, console.AuthUser{Email: "alice@acme.test", Password: "password"})
+			require.True(t, console.ErrSSORequired.Has(err))
+		})
+
+		t.Run("password registration is rejected", func(t *testing.T) {
+			_, err := service.CreateUser(ctx, console.CreateUser{
+				FullName: "Alice",
+				Email:    "alice@acme.test",
+				Password: "password123",
+			}, console.RegistrationSecret{})
+			require.True(t, console.ErrSSORequired.Has(err))
+		})
+
+		t.Run("unknown provider", func(t *testing.T) {
+			_, err := service.SSOLoginURL(ctx, "unknown", redirectURL, "state")
+			require.True(t, console.ErrSSOProvider.Has(err))
+
+			_, err = service.SSOToken(ctx, "unknown", redirectURL, "code", "", "")
+			require.True(t, console.ErrSSOProvider.Has(err))
+		})
+
+		t.Run("provisioning", func(t *testing.T) {
+			idp.AddCode("alice", consolessotest.Claims{
+				Subject:       "alice-subject",
+				Email:         "alice@acme.test",
+				EmailVerified: true,
+				Name:          "Alice",
+			})
+
+			tokenInfo, err := service.SSOToken(ctx, "acme", redirectURL, "alice", "127.0.0.1", "test")
+			require.NoError(t, err)
+			require.NotNil(t, tokenInfo)
+
+			user, err := users.GetByEmail(ctx, "alice@acme.test")
+			require.NoError(t, err)
+			require.Equal(t, console.Active, user.Status)
+			require.Equal(t, "Alice", user.FullName)
+
+			userCtx, err := service.TokenAuth(ctx, tokenInfo.Token, tokenInfo.ExpiresAt.Add(-1))
+			require.NoError(t, err)
+			authenticated, err := console.GetUser(userCtx)
+			require.NoError(t, err)
+			require.Equal(t, user.ID, authenticated.ID)
+
+			// logging in again links to the same account
+			idp.AddCode("alice-again", consolessotest.Claims{
+				Subject:       "alice-subject",
+				Email:         "alice@acme.test",
+				EmailVerified: true,
+			})
+
+			_, err = service.SSOToken(ctx, "acme", redirectURL, "alice-again", "127.0.0.1", "test")
+			require.NoError(t, err)
+
+			verified, unverified, err := users.GetByEmailWithUnverified(ctx, "alice@acme.test")
+			require.NoError(t, err)
+			require.Equal(t, user.ID, verified.ID)
+			require.Empty(t, unverified)
+		})
+
+		t.Run("unverified account is activated", func(t *testing.T) {
+			pending, err := users.Insert(ctx, &console.User{
+				ID:           testrand.UUID(),
+				FullName:     "Carol",
+				Email:        "carol@acme.test",
+				PasswordHash: []byte("hash"),
+			})
+			require.NoError(t, err)
+
+			idp.AddCode("carol", consolessotest.Claims{
+				Subject:       "carol-subject",
+				Email:         "carol@acme.test",
+				EmailVerified: true,
+			})
+
+			_, err = service.SSOToken(ctx, "acme", redirectURL, "carol", "127.0.0.1", "test")
+			require.NoError(t, err)
+
+			user, err := users.Get(ctx, pending.ID)
+			require.NoError(t, err)
+			require.Equal(t, console.Active, user.Status)
+		})
+
+		t.Run("deleted account is not activated", func(t *testing.T) {
+			deleted, err := users.Insert(ctx, &console.User{
+				ID:           testrand.UUID(),
+				FullName:     "Dave",
+				Email:        "dave@acme.test",
+				PasswordHash: []byte("hash"),
+			})
+			require.NoError(t, err)
+
+			status := console.Deleted
+			require.NoError(t, users.Update(ctx, deleted.ID, console.UpdateUserRequest{Status: &status}))
+
+			idp.AddCode("dave", consolessotest.Claims{
+				Subject:       "dave-subject",
+				Email:         "dave@acme.test",
+				EmailVerified: true,
+			})
+
+			_, err = service.SSOToken(ctx, "acme", redirectURL, "dave", "127.0.0.1", "test")
+			require.True(t, console.ErrUnauthorized.Has(err))
+
+			user, err := users.Get(ctx, deleted.ID)
+			require.NoError(t, err)
+			require.Equal(t, console.Deleted, user.Status)
+		})
+
+		t.Run("account is linked by subject", func(t *testing.T) {
+			alice, err := users.GetByEmail(ctx, "alice@acme.test")
+			require.NoError(t, err)
+
+			// the email was reassigned to another account at the provider.
+			idp.AddCode("alice-reassigned", consolessotest.Claims{
+				Subject:       "another-subject",
+				Email:         "alice@acme.test",
+				EmailVerified: true,
+			})
+
+			_, err = service.SSOToken(ctx, "acme", redirectURL, "alice-reassigned", "127.0.0.1", "test")
+			require.True(t, console.ErrUnauthorized.Has(err))
+
+			// the email of the linked account changed at the provider.
+			idp.AddCode("alice-renamed", consolessotest.Claims{
+				Subject:       "alice-subject",
+				Email:         "alice.renamed@acme.test",
+				EmailVerified: true,
+			})
+
+			tokenInfo, err := service.SSOToken(ctx, "acme", redirectURL, "alice-renamed", "127.0.0.1", "test")
+			require.NoError(t, err)
+
+			userCtx, err := service.TokenAuth(ctx, tokenInfo.Token, tokenInfo.ExpiresAt.Add(-1))
+			require.NoError(t, err)
+			authenticated, err := console.GetUser(userCtx)
+			require.NoError(t, err)
+			require.Equal(t, alice.ID, authenticated.ID)
+		})
+
+		t.Run("rejected identity", func(t *testing.T) {
+			idp.AddCode("mallory", consolessotest.Claims{
+				Subject:       "mallory-subject",
+				Email:         "mallory@storj.test",
+				EmailVerified: true,
+			})
+
+			_, err := service.SSOToken(ctx, "acme", redirectURL, "mallory", "127.0.0.1", "test")
+			require.True(t, console.ErrUnauthorized.Has(err))
+
+			_, err = users.GetByEmail(ctx, "mallory@storj.test")
+			require.Error(t, err)
+		})
+	})
+}
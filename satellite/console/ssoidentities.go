@@ -0,0 +1,34 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// SSOIdentities exposes methods to manage the identity provider accounts
+// linked to users.
+//
+// architecture: Database
+type SSOIdentities interface {
+	// Get returns the identity with the subject at the provider.
+	Get(ctx context.Context, provider, subject string) (*SSOIdentity, error)
+	// GetByUserID returns the identity of the user at the provider.
+	GetByUserID(ctx context.Context, userID uuid.UUID, provider string) (*SSOIdentity, error)
+	// Insert links the identity to its user.
+	Insert(ctx context.Context, identity SSOIdentity) error
+}
+
+// SSOIdentity links the subject of an identity provider to a user.
+//
+// The subject never changes for an account at the provider, unlike its email.
+type SSOIdentity struct {
+	Provider  string
+	Subject   string
+	UserID    uuid.UUID
+	CreatedAt time.Time
+}
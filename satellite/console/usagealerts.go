@@ -0,0 +1,244 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// UsageAlerts exposes methods to manage usage alerts in the database.
+//
+// architecture: Database
+type UsageAlerts interface {
+	// Insert inserts a new usage alert.
+	Insert(ctx context.Context, alert UsageAlert) (*UsageAlert, error)
+	// Get retrieves the usage alert with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (*UsageAlert, error)
+	// GetByProjectID retrieves all usage alerts of the project.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]UsageAlert, error)
+	// List returns at most limit usage alerts with IDs greater than cursor, ordered by ID.
+	List(ctx context.Context, cursor uuid.UUID, limit int) ([]UsageAlert, error)
+	// UpdateTriggeredAt sets the time the alert was triggered, or clears it when triggeredAt is nil.
+	UpdateTriggeredAt(ctx context.Context, id uuid.UUID, triggeredAt *time.Time) error
+	// Delete deletes the usage alert with the given ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// InsertNotification inserts a console notification about a triggered usage alert.
+	InsertNotification(ctx context.Context, notification UsageAlertNotification) error
+	// ListNotifications returns at most limit newest notifications of the user.
+	ListNotifications(ctx context.Context, userID uuid.UUID, limit int) ([]UsageAlertNotification, error)
+	// MarkNotificationRead marks the notification of the user as read.
+	MarkNotificationRead(ctx context.Context, userID, id uuid.UUID) error
+}
+
+// UsageAlertKind is the usage metric a usage alert is watching.
+type UsageAlertKind string
+
+const (
+	// UsageAlertStorage watches the currently stored bytes.
+	UsageAlertStorage UsageAlertKind = "storage"
+	// UsageAlertEgress watches the egress of the current month in bytes.
+	UsageAlertEgress UsageAlertKind = "egress"
+	// UsageAlertSegments watches the currently stored segments.
+	UsageAlertSegments UsageAlertKind = "segments"
+	// UsageAlertCost watches the estimated cost of the current month in cents.
+	UsageAlertCost UsageAlertKind = "cost"
+)
+
+// Valid returns whether the kind is known.
+func (kind UsageAlertKind) Valid() bool {
+	switch kind {
+	case UsageAlertStorage, UsageAlertEgress, UsageAlertSegments, UsageAlertCost:
+		return true
+	}
+	return false
+}
+
+// Cumulative returns whether the kind accumulates over the month and starts
+// from zero again in the next one.
+func (kind UsageAlertKind) Cumulative() bool {
+	return kind == UsageAlertEgress || kind == UsageAlertCost
+}
+
+// UsageAlert is a soft budget on the usage of a project or a single bucket.
+//
+// Crossing the threshold does not limit the usage, it only notifies the project owner.
+type UsageAlert struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"projectId"`
+	// BucketName is empty when the alert watches the whole project.
+	BucketName string         `json:"bucketName"`
+	Kind       UsageAlertKind `json:"kind"`
+	Threshold  int64          `json:"threshold"`
+	// TriggeredAt is set while the usage stays above the threshold.
+	TriggeredAt *time.Time `json:"triggeredAt"`
+	CreatedBy   uuid.UUID  `json:"createdBy"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// UsageAlertNotification is shown in the console to a user when a usage alert
+// of one of their projects is triggered.
+type UsageAlertNotification struct {
+	ID         uuid.UUID      `json:"id"`
+	UserID     uuid.UUID      `json:"-"`
+	AlertID    uuid.UUID      `json:"alertId"`
+	ProjectID  uuid.UUID      `json:"projectId"`
+	BucketName string         `json:"bucketName"`
+	Kind       UsageAlertKind `json:"kind"`
+	Threshold  int64          `json:"threshold"`
+	// Value is the usage at the time the alert was triggered.
+	Value     int64      `json:"value"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ReadAt    *time.Time `json:"readAt"`
+}
+
+// UsageAlertRequest holds the information needed to create a usage alert.
+type UsageAlertRequest struct {
+	BucketName string         `json:"bucketName"`
+	Kind       UsageAlertKind `json:"kind"`
+	Threshold  int64          `json:"threshold"`
+}
+
+// ErrUsageAlert occurs when a usage alert request is invalid.
+var ErrUsageAlert = errs.Class("usage alert")
+
+// usageAlertNotificationsLimit is the number of notifications shown to a user.
+const usageAlertNotificationsLimit = 50
+
+// CreateUsageAlert creates a new usage alert for the project.
+func (s *Service) CreateUsageAlert(ctx context.Context, projectID uuid.UUID, request UsageAlertRequest) (_ *UsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create usage alert", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if !request.Kind.Valid() {
+		return nil, ErrUsageAlert.New("unknown usage alert kind %q", request.Kind)
+	}
+	if request.Threshold <= 0 {
+		return nil, ErrUsageAlert.New("threshold must be positive")
+	}
+
+	if request.BucketName != "" {
+		if _, err := s.buckets.GetBucket(ctx, []byte(request.BucketName), projectID); err != nil {
+			return nil, ErrUsageAlert.New("bucket %q not found", request.BucketName)
+		}
+	}
+
+	alerts, err := s.store.UsageAlerts().GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(alerts) >= s.config.UsageAlertsLimit {
+		return nil, ErrUsageAlert.New("project can not have more than %d usage alerts", s.config.UsageAlertsLimit)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	alert, err := s.store.UsageAlerts().Insert(ctx, UsageAlert{
+		ID:         id,
+		ProjectID:  projectID,
+		BucketName: request.BucketName,
+		Kind:       request.Kind,
+		Threshold:  request.Threshold,
+		CreatedBy:  user.ID,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return alert, nil
+}
+
+// GetUsageAlerts returns all usage alerts of the project.
+func (s *Service) GetUsageAlerts(ctx context.Context, projectID uuid.UUID) (_ []UsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get usage alerts", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	alerts, err := s.store.UsageAlerts().GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return alerts, nil
+}
+
+// DeleteUsageAlert deletes the usage alert of the project.
+func (s *Service) DeleteUsageAlert(ctx context.Context, projectID, alertID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete usage alert", zap.String("projectID", projectID.String()), zap.String("alertID", alertID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	alert, err := s.store.UsageAlerts().Get(ctx, alertID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if alert.ProjectID != projectID {
+		return ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	return Error.Wrap(s.store.UsageAlerts().Delete(ctx, alertID))
+}
+
+// GetUsageAlertNotifications returns the newest usage alert notifications of the current user.
+func (s *Service) GetUsageAlertNotifications(ctx context.Context) (_ []UsageAlertNotification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get usage alert notifications")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	notifications, err := s.store.UsageAlerts().ListNotifications(ctx, user.ID, usageAlertNotificationsLimit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return notifications, nil
+}
+
+// ReadUsageAlertNotification marks the usage alert notification of the current user as read.
+func (s *Service) ReadUsageAlertNotification(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "read usage alert notification", zap.String("notificationID", id.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.store.UsageAlerts().MarkNotificationRead(ctx, user.ID, id))
+}
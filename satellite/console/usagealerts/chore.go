@@ -0,0 +1,404 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usagealerts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/payments/paymentsconfig"
+)
+
+var (
+	// Error is the error class for usage alerts chore.
+	Error = errs.Class("usage alerts")
+
+	mon = monkit.Package()
+)
+
+// hoursPerMonth is the number of hours in a billing month.
+const hoursPerMonth = 24 * 30
+
+// Config contains configurations for usage alerts.
+type Config struct {
+	Enable        bool          `help:"enable evaluating usage alerts and emailing project owners" default:"false"`
+	ChoreInterval time.Duration `help:"how often to evaluate usage alerts" default:"1h"`
+	ResetMargin   float64       `help:"fraction of the threshold the usage has to drop below it before an alert can trigger again" default:"0.1"`
+	ListLimit     int           `help:"how many usage alerts to evaluate in a batch" default:"1000"`
+}
+
+// Chore evaluates usage alerts against the current usage and notifies the
+// project owner by email and in the console when a threshold is crossed.
+//
+// Storage and segment alerts watch what is currently stored, egress and cost
+// alerts watch what has accumulated since the start of the month.
+//
+// An alert triggers only once. It is re-armed when the usage drops below the
+// threshold by the configured margin. Alerts on accumulated usage are also
+// re-armed when a new month starts.
+//
+// architecture: Chore
+type Chore struct {
+	log  *zap.Logger
+	Loop *sync2.Cycle
+
+	alerts            console.UsageAlerts
+	projects          console.Projects
+	users             console.Users
+	projectAccounting accounting.ProjectAccounting
+	liveAccounting    accounting.Cache
+	mailService       *mailservice.Service
+	config            Config
+	address           string
+
+	storageMBMonthPriceCents decimal.Decimal
+	egressMBPriceCents       decimal.Decimal
+	segmentMonthPriceCents   decimal.Decimal
+
+	nowFn func() time.Time
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, alerts console.UsageAlerts, projects console.Projects, users console.Users, projectAccounting accounting.ProjectAccounting, liveAccounting accounting.Cache, mailService *mailservice.Service, pricing paymentsconfig.PricingValues, config Config, address string) (*Chore, error) {
+	storageTBMonthDollars, err := decimal.NewFromString(pricing.StorageTBPrice)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	egressTBDollars, err := decimal.NewFromString(pricing.EgressTBPrice)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	segmentMonthDollars, err := decimal.NewFromString(pricing.SegmentPrice)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if !strings.HasSuffix(address, "/") {
+		address += "/"
+	}
+
+	return &Chore{
+		log:               log,
+		Loop:              sync2.NewCycle(config.ChoreInterval),
+		alerts:            alerts,
+		projects:          projects,
+		users:             users,
+		projectAccounting: projectAccounting,
+		liveAccounting:    liveAccounting,
+		mailService:       mailService,
+		config:            config,
+		address:           address,
+
+		// change the precision from TB dollars to MB cents
+		storageMBMonthPriceCents: storageTBMonthDollars.Shift(-6).Shift(2),
+		egressMBPriceCents:       egressTBDollars.Shift(-6).Shift(2),
+		segmentMonthPriceCents:   segmentMonthDollars.Shift(2),
+
+		nowFn: time.Now,
+	}, nil
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx)
+		if err != nil {
+			chore.log.Error("error evaluating usage alerts", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce evaluates all usage alerts.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn().UTC()
+	since := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	// alerts of the same project are listed far apart, so usage is
+	// cached for the whole run.
+	usages := make(map[usageKey]usage)
+
+	var cursor uuid.UUID
+	for {
+		alerts, err := chore.alerts.List(ctx, cursor, chore.config.ListLimit)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, alert := range alerts {
+			if err := chore.evaluate(ctx, usages, alert, since, now); err != nil {
+				chore.log.Error("error evaluating usage alert",
+					zap.Stringer("Alert ID", alert.ID),
+					zap.Stringer("Project ID", alert.ProjectID),
+					zap.Error(err))
+			}
+		}
+
+		if len(alerts) < chore.config.ListLimit {
+			return nil
+		}
+		cursor = alerts[len(alerts)-1].ID
+	}
+}
+
+// evaluate checks a single alert and updates its state.
+func (chore *Chore) evaluate(ctx context.Context, usages map[usageKey]usage, alert console.UsageAlert, since, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key := usageKey{projectID: alert.ProjectID, bucketName: alert.BucketName}
+	current, ok := usages[key]
+	if !ok {
+		current, err = chore.usage(ctx, key, since, now)
+		if err != nil {
+			return err
+		}
+		usages[key] = current
+	}
+
+	value := chore.value(current, alert.Kind)
+	threshold := float64(alert.Threshold)
+
+	triggered := alert.TriggeredAt != nil
+	if alert.Kind.Cumulative() && triggered {
+		// accumulated usage starts from zero every month, so alerts
+		// triggered in a previous month are treated as re-armed.
+		triggered = !alert.TriggeredAt.Before(since)
+	}
+
+	switch {
+	case !triggered && value >= threshold:
+		mon.Counter("usage_alerts_triggered").Inc(1)
+
+		if err := chore.notify(ctx, alert, value, now); err != nil {
+			return err
+		}
+		return chore.alerts.UpdateTriggeredAt(ctx, alert.ID, &now)
+
+	case alert.TriggeredAt != nil && value < threshold*(1-chore.config.ResetMargin):
+		mon.Counter("usage_alerts_reset").Inc(1)
+
+		return chore.alerts.UpdateTriggeredAt(ctx, alert.ID, nil)
+	}
+
+	return nil
+}
+
+// usageKey identifies the project or bucket an alert is watching.
+type usageKey struct {
+	projectID  uuid.UUID
+	bucketName string
+}
+
+// usage is the current usage of a project or bucket together with the usage
+// accumulated since the start of the month.
+type usage struct {
+	storageBytes float64
+	segments     float64
+
+	storageByteHours float64
+	egressBytes      float64
+	segmentHours     float64
+}
+
+// usage returns the current usage and the usage since the start of the month.
+func (chore *Chore) usage(ctx context.Context, key usageKey, since, now time.Time) (_ usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if key.bucketName == "" {
+		total, err := chore.projectAccounting.GetProjectTotal(ctx, key.projectID, since, now)
+		if err != nil {
+			return usage{}, Error.Wrap(err)
+		}
+		storage, err := chore.liveAccounting.GetProjectStorageUsage(ctx, key.projectID)
+		if err != nil {
+			return usage{}, Error.Wrap(err)
+		}
+		segments, err := chore.liveAccounting.GetProjectSegmentUsage(ctx, key.projectID)
+		if err != nil {
+			return usage{}, Error.Wrap(err)
+		}
+		return usage{
+			storageBytes:     float64(storage),
+			segments:         float64(segments),
+			storageByteHours: total.Storage,
+			egressBytes:      float64(total.Egress),
+			segmentHours:     total.SegmentCount,
+		}, nil
+	}
+
+	rollup, err := chore.projectAccounting.GetSingleBucketUsageRollup(ctx, key.projectID, key.bucketName, since, now)
+	if err != nil {
+		return usage{}, Error.Wrap(err)
+	}
+	// the latest tally is the current usage of the bucket. The exact name
+	// is the first of the buckets starting with it.
+	totals, err := chore.projectAccounting.GetBucketTotals(ctx, key.projectID, accounting.BucketUsageCursor{
+		Search: key.bucketName,
+		Limit:  1,
+		Page:   1,
+	}, now)
+	if err != nil {
+		return usage{}, Error.Wrap(err)
+	}
+	var current accounting.BucketUsage
+	if len(totals.BucketUsages) > 0 && totals.BucketUsages[0].BucketName == key.bucketName {
+		current = totals.BucketUsages[0]
+	}
+
+	// bucket rollups and totals are reported in GB.
+	return usage{
+		storageBytes:     current.Storage * float64(memory.GB),
+		segments:         float64(current.SegmentCount),
+		storageByteHours: rollup.TotalStoredData * float64(memory.GB),
+		egressBytes:      rollup.GetEgress * float64(memory.GB),
+		segmentHours:     rollup.TotalSegments,
+	}, nil
+}
+
+// value returns the usage in the unit of the alert kind.
+func (chore *Chore) value(current usage, kind console.UsageAlertKind) float64 {
+	switch kind {
+	case console.UsageAlertStorage:
+		return current.storageBytes
+	case console.UsageAlertEgress:
+		return current.egressBytes
+	case console.UsageAlertSegments:
+		return current.segments
+	case console.UsageAlertCost:
+		storage := decimal.NewFromFloat(current.storageByteHours).Shift(-6).Div(decimal.NewFromInt(hoursPerMonth))
+		egress := decimal.NewFromFloat(current.egressBytes).Shift(-6)
+		segments := decimal.NewFromFloat(current.segmentHours).Div(decimal.NewFromInt(hoursPerMonth))
+
+		cost := chore.storageMBMonthPriceCents.Mul(storage).
+			Add(chore.egressMBPriceCents.Mul(egress)).
+			Add(chore.segmentMonthPriceCents.Mul(segments))
+
+		cents, _ := cost.Float64()
+		return cents
+	}
+	return 0
+}
+
+// notify emails the project owner and the creator of the alert and shows them
+// a notification in the console.
+func (chore *Chore) notify(ctx context.Context, alert console.UsageAlert, value float64, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := chore.projects.Get(ctx, alert.ProjectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	recipients := []uuid.UUID{project.OwnerID}
+	if alert.CreatedBy != project.OwnerID {
+		recipients = append(recipients, alert.CreatedBy)
+	}
+
+	for _, userID := range recipients {
+		user, err := chore.users.Get(ctx, userID)
+		if err != nil {
+			// the creator of the alert may have left the project.
+			chore.log.Debug("unable to get usage alert recipient", zap.Stringer("User ID", userID), zap.Error(err))
+			continue
+		}
+
+		id, err := uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		err = chore.alerts.InsertNotification(ctx, console.UsageAlertNotification{
+			ID:         id,
+			UserID:     user.ID,
+			AlertID:    alert.ID,
+			ProjectID:  alert.ProjectID,
+			BucketName: alert.BucketName,
+			Kind:       alert.Kind,
+			Threshold:  alert.Threshold,
+			Value:      int64(value),
+			CreatedAt:  now,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		userName := user.ShortName
+		if userName == "" {
+			userName = user.FullName
+		}
+
+		chore.mailService.SendRenderedAsync(
+			ctx,
+			[]post.Address{{Address: user.Email, Name: userName}},
+			&console.UsageAlertEmail{
+				UserName:             userName,
+				ProjectName:          project.Name,
+				BucketName:           alert.BucketName,
+				Usage:                usageName(alert.Kind),
+				Current:              formatValue(alert.Kind, value),
+				Threshold:            formatValue(alert.Kind, float64(alert.Threshold)),
+				ProjectDashboardLink: chore.address + "project-dashboard",
+			},
+		)
+	}
+
+	return nil
+}
+
+// usageName returns the human readable name of the usage the alert is watching.
+func usageName(kind console.UsageAlertKind) string {
+	switch kind {
+	case console.UsageAlertStorage:
+		return "stored data"
+	case console.UsageAlertEgress:
+		return "egress"
+	case console.UsageAlertSegments:
+		return "segment count"
+	case console.UsageAlertCost:
+		return "estimated cost"
+	}
+	return string(kind)
+}
+
+// formatValue formats the value in the unit of the alert kind.
+func formatValue(kind console.UsageAlertKind, value float64) string {
+	switch kind {
+	case console.UsageAlertStorage:
+		return memory.Size(value).String()
+	case console.UsageAlertEgress:
+		return memory.Size(value).String()
+	case console.UsageAlertSegments:
+		return fmt.Sprintf("%.0f segments", value)
+	case console.UsageAlertCost:
+		return fmt.Sprintf("$%.2f", value/100)
+	}
+	return fmt.Sprintf("%.0f", value)
+}
+
+// Close closes chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestSetNow allows tests to have the chore act as if the current time is different.
+func (chore *Chore) TestSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usagealerts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+)
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.UsageAlerts.Enable = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		alerts := sat.DB.Console().UsageAlerts()
+		chore := sat.Core.Mail.UsageAlerts
+		chore.Loop.Pause()
+
+		project := planet.Uplinks[0].Projects[0]
+
+		newAlert := func(bucketName string, kind console.UsageAlertKind, threshold int64) console.UsageAlert {
+			alert, err := alerts.Insert(ctx, console.UsageAlert{
+				ID:         testrand.UUID(),
+				ProjectID:  project.ID,
+				BucketName: bucketName,
+				Kind:       kind,
+				Threshold:  threshold,
+				CreatedBy:  project.Owner.ID,
+			})
+			require.NoError(t, err)
+			return *alert
+		}
+
+		projectEgress := newAlert("", console.UsageAlertEgress, memory.GB.Int64())
+		bucketEgress := newAlert("testbucket", console.UsageAlertEgress, 5*memory.GB.Int64())
+		projectCost := newAlert("", console.UsageAlertCost, 5)
+		bucketStorage := newAlert("testbucket", console.UsageAlertStorage, memory.KiB.Int64())
+
+		triggeredAt := func(alert console.UsageAlert) *time.Time {
+			got, err := alerts.Get(ctx, alert.ID)
+			require.NoError(t, err)
+			return got.TriggeredAt
+		}
+
+		// the usage is evaluated in the following months, so the bucket and
+		// its tallies exist before the evaluation time.
+		today := time.Now()
+		now := time.Date(today.Year(), today.Month()+1, 15, 12, 0, 0, 0, time.UTC)
+		chore.TestSetNow(func() time.Time { return now })
+
+		// no usage yet
+		require.NoError(t, chore.RunOnce(ctx))
+		require.Nil(t, triggeredAt(projectEgress))
+		require.Nil(t, triggeredAt(bucketEgress))
+		require.Nil(t, triggeredAt(projectCost))
+		require.Nil(t, triggeredAt(bucketStorage))
+
+		err := planet.Uplinks[0].Upload(ctx, sat, "testbucket", "object", testrand.Bytes(2*memory.KiB))
+		require.NoError(t, err)
+		sat.Accounting.Tally.Loop.TriggerWait()
+
+		err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("testbucket"),
+			pb.PieceAction_GET, 2*memory.GB.Int64(), 0, now.Add(-2*time.Hour))
+		require.NoError(t, err)
+
+		require.NoError(t, chore.RunOnce(ctx))
+		triggered := triggeredAt(projectEgress)
+		require.NotNil(t, triggered)
+		require.True(t, triggered.Equal(now))
+		require.Nil(t, triggeredAt(bucketEgress))
+		require.NotNil(t, triggeredAt(projectCost))
+		require.NotNil(t, triggeredAt(bucketStorage))
+
+		notifications, err := alerts.ListNotifications(ctx, project.Owner.ID, 10)
+		require.NoError(t, err)
+		notified := make(map[uuid.UUID]int64)
+		for _, notification := range notifications {
+			notified[notification.AlertID] = notification.Value
+		}
+		require.Len(t, notified, 3)
+		require.Equal(t, 2*memory.GB.Int64(), notified[projectEgress.ID])
+		require.Contains(t, notified, projectCost.ID)
+		require.GreaterOrEqual(t, notified[bucketStorage.ID], 2*memory.KiB.Int64())
+
+		// an alert triggers only once while the usage stays above the threshold
+		later := now.Add(time.Hour)
+		chore.TestSetNow(func() time.Time { return later })
+		require.NoError(t, chore.RunOnce(ctx))
+		require.True(t, triggeredAt(projectEgress).Equal(now))
+
+		// usage of a new month starts from zero, which re-arms the alerts
+		nextMonth := time.Date(today.Year(), today.Month()+2, 1, 12, 0, 0, 0, time.UTC)
+		chore.TestSetNow(func() time.Time { return nextMonth })
+		require.NoError(t, chore.RunOnce(ctx))
+		require.Nil(t, triggeredAt(projectEgress))
+		require.Nil(t, triggeredAt(projectCost))
+
+		// stored data does not start from zero, so it stays triggered
+		require.NotNil(t, triggeredAt(bucketStorage))
+
+		// the tally of the emptied bucket
+		err = sat.DB.ProjectAccounting().CreateStorageTally(ctx, accounting.BucketStorageTally{
+			BucketName:    "testbucket",
+			ProjectID:     project.ID,
+			IntervalStart: nextMonth.Add(-time.Minute),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, chore.RunOnce(ctx))
+		require.Nil(t, triggeredAt(bucketStorage))
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestUsageAlerts(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		project := planet.Uplinks[0].Projects[0]
+		userCtx, err := sat.UserContext(ctx, project.Owner.ID)
+		require.NoError(t, err)
+
+		otherCtx, err := sat.UserContext(ctx, planet.Uplinks[1].Projects[0].Owner.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "testbucket"))
+
+		t.Run("invalid requests", func(t *testing.T) {
+			_, err := service.CreateUsageAlert(userCtx, project.ID, console.UsageAlertRequest{Kind: "unknown", Threshold: 1})
+			require.True(t, console.ErrUsageAlert.Has(err))
+
+			_, err = service.CreateUsageAlert(userCtx, project.ID, console.UsageAlertRequest{Kind: console.UsageAlertEgress})
+			require.True(t, console.ErrUsageAlert.Has(err))
+
+			_, err = service.CreateUsageAlert(userCtx, project.ID, console.UsageAlertRequest{BucketName: "missing", Kind: console.UsageAlertEgress, Threshold: 1})
+			require.True(t, console.ErrUsageAlert.Has(err))
+		})
+
+		projectAlert, err := service.CreateUsageAlert(userCtx, project.ID, console.UsageAlertRequest{
+			Kind:      console.UsageAlertCost,
+			Threshold: 1000,
+		})
+		require.NoError(t, err)
+		require.Equal(t, project.Owner.ID, projectAlert.CreatedBy)
+		require.Nil(t, projectAlert.TriggeredAt)
+
+		bucketAlert, err := service.CreateUsageAlert(userCtx, project.ID, console.UsageAlertRequest{
+			BucketName: "testbucket",
+			Kind:       console.UsageAlertStorage,
+			Threshold:  1 << 30,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "testbucket", bucketAlert.BucketName)
+
+		alerts, err := service.GetUsageAlerts(userCtx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, alerts, 2)
+
+		t.Run("non-members", func(t *testing.T) {
+			_, err := service.GetUsageAlerts(otherCtx, project.ID)
+			require.Error(t, err)
+
+			_, err = service.CreateUsageAlert(otherCtx, project.ID, console.UsageAlertRequest{Kind: console.UsageAlertEgress, Threshold: 1})
+			require.Error(t, err)
+
+			require.Error(t, service.DeleteUsageAlert(otherCtx, project.ID, projectAlert.ID))
+
+			// alerts can't be deleted through another project
+			otherProject := planet.Uplinks[1].Projects[0]
+			require.True(t, console.ErrUnauthorized.Has(service.DeleteUsageAlert(otherCtx, otherProject.ID, projectAlert.ID)))
+		})
+
+		require.NoError(t, service.DeleteUsageAlert(userCtx, project.ID, projectAlert.ID))
+		require.Error(t, service.DeleteUsageAlert(userCtx, project.ID, testrand.UUID()))
+
+		alerts, err = service.GetUsageAlerts(userCtx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, alerts, 1)
+		require.Equal(t, bucketAlert.ID, alerts[0].ID)
+
+		t.Run("notifications", func(t *testing.T) {
+			notification := console.UsageAlertNotification{
+				ID:         testrand.UUID(),
+				UserID:     project.Owner.ID,
+				AlertID:    bucketAlert.ID,
+				ProjectID:  project.ID,
+				BucketName: bucketAlert.BucketName,
+				Kind:       bucketAlert.Kind,
+				Threshold:  bucketAlert.Threshold,
+				Value:      bucketAlert.Threshold + 1,
+			}
+			require.NoError(t, sat.DB.Console().UsageAlerts().InsertNotification(ctx, notification))
+
+			notifications, err := service.GetUsageAlertNotifications(userCtx)
+			require.NoError(t, err)
+			require.Len(t, notifications, 1)
+			require.Equal(t, notification.ID, notifications[0].ID)
+			require.Equal(t, notification.Value, notifications[0].Value)
+			require.Nil(t, notifications[0].ReadAt)
+
+			notifications, err = service.GetUsageAlertNotifications(otherCtx)
+			require.NoError(t, err)
+			require.Empty(t, notifications)
+
+			// notifications can only be read by their user
+			require.Error(t, service.ReadUsageAlertNotification(otherCtx, notification.ID))
+			require.NoError(t, service.ReadUsageAlertNotification(userCtx, notification.ID))
+
+			notifications, err = service.GetUsageAlertNotifications(userCtx)
+			require.NoError(t, err)
+			require.Len(t, notifications, 1)
+			require.NotNil(t, notifications[0].ReadAt)
+		})
+	})
+}
@@ -8,14 +8,17 @@ import (
 	"crypto/x509"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcpeer"
 	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/storj/private/nodetag"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/storagenode"
 )
@@ -54,6 +57,97 @@ func TestSatelliteContactEndpoint(t *testing.T) {
 	})
 }
 
+func TestSatelliteContactEndpoint_NodeTags(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Contact.Tags = "datacenter=true,region=eu-west"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+		nodeInfo := node.Contact.Service.Local()
+		require.NotNil(t, nodeInfo.SignedTags)
+
+		checkIn := func(signed *nodetag.SignedTagSet) {
+			peer := rpcpeer.Peer{
+				Addr: &net.TCPAddr{
+					IP:   net.ParseIP(nodeInfo.Address),
+					Port: 5,
+				},
+				State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{node.Identity.Leaf, node.Identity.CA},
+				},
+			}
+			req := &pb.CheckInRequest{
+				Address:  nodeInfo.Address,
+				Version:  &nodeInfo.Version,
+				Capacity: &nodeInfo.Capacity,
+				Operator: &nodeInfo.Operator,
+			}
+			nodetag.Attach(req, signed)
+			_, err := satellite.Contact.Endpoint.CheckIn(rpcpeer.NewContext(ctx, &peer), req)
+			require.NoError(t, err)
+		}
+
+		checkIn(nodeInfo.SignedTags)
+
+		tags, err := satellite.Overlay.Service.GetNodeTags(ctx, node.ID())
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"datacenter": "true", "region": "eu-west"}, tags.Map())
+
+		reputable, newNodes, err := satellite.Overlay.DB.SelectAllStorageNodesUpload(ctx, satellite.Config.Overlay.Node)
+		require.NoError(t, err)
+		var found bool
+		for _, selected := range append(reputable, newNodes...) {
+			if selected.ID == node.ID() {
+				found = true
+				require.Equal(t, tags.Map(), selected.Tags)
+			}
+		}
+		require.True(t, found)
+
+		// tags signed by another node are ignored.
+		otherSigned, err := nodetag.Sign(ctx, &nodetag.TagSet{
+			NodeID:   planet.StorageNodes[1].ID(),
+			SignedAt: time.Now(),
+		}, signing.SignerFromFullIdentity(planet.StorageNodes[1].Identity))
+		require.NoError(t, err)
+		checkIn(otherSigned)
+
+		tags, err = satellite.Overlay.Service.GetNodeTags(ctx, node.ID())
+		require.NoError(t, err)
+		require.Len(t, tags, 2)
+
+		// tags signed before the stored tags are ignored.
+		olderSigned, err := nodetag.Sign(ctx, &nodetag.TagSet{
+			NodeID:   node.ID(),
+			SignedAt: time.Now().Add(-time.Hour),
+			Tags:     []nodetag.Tag{{Name: "region", Value: "us-east"}},
+		}, signing.SignerFromFullIdentity(node.Identity))
+		require.NoError(t, err)
+		checkIn(olderSigned)
+
+		tags, err = satellite.Overlay.Service.GetNodeTags(ctx, node.ID())
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"datacenter": "true", "region": "eu-west"}, tags.Map())
+
+		// an empty tag set removes the tags.
+		emptySigned, err := nodetag.Sign(ctx, &nodetag.TagSet{
+			NodeID:   node.ID(),
+			SignedAt: time.Now(),
+		}, signing.SignerFromFullIdentity(node.Identity))
+		require.NoError(t, err)
+		checkIn(emptySigned)
+
+		tags, err = satellite.Overlay.Service.GetNodeTags(ctx, node.ID())
+		require.NoError(t, err)
+		require.Empty(t, tags)
+	})
+}
+
 func TestSatelliteContactEndpoint_QUIC_Unreachable(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
@@ -14,8 +14,10 @@ import (
 	"storj.io/common/identity"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/storj/private/nodeoperator"
+	"storj.io/storj/private/nodetag"
 	"storj.io/storj/satellite/overlay"
 )
 
@@ -120,6 +122,8 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		return nil, rpcstatus.Error(rpcstatus.Internal, Error.Wrap(err).Error())
 	}
 
+	endpoint.updateNodeTags(ctx, peerID, req)
+
 	endpoint.log.Debug("checking in", zap.Stringer("Node ID", nodeID), zap.String("node addr", req.Address), zap.Bool("ping node success", pingNodeSuccess), zap.String("ping node err msg", pingErrorMessage))
 	return &pb.CheckInResponse{
 		PingNodeSuccess:     pingNodeSuccess,
@@ -128,6 +132,36 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 	}, nil
 }
 
+// updateNodeTags stores the tags sent by the node, when they are signed by the
+// node. Invalid tags are ignored, so they don't prevent the node from checking
+// in.
+func (endpoint *Endpoint) updateNodeTags(ctx context.Context, peerID *identity.PeerIdentity, req *pb.CheckInRequest) {
+	defer mon.Task()(&ctx)(nil)
+
+	signed, err := nodetag.FromCheckIn(req)
+	if err != nil {
+		endpoint.log.Info("ignoring invalid node tags", zap.Stringer("Node ID", peerID.ID), zap.Error(err))
+		return
+	}
+	if signed == nil {
+		return
+	}
+
+	tags, err := nodetag.Verify(ctx, signed, signing.SigneeFromPeerIdentity(peerID))
+	if err != nil {
+		endpoint.log.Info("ignoring invalid node tags", zap.Stringer("Node ID", peerID.ID), zap.Error(err))
+		return
+	}
+
+	if err := endpoint.service.overlay.UpdateNodeTags(ctx, tags); err != nil {
+		if overlay.ErrNodeTagsOutdated.Has(err) {
+			endpoint.log.Info("ignoring outdated node tags", zap.Stringer("Node ID", peerID.ID), zap.Error(err))
+			return
+		}
+		endpoint.log.Warn("failed to update node tags", zap.Stringer("Node ID", peerID.ID), zap.Error(err))
+	}
+}
+
 // GetTime returns current timestamp.
 func (endpoint *Endpoint) GetTime(ctx context.Context, req *pb.GetTimeRequest) (_ *pb.GetTimeResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
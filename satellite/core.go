@@ -34,9 +34,11 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/emailreminders"
+	"storj.io/storj/satellite/console/usagealerts"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/consistency"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
@@ -46,6 +48,7 @@ import (
 	"storj.io/storj/satellite/overlay/straynodes"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/checker"
@@ -74,6 +77,7 @@ type Core struct {
 	Mail struct {
 		Service        *mailservice.Service
 		EmailReminders *emailreminders.Chore
+		UsageAlerts    *usagealerts.Chore
 	}
 
 	Debug struct {
@@ -113,6 +117,11 @@ type Core struct {
 		Chore    *audit.Chore
 		Verifier *audit.Verifier
 		Reporter audit.Reporter
+
+		ExistenceQueues   *audit.Queues
+		ExistenceVerifier *audit.ExistenceVerifier
+		ExistenceWorker   *audit.ExistenceWorker
+		ExistenceChore    *audit.Chore
 	}
 
 	ExpiredDeletion struct {
@@ -123,6 +132,11 @@ type Core struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	MetabaseConsistency struct {
+		SegmentLoop *segmentloop.Service
+		Chore       *consistency.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		NodeTally             *nodetally.Service
@@ -259,6 +273,38 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 	}
 
+	{ // setup usage alerts
+		if config.UsageAlerts.Enable {
+			peer.Mail.UsageAlerts, err = usagealerts.NewChore(
+				peer.Log.Named("console:usage-alerts"),
+				peer.DB.Console().UsageAlerts(),
+				peer.DB.Console().Projects(),
+				peer.DB.Console().Users(),
+				peer.DB.ProjectAccounting(),
+				liveAccounting,
+				peer.Mail.Service,
+				paymentsconfig.PricingValues{
+					StorageTBPrice: config.Payments.StorageTBPrice,
+					EgressTBPrice:  config.Payments.EgressTBPrice,
+					SegmentPrice:   config.Payments.SegmentPrice,
+				},
+				config.UsageAlerts,
+				config.Console.ExternalAddress,
+			)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "mail:usage-alerts",
+				Run:   peer.Mail.UsageAlerts.Run,
+				Close: peer.Mail.UsageAlerts.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Usage Alerts", peer.Mail.UsageAlerts.Loop))
+		}
+	}
+
 	{ // setup overlay
 		peer.Overlay.DB = peer.DB.OverlayCache()
 		peer.Overlay.Service, err = overlay.NewService(peer.Log.Named("overlay"), peer.Overlay.DB, config.Overlay)
@@ -316,6 +362,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Log.Named("metainfo:segmentloop"),
 			config.Metainfo.SegmentLoop,
 			peer.Metainfo.Metabase,
+			peer.DB.SegmentLoopCheckpoints(),
+			"core",
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:segmentloop",
@@ -414,6 +462,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.Audit.Chore = audit.NewChore(peer.Log.Named("audit:chore"),
 			peer.Audit.Queues,
 			peer.Metainfo.SegmentLoop,
+			peer.Overlay.Service,
 			config,
 		)
 		peer.Services.Add(lifecycle.Item{
@@ -423,6 +472,46 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Audit Chore", peer.Audit.Chore.Loop))
+
+		if config.ExistenceEnabled {
+			peer.Audit.ExistenceQueues = audit.NewQueues()
+
+			peer.Audit.ExistenceVerifier = audit.NewExistenceVerifier(log.Named("audit:existence-verifier"),
+				peer.Metainfo.Metabase,
+				dialer,
+				peer.Orders.Service,
+				peer.Audit.Verifier,
+				peer.Identity,
+				config,
+			)
+
+			peer.Audit.ExistenceWorker = audit.NewExistenceWorker(peer.Log.Named("audit:existence-worker"),
+				peer.Audit.ExistenceQueues,
+				peer.Audit.ExistenceVerifier,
+				peer.Reputation.Service,
+				config,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "audit:existence-worker",
+				Run:   peer.Audit.ExistenceWorker.Run,
+				Close: peer.Audit.ExistenceWorker.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Audit Existence Worker", peer.Audit.ExistenceWorker.Loop))
+
+			peer.Audit.ExistenceChore = audit.NewExistenceChore(peer.Log.Named("audit:existence-chore"),
+				peer.Audit.ExistenceQueues,
+				peer.Metainfo.SegmentLoop,
+				config,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "audit:existence-chore",
+				Run:   peer.Audit.ExistenceChore.Run,
+				Close: peer.Audit.ExistenceChore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Audit Existence Chore", peer.Audit.ExistenceChore.Loop))
+		}
 	}
 
 	{ // setup expired segment cleanup
@@ -455,6 +544,43 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Zombie Objects Chore", peer.ZombieDeletion.Chore.Loop))
 	}
 
+	{ // setup metabase consistency checks
+		if config.MetabaseConsistency.Enabled {
+			// the checks run on their own loop, which passes the segments with
+			// unknown node aliases to them instead of failing like the core loop.
+			peer.MetabaseConsistency.SegmentLoop = segmentloop.New(
+				peer.Log.Named("metabase-consistency:segmentloop"),
+				config.Metainfo.SegmentLoop,
+				peer.Metainfo.Metabase,
+				nil,
+				"consistency",
+			)
+			peer.MetabaseConsistency.SegmentLoop.IncludeMissingAliases()
+			peer.Services.Add(lifecycle.Item{
+				Name:  "metabase-consistency:segmentloop",
+				Run:   peer.MetabaseConsistency.SegmentLoop.Run,
+				Close: peer.MetabaseConsistency.SegmentLoop.Close,
+			})
+
+			peer.MetabaseConsistency.Chore = consistency.NewChore(
+				peer.Log.Named("metabase-consistency"),
+				peer.DB.MetabaseConsistencyFindings(),
+				peer.Metainfo.Metabase,
+				peer.MetabaseConsistency.SegmentLoop,
+				config.MetabaseConsistency,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "metabase-consistency:chore",
+				Run:   peer.MetabaseConsistency.Chore.Run,
+				Close: peer.MetabaseConsistency.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Metabase Consistency", peer.MetabaseConsistency.Chore.Loop))
+		} else {
+			peer.Log.Named("metabase-consistency").Info("disabled")
+		}
+	}
+
 	{ // setup accounting
 		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, config.Tally)
 		peer.Services.Add(lifecycle.Item{
@@ -532,6 +658,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.Billing(),
 			peer.DB.Console().Projects(),
 			peer.DB.ProjectAccounting(),
+			peer.DB.PricePlans(),
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
@@ -118,6 +118,8 @@ func NewGarbageCollectionBF(log *zap.Logger, full *identity.FullIdentity, db DB,
 			log.Named("segmentloop"),
 			config.Metainfo.SegmentLoop,
 			metabaseDB,
+			peer.DB.SegmentLoopCheckpoints(),
+			"gc-bf",
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:segmentloop",
@@ -137,6 +137,8 @@ func NewGarbageCollection(log *zap.Logger, full *identity.FullIdentity, db DB,
 			log.Named("segmentloop"),
 			config.Metainfo.SegmentLoop,
 			metabaseDB,
+			peer.DB.SegmentLoopCheckpoints(),
+			"gc",
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:segmentloop",
@@ -5,6 +5,8 @@ package bloomfilter
 
 import (
 	"context"
+	"encoding/binary"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -16,7 +18,10 @@ import (
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
-var _ segmentloop.Observer = (*PieceTracker)(nil)
+var (
+	_ segmentloop.PartitionedObserver  = (*PieceTracker)(nil)
+	_ segmentloop.CheckpointedObserver = (*PieceTracker)(nil)
+)
 
 // RetainInfo contains info needed for a storage node to retain important data and delete garbage data.
 type RetainInfo struct {
@@ -35,6 +40,12 @@ type PieceTracker struct {
 	// TODO: should we use int or int64 consistently for piece count (db type is int64)?
 	pieceCounts map[storj.NodeID]int
 
+	// parent is the tracker, which forked this tracker for a range of a
+	// parallel loop. mu protects the RetainInfos of a parent, which are
+	// accessed by the forks concurrently.
+	parent *PieceTracker
+	mu     sync.Mutex
+
 	RetainInfos map[storj.NodeID]*RetainInfo
 }
 
@@ -75,16 +86,10 @@ func (pieceTracker *PieceTracker) RemoteSegment(ctx context.Context, segment *se
 func (pieceTracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID) {
 	info, ok := pieceTracker.RetainInfos[nodeID]
 	if !ok {
-		// If we know how many pieces a node should be storing, use that number. Otherwise use default.
-		numPieces := pieceTracker.config.InitialPieces
-		if pieceTracker.pieceCounts[nodeID] > 0 {
-			numPieces = pieceTracker.pieceCounts[nodeID]
-		}
-		// limit size of bloom filter to ensure we are under the limit for RPC
-		filter := bloomfilter.NewOptimalMaxSize(numPieces, pieceTracker.config.FalsePositiveRate, 2*memory.MiB)
-		info = &RetainInfo{
-			Filter:       filter,
-			CreationDate: pieceTracker.creationDate,
+		if pieceTracker.parent != nil {
+			info = pieceTracker.parent.emptyRetainInfo(nodeID)
+		} else {
+			info = pieceTracker.newRetainInfo(nodeID)
 		}
 		pieceTracker.RetainInfos[nodeID] = info
 	}
@@ -97,3 +102,152 @@ func (pieceTracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID
 func (pieceTracker *PieceTracker) InlineSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
 	return nil
 }
+
+// newRetainInfo creates the RetainInfo for the node.
+func (pieceTracker *PieceTracker) newRetainInfo(nodeID storj.NodeID) *RetainInfo {
+	// If we know how many pieces a node should be storing, use that number. Otherwise use default.
+	numPieces := pieceTracker.config.InitialPieces
+	if pieceTracker.pieceCounts[nodeID] > 0 {
+		numPieces = pieceTracker.pieceCounts[nodeID]
+	}
+	// limit size of bloom filter to ensure we are under the limit for RPC
+	filter := bloomfilter.NewOptimalMaxSize(numPieces, pieceTracker.config.FalsePositiveRate, 2*memory.MiB)
+	return &RetainInfo{
+		Filter:       filter,
+		CreationDate: pieceTracker.creationDate,
+	}
+}
+
+// emptyRetainInfo returns an empty RetainInfo for a fork, which uses the same
+// filter parameters as the RetainInfo of the node, so they can be joined.
+func (pieceTracker *PieceTracker) emptyRetainInfo(nodeID storj.NodeID) *RetainInfo {
+	pieceTracker.mu.Lock()
+	defer pieceTracker.mu.Unlock()
+
+	info, ok := pieceTracker.RetainInfos[nodeID]
+	if !ok {
+		info = pieceTracker.newRetainInfo(nodeID)
+		pieceTracker.RetainInfos[nodeID] = info
+	}
+
+	data := info.Filter.Bytes()
+	for i := 3; i < len(data); i++ {
+		data[i] = 0
+	}
+	filter, err := bloomfilter.NewFromBytes(data)
+	if err != nil {
+		// the data comes from a valid filter, so this can't happen.
+		panic(err)
+	}
+	return &RetainInfo{
+		Filter:       filter,
+		CreationDate: info.CreationDate,
+	}
+}
+
+// Fork creates a tracker for a range of a parallel loop.
+func (pieceTracker *PieceTracker) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return &PieceTracker{
+		log:          pieceTracker.log,
+		config:       pieceTracker.config,
+		creationDate: pieceTracker.creationDate,
+		pieceCounts:  pieceTracker.pieceCounts,
+		parent:       pieceTracker,
+
+		RetainInfos: make(map[storj.NodeID]*RetainInfo),
+	}, nil
+}
+
+// Join adds the pieces of a forked tracker to the bloom filters.
+func (pieceTracker *PieceTracker) Join(ctx context.Context, partial segmentloop.Partial) error {
+	fork, ok := partial.(*PieceTracker)
+	if !ok || fork.parent != pieceTracker {
+		return errs.New("expected a fork of the piece tracker, got %T", partial)
+	}
+
+	pieceTracker.mu.Lock()
+	defer pieceTracker.mu.Unlock()
+
+	for nodeID, forkInfo := range fork.RetainInfos {
+		info := pieceTracker.RetainInfos[nodeID]
+
+		data := info.Filter.Bytes()
+		forkData := forkInfo.Filter.Bytes()
+		if len(data) != len(forkData) || data[1] != forkData[1] || data[2] != forkData[2] {
+			return errs.New("bloom filter parameters of node %s don't match", nodeID)
+		}
+		for i := 3; i < len(data); i++ {
+			data[i] |= forkData[i]
+		}
+
+		filter, err := bloomfilter.NewFromBytes(data)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		info.Filter = filter
+		info.Count += forkInfo.Count
+	}
+	return nil
+}
+
+// SaveState serializes the bloom filters, so the loop can be resumed after a
+// restart. The first chunk contains the creation date of the filters and
+// every other chunk contains the filter of a single node.
+func (pieceTracker *PieceTracker) SaveState(ctx context.Context) ([][]byte, error) {
+	pieceTracker.mu.Lock()
+	defer pieceTracker.mu.Unlock()
+
+	state := make([][]byte, 0, len(pieceTracker.RetainInfos)+1)
+
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint64(header, uint64(pieceTracker.creationDate.UnixNano()))
+	state = append(state, header)
+
+	for nodeID, info := range pieceTracker.RetainInfos {
+		filter := info.Filter.Bytes()
+
+		chunk := make([]byte, len(nodeID)+8, len(nodeID)+8+len(filter))
+		copy(chunk, nodeID[:])
+		binary.BigEndian.PutUint64(chunk[len(nodeID):], uint64(info.Count))
+		state = append(state, append(chunk, filter...))
+	}
+	return state, nil
+}
+
+// RestoreState restores the bloom filters serialized with SaveState.
+func (pieceTracker *PieceTracker) RestoreState(ctx context.Context, state [][]byte) error {
+	if len(state) == 0 || len(state[0]) != 8 {
+		return errs.New("invalid piece tracker state")
+	}
+	creationDate := time.Unix(0, int64(binary.BigEndian.Uint64(state[0]))).UTC()
+
+	retainInfos := make(map[storj.NodeID]*RetainInfo, len(state)-1)
+	for _, chunk := range state[1:] {
+		const headerSize = len(storj.NodeID{}) + 8
+		if len(chunk) <= headerSize {
+			return errs.New("invalid piece tracker state")
+		}
+
+		var nodeID storj.NodeID
+		copy(nodeID[:], chunk)
+		count := binary.BigEndian.Uint64(chunk[len(nodeID):])
+
+		filter, err := bloomfilter.NewFromBytes(append([]byte(nil), chunk[headerSize:]...))
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		retainInfos[nodeID] = &RetainInfo{
+			Filter:       filter,
+			CreationDate: creationDate,
+			Count:        int(count),
+		}
+	}
+
+	pieceTracker.mu.Lock()
+	defer pieceTracker.mu.Unlock()
+
+	pieceTracker.creationDate = creationDate
+	pieceTracker.RetainInfos = retainInfos
+	return nil
+}
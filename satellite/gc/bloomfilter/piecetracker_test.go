@@ -0,0 +1,122 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/gc/bloomfilter"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+func TestPieceTrackerForkJoin(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	nodes := []storj.NodeID{testrand.NodeID(), testrand.NodeID(), testrand.NodeID()}
+
+	tracker := bloomfilter.NewPieceTracker(zaptest.NewLogger(t), bloomfilter.Config{
+		InitialPieces:     100,
+		FalsePositiveRate: 0.1,
+	}, map[storj.NodeID]int{})
+
+	var segments []*segmentloop.Segment
+	for i := 0; i < 30; i++ {
+		segments = append(segments, &segmentloop.Segment{
+			StreamID:    testrand.UUID(),
+			RootPieceID: testrand.PieceID(),
+			Pieces: metabase.Pieces{
+				{Number: 0, StorageNode: nodes[i%len(nodes)]},
+				{Number: 1, StorageNode: nodes[(i+1)%len(nodes)]},
+			},
+		})
+	}
+
+	// every fork processes a part of the segments.
+	forks := make([]segmentloop.Partial, 3)
+	for i := range forks {
+		fork, err := tracker.Fork(ctx)
+		require.NoError(t, err)
+		forks[i] = fork
+	}
+	for i, segment := range segments {
+		require.NoError(t, forks[i%len(forks)].RemoteSegment(ctx, segment))
+	}
+	for _, fork := range forks {
+		require.NoError(t, tracker.Join(ctx, fork))
+	}
+
+	require.Len(t, tracker.RetainInfos, len(nodes))
+	total := 0
+	for _, info := range tracker.RetainInfos {
+		total += info.Count
+	}
+	require.Equal(t, 2*len(segments), total)
+
+	for _, segment := range segments {
+		deriver := segment.RootPieceID.Deriver()
+		for _, piece := range segment.Pieces {
+			pieceID := deriver.Derive(piece.StorageNode, int32(piece.Number))
+			require.True(t, tracker.RetainInfos[piece.StorageNode].Filter.Contains(pieceID))
+		}
+	}
+
+	// a tracker doesn't accept partials, which it didn't fork.
+	other, err := bloomfilter.NewPieceTracker(zaptest.NewLogger(t), bloomfilter.Config{}, nil).Fork(ctx)
+	require.NoError(t, err)
+	require.Error(t, tracker.Join(ctx, other))
+}
+
+func TestPieceTrackerState(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	config := bloomfilter.Config{
+		InitialPieces:     100,
+		FalsePositiveRate: 0.1,
+	}
+	tracker := bloomfilter.NewPieceTracker(zaptest.NewLogger(t), config, map[storj.NodeID]int{})
+
+	segment := &segmentloop.Segment{
+		StreamID:    testrand.UUID(),
+		RootPieceID: testrand.PieceID(),
+		Pieces: metabase.Pieces{
+			{Number: 0, StorageNode: testrand.NodeID()},
+			{Number: 1, StorageNode: testrand.NodeID()},
+		},
+	}
+	require.NoError(t, tracker.RemoteSegment(ctx, segment))
+
+	state, err := tracker.SaveState(ctx)
+	require.NoError(t, err)
+
+	restored := bloomfilter.NewPieceTracker(zaptest.NewLogger(t), config, map[storj.NodeID]int{})
+	require.NoError(t, restored.RestoreState(ctx, state))
+
+	require.Len(t, restored.RetainInfos, len(tracker.RetainInfos))
+	for nodeID, info := range tracker.RetainInfos {
+		restoredInfo := restored.RetainInfos[nodeID]
+		require.NotNil(t, restoredInfo)
+		require.Equal(t, info.Count, restoredInfo.Count)
+		require.Equal(t, info.Filter.Bytes(), restoredInfo.Filter.Bytes())
+		require.True(t, info.CreationDate.Equal(restoredInfo.CreationDate))
+	}
+
+	// the restored tracker keeps the creation date of the first loop.
+	require.NoError(t, restored.LoopStarted(ctx, segmentloop.LoopInfo{Started: tracker.RetainInfos[segment.Pieces[0].StorageNode].CreationDate}))
+
+	// every node is saved in a separate chunk.
+	require.Len(t, state, len(tracker.RetainInfos)+1)
+
+	last := state[len(state)-1]
+	require.Error(t, restored.RestoreState(ctx, append(state[:len(state)-1:len(state)-1], last[:len(storj.NodeID{})])))
+	require.Error(t, restored.RestoreState(ctx, nil))
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip
+
+import (
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// IPToASN defines an abstraction for resolving the autonomous system number given the string representation of an IP address.
+type IPToASN interface {
+	Close() error
+	LookupASN(address string) (uint32, error)
+}
+
+// OpenMaxmindASNDB will use the provided filepath to open the target maxmind ASN database.
+func OpenMaxmindASNDB(filepath string) (*MaxmindASNDB, error) {
+	db, err := maxminddb.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaxmindASNDB{
+		db: db,
+	}, nil
+}
+
+type asnInfo struct {
+	AutonomousSystemNumber uint32 `maxminddb:"autonomous_system_number"`
+}
+
+// MaxmindASNDB provides access to the autonomous systems via the maxmind ASN databases.
+type MaxmindASNDB struct {
+	db *maxminddb.Reader
+}
+
+var _ IPToASN = &MaxmindASNDB{}
+
+// Close will disconnect the underlying connection to the database.
+func (m *MaxmindASNDB) Close() error {
+	return m.db.Close()
+}
+
+// LookupASN accepts an IP address. It returns zero when the autonomous system isn't known.
+func (m *MaxmindASNDB) LookupASN(address string) (uint32, error) {
+	ip, err := addressToIP(address)
+	if err != nil || ip == nil {
+		return 0, err
+	}
+
+	info := &asnInfo{}
+	err = m.db.Lookup(ip, info)
+	if err != nil {
+		return 0, err
+	}
+
+	return info.AutonomousSystemNumber, nil
+}
+
+// NoASN is used when there is no ASN database, it doesn't know any autonomous system.
+type NoASN struct{}
+
+var _ IPToASN = NoASN{}
+
+// Close does nothing for NoASN.
+func (NoASN) Close() error { return nil }
+
+// LookupASN returns zero for every address.
+func (NoASN) LookupASN(address string) (uint32, error) { return 0, nil }
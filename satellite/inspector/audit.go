@@ -0,0 +1,70 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inspector
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/reputation"
+)
+
+// AuditEndpoint for checking how often nodes are audited.
+//
+// architecture: Endpoint
+type AuditEndpoint struct {
+	internalpb.DRPCAuditInspectorUnimplementedServer
+	log         *zap.Logger
+	reputations *reputation.Service
+	config      audit.Config
+}
+
+// NewAuditEndpoint will initialize an AuditEndpoint struct.
+func NewAuditEndpoint(log *zap.Logger, reputations *reputation.Service, config audit.Config) *AuditEndpoint {
+	return &AuditEndpoint{
+		log:         log,
+		reputations: reputations,
+		config:      config,
+	}
+}
+
+// NodeAuditStats returns the audit rate of the requested nodes.
+func (endpoint *AuditEndpoint) NodeAuditStats(ctx context.Context, in *internalpb.NodeAuditStatsRequest) (_ *internalpb.NodeAuditStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(in.GetNodeIds()) == 0 {
+		return nil, Error.New("no node ids provided")
+	}
+
+	now := time.Now()
+	resp := &internalpb.NodeAuditStatsResponse{}
+	for _, id := range in.GetNodeIds() {
+		nodeID, err := storj.NodeIDFromBytes(id)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		info, err := endpoint.reputations.Get(ctx, nodeID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		vetted := info.VettedAt != nil
+		resp.Stats = append(resp.Stats, &internalpb.NodeAuditStats{
+			NodeId:             nodeID.Bytes(),
+			Vetted:             vetted,
+			TotalAuditCount:    info.TotalAuditCount,
+			AuditSuccessCount:  info.AuditSuccessCount,
+			AuditsPerDay:       audit.AuditsPerDay(info.AuditHistory, now),
+			TargetAuditsPerDay: endpoint.config.TargetAuditsPerDay(vetted),
+		})
+	}
+
+	return resp, nil
+}
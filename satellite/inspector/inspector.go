@@ -195,6 +195,21 @@ func (endpoint *Endpoint) segmentHealth(ctx context.Context, segment metabase.Se
 	health.UnhealthyIds = unhealthyNodes
 	health.OfflineIds = offlineNodes
 
+	// nodes which lost their tags or moved out of the placement still hold
+	// the pieces, but the checker repairs them.
+	outOfPlacement, err := endpoint.overlay.GetPiecesOutOfPlacement(ctx, segment.Pieces, segment.Placement)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, number := range outOfPlacement {
+		for _, piece := range segment.Pieces {
+			if piece.Number == number {
+				health.OutOfPlacementIds = append(health.OutOfPlacementIds, piece.StorageNode)
+				break
+			}
+		}
+	}
+
 	health.Segment = make([]byte, 8)
 
 	binary.LittleEndian.PutUint64(health.Segment, segment.Position.Encode())
@@ -6,9 +6,11 @@ package inspector_test
 import (
 	"encoding/binary"
 	"errors"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/base58"
 	"storj.io/common/encryption"
@@ -19,6 +21,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/uplink/private/eestream"
@@ -80,6 +83,7 @@ func TestInspectorStats(t *testing.T) {
 			encodedPosition := binary.LittleEndian.Uint64(resp.GetHealth().GetSegment())
 			position := metabase.SegmentPositionFromEncoded(encodedPosition)
 			require.Equal(t, segment.Position, position)
+			require.Empty(t, resp.GetHealth().OutOfPlacementIds)
 		}
 
 		{ // Test Object Health Request
@@ -109,6 +113,46 @@ func TestInspectorStats(t *testing.T) {
 	})
 }
 
+func TestNodeAuditStats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Audit.NodeScheduling = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		sat.Audit.Worker.Loop.Pause()
+		sat.Audit.Chore.Loop.Pause()
+
+		for i := 0; i < 5; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "testbucket", "test/path/"+strconv.Itoa(i), testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		// every node is unvetted, so all 5 segments are queued.
+		sat.Audit.Chore.Loop.TriggerWait()
+		require.Equal(t, 5, sat.Audit.Queues.Fetch().Size())
+
+		_, err := sat.Inspector.AuditEndpoint.NodeAuditStats(ctx, &internalpb.NodeAuditStatsRequest{})
+		require.Error(t, err)
+
+		req := &internalpb.NodeAuditStatsRequest{}
+		for _, node := range planet.StorageNodes {
+			req.NodeIds = append(req.NodeIds, node.ID().Bytes())
+		}
+
+		resp, err := sat.Inspector.AuditEndpoint.NodeAuditStats(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.Stats, len(planet.StorageNodes))
+		for i, stats := range resp.Stats {
+			require.Equal(t, planet.StorageNodes[i].ID().Bytes(), stats.NodeId)
+			require.Equal(t, sat.Config.Audit.TargetAuditsPerDay(stats.Vetted), stats.TargetAuditsPerDay)
+		}
+	})
+}
+
 func encryptionAccess(access string) (*encryption.Store, error) {
 	data, version, err := base58.CheckDecode(access)
 	if err != nil || version != 0 {
@@ -260,6 +260,7 @@ type SegmentHealth struct {
 	UnhealthyIds         []NodeID `protobuf:"bytes,2,rep,name=unhealthy_ids,json=unhealthyIds,proto3,customtype=NodeID" json:"unhealthy_ids,omitempty"`
 	OfflineIds           []NodeID `protobuf:"bytes,3,rep,name=offline_ids,json=offlineIds,proto3,customtype=NodeID" json:"offline_ids,omitempty"`
 	Segment              []byte   `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`
+	OutOfPlacementIds    []NodeID `protobuf:"bytes,5,rep,name=out_of_placement_ids,json=outOfPlacementIds,proto3,customtype=NodeID" json:"out_of_placement_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -307,38 +308,40 @@ func init() {
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x8f, 0xd3, 0x30,
-	0x10, 0xc5, 0xdb, 0x6d, 0x81, 0x69, 0x4a, 0xc1, 0x2d, 0x28, 0x2a, 0x42, 0x54, 0x59, 0xad, 0xd4,
-	0x65, 0x51, 0x2a, 0x95, 0x1b, 0x48, 0x48, 0x54, 0x1c, 0xe8, 0x05, 0x50, 0xf6, 0xc6, 0x25, 0x4a,
-	0xe2, 0x69, 0x93, 0x25, 0xb5, 0x83, 0xed, 0x4a, 0xf4, 0x5f, 0x20, 0x71, 0xe2, 0x7f, 0xf0, 0x6b,
-	0x38, 0x70, 0xe0, 0xc2, 0xdf, 0x40, 0x75, 0xdc, 0x6c, 0xbf, 0x0e, 0x95, 0xb8, 0x65, 0xe6, 0xbd,
-	0x79, 0x9e, 0xbc, 0xe7, 0x04, 0xda, 0x19, 0x57, 0x05, 0x26, 0x5a, 0x48, 0xbf, 0x90, 0x42, 0x0b,
-	0xda, 0x51, 0x91, 0xc6, 0x3c, 0xcf, 0x34, 0xfa, 0x15, 0xd4, 0x83, 0x99, 0x98, 0x89, 0x92, 0xd0,
-	0x6b, 0x17, 0x22, 0xe3, 0x1a, 0x25, 0x8b, 0xcb, 0x86, 0xf7, 0x97, 0x40, 0xe7, 0x43, 0x7c, 0x8d,
-	0x89, 0x7e, 0x87, 0x51, 0xae, 0xd3, 0x00, 0xbf, 0x2c, 0x50, 0x69, 0x7a, 0x0e, 0xf7, 0x90, 0x27,
-	0x72, 0x59, 0x68, 0x64, 0x61, 0x11, 0xe9, 0xd4, 0x25, 0x7d, 0x32, 0x70, 0x82, 0x56, 0xd5, 0xfd,
-	0x18, 0xe9, 0x94, 0x3e, 0x82, 0x46, 0xbc, 0x48, 0x3e, 0xa3, 0x76, 0x4f, 0x0c, 0x6c, 0x2b, 0xfa,
-	0x04, 0xa0, 0x90, 0x62, 0x25, 0x1b, 0x66, 0xcc, 0xad, 0x19, 0xec, 0xae, 0xed, 0x4c, 0x18, 0xf5,
-	0xa1, 0xa3, 0x74, 0x24, 0x75, 0x18, 0x4d, 0x35, 0xca, 0x50, 0xe1, 0x6c, 0x8e, 0x5c, 0xbb, 0xa7,
-	0x7d, 0x32, 0xa8, 0x05, 0x0f, 0x0c, 0xf4, 0x66, 0x85, 0x5c, 0x95, 0x00, 0x7d, 0x0e, 0x14, 0x39,
-	0x0b, 0x63, 0x9c, 0x0a, 0x89, 0x15, 0xbd, 0x6e, 0xe8, 0xf7, 0x91, 0xb3, 0xb1, 0x01, 0xd6, 0xec,
-	0x2e, 0xd4, 0xf3, 0x6c, 0x9e, 0x69, 0xb7, 0xd1, 0x27, 0x83, 0x7a, 0x50, 0x16, 0xde, 0x77, 0x02,
-	0xdd, 0xed, 0x37, 0x55, 0x85, 0xe0, 0x0a, 0xe9, 0x6b, 0xb8, 0x63, 0x15, 0x95, 0x4b, 0xfa, 0xb5,
-	0x41, 0x73, 0xe4, 0xf9, 0x07, 0x7c, 0xf4, 0xad, 0xbc, 0x9d, 0xae, 0x66, 0xe8, 0x2b, 0x00, 0x89,
-	0x6c, 0xc1, 0x59, 0xc4, 0x93, 0xa5, 0xf1, 0xa1, 0x39, 0x7a, 0xec, 0xdf, 0x18, 0x1d, 0x54, 0xe0,
-	0x55, 0x92, 0xe2, 0x1c, 0x83, 0x0d, 0xba, 0xf7, 0x83, 0x40, 0x77, 0x5b, 0xd8, 0x06, 0x70, 0xe3,
-	0x2c, 0xd9, 0x72, 0x76, 0x3f, 0x98, 0x93, 0x43, 0xc1, 0x9c, 0x41, 0xcb, 0x2e, 0x18, 0x66, 0x9c,
-	0xe1, 0x57, 0x93, 0x41, 0x2d, 0x70, 0x6c, 0x73, 0xb2, 0xea, 0xed, 0xa4, 0x74, 0xba, 0x93, 0x92,
-	0xf7, 0x8d, 0xc0, 0xc3, 0x9d, 0xdd, 0xac, 0x65, 0x2f, 0xa1, 0x91, 0x9a, 0x8e, 0x59, 0xee, 0x38,
-	0xc3, 0xec, 0xc4, 0xff, 0xd9, 0xf5, 0x93, 0x40, 0x6b, 0x4b, 0x96, 0x5e, 0x42, 0xb3, 0x14, 0x5e,
-	0x86, 0x19, 0x2b, 0x03, 0x74, 0xc6, 0xf0, 0xeb, 0xf7, 0xd3, 0xc6, 0x7b, 0xc1, 0x70, 0xf2, 0x36,
-	0x00, 0x0b, 0x4f, 0x98, 0xa2, 0x43, 0x68, 0x2d, 0xf8, 0x26, 0xfd, 0x64, 0x8f, 0xee, 0x54, 0x84,
-	0xd5, 0xc0, 0x25, 0x34, 0xc5, 0x74, 0x9a, 0x67, 0x1c, 0x0d, 0xbd, 0xb6, 0xaf, 0x6e, 0xe1, 0x15,
-	0xd9, 0x85, 0xdb, 0x9b, 0x37, 0xd9, 0x09, 0xd6, 0xe5, 0xe8, 0x0f, 0x81, 0x76, 0xb9, 0xef, 0x64,
-	0xed, 0x0e, 0x45, 0x70, 0x36, 0xaf, 0x23, 0x1d, 0x1c, 0xf4, 0xf0, 0xc0, 0xb7, 0xd9, 0xbb, 0x38,
-	0x82, 0x59, 0x06, 0xe5, 0xdd, 0xa2, 0xe9, 0xae, 0x61, 0x17, 0x47, 0x64, 0x65, 0x0f, 0x7a, 0x76,
-	0x0c, 0x75, 0x7d, 0xd2, 0xf8, 0xfc, 0xd3, 0x99, 0xd2, 0x42, 0x5e, 0xfb, 0x99, 0x18, 0x9a, 0x87,
-	0x61, 0x35, 0x3d, 0x34, 0xe1, 0xf2, 0x28, 0x2f, 0xe2, 0xb8, 0x61, 0x7e, 0x3c, 0x2f, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x03, 0xb4, 0x8a, 0x34, 0xbd, 0x04, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc5, 0xeb, 0x5a, 0xe0, 0xd7, 0x94, 0x31, 0xaf, 0xa0, 0x68, 0x08, 0x51, 0x65, 0x9a, 0xd4,
+	0x31, 0x94, 0x4a, 0xe3, 0xc6, 0x24, 0x24, 0x2a, 0x0e, 0xe4, 0xc2, 0xa6, 0xec, 0xc6, 0x25, 0x4a,
+	0xe2, 0x5f, 0x9a, 0x8c, 0xd4, 0x0e, 0xb1, 0x23, 0xd1, 0x6f, 0x81, 0xc4, 0x89, 0xaf, 0xc6, 0x81,
+	0x03, 0x17, 0xce, 0x7c, 0x03, 0x54, 0xc7, 0xcd, 0xfa, 0xef, 0x50, 0x89, 0x5b, 0xfd, 0xde, 0xfb,
+	0x3d, 0xdb, 0xef, 0xb9, 0x81, 0x83, 0x8c, 0xcb, 0x02, 0x63, 0x25, 0x4a, 0xb7, 0x28, 0x85, 0x12,
+	0xf4, 0x48, 0x86, 0x0a, 0xf3, 0x3c, 0x53, 0xe8, 0x36, 0xd4, 0x31, 0x4c, 0xc4, 0x44, 0xd4, 0x82,
+	0xe3, 0x83, 0x42, 0x64, 0x5c, 0x61, 0xc9, 0xa2, 0x1a, 0x70, 0xfe, 0x10, 0x38, 0xba, 0x8a, 0x6e,
+	0x31, 0x56, 0x1f, 0x30, 0xcc, 0x55, 0xea, 0xe3, 0x97, 0x0a, 0xa5, 0xa2, 0xa7, 0xf0, 0x08, 0x79,
+	0x5c, 0xce, 0x0a, 0x85, 0x2c, 0x28, 0x42, 0x95, 0xda, 0x64, 0x40, 0x86, 0x96, 0xdf, 0x6b, 0xd0,
+	0xeb, 0x50, 0xa5, 0xf4, 0x29, 0x74, 0xa2, 0x2a, 0xfe, 0x8c, 0xca, 0xde, 0xd3, 0xb4, 0x59, 0xd1,
+	0xe7, 0x00, 0x45, 0x29, 0xe6, 0xb6, 0x41, 0xc6, 0xec, 0x96, 0xe6, 0x1e, 0x1a, 0xc4, 0x63, 0xd4,
+	0x85, 0x23, 0xa9, 0xc2, 0x52, 0x05, 0x61, 0xa2, 0xb0, 0x0c, 0x24, 0x4e, 0xa6, 0xc8, 0x95, 0xbd,
+	0x3f, 0x20, 0xc3, 0x96, 0x7f, 0xa8, 0xa9, 0x77, 0x73, 0xe6, 0xa6, 0x26, 0xe8, 0x2b, 0xa0, 0xc8,
+	0x59, 0x10, 0x61, 0x22, 0x4a, 0x6c, 0xe4, 0x6d, 0x2d, 0x7f, 0x8c, 0x9c, 0x8d, 0x35, 0xb1, 0x50,
+	0xf7, 0xa1, 0x9d, 0x67, 0xd3, 0x4c, 0xd9, 0x9d, 0x01, 0x19, 0xb6, 0xfd, 0x7a, 0xe1, 0x7c, 0x27,
+	0xd0, 0x5f, 0xbd, 0xa9, 0x2c, 0x04, 0x97, 0x48, 0xdf, 0xc2, 0x03, 0xe3, 0x28, 0x6d, 0x32, 0x68,
+	0x0d, 0xbb, 0x17, 0x8e, 0xbb, 0x25, 0x47, 0xd7, 0xd8, 0x9b, 0xe9, 0x66, 0x86, 0x5e, 0x02, 0x94,
+	0xc8, 0x2a, 0xce, 0x42, 0x1e, 0xcf, 0x74, 0x0e, 0xdd, 0x8b, 0x67, 0xee, 0x5d, 0xd0, 0x7e, 0x43,
+	0xde, 0xc4, 0x29, 0x4e, 0xd1, 0x5f, 0x92, 0x3b, 0x3f, 0x08, 0xf4, 0x57, 0x8d, 0x4d, 0x01, 0x77,
+	0xc9, 0x92, 0x95, 0x64, 0x37, 0x8b, 0xd9, 0xdb, 0x56, 0xcc, 0x09, 0xf4, 0xcc, 0x01, 0x83, 0x8c,
+	0x33, 0xfc, 0xaa, 0x3b, 0x68, 0xf9, 0x96, 0x01, 0xbd, 0x39, 0xb6, 0xd6, 0xd2, 0xfe, 0x5a, 0x4b,
+	0xce, 0x37, 0x02, 0x4f, 0xd6, 0xce, 0x66, 0x22, 0x7b, 0x03, 0x9d, 0x54, 0x23, 0xfa, 0x70, 0xbb,
+	0x05, 0x66, 0x26, 0xfe, 0x2f, 0xae, 0xbf, 0x04, 0x7a, 0x2b, 0xb6, 0xf4, 0x1c, 0xba, 0xb5, 0xf1,
+	0x2c, 0xc8, 0x58, 0x5d, 0xa0, 0x35, 0x86, 0x9f, 0xbf, 0x5e, 0x74, 0x3e, 0x0a, 0x86, 0xde, 0x7b,
+	0x1f, 0x0c, 0xed, 0x31, 0x49, 0x47, 0xd0, 0xab, 0xf8, 0xb2, 0x7c, 0x6f, 0x43, 0x6e, 0x55, 0x7c,
+	0x69, 0xe0, 0x1c, 0xba, 0x22, 0x49, 0xf2, 0x8c, 0xa3, 0x96, 0xb7, 0x36, 0xdd, 0x0d, 0x3d, 0x17,
+	0xdb, 0x70, 0x7f, 0xf9, 0x25, 0x5b, 0xfe, 0x62, 0x49, 0x2f, 0xa1, 0x2f, 0x2a, 0x15, 0x88, 0x24,
+	0x28, 0xf2, 0x30, 0xc6, 0xba, 0x16, 0x26, 0xed, 0xf6, 0x86, 0xdf, 0xa1, 0xa8, 0xd4, 0x55, 0x72,
+	0xbd, 0x50, 0x79, 0x4c, 0x5e, 0xfc, 0x26, 0x70, 0x50, 0x5f, 0xd6, 0x5b, 0x44, 0x4b, 0x11, 0xac,
+	0xe5, 0xb7, 0x4c, 0x87, 0x5b, 0x0b, 0xd8, 0xf2, 0xc7, 0x3e, 0x3e, 0xdb, 0x41, 0x59, 0xb7, 0xec,
+	0xdc, 0xa3, 0xe9, 0x7a, 0xda, 0x67, 0x3b, 0x14, 0x6d, 0x36, 0x7a, 0xb9, 0x8b, 0x74, 0xb1, 0xd3,
+	0xf8, 0xf4, 0xd3, 0x89, 0x54, 0xa2, 0xbc, 0x75, 0x33, 0x31, 0xd2, 0x3f, 0x46, 0xcd, 0xf4, 0x48,
+	0xbf, 0x0c, 0x1e, 0xe6, 0x45, 0x14, 0x75, 0xf4, 0x57, 0xeb, 0xf5, 0xbf, 0x01, 0x00, 0x95, 0x4d,
+	0x6e, 0xfb, 0xfa, 0x04, 0x00, 0x00,
 }
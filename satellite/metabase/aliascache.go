@@ -206,6 +206,44 @@ func (cache *NodeAliasCache) ConvertAliasesToPieces(ctx context.Context, aliasPi
 	return pieces, nil
 }
 
+// convertAliasesToKnownPieces converts alias pieces to pieces, refreshing the
+// cache once when an alias is missing. Unlike ConvertAliasesToPieces, it leaves
+// out the pieces, whose aliases aren't in the database, and returns their
+// aliases.
+func (cache *NodeAliasCache) convertAliasesToKnownPieces(ctx context.Context, aliasPieces AliasPieces) (_ Pieces, missing []NodeAlias, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	latest := cache.getLatest()
+	for _, aliasPiece := range aliasPieces {
+		if _, ok := latest.Node(aliasPiece.Alias); !ok {
+			missing = append(missing, aliasPiece.Alias)
+		}
+	}
+
+	if len(missing) > 0 {
+		latest, err = cache.refresh(ctx, nil, missing)
+		if err != nil {
+			return nil, nil, Error.New("failed to refresh node alias db: %w", err)
+		}
+		missing = nil
+	}
+
+	pieces := make(Pieces, 0, len(aliasPieces))
+	for _, aliasPiece := range aliasPieces {
+		node, ok := latest.Node(aliasPiece.Alias)
+		if !ok {
+			missing = append(missing, aliasPiece.Alias)
+			continue
+		}
+		pieces = append(pieces, Piece{
+			Number:      aliasPiece.Number,
+			StorageNode: node,
+		})
+	}
+
+	return pieces, missing, nil
+}
+
 // NodeAliasMap contains bidirectional mapping between node ID and a NodeAlias.
 type NodeAliasMap struct {
 	node  []storj.NodeID
@@ -0,0 +1,505 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// BucketStats contains the statistics of a bucket.
+//
+// The statistics are maintained incrementally: every change of the objects
+// inserts a delta into bucket_stats_deltas in the same statement or
+// transaction, and CompactBucketStats periodically folds the deltas into
+// bucket_stats. Expired objects are counted until they are deleted.
+type BucketStats struct {
+	// ObjectCount is the number of committed objects.
+	ObjectCount int64
+	// PendingObjectCount is the number of pending objects.
+	PendingObjectCount int64
+	// SegmentCount is the number of segments of the committed objects.
+	SegmentCount int64
+	// TotalEncryptedSize is the total encrypted size of the committed objects.
+	TotalEncryptedSize int64
+	// MetadataSize is the total size of the encrypted metadata of the
+	// committed objects.
+	MetadataSize int64
+}
+
+// Add adds the other statistics to stats.
+func (stats *BucketStats) Add(other BucketStats) {
+	stats.ObjectCount += other.ObjectCount
+	stats.PendingObjectCount += other.PendingObjectCount
+	stats.SegmentCount += other.SegmentCount
+	stats.TotalEncryptedSize += other.TotalEncryptedSize
+	stats.MetadataSize += other.MetadataSize
+}
+
+// Sub subtracts the other statistics from stats.
+func (stats *BucketStats) Sub(other BucketStats) {
+	stats.ObjectCount -= other.ObjectCount
+	stats.PendingObjectCount -= other.PendingObjectCount
+	stats.SegmentCount -= other.SegmentCount
+	stats.TotalEncryptedSize -= other.TotalEncryptedSize
+	stats.MetadataSize -= other.MetadataSize
+}
+
+// IsZero returns whether the statistics are all zero.
+func (stats BucketStats) IsZero() bool {
+	return stats == BucketStats{}
+}
+
+// objectBucketStats returns the contribution of the object to the statistics
+// of its bucket.
+func objectBucketStats(object Object) BucketStats {
+	if object.Status == Pending {
+		return BucketStats{PendingObjectCount: 1}
+	}
+	return BucketStats{
+		ObjectCount:        1,
+		SegmentCount:       int64(object.SegmentCount),
+		TotalEncryptedSize: object.TotalEncryptedSize,
+		MetadataSize:       int64(len(object.EncryptedMetadata)),
+	}
+}
+
+// bucketStatsDeltas collects the changes of the bucket statistics within a
+// transaction.
+type bucketStatsDeltas map[BucketLocation]BucketStats
+
+// add records the addition of the object.
+func (deltas bucketStatsDeltas) add(object Object) {
+	stats := deltas[object.Location().Bucket()]
+	stats.Add(objectBucketStats(object))
+	deltas[object.Location().Bucket()] = stats
+}
+
+// remove records the removal of the object.
+func (deltas bucketStatsDeltas) remove(object Object) {
+	stats := deltas[object.Location().Bucket()]
+	stats.Sub(objectBucketStats(object))
+	deltas[object.Location().Bucket()] = stats
+}
+
+// insertBucketStatsDeltas inserts the deltas, which aren't zero.
+func insertBucketStatsDeltas(ctx context.Context, tx tagsql.Tx, deltas bucketStatsDeltas) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var (
+		projectIDs    []uuid.UUID
+		bucketNames   [][]byte
+		ids           []uuid.UUID
+		objectCounts  []int64
+		pendingCounts []int64
+		segmentCounts []int64
+		sizes         []int64
+		metadataSizes []int64
+	)
+	for location, stats := range deltas {
+		if stats.IsZero() {
+			continue
+		}
+		id, err := uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		projectIDs = append(projectIDs, location.ProjectID)
+		bucketNames = append(bucketNames, []byte(location.BucketName))
+		ids = append(ids, id)
+		objectCounts = append(objectCounts, stats.ObjectCount)
+		pendingCounts = append(pendingCounts, stats.PendingObjectCount)
+		segmentCounts = append(segmentCounts, stats.SegmentCount)
+		sizes = append(sizes, stats.TotalEncryptedSize)
+		metadataSizes = append(metadataSizes, stats.MetadataSize)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO bucket_stats_deltas (
+			project_id, bucket_name, id,
+			object_count, pending_object_count, segment_count,
+			total_encrypted_size, metadata_size
+		) SELECT
+			UNNEST($1::BYTEA[]), UNNEST($2::BYTEA[]), UNNEST($3::BYTEA[]),
+			UNNEST($4::INT8[]), UNNEST($5::INT8[]), UNNEST($6::INT8[]),
+			UNNEST($7::INT8[]), UNNEST($8::INT8[])
+	`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.UUIDArray(ids),
+		pgutil.Int8Array(objectCounts), pgutil.Int8Array(pendingCounts), pgutil.Int8Array(segmentCounts),
+		pgutil.Int8Array(sizes), pgutil.Int8Array(metadataSizes),
+	)
+	if err != nil {
+		return Error.New("unable to insert bucket stats deltas: %w", err)
+	}
+	return nil
+}
+
+// bucketStatsDeltaSQL returns a data-modifying WITH query named name, which
+// records the change of the bucket statistics caused by the rows of the WITH
+// query objects. The rows must contain project_id, bucket_name, status,
+// segment_count, total_encrypted_size and encrypted_metadata. Sign is "+"
+// for inserted and "-" for deleted rows. The id argument is the placeholder
+// of a random delta id.
+//
+// It's used by the single statement queries, which don't run in a
+// transaction.
+func bucketStatsDeltaSQL(name, objects, sign, id string) string {
+	return name + ` AS (
+		INSERT INTO bucket_stats_deltas (
+			project_id, bucket_name, id,
+			object_count, pending_object_count, segment_count,
+			total_encrypted_size, metadata_size
+		) SELECT
+			project_id, bucket_name, ` + id + `::BYTEA,
+			` + sign + `sum(CASE WHEN status = ` + committedStatus + ` THEN 1 ELSE 0 END)::INT8,
+			` + sign + `sum(CASE WHEN status = ` + pendingStatus + ` THEN 1 ELSE 0 END)::INT8,
+			` + sign + `sum(CASE WHEN status = ` + committedStatus + ` THEN segment_count ELSE 0 END)::INT8,
+			` + sign + `sum(CASE WHEN status = ` + committedStatus + ` THEN total_encrypted_size ELSE 0 END)::INT8,
+			` + sign + `sum(CASE WHEN status = ` + committedStatus + ` THEN coalesce(length(encrypted_metadata), 0) ELSE 0 END)::INT8
+		FROM ` + objects + `
+		GROUP BY project_id, bucket_name
+	)`
+}
+
+// GetBucketStats contains arguments necessary for getting the bucket statistics.
+type GetBucketStats struct {
+	BucketLocation
+}
+
+// GetBucketStats returns the up-to-date statistics of a bucket, including the
+// deltas which haven't been compacted yet.
+func (db *DB) GetBucketStats(ctx context.Context, opts GetBucketStats) (stats BucketStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return BucketStats{}, err
+	}
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT
+			coalesce(sum(object_count), 0)::INT8,
+			coalesce(sum(pending_object_count), 0)::INT8,
+			coalesce(sum(segment_count), 0)::INT8,
+			coalesce(sum(total_encrypted_size), 0)::INT8,
+			coalesce(sum(metadata_size), 0)::INT8
+		FROM (
+			SELECT object_count, pending_object_count, segment_count, total_encrypted_size, metadata_size
+			FROM bucket_stats
+			WHERE project_id = $1 AND bucket_name = $2
+			UNION ALL
+			SELECT object_count, pending_object_count, segment_count, total_encrypted_size, metadata_size
+			FROM bucket_stats_deltas
+			WHERE project_id = $1 AND bucket_name = $2
+		) AS stats
+	`, opts.ProjectID, []byte(opts.BucketName)).Scan(
+		&stats.ObjectCount, &stats.PendingObjectCount, &stats.SegmentCount,
+		&stats.TotalEncryptedSize, &stats.MetadataSize,
+	)
+	if err != nil {
+		return BucketStats{}, Error.New("unable to query bucket stats: %w", err)
+	}
+	return stats, nil
+}
+
+// CompactBucketStats contains arguments necessary for compacting the bucket statistics.
+type CompactBucketStats struct {
+	BatchSize int
+}
+
+// CompactBucketStats folds the deltas of the bucket statistics into
+// bucket_stats. It returns the number of compacted deltas.
+func (db *DB) CompactBucketStats(ctx context.Context, opts CompactBucketStats) (compacted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	batchsizeLimit.Ensure(&opts.BatchSize)
+
+	for {
+		var count int64
+		err := db.db.QueryRowContext(ctx, `
+			WITH compacted AS (
+				DELETE FROM bucket_stats_deltas
+				WHERE (project_id, bucket_name, id) IN (
+					SELECT project_id, bucket_name, id
+					FROM bucket_stats_deltas
+					LIMIT $1
+				)
+				RETURNING
+					project_id, bucket_name,
+					object_count, pending_object_count, segment_count,
+					total_encrypted_size, metadata_size
+			), updated AS (
+				INSERT INTO bucket_stats (
+					project_id, bucket_name,
+					object_count, pending_object_count, segment_count,
+					total_encrypted_size, metadata_size,
+					updated_at
+				) SELECT
+					project_id, bucket_name,
+					sum(object_count)::INT8, sum(pending_object_count)::INT8, sum(segment_count)::INT8,
+					sum(total_encrypted_size)::INT8, sum(metadata_size)::INT8,
+					now()
+				FROM compacted
+				GROUP BY project_id, bucket_name
+				ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+					object_count         = bucket_stats.object_count + EXCLUDED.object_count,
+					pending_object_count = bucket_stats.pending_object_count + EXCLUDED.pending_object_count,
+					segment_count        = bucket_stats.segment_count + EXCLUDED.segment_count,
+					total_encrypted_size = bucket_stats.total_encrypted_size + EXCLUDED.total_encrypted_size,
+					metadata_size        = bucket_stats.metadata_size + EXCLUDED.metadata_size,
+					updated_at           = EXCLUDED.updated_at
+				RETURNING 1
+			)
+			SELECT count(*) FROM compacted
+		`, opts.BatchSize).Scan(&count)
+		if err != nil {
+			return compacted, Error.New("unable to compact bucket stats: %w", err)
+		}
+
+		compacted += count
+		if count < int64(opts.BatchSize) {
+			break
+		}
+	}
+
+	mon.Meter("bucket_stats_compacted_deltas").Mark64(compacted)
+
+	return compacted, nil
+}
+
+// IterateBucketStats contains arguments necessary for iterating the bucket statistics.
+type IterateBucketStats struct {
+	BatchSize int
+}
+
+// IterateBucketStats calls fn for the compacted statistics of every bucket
+// with objects. The deltas, which haven't been compacted yet, are not
+// included, so CompactBucketStats should be called before. The statistics
+// aren't read as of the system time, since it would miss the deltas, which
+// were compacted after that time.
+func (db *DB) IterateBucketStats(ctx context.Context, opts IterateBucketStats, fn func(context.Context, BucketLocation, BucketStats) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	batchsizeLimit.Ensure(&opts.BatchSize)
+
+	var cursor BucketLocation
+	for {
+		type entry struct {
+			location BucketLocation
+			stats    BucketStats
+		}
+		entries := make([]entry, 0, opts.BatchSize)
+
+		err := withRows(db.db.QueryContext(ctx, `
+			SELECT
+				project_id, bucket_name,
+				object_count, pending_object_count, segment_count,
+				total_encrypted_size, metadata_size
+			FROM bucket_stats
+			WHERE (project_id, bucket_name) > ($1, $2)
+			ORDER BY project_id, bucket_name
+			LIMIT $3
+		`, cursor.ProjectID, []byte(cursor.BucketName), opts.BatchSize))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var e entry
+				if err := rows.Scan(
+					&e.location.ProjectID, &e.location.BucketName,
+					&e.stats.ObjectCount, &e.stats.PendingObjectCount, &e.stats.SegmentCount,
+					&e.stats.TotalEncryptedSize, &e.stats.MetadataSize,
+				); err != nil {
+					return err
+				}
+				entries = append(entries, e)
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.New("unable to iterate bucket stats: %w", err)
+		}
+
+		for _, e := range entries {
+			if e.stats.IsZero() {
+				continue
+			}
+			if err := fn(ctx, e.location, e.stats); err != nil {
+				return err
+			}
+		}
+
+		if len(entries) < opts.BatchSize {
+			return nil
+		}
+		cursor = entries[len(entries)-1].location
+	}
+}
+
+// ReconcileBucketStats contains arguments necessary for reconciling the bucket statistics.
+type ReconcileBucketStats struct {
+	// Cursor is the bucket after which the reconciliation continues.
+	Cursor BucketLocation
+	// Limit is the maximum number of reconciled buckets.
+	Limit int
+}
+
+// ReconcileBucketStatsResult contains the result of reconciling the bucket statistics.
+type ReconcileBucketStatsResult struct {
+	// Cursor is the last reconciled bucket. It's zero, when there are no more
+	// buckets and the next reconciliation should start from the beginning.
+	Cursor BucketLocation
+	// Reconciled is the number of reconciled buckets.
+	Reconciled int
+	// Corrected is the number of buckets, whose statistics were corrected.
+	Corrected int
+}
+
+// ReconcileBucketStats recounts the objects of the buckets after the cursor
+// and corrects the statistics, which have drifted, e.g. by objects, which
+// were changed by a satellite without the statistics, during a rolling
+// deployment. The statistics of the existing buckets are populated in the
+// same way, so UseBucketStats of tally should only be enabled after all
+// buckets were reconciled once.
+//
+// The correction is inserted as a delta, and every bucket is recounted with
+// a single statement, so the recount and the recorded statistics are read
+// from the same snapshot. The compacted statistics of buckets without
+// objects are removed.
+func (db *DB) ReconcileBucketStats(ctx context.Context, opts ReconcileBucketStats) (result ReconcileBucketStatsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result.Cursor = opts.Cursor
+	for result.Reconciled < opts.Limit {
+		var bucket BucketLocation
+		err := db.db.QueryRowContext(ctx, `
+			SELECT project_id, bucket_name FROM (
+				(
+					SELECT project_id, bucket_name FROM objects
+					WHERE (project_id, bucket_name) > ($1, $2)
+					ORDER BY project_id, bucket_name
+					LIMIT 1
+				) UNION ALL (
+					SELECT project_id, bucket_name FROM bucket_stats
+					WHERE (project_id, bucket_name) > ($1, $2)
+					ORDER BY project_id, bucket_name
+					LIMIT 1
+				) UNION ALL (
+					SELECT project_id, bucket_name FROM bucket_stats_deltas
+					WHERE (project_id, bucket_name) > ($1, $2)
+					ORDER BY project_id, bucket_name
+					LIMIT 1
+				)
+			) AS buckets
+			ORDER BY project_id, bucket_name
+			LIMIT 1
+		`, result.Cursor.ProjectID, []byte(result.Cursor.BucketName)).Scan(&bucket.ProjectID, &bucket.BucketName)
+		if errors.Is(err, sql.ErrNoRows) {
+			// all buckets were reconciled, start from the beginning next time.
+			result.Cursor = BucketLocation{}
+			mon.Meter("bucket_stats_corrected").Mark(result.Corrected)
+			return result, nil
+		}
+		if err != nil {
+			return result, Error.New("unable to query next bucket: %w", err)
+		}
+
+		corrected, err := db.reconcileBucketStats(ctx, bucket)
+		if err != nil {
+			return result, err
+		}
+
+		result.Reconciled++
+		if corrected {
+			result.Corrected++
+		}
+		result.Cursor = bucket
+	}
+
+	mon.Meter("bucket_stats_corrected").Mark(result.Corrected)
+	return result, nil
+}
+
+// reconcileBucketStats recounts the objects of the bucket and inserts the
+// difference to the recorded statistics as a delta.
+func (db *DB) reconcileBucketStats(ctx context.Context, bucket BucketLocation) (corrected bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deltaID, err := uuid.New()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	var count int64
+	err = db.db.QueryRowContext(ctx, `
+		WITH actual AS (
+			SELECT
+				coalesce(sum(CASE WHEN status = `+committedStatus+` THEN 1 ELSE 0 END), 0)::INT8 AS object_count,
+				coalesce(sum(CASE WHEN status = `+pendingStatus+` THEN 1 ELSE 0 END), 0)::INT8 AS pending_object_count,
+				coalesce(sum(CASE WHEN status = `+committedStatus+` THEN segment_count ELSE 0 END), 0)::INT8 AS segment_count,
+				coalesce(sum(CASE WHEN status = `+committedStatus+` THEN total_encrypted_size ELSE 0 END), 0)::INT8 AS total_encrypted_size,
+				coalesce(sum(CASE WHEN status = `+committedStatus+` THEN coalesce(length(encrypted_metadata), 0) ELSE 0 END), 0)::INT8 AS metadata_size
+			FROM objects
+			WHERE project_id = $1 AND bucket_name = $2
+		), recorded AS (
+			SELECT
+				coalesce(sum(object_count), 0)::INT8 AS object_count,
+				coalesce(sum(pending_object_count), 0)::INT8 AS pending_object_count,
+				coalesce(sum(segment_count), 0)::INT8 AS segment_count,
+				coalesce(sum(total_encrypted_size), 0)::INT8 AS total_encrypted_size,
+				coalesce(sum(metadata_size), 0)::INT8 AS metadata_size
+			FROM (
+				SELECT object_count, pending_object_count, segment_count, total_encrypted_size, metadata_size
+				FROM bucket_stats
+				WHERE project_id = $1 AND bucket_name = $2
+				UNION ALL
+				SELECT object_count, pending_object_count, segment_count, total_encrypted_size, metadata_size
+				FROM bucket_stats_deltas
+				WHERE project_id = $1 AND bucket_name = $2
+			) AS stats
+		), correction AS (
+			INSERT INTO bucket_stats_deltas (
+				project_id, bucket_name, id,
+				object_count, pending_object_count, segment_count,
+				total_encrypted_size, metadata_size
+			) SELECT
+				$1, $2, $3,
+				actual.object_count - recorded.object_count,
+				actual.pending_object_count - recorded.pending_object_count,
+				actual.segment_count - recorded.segment_count,
+				actual.total_encrypted_size - recorded.total_encrypted_size,
+				actual.metadata_size - recorded.metadata_size
+			FROM actual, recorded
+			WHERE
+				actual.object_count <> recorded.object_count OR
+				actual.pending_object_count <> recorded.pending_object_count OR
+				actual.segment_count <> recorded.segment_count OR
+				actual.total_encrypted_size <> recorded.total_encrypted_size OR
+				actual.metadata_size <> recorded.metadata_size
+			RETURNING 1
+		)
+		SELECT count(*) FROM correction
+	`, bucket.ProjectID, []byte(bucket.BucketName), deltaID).Scan(&count)
+	if err != nil {
+		return false, Error.New("unable to reconcile bucket stats: %w", err)
+	}
+
+	// a zero row doesn't change the statistics, so it can be removed
+	// regardless of the deltas, which weren't compacted yet.
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_stats
+		WHERE
+			project_id = $1 AND bucket_name = $2 AND
+			object_count = 0 AND pending_object_count = 0 AND segment_count = 0 AND
+			total_encrypted_size = 0 AND metadata_size = 0
+	`, bucket.ProjectID, []byte(bucket.BucketName))
+	if err != nil {
+		return false, Error.New("unable to remove empty bucket stats: %w", err)
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,411 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestBucketStats(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid bucket", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetBucketStats{
+				Opts: metabase.GetBucketStats{
+					BucketLocation: metabase.BucketLocation{BucketName: "bucket"},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("upload, update and delete", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			pending := metabasetest.RandObjectStream()
+			bucket := pending.Location().Bucket()
+
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: bucket},
+				Result: metabase.BucketStats{},
+			}.Check(ctx, t, db)
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: pending,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: pending.Version,
+			}.Check(ctx, t, db)
+
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: bucket},
+				Result: metabase.BucketStats{PendingObjectCount: 1},
+			}.Check(ctx, t, db)
+
+			committed := metabasetest.RandObjectStream()
+			committed.ProjectID = bucket.ProjectID
+			committed.BucketName = bucket.BucketName
+			metabasetest.CreateObject(ctx, t, db, committed, 2)
+
+			metabasetest.GetBucketStats{
+				Opts: metabase.GetBucketStats{BucketLocation: bucket},
+				Result: metabase.BucketStats{
+					ObjectCount:        1,
+					PendingObjectCount: 1,
+					SegmentCount:       2,
+					TotalEncryptedSize: 2048,
+				},
+			}.Check(ctx, t, db)
+
+			err := db.UpdateObjectMetadata(ctx, metabase.UpdateObjectMetadata{
+				ProjectID:                     committed.ProjectID,
+				BucketName:                    committed.BucketName,
+				ObjectKey:                     committed.ObjectKey,
+				StreamID:                      committed.StreamID,
+				EncryptedMetadata:             testrand.Bytes(100),
+				EncryptedMetadataNonce:        testrand.Nonce().Bytes(),
+				EncryptedMetadataEncryptedKey: testrand.Bytes(32),
+			})
+			require.NoError(t, err)
+
+			metabasetest.GetBucketStats{
+				Opts: metabase.GetBucketStats{BucketLocation: bucket},
+				Result: metabase.BucketStats{
+					ObjectCount:        1,
+					PendingObjectCount: 1,
+					SegmentCount:       2,
+					TotalEncryptedSize: 2048,
+					MetadataSize:       100,
+				},
+			}.Check(ctx, t, db)
+
+			_, err = db.DeletePendingObject(ctx, metabase.DeletePendingObject{
+				ObjectStream: pending,
+			})
+			require.NoError(t, err)
+
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: committed.Location(),
+				Version:        committed.Version,
+			})
+			require.NoError(t, err)
+
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: bucket},
+				Result: metabase.BucketStats{},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("copy and move", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+			copyStream := metabasetest.RandObjectStream()
+			copyStream.ProjectID = obj.ProjectID
+			copyStream.BucketName = "copy-bucket"
+			metabasetest.CreateObjectCopy{
+				OriginalObject:   object,
+				CopyObjectStream: &copyStream,
+			}.Run(ctx, t, db)
+
+			stats := metabase.BucketStats{
+				ObjectCount:        1,
+				SegmentCount:       2,
+				TotalEncryptedSize: 2048,
+			}
+
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: obj.Location().Bucket()},
+				Result: stats,
+			}.Check(ctx, t, db)
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: copyStream.Location().Bucket()},
+				Result: stats,
+			}.Check(ctx, t, db)
+
+			newSegmentKeys := make([]metabase.EncryptedKeyAndNonce, object.SegmentCount)
+			for i := range newSegmentKeys {
+				newSegmentKeys[i] = metabasetest.RandEncryptedKeyAndNonce(i)
+			}
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             "move-bucket",
+					NewSegmentKeys:        newSegmentKeys,
+					NewEncryptedObjectKey: []byte("moved"),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: obj.Location().Bucket()},
+				Result: metabase.BucketStats{},
+			}.Check(ctx, t, db)
+			metabasetest.GetBucketStats{
+				Opts: metabase.GetBucketStats{BucketLocation: metabase.BucketLocation{
+					ProjectID:  obj.ProjectID,
+					BucketName: "move-bucket",
+				}},
+				Result: stats,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("compact and iterate", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			first := metabasetest.RandObjectStream()
+			second := metabasetest.RandObjectStream()
+			second.ProjectID = first.ProjectID
+			second.BucketName = first.BucketName
+			other := metabasetest.RandObjectStream()
+
+			metabasetest.CreateObject(ctx, t, db, first, 1)
+			metabasetest.CreateObject(ctx, t, db, second, 1)
+			metabasetest.CreateObject(ctx, t, db, other, 3)
+
+			expected := map[metabase.BucketLocation]metabase.BucketStats{
+				first.Location().Bucket(): {
+					ObjectCount:        2,
+					SegmentCount:       2,
+					TotalEncryptedSize: 2048,
+				},
+				other.Location().Bucket(): {
+					ObjectCount:        1,
+					SegmentCount:       3,
+					TotalEncryptedSize: 3072,
+				},
+			}
+
+			// every object adds a delta when it's started and committed.
+			metabasetest.CompactBucketStats{
+				Opts:   metabase.CompactBucketStats{BatchSize: 2},
+				Result: 6,
+			}.Check(ctx, t, db)
+
+			metabasetest.CompactBucketStats{
+				Result: 0,
+			}.Check(ctx, t, db)
+
+			for bucket, stats := range expected {
+				metabasetest.GetBucketStats{
+					Opts:   metabase.GetBucketStats{BucketLocation: bucket},
+					Result: stats,
+				}.Check(ctx, t, db)
+			}
+
+			collected := map[metabase.BucketLocation]metabase.BucketStats{}
+			err := db.IterateBucketStats(ctx, metabase.IterateBucketStats{BatchSize: 1},
+				func(ctx context.Context, bucket metabase.BucketLocation, stats metabase.BucketStats) error {
+					collected[bucket] = stats
+					return nil
+				})
+			require.NoError(t, err)
+			require.Equal(t, expected, collected)
+
+			// the deltas after compaction are included.
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: second.Location(),
+				Version:        second.Version,
+			})
+			require.NoError(t, err)
+
+			metabasetest.GetBucketStats{
+				Opts: metabase.GetBucketStats{BucketLocation: first.Location().Bucket()},
+				Result: metabase.BucketStats{
+					ObjectCount:        1,
+					SegmentCount:       1,
+					TotalEncryptedSize: 1024,
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("delete bucket", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+			metabasetest.CompactBucketStats{
+				Result: 2,
+			}.Check(ctx, t, db)
+
+			metabasetest.CreatePendingObject(ctx, t, db, metabase.ObjectStream{
+				ProjectID:  obj.ProjectID,
+				BucketName: obj.BucketName,
+				ObjectKey:  metabasetest.RandObjectKey(),
+				Version:    1,
+				StreamID:   testrand.UUID(),
+			}, 0)
+
+			metabasetest.DeleteBucketObjects{
+				Opts: metabase.DeleteBucketObjects{
+					Bucket: obj.Location().Bucket(),
+				},
+				Deleted: 2,
+			}.Check(ctx, t, db)
+
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: obj.Location().Bucket()},
+				Result: metabase.BucketStats{},
+			}.Check(ctx, t, db)
+
+			// the pending object and the deleted batch add a delta.
+			metabasetest.CompactBucketStats{
+				Result: 2,
+			}.Check(ctx, t, db)
+
+			// the empty statistics are removed by the reconciliation.
+			metabasetest.ReconcileBucketStats{
+				Opts:   metabase.ReconcileBucketStats{Limit: 10},
+				Result: metabase.ReconcileBucketStatsResult{Reconciled: 1},
+			}.Check(ctx, t, db)
+
+			err := db.IterateBucketStats(ctx, metabase.IterateBucketStats{},
+				func(ctx context.Context, bucket metabase.BucketLocation, stats metabase.BucketStats) error {
+					return errs.New("unexpected bucket %v", bucket)
+				})
+			require.NoError(t, err)
+
+			var rows int
+			err = db.UnderlyingTagSQL().QueryRowContext(ctx, "SELECT count(*) FROM bucket_stats").Scan(&rows)
+			require.NoError(t, err)
+			require.Zero(t, rows)
+		})
+
+		t.Run("reconcile", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			first := metabasetest.RandObjectStream()
+			first.BucketName = "bucket-a"
+			second := metabasetest.RandObjectStream()
+			second.ProjectID = first.ProjectID
+			second.BucketName = "bucket-b"
+
+			metabasetest.CreateObject(ctx, t, db, first, 2)
+			metabasetest.CreateObject(ctx, t, db, second, 1)
+
+			expected := map[metabase.BucketLocation]metabase.BucketStats{
+				first.Location().Bucket(): {
+					ObjectCount:        1,
+					SegmentCount:       2,
+					TotalEncryptedSize: 2048,
+				},
+				second.Location().Bucket(): {
+					ObjectCount:        1,
+					SegmentCount:       1,
+					TotalEncryptedSize: 1024,
+				},
+			}
+
+			// simulate objects, which were created without the statistics.
+			_, err := db.UnderlyingTagSQL().ExecContext(ctx, "DELETE FROM bucket_stats_deltas")
+			require.NoError(t, err)
+
+			metabasetest.ReconcileBucketStats{
+				Opts: metabase.ReconcileBucketStats{Limit: 1},
+				Result: metabase.ReconcileBucketStatsResult{
+					Cursor:     first.Location().Bucket(),
+					Reconciled: 1,
+					Corrected:  1,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: first.Location().Bucket()},
+				Result: expected[first.Location().Bucket()],
+			}.Check(ctx, t, db)
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: second.Location().Bucket()},
+				Result: metabase.BucketStats{},
+			}.Check(ctx, t, db)
+
+			metabasetest.ReconcileBucketStats{
+				Opts: metabase.ReconcileBucketStats{
+					Cursor: first.Location().Bucket(),
+					Limit:  10,
+				},
+				Result: metabase.ReconcileBucketStatsResult{
+					Reconciled: 1,
+					Corrected:  1,
+				},
+			}.Check(ctx, t, db)
+
+			for bucket, stats := range expected {
+				metabasetest.GetBucketStats{
+					Opts:   metabase.GetBucketStats{BucketLocation: bucket},
+					Result: stats,
+				}.Check(ctx, t, db)
+			}
+
+			// the statistics, which haven't drifted, aren't corrected.
+			metabasetest.ReconcileBucketStats{
+				Opts:   metabase.ReconcileBucketStats{Limit: 10},
+				Result: metabase.ReconcileBucketStatsResult{Reconciled: 2},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("expired and zombie objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now()
+
+			expired := metabasetest.RandObjectStream()
+			metabasetest.CreateExpiredObject(ctx, t, db, expired, 2, now.Add(-time.Hour))
+
+			zombie := metabasetest.RandObjectStream()
+			zombie.ProjectID = expired.ProjectID
+			zombie.BucketName = expired.BucketName
+			deadline := now.Add(-time.Hour)
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream:           zombie,
+					Encryption:             metabasetest.DefaultEncryption,
+					ZombieDeletionDeadline: &deadline,
+				},
+				Version: zombie.Version,
+			}.Check(ctx, t, db)
+
+			metabasetest.GetBucketStats{
+				Opts: metabase.GetBucketStats{BucketLocation: expired.Location().Bucket()},
+				Result: metabase.BucketStats{
+					ObjectCount:        1,
+					PendingObjectCount: 1,
+					SegmentCount:       2,
+					TotalEncryptedSize: 2048,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore: now,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteZombieObjects{
+				Opts: metabase.DeleteZombieObjects{
+					DeadlineBefore:   now,
+					InactiveDeadline: now,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: expired.Location().Bucket()},
+				Result: metabase.BucketStats{},
+			}.Check(ctx, t, db)
+		})
+	})
+}
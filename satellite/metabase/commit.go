@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strconv"
 	"time"
 
 	pgxerrcode "github.com/jackc/pgerrcode"
@@ -14,6 +15,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/private/dbutil/pgutil/pgerrcode"
 	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
@@ -76,33 +78,50 @@ func (db *DB) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVe
 		opts.ZombieDeletionDeadline = &deadline
 	}
 
+	deltaID, err := uuid.New()
+	if err != nil {
+		return -1, Error.Wrap(err)
+	}
+
 	row := db.db.QueryRowContext(ctx, `
-		INSERT INTO objects (
-			project_id, bucket_name, object_key, version, stream_id,
-			expires_at, encryption,
-			zombie_deletion_deadline,
-			encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key
-		) VALUES (
-			$1, $2, $3,
-				coalesce((
-					SELECT version + 1
-					FROM objects
-					WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3
-					ORDER BY version DESC
-					LIMIT 1
-				), 1),
-			$4, $5, $6,
-			$7,
-			$8, $9, $10)
-		RETURNING version
+		WITH new_object AS (
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				expires_at, encryption,
+				zombie_deletion_deadline,
+				encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key
+			) SELECT
+				$1, $2, $3,
+					coalesce((
+						SELECT version + 1
+						FROM objects
+						WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3
+						ORDER BY version DESC
+						LIMIT 1
+					), 1),
+				$4, $5, $6,
+				$7,
+				$8, $9, $10
+			WHERE NOT EXISTS (
+				SELECT 1 FROM bucket_fences WHERE project_id = $1 AND bucket_name = $2
+			)
+			RETURNING
+				project_id, bucket_name, version,
+				status, segment_count, total_encrypted_size, encrypted_metadata
+		), `+bucketStatsDeltaSQL("new_object_stats", "new_object", "+", "$11")+`
+		SELECT version FROM new_object
 	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID,
 		opts.ExpiresAt, encryptionParameters{&opts.Encryption},
 		opts.ZombieDeletionDeadline,
 		opts.EncryptedMetadata, opts.EncryptedMetadataNonce, opts.EncryptedMetadataEncryptedKey,
+		deltaID,
 	)
 
 	var v int64
 	if err := row.Scan(&v); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrBucketFenced.New("%s", opts.BucketName)
+		}
 		return -1, Error.New("unable to insert object: %w", err)
 	}
 
@@ -169,28 +188,44 @@ func (db *DB) BeginObjectExactVersion(ctx context.Context, opts BeginObjectExact
 		ZombieDeletionDeadline: opts.ZombieDeletionDeadline,
 	}
 
+	deltaID, err := uuid.New()
+	if err != nil {
+		return Object{}, Error.Wrap(err)
+	}
+
 	err = db.db.QueryRowContext(ctx, `
-		INSERT INTO objects (
-			project_id, bucket_name, object_key, version, stream_id,
-			expires_at, encryption,
-			zombie_deletion_deadline,
-			encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key
-		) VALUES (
-			$1, $2, $3, $4, $5,
-			$6, $7,
-			$8,
-			$9, $10, $11
-		)
-		RETURNING status, created_at
+		WITH new_object AS (
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				expires_at, encryption,
+				zombie_deletion_deadline,
+				encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key
+			) SELECT
+				$1, $2, $3, $4, $5,
+				$6, $7,
+				$8,
+				$9, $10, $11
+			WHERE NOT EXISTS (
+				SELECT 1 FROM bucket_fences WHERE project_id = $1 AND bucket_name = $2
+			)
+			RETURNING
+				project_id, bucket_name, created_at,
+				status, segment_count, total_encrypted_size, encrypted_metadata
+		), `+bucketStatsDeltaSQL("new_object_stats", "new_object", "+", "$12")+`
+		SELECT status, created_at FROM new_object
 	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID,
 		opts.ExpiresAt, encryptionParameters{&opts.Encryption},
 		opts.ZombieDeletionDeadline,
 		opts.EncryptedMetadata, opts.EncryptedMetadataNonce, opts.EncryptedMetadataEncryptedKey,
+		deltaID,
 	).
 		Scan(
 			&object.Status, &object.CreatedAt,
 		)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Object{}, ErrBucketFenced.New("%s", opts.BucketName)
+		}
 		if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
 			return Object{}, Error.Wrap(ErrObjectAlreadyExists.New(""))
 		}
@@ -474,6 +509,8 @@ type CommitObject struct {
 	EncryptedMetadata             []byte // optional
 	EncryptedMetadataNonce        []byte // optional
 	EncryptedMetadataEncryptedKey []byte // optional
+
+	Tags ObjectTags // optional
 }
 
 // Verify verifies reqest fields.
@@ -493,7 +530,7 @@ func (c *CommitObject) Verify() error {
 			return ErrInvalidRequest.New("EncryptedMetadataNonce and EncryptedMetadataEncryptedKey must be set if EncryptedMetadata is set")
 		}
 	}
-	return nil
+	return c.Tags.Verify()
 }
 
 // CommitObject adds a pending object to the database.
@@ -565,6 +602,14 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 			`
 		}
 
+		tagsColumn := ""
+		if len(opts.Tags) > 0 {
+			args = append(args, opts.Tags)
+			tagsColumn = `,
+				tags = $` + strconv.Itoa(len(args)) + `::JSONB
+			`
+		}
+
 		err = tx.QueryRowContext(ctx, `
 			UPDATE objects SET
 				status =`+committedStatus+`,
@@ -581,7 +626,7 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 					WHEN objects.encryption = 0 AND $10 = 0 THEN NULL
 					ELSE objects.encryption
 				END
-			    `+metadataColumns+`
+			    `+metadataColumns+tagsColumn+`
 			WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
@@ -592,10 +637,12 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 			RETURNING
 				created_at, expires_at,
 				encrypted_metadata, encrypted_metadata_encrypted_key, encrypted_metadata_nonce,
+				tags,
 				encryption;
 		`, args...).Scan(
 			&object.CreatedAt, &object.ExpiresAt,
 			&object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey, &object.EncryptedMetadataNonce,
+			&object.Tags,
 			encryptionParameters{&object.Encryption},
 		)
 		if err != nil {
@@ -618,7 +665,11 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 		object.TotalPlainSize = totalPlainSize
 		object.TotalEncryptedSize = totalEncryptedSize
 		object.FixedSegmentSize = fixedSegmentSize
-		return nil
+
+		deltas := bucketStatsDeltas{}
+		deltas.remove(Object{ObjectStream: opts.ObjectStream, Status: Pending})
+		deltas.add(object)
+		return insertBucketStatsDeltas(ctx, tx, deltas)
 	})
 	if err != nil {
 		return Object{}, err
@@ -141,7 +141,11 @@ func (db *DB) CommitObjectWithSegments(ctx context.Context, opts CommitObjectWit
 		object.TotalPlainSize = totalPlainSize
 		object.TotalEncryptedSize = totalEncryptedSize
 		object.FixedSegmentSize = fixedSegmentSize
-		return nil
+
+		deltas := bucketStatsDeltas{}
+		deltas.remove(Object{ObjectStream: opts.ObjectStream, Status: Pending})
+		deltas.add(object)
+		return insertBucketStatsDeltas(ctx, tx, deltas)
 	})
 	if err != nil {
 		return Object{}, nil, err
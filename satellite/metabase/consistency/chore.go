@@ -0,0 +1,129 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consistency
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+var (
+	// Error is the error class for this package.
+	Error = errs.Class("consistency")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the metabase consistency checks.
+type Config struct {
+	Enabled            bool          `help:"whether to check the consistency of the metabase on the segment loop, requires the objects stream id index, see 'satellite metabase create-stream-id-index'" default:"false"`
+	Interval           time.Duration `help:"how often to check the consistency of the metabase" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	BatchSize          int           `help:"number of streams, whose objects are checked with a single query" default:"100"`
+	AsOfSystemInterval time.Duration `help:"as of system interval of the object queries" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Chore checks the consistency of the metabase on the segment loop.
+//
+// The segment loop of the chore must include the segments with unknown node
+// aliases, see segmentloop.Service.IncludeMissingAliases, so they are reported
+// instead of failing the loop.
+//
+// architecture: Chore
+type Chore struct {
+	log         *zap.Logger
+	db          DB
+	metabase    MetabaseDB
+	segmentLoop *segmentloop.Service
+	config      Config
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new metabase consistency chore.
+func NewChore(log *zap.Logger, db DB, metabase MetabaseDB, segmentLoop *segmentloop.Service, config Config) *Chore {
+	return &Chore{
+		log:         log,
+		db:          db,
+		metabase:    metabase,
+		segmentLoop: segmentLoop,
+		config:      config,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the metabase consistency chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.RunOnce(ctx); err != nil {
+			chore.log.Error("consistency checks failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce checks the consistency of the metabase on a single segment loop.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// looking up the objects of the segments without the index would scan
+	// the whole objects table for every batch.
+	exists, err := chore.metabase.HasStreamIDIndex(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !exists {
+		return Error.New("objects stream id index is missing, create it with 'satellite metabase create-stream-id-index'")
+	}
+
+	observer := NewObserver(chore.log, chore.db, chore.metabase, chore.config)
+	if err := chore.segmentLoop.Join(ctx, observer); err != nil {
+		return Error.Wrap(err)
+	}
+	if err := observer.Finish(ctx); err != nil {
+		return err
+	}
+
+	stats := observer.Stats()
+	mon.IntVal("consistency_checked_streams").Observe(stats.Streams)
+	mon.IntVal("consistency_checked_segments").Observe(stats.Segments)
+	mon.IntVal("consistency_skipped_streams").Observe(stats.Skipped)
+
+	var findings int64
+	for kind, count := range stats.Findings {
+		findings += count
+		chore.log.Warn("metabase inconsistencies found",
+			zap.String("kind", string(kind)), zap.Int64("count", count))
+	}
+	mon.IntVal("consistency_findings_total").Observe(findings)
+
+	// the inconsistencies, which weren't found again, have been fixed.
+	resolved, err := chore.db.DeleteNotSeenSince(ctx, observer.Started())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	chore.log.Info("consistency checks finished",
+		zap.Int64("streams", stats.Streams),
+		zap.Int64("segments", stats.Segments),
+		zap.Int64("skipped", stats.Skipped),
+		zap.Int64("findings", findings),
+		zap.Int64("resolved", resolved))
+	return nil
+}
+
+// Close closes the metabase consistency chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consistency_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/consistency"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		findings := db.MetabaseConsistencyFindings()
+
+		orphaned := consistency.Finding{
+			Kind:     consistency.KindOrphanedSegments,
+			StreamID: testrand.UUID(),
+			Details:  "2 segments without an object",
+		}
+		pieces := consistency.Finding{
+			Kind:     consistency.KindPieces,
+			StreamID: testrand.UUID(),
+			Position: metabase.SegmentPosition{Part: 1, Index: 2},
+			Details:  "duplicated piece number 3",
+		}
+		require.NoError(t, findings.Report(ctx, []consistency.Finding{orphaned, pieces, pieces}))
+
+		list, err := findings.List(ctx, consistency.ListFindings{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+
+		list, err = findings.List(ctx, consistency.ListFindings{Kind: consistency.KindPieces, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, pieces.StreamID, list[0].StreamID)
+		require.Equal(t, pieces.Position, list[0].Position)
+		require.Equal(t, "duplicated piece number 3; duplicated piece number 3", list[0].Details)
+		firstSeenAt := list[0].FirstSeenAt
+
+		// reporting again keeps the first seen time.
+		pieces.Details = "pieces should be ordered"
+		require.NoError(t, findings.Report(ctx, []consistency.Finding{pieces}))
+		list, err = findings.List(ctx, consistency.ListFindings{Kind: consistency.KindPieces, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, "pieces should be ordered", list[0].Details)
+		require.Equal(t, firstSeenAt, list[0].FirstSeenAt)
+		require.False(t, list[0].LastSeenAt.Before(firstSeenAt))
+
+		require.NoError(t, findings.Delete(ctx, pieces.Kind, pieces.StreamID, pieces.Position))
+		err = findings.Delete(ctx, pieces.Kind, pieces.StreamID, pieces.Position)
+		require.True(t, consistency.ErrNotFound.Has(err))
+
+		deleted, err := findings.DeleteNotSeenSince(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, deleted)
+
+		deleted, err = findings.DeleteNotSeenSince(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+
+		list, err = findings.List(ctx, consistency.ListFindings{Limit: 10})
+		require.NoError(t, err)
+		require.Empty(t, list)
+	})
+}
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		db := sat.Metabase.DB
+		findings := sat.DB.MetabaseConsistencyFindings()
+
+		// the core loop fails on the segments with unknown node aliases.
+		segmentLoop := segmentloop.New(zaptest.NewLogger(t), sat.Config.Metainfo.SegmentLoop, db, nil, "consistency")
+		segmentLoop.IncludeMissingAliases()
+
+		chore := consistency.NewChore(zaptest.NewLogger(t), findings, db, segmentLoop, consistency.Config{
+			BatchSize:          2,
+			AsOfSystemInterval: -time.Microsecond,
+		})
+
+		runOnce := func() {
+			var group errgroup.Group
+			group.Go(func() error {
+				return segmentLoop.RunOnce(ctx)
+			})
+			require.NoError(t, chore.RunOnce(ctx))
+			require.NoError(t, group.Wait())
+		}
+
+		// a consistent object.
+		metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 3)
+
+		// an object with an invalid size.
+		resized := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+		_, err := db.UnderlyingTagSQL().ExecContext(ctx,
+			`UPDATE objects SET total_encrypted_size = 1 WHERE stream_id = $1`, resized.StreamID)
+		require.NoError(t, err)
+
+		// a copy, whose ancestor was removed without promoting the copy.
+		original := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+		copyObj, _, _ := metabasetest.CreateObjectCopy{OriginalObject: original}.Run(ctx, t, db)
+		_, err = db.UnderlyingTagSQL().ExecContext(ctx,
+			`DELETE FROM objects WHERE stream_id = $1`, original.StreamID)
+		require.NoError(t, err)
+
+		// a segment with duplicated pieces.
+		duplicated := metabase.RawSegment{
+			StreamID:          testrand.UUID(),
+			Position:          metabase.SegmentPosition{Index: 1},
+			CreatedAt:         time.Now(),
+			RootPieceID:       testrand.PieceID(),
+			EncryptedKey:      testrand.Bytes(32),
+			EncryptedKeyNonce: testrand.Bytes(32),
+			EncryptedSize:     1024,
+			PlainSize:         512,
+			Redundancy:        metabasetest.DefaultRedundancy,
+			Pieces: metabase.Pieces{
+				{Number: 0, StorageNode: storj.NodeID{2}},
+				{Number: 0, StorageNode: storj.NodeID{3}},
+			},
+		}
+		require.NoError(t, db.ImportBatch(ctx, metabase.ImportBatch{Segments: []metabase.RawSegment{duplicated}}))
+
+		// a segment with a piece on an unknown node alias doesn't stop the loop.
+		unknownAlias := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+		_, err = db.UnderlyingTagSQL().ExecContext(ctx,
+			`UPDATE segments SET remote_alias_pieces = $2 WHERE stream_id = $1`, unknownAlias.StreamID,
+			metabase.AliasPieces{{Number: 0, Alias: 30000}})
+		require.NoError(t, err)
+
+		runOnce()
+
+		type key struct {
+			Kind     consistency.Kind
+			StreamID uuid.UUID
+			Position metabase.SegmentPosition
+		}
+		listKeys := func() []key {
+			list, err := findings.List(ctx, consistency.ListFindings{Limit: 100})
+			require.NoError(t, err)
+
+			var keys []key
+			for _, finding := range list {
+				keys = append(keys, key{finding.Kind, finding.StreamID, finding.Position})
+			}
+			return keys
+		}
+
+		require.ElementsMatch(t, []key{
+			{consistency.KindObjectSize, resized.StreamID, metabase.SegmentPosition{}},
+			{consistency.KindOrphanedSegments, original.StreamID, metabase.SegmentPosition{}},
+			{consistency.KindMissingAncestor, copyObj.StreamID, metabase.SegmentPosition{}},
+			{consistency.KindOrphanedSegments, duplicated.StreamID, metabase.SegmentPosition{}},
+			{consistency.KindPieces, duplicated.StreamID, duplicated.Position},
+			{consistency.KindNodeAliases, unknownAlias.StreamID, metabase.SegmentPosition{}},
+		}, listKeys())
+
+		// the fixed inconsistencies are removed by the next loop.
+		_, err = db.UnderlyingTagSQL().ExecContext(ctx,
+			`UPDATE objects SET total_encrypted_size = 2048 WHERE stream_id = $1`, resized.StreamID)
+		require.NoError(t, err)
+		_, err = db.UnderlyingTagSQL().ExecContext(ctx,
+			`DELETE FROM segments WHERE stream_id = $1`, duplicated.StreamID)
+		require.NoError(t, err)
+
+		runOnce()
+
+		require.ElementsMatch(t, []key{
+			{consistency.KindOrphanedSegments, original.StreamID, metabase.SegmentPosition{}},
+			{consistency.KindMissingAncestor, copyObj.StreamID, metabase.SegmentPosition{}},
+			{consistency.KindNodeAliases, unknownAlias.StreamID, metabase.SegmentPosition{}},
+		}, listKeys())
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package consistency implements a segment loop observer, which continuously
+// checks the invariants of the metabase and saves the inconsistencies it
+// finds for triage.
+package consistency
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrNotFound is returned when the finding doesn't exist.
+var ErrNotFound = errs.Class("consistency finding not found")
+
+// Kind is the kind of an inconsistency.
+type Kind string
+
+const (
+	// KindPlainSize is a segment, whose plain size is larger than its
+	// encrypted size.
+	KindPlainSize Kind = "segment_plain_size"
+	// KindPieces is a segment with unordered or duplicated pieces, or pieces
+	// without a node.
+	KindPieces Kind = "segment_pieces"
+	// KindMissingPieces is a remote segment without pieces, which isn't
+	// a server-side copy.
+	KindMissingPieces Kind = "segment_missing_pieces"
+	// KindNodeAliases is a segment with pieces, whose node aliases don't
+	// exist.
+	KindNodeAliases Kind = "segment_node_aliases"
+	// KindPlainOffset is a segment of a committed object, whose plain offset
+	// doesn't follow the previous segment.
+	KindPlainOffset Kind = "segment_plain_offset"
+	// KindSegmentCount is a committed object, whose segment count doesn't
+	// match its segments.
+	KindSegmentCount Kind = "object_segment_count"
+	// KindObjectSize is a committed object, whose total sizes don't match the
+	// sizes of its segments.
+	KindObjectSize Kind = "object_size"
+	// KindMissingAncestor is a server-side copy, whose ancestor doesn't exist.
+	KindMissingAncestor Kind = "copy_missing_ancestor"
+	// KindOrphanedSegments is a stream with segments, but without an object.
+	KindOrphanedSegments Kind = "orphaned_segments"
+)
+
+// Finding is an inconsistency found in the metabase. The findings of a stream
+// use the zero position.
+type Finding struct {
+	Kind     Kind
+	StreamID uuid.UUID
+	Position metabase.SegmentPosition
+	Details  string
+
+	// FirstSeenAt and LastSeenAt are the times of the first and the latest
+	// loops, which found the inconsistency.
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+// ListFindings contains the arguments for listing findings.
+type ListFindings struct {
+	// Kind limits the findings to a single kind, when it's set.
+	Kind  Kind
+	Limit int
+}
+
+// DB stores the findings of the consistency checks.
+//
+// architecture: Database
+type DB interface {
+	// Report saves the findings. The last seen time of a finding, which has
+	// already been saved, is updated.
+	Report(ctx context.Context, findings []Finding) error
+	// List returns the findings, the most recently seen first.
+	List(ctx context.Context, opts ListFindings) ([]Finding, error)
+	// Delete removes a triaged finding.
+	Delete(ctx context.Context, kind Kind, streamID uuid.UUID, position metabase.SegmentPosition) error
+	// DeleteNotSeenSince removes the findings, which haven't been found since
+	// the time. These inconsistencies have been fixed.
+	DeleteNotSeenSince(ctx context.Context, since time.Time) (int64, error)
+}
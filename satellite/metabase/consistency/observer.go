@@ -0,0 +1,379 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consistency
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+// MetabaseDB contains the queries, which are needed for checking the objects of
+// the segments.
+type MetabaseDB interface {
+	ListVerifyStreams(ctx context.Context, opts metabase.ListVerifyStreams) ([]metabase.VerifyStream, error)
+	HasStreamIDIndex(ctx context.Context) (bool, error)
+}
+
+// Stats contains the results of a loop.
+type Stats struct {
+	// Streams is the number of checked streams.
+	Streams int64
+	// Segments is the number of checked segments.
+	Segments int64
+	// Skipped is the number of streams, which were modified during the loop,
+	// so their objects couldn't be checked.
+	Skipped int64
+	// Findings is the number of inconsistencies by their kind.
+	Findings map[Kind]int64
+}
+
+func (stats *Stats) add(other Stats) {
+	stats.Streams += other.Streams
+	stats.Segments += other.Segments
+	stats.Skipped += other.Skipped
+	for kind, count := range other.Findings {
+		if stats.Findings == nil {
+			stats.Findings = map[Kind]int64{}
+		}
+		stats.Findings[kind] += count
+	}
+}
+
+// Observer checks the consistency of the segments and their objects.
+//
+// The segments are checked as the loop passes them. The objects and copies of
+// the streams are queried in batches, together with the totals of their
+// segments. When the totals don't match the segments passed by the loop, the
+// stream was modified during the loop, and it's skipped.
+//
+// architecture: Observer
+type Observer struct {
+	log      *zap.Logger
+	db       DB
+	metabase MetabaseDB
+	config   Config
+
+	started time.Time
+	serial  *partial
+
+	mu    sync.Mutex
+	stats Stats
+}
+
+var _ segmentloop.PartitionedObserver = (*Observer)(nil)
+
+// NewObserver creates a new consistency observer.
+func NewObserver(log *zap.Logger, db DB, metabase MetabaseDB, config Config) *Observer {
+	observer := &Observer{
+		log:      log,
+		db:       db,
+		metabase: metabase,
+		config:   config,
+	}
+	observer.serial = observer.newPartial()
+	return observer
+}
+
+// LoopStarted is called at each start of a loop.
+func (observer *Observer) LoopStarted(ctx context.Context, info segmentloop.LoopInfo) error {
+	observer.started = info.Started
+	observer.serial = observer.newPartial()
+
+	observer.mu.Lock()
+	observer.stats = Stats{}
+	observer.mu.Unlock()
+	return nil
+}
+
+// RemoteSegment checks a remote segment of a serial loop.
+func (observer *Observer) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) error {
+	return observer.serial.RemoteSegment(ctx, segment)
+}
+
+// InlineSegment checks an inline segment of a serial loop.
+func (observer *Observer) InlineSegment(ctx context.Context, segment *segmentloop.Segment) error {
+	return observer.serial.InlineSegment(ctx, segment)
+}
+
+// Fork creates a partial for a range of a parallel loop.
+func (observer *Observer) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return observer.newPartial(), nil
+}
+
+// Join checks the remaining streams of the range and adds its results.
+func (observer *Observer) Join(ctx context.Context, p segmentloop.Partial) error {
+	partial, ok := p.(*partial)
+	if !ok || partial.observer != observer {
+		return errs.New("expected a fork of the consistency observer, got %T", p)
+	}
+	return observer.finish(ctx, partial)
+}
+
+// Finish checks the remaining streams of a serial loop. It must be called,
+// after the loop has finished.
+func (observer *Observer) Finish(ctx context.Context) error {
+	return observer.finish(ctx, observer.serial)
+}
+
+// Stats returns the results of the loop.
+func (observer *Observer) Stats() Stats {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	var stats Stats
+	stats.add(observer.stats)
+	return stats
+}
+
+// Started returns the start time of the loop.
+func (observer *Observer) Started() time.Time { return observer.started }
+
+func (observer *Observer) finish(ctx context.Context, partial *partial) error {
+	if err := partial.finishStream(ctx); err != nil {
+		return err
+	}
+	if err := partial.flush(ctx); err != nil {
+		return err
+	}
+
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	observer.stats.add(partial.stats)
+	partial.stats = Stats{}
+	return nil
+}
+
+func (observer *Observer) newPartial() *partial {
+	return &partial{observer: observer}
+}
+
+// partial checks the segments of a single range, or of the whole serial loop.
+type partial struct {
+	observer *Observer
+
+	current  *streamState
+	pending  []streamState
+	findings []Finding
+	stats    Stats
+}
+
+// streamState contains the segment totals of a stream, which are compared
+// with its object.
+type streamState struct {
+	StreamID      uuid.UUID
+	Segments      int64
+	PlainSize     int64
+	EncryptedSize int64
+
+	// ExpectedOffset is the plain offset of the next segment.
+	ExpectedOffset int64
+	// InvalidOffset is the first segment with an unexpected offset.
+	InvalidOffset *Finding
+	// MissingPieces is the first remote segment without pieces.
+	MissingPieces *Finding
+}
+
+// RemoteSegment implements segmentloop.Partial.
+func (partial *partial) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) error {
+	return partial.segment(ctx, segment)
+}
+
+// InlineSegment implements segmentloop.Partial.
+func (partial *partial) InlineSegment(ctx context.Context, segment *segmentloop.Segment) error {
+	return partial.segment(ctx, segment)
+}
+
+func (partial *partial) segment(ctx context.Context, segment *segmentloop.Segment) error {
+	if partial.current == nil || partial.current.StreamID != segment.StreamID {
+		if err := partial.finishStream(ctx); err != nil {
+			return err
+		}
+		partial.current = &streamState{StreamID: segment.StreamID}
+	}
+
+	stream := partial.current
+	stream.Segments++
+	stream.PlainSize += int64(segment.PlainSize)
+	stream.EncryptedSize += int64(segment.EncryptedSize)
+	partial.stats.Segments++
+
+	if segment.PlainSize > segment.EncryptedSize {
+		partial.report(KindPlainSize, segment.StreamID, segment.Position,
+			"plain size %d is larger than encrypted size %d", segment.PlainSize, segment.EncryptedSize)
+	}
+
+	if stream.InvalidOffset == nil && segment.PlainOffset != stream.ExpectedOffset {
+		stream.InvalidOffset = newFinding(KindPlainOffset, segment.StreamID, segment.Position,
+			"plain offset %d, expected %d", segment.PlainOffset, stream.ExpectedOffset)
+	}
+	stream.ExpectedOffset += int64(segment.PlainSize)
+
+	if segment.Inline() {
+		return nil
+	}
+
+	if len(segment.MissingAliases) > 0 {
+		partial.report(KindNodeAliases, segment.StreamID, segment.Position,
+			"node aliases missing in database: %v", segment.MissingAliases)
+		return nil
+	}
+
+	if len(segment.Pieces) == 0 {
+		if stream.MissingPieces == nil {
+			stream.MissingPieces = newFinding(KindMissingPieces, segment.StreamID, segment.Position,
+				"remote segment without pieces")
+		}
+		return nil
+	}
+
+	if err := segment.Pieces.Verify(); err != nil {
+		partial.report(KindPieces, segment.StreamID, segment.Position, "%v", err)
+		return nil
+	}
+	for _, piece := range segment.Pieces {
+		if int16(piece.Number) >= segment.Redundancy.TotalShares {
+			partial.report(KindPieces, segment.StreamID, segment.Position,
+				"piece number %d is outside of redundancy %d/%d",
+				piece.Number, segment.Redundancy.RequiredShares, segment.Redundancy.TotalShares)
+			break
+		}
+	}
+	return nil
+}
+
+// finishStream queues the current stream for checking its object.
+func (partial *partial) finishStream(ctx context.Context) error {
+	if partial.current == nil {
+		return nil
+	}
+
+	partial.pending = append(partial.pending, *partial.current)
+	partial.current = nil
+
+	if len(partial.pending) < partial.observer.config.BatchSize {
+		return nil
+	}
+	return partial.flush(ctx)
+}
+
+// flush checks the objects of the pending streams and saves the findings.
+func (partial *partial) flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(partial.pending) > 0 {
+		streamIDs := make([]uuid.UUID, len(partial.pending))
+		for i, stream := range partial.pending {
+			streamIDs[i] = stream.StreamID
+		}
+
+		streams, err := partial.observer.metabase.ListVerifyStreams(ctx, metabase.ListVerifyStreams{
+			StreamIDs:          streamIDs,
+			AsOfSystemInterval: partial.observer.config.AsOfSystemInterval,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		byStreamID := make(map[uuid.UUID]metabase.VerifyStream, len(streams))
+		for _, stream := range streams {
+			byStreamID[stream.StreamID] = stream
+		}
+		for _, state := range partial.pending {
+			partial.checkStream(state, byStreamID[state.StreamID])
+		}
+		partial.pending = partial.pending[:0]
+	}
+
+	if len(partial.findings) == 0 {
+		return nil
+	}
+	if err := partial.observer.db.Report(ctx, partial.findings); err != nil {
+		return Error.Wrap(err)
+	}
+	partial.findings = partial.findings[:0]
+	return nil
+}
+
+// checkStream compares the segments passed by the loop with the object of
+// the stream.
+func (partial *partial) checkStream(state streamState, stream metabase.VerifyStream) {
+	if stream.Segments != state.Segments ||
+		stream.SegmentsPlainSize != state.PlainSize ||
+		stream.SegmentsEncryptedSize != state.EncryptedSize {
+		// the stream was modified or deleted after the loop passed it.
+		partial.stats.Skipped++
+		return
+	}
+	partial.stats.Streams++
+
+	if !stream.ObjectExists {
+		partial.report(KindOrphanedSegments, state.StreamID, metabase.SegmentPosition{},
+			"%d segments without an object", state.Segments)
+		return
+	}
+
+	if stream.Status == metabase.Committed {
+		if int64(stream.SegmentCount) != state.Segments {
+			partial.report(KindSegmentCount, state.StreamID, metabase.SegmentPosition{},
+				"object has %d segments, found %d", stream.SegmentCount, state.Segments)
+		}
+		if stream.TotalEncryptedSize != state.EncryptedSize {
+			partial.report(KindObjectSize, state.StreamID, metabase.SegmentPosition{},
+				"object encrypted size %d, segments have %d", stream.TotalEncryptedSize, state.EncryptedSize)
+		}
+		// migrated objects don't have the plain sizes and offsets.
+		if stream.TotalPlainSize != 0 {
+			if stream.TotalPlainSize != state.PlainSize {
+				partial.report(KindObjectSize, state.StreamID, metabase.SegmentPosition{},
+					"object plain size %d, segments have %d", stream.TotalPlainSize, state.PlainSize)
+			}
+			if state.InvalidOffset != nil {
+				partial.add(*state.InvalidOffset)
+			}
+		}
+	}
+
+	if !stream.AncestorStreamID.IsZero() && !stream.AncestorExists {
+		partial.report(KindMissingAncestor, state.StreamID, metabase.SegmentPosition{},
+			"ancestor %s doesn't exist", stream.AncestorStreamID)
+	}
+	// the segments of a copy use the pieces of its ancestor.
+	if state.MissingPieces != nil && stream.AncestorStreamID.IsZero() {
+		partial.add(*state.MissingPieces)
+	}
+}
+
+func (partial *partial) report(kind Kind, streamID uuid.UUID, position metabase.SegmentPosition, format string, args ...interface{}) {
+	partial.add(*newFinding(kind, streamID, position, format, args...))
+}
+
+func (partial *partial) add(finding Finding) {
+	mon.Meter("consistency_findings", monkit.NewSeriesTag("kind", string(finding.Kind))).Mark(1)
+
+	if partial.stats.Findings == nil {
+		partial.stats.Findings = map[Kind]int64{}
+	}
+	partial.stats.Findings[finding.Kind]++
+	partial.findings = append(partial.findings, finding)
+}
+
+func newFinding(kind Kind, streamID uuid.UUID, position metabase.SegmentPosition, format string, args ...interface{}) *Finding {
+	return &Finding{
+		Kind:     kind,
+		StreamID: streamID,
+		Position: position,
+		Details:  fmt.Sprintf(format, args...),
+	}
+}
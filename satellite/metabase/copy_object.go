@@ -105,6 +105,9 @@ func (db *DB) BeginCopyObject(ctx context.Context, opts BeginCopyObject) (result
 // FinishCopyObject holds all data needed to finish object copy.
 type FinishCopyObject struct {
 	ObjectStream
+	// NewProjectID is the project of the copy. The copy is created in the
+	// project of the source object when it's not set.
+	NewProjectID          uuid.UUID
 	NewBucket             string
 	NewEncryptedObjectKey ObjectKey
 	NewStreamID           uuid.UUID
@@ -157,6 +160,14 @@ func (finishCopy FinishCopyObject) Verify() error {
 	return nil
 }
 
+// newProjectID returns the project, where the copy is created.
+func (finishCopy FinishCopyObject) newProjectID() uuid.UUID {
+	if finishCopy.NewProjectID.IsZero() {
+		return finishCopy.ProjectID
+	}
+	return finishCopy.NewProjectID
+}
+
 // FinishCopyObject accepts new encryption keys for copied object and insert the corresponding new object ObjectKey and segments EncryptedKey.
 // It returns the object at the destination location.
 func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (object Object, err error) {
@@ -170,6 +181,10 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 	var copyMetadata []byte
 
 	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		err = checkBucketFence(ctx, tx, BucketLocation{ProjectID: opts.newProjectID(), BucketName: opts.NewBucket})
+		if err != nil {
+			return err
+		}
 
 		sourceObject, ancestorStreamID, objectAtDestination, err := getObjectAtCopySourceAndDestination(ctx, tx, opts)
 		if err != nil {
@@ -269,6 +284,8 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			copyMetadata = sourceObject.EncryptedMetadata
 		}
 
+		deltas := bucketStatsDeltas{}
+
 		if objectAtDestination != nil {
 			deletedObjects, err := db.deleteObjectExactVersionServerSideCopy(
 				ctx, DeleteObjectExactVersion{
@@ -293,6 +310,10 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 
 				ancestorStreamID = *deletedObjects[0].PromotedAncestor
 			}
+
+			for _, deleted := range deletedObjects {
+				deltas.remove(deleted.Object)
+			}
 		}
 
 		// TODO we need to handle metadata correctly (copy from original object or replace)
@@ -303,21 +324,24 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 				encryption,
 				encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key,
 				total_plain_size, total_encrypted_size, fixed_segment_size,
-				zombie_deletion_deadline
+				zombie_deletion_deadline,
+				tags
 			) VALUES (
 				$1, $2, $3, $4, $5,
 				$6,`+committedStatus+`, $7,
 				$8,
 				$9, $10, $11,
-				$12, $13, $14, null
+				$12, $13, $14, null,
+				$15::JSONB
 			)
 			RETURNING
 				created_at`,
-			opts.ProjectID, opts.NewBucket, opts.NewEncryptedObjectKey, opts.Version, opts.NewStreamID,
+			opts.newProjectID(), opts.NewBucket, opts.NewEncryptedObjectKey, opts.Version, opts.NewStreamID,
 			sourceObject.ExpiresAt, sourceObject.SegmentCount,
 			encryptionParameters{&sourceObject.Encryption},
 			copyMetadata, opts.NewEncryptedMetadataKeyNonce, opts.NewEncryptedMetadataKey,
 			sourceObject.TotalPlainSize, sourceObject.TotalEncryptedSize, sourceObject.FixedSegmentSize,
+			sourceObject.Tags,
 		)
 
 		newObject = sourceObject
@@ -326,6 +350,15 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			return Error.New("unable to copy object: %w", err)
 		}
 
+		copiedObject := sourceObject
+		copiedObject.ProjectID = opts.newProjectID()
+		copiedObject.BucketName = opts.NewBucket
+		copiedObject.EncryptedMetadata = copyMetadata
+		deltas.add(copiedObject)
+		if err := insertBucketStatsDeltas(ctx, tx, deltas); err != nil {
+			return err
+		}
+
 		_, err = tx.ExecContext(ctx, `
 			INSERT INTO segments (
 				stream_id, position, expires_at,
@@ -375,6 +408,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 	}
 
 	newObject.StreamID = opts.NewStreamID
+	newObject.ProjectID = opts.newProjectID()
 	newObject.BucketName = opts.NewBucket
 	newObject.ObjectKey = opts.NewEncryptedObjectKey
 	newObject.EncryptedMetadata = copyMetadata
@@ -402,11 +436,13 @@ func getObjectAtCopySourceAndDestination(
 	rows, err := tx.QueryContext(ctx, `
 		SELECT
 			objects.stream_id,
+			project_id,
 			bucket_name,
 			object_key,
 			expires_at,
 			segment_count,
 			encrypted_metadata,
+			tags,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
 			encryption,
 			segment_copies.ancestor_stream_id
@@ -421,24 +457,27 @@ func getObjectAtCopySourceAndDestination(
 		UNION ALL
 		SELECT
 			objects.stream_id,
+			project_id,
 			bucket_name,
 			object_key,
 			expires_at,
 			segment_count,
 			encrypted_metadata,
+			tags,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
 			encryption,
 			NULL
 		FROM objects
 		WHERE
-			project_id   = $1 AND
+			project_id   = $7 AND
 			bucket_name  = $5 AND
 			object_key   = $6 AND
 			version      = $2 AND
 			status       = `+committedStatus,
 		opts.ProjectID, opts.Version,
 		[]byte(opts.BucketName), opts.ObjectKey,
-		opts.NewBucket, opts.NewEncryptedObjectKey)
+		opts.NewBucket, opts.NewEncryptedObjectKey,
+		opts.newProjectID())
 	if err != nil {
 		return Object{}, uuid.UUID{}, nil, err
 	}
@@ -453,11 +492,13 @@ func getObjectAtCopySourceAndDestination(
 
 	err = rows.Scan(
 		&sourceObject.StreamID,
+		&sourceObject.ProjectID,
 		&sourceObject.BucketName,
 		&sourceObject.ObjectKey,
 		&sourceObject.ExpiresAt,
 		&sourceObject.SegmentCount,
 		&sourceObject.EncryptedMetadata,
+		&sourceObject.Tags,
 		&sourceObject.TotalPlainSize, &sourceObject.TotalEncryptedSize, &sourceObject.FixedSegmentSize,
 		encryptionParameters{&sourceObject.Encryption},
 		&ancestorStreamIDBytes,
@@ -465,14 +506,13 @@ func getObjectAtCopySourceAndDestination(
 	if err != nil {
 		return Object{}, uuid.UUID{}, nil, Error.New("unable to query object status: %w", err)
 	}
-	if sourceObject.BucketName != opts.BucketName || sourceObject.ObjectKey != opts.ObjectKey {
+	if sourceObject.ProjectID != opts.ProjectID || sourceObject.BucketName != opts.BucketName || sourceObject.ObjectKey != opts.ObjectKey {
 		return Object{}, uuid.UUID{}, nil, storj.ErrObjectNotFound.New("source object is gone")
 	}
 	if sourceObject.StreamID != opts.StreamID {
 		return Object{}, uuid.UUID{}, nil, storj.ErrObjectNotFound.New("object was changed during copy")
 	}
 
-	sourceObject.ProjectID = opts.ProjectID
 	sourceObject.Version = opts.Version
 	sourceObject.Status = Committed
 
@@ -490,18 +530,20 @@ func getObjectAtCopySourceAndDestination(
 	if rows.Next() {
 		var _bogusBytes []byte
 		destinationObject = &Object{}
-		destinationObject.ProjectID = opts.ProjectID
+		destinationObject.ProjectID = opts.newProjectID()
 		destinationObject.BucketName = opts.NewBucket
 		destinationObject.ObjectKey = opts.NewEncryptedObjectKey
 		// There is an object at the destination.
 		// We will delete it before doing the copy
 		err := rows.Scan(
 			&destinationObject.StreamID,
+			&destinationObject.ProjectID,
 			&destinationObject.BucketName,
 			&destinationObject.ObjectKey,
 			&destinationObject.ExpiresAt,
 			&destinationObject.SegmentCount,
 			&destinationObject.EncryptedMetadata,
+			&destinationObject.Tags,
 			&destinationObject.TotalPlainSize, &destinationObject.TotalEncryptedSize, &destinationObject.FixedSegmentSize,
 			encryptionParameters{&destinationObject.Encryption},
 			&_bogusBytes,
@@ -510,7 +552,7 @@ func getObjectAtCopySourceAndDestination(
 			return Object{}, uuid.UUID{}, nil, Error.New("error while reading existing object at destination: %w", err)
 		}
 
-		if destinationObject.BucketName != opts.NewBucket || destinationObject.ObjectKey != opts.NewEncryptedObjectKey {
+		if destinationObject.ProjectID != opts.newProjectID() || destinationObject.BucketName != opts.NewBucket || destinationObject.ObjectKey != opts.NewEncryptedObjectKey {
 			return Object{}, uuid.UUID{}, nil, Error.New("unexpected")
 		}
 	}
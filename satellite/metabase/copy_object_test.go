@@ -902,6 +902,75 @@ func TestFinishCopyObject(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("copy object to another project", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			numberOfSegments := 5
+			originalObjStream := metabasetest.RandObjectStream()
+			copyStream := metabasetest.RandObjectStream()
+
+			originalObj, originalSegments := metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream:                  originalObjStream,
+					EncryptedMetadata:             testrand.Bytes(64),
+					EncryptedMetadataNonce:        testrand.Nonce().Bytes(),
+					EncryptedMetadataEncryptedKey: testrand.Bytes(265),
+				},
+			}.Run(ctx, t, db, originalObjStream, byte(numberOfSegments))
+
+			copyObj, expectedOriginalSegments, expectedCopySegments := metabasetest.CreateObjectCopy{
+				OriginalObject:   originalObj,
+				CopyObjectStream: &copyStream,
+				NewProjectID:     copyStream.ProjectID,
+			}.Run(ctx, t, db)
+			require.Equal(t, copyStream.Location(), copyObj.Location())
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(originalObj),
+					metabase.RawObject(copyObj),
+				},
+				Segments: append(expectedOriginalSegments, expectedCopySegments...),
+				Copies: []metabase.RawCopy{{
+					StreamID:         copyObj.StreamID,
+					AncestorStreamID: originalObj.StreamID,
+				}},
+			}.Check(ctx, t, db)
+
+			// the copy is accounted to the destination project.
+			metabasetest.GetBucketStats{
+				Opts: metabase.GetBucketStats{BucketLocation: copyStream.Location().Bucket()},
+				Result: metabase.BucketStats{
+					ObjectCount:        1,
+					SegmentCount:       int64(numberOfSegments),
+					TotalEncryptedSize: copyObj.TotalEncryptedSize,
+					MetadataSize:       int64(len(copyObj.EncryptedMetadata)),
+				},
+			}.Check(ctx, t, db)
+
+			// deleting the source object promotes the copy in the other project.
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: originalObj.Location(),
+					Version:        originalObj.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{originalObj},
+				},
+			}.Check(ctx, t, db)
+
+			for i := range expectedCopySegments {
+				expectedCopySegments[i].Pieces = originalSegments[i].Pieces
+			}
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(copyObj),
+				},
+				Segments: expectedCopySegments,
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("copied segments has same expires_at as original", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
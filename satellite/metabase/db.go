@@ -125,6 +125,9 @@ func (db *DB) DestroyTables(ctx context.Context) error {
 		DROP TABLE IF EXISTS segments;
 		DROP TABLE IF EXISTS node_aliases;
 		DROP SEQUENCE IF EXISTS node_alias_seq;
+		DROP TABLE IF EXISTS bucket_stats;
+		DROP TABLE IF EXISTS bucket_stats_deltas;
+		DROP TABLE IF EXISTS bucket_fences;
 	`)
 	db.aliasCache = NewNodeAliasCache(db)
 	return Error.Wrap(err)
@@ -198,6 +201,8 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 
 						zombie_deletion_deadline TIMESTAMPTZ default now() + '1 day',
 
+						tags JSONB default NULL,
+
 						PRIMARY KEY (project_id, bucket_name, object_key, version)
 					);
 					CREATE TABLE segments (
@@ -241,7 +246,45 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 
 						CONSTRAINT not_self_ancestor CHECK (stream_id != ancestor_stream_id)
 					);
-					CREATE INDEX ON segment_copies (ancestor_stream_id);`,
+					CREATE INDEX ON segment_copies (ancestor_stream_id);
+
+					CREATE TABLE bucket_stats (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+
+						object_count         INT8 NOT NULL default 0,
+						pending_object_count INT8 NOT NULL default 0,
+						segment_count        INT8 NOT NULL default 0,
+						total_encrypted_size INT8 NOT NULL default 0,
+						metadata_size        INT8 NOT NULL default 0,
+
+						updated_at TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name)
+					);
+					CREATE TABLE bucket_stats_deltas (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						id          BYTEA NOT NULL,
+
+						object_count         INT8 NOT NULL default 0,
+						pending_object_count INT8 NOT NULL default 0,
+						segment_count        INT8 NOT NULL default 0,
+						total_encrypted_size INT8 NOT NULL default 0,
+						metadata_size        INT8 NOT NULL default 0,
+
+						created_at TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name, id)
+					);
+
+					CREATE TABLE bucket_fences (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						created_at  TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name)
+					);`,
 				},
 			},
 		},
@@ -471,6 +514,64 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`CREATE INDEX ON segment_copies (ancestor_stream_id)`,
 				},
 			},
+			{
+				DB:          &db.db,
+				Description: "add tags column to objects",
+				Version:     16,
+				Action: migrate.SQL{
+					`ALTER TABLE objects ADD COLUMN tags JSONB default NULL`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add tables for bucket statistics",
+				Version:     17,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_stats (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+
+						object_count         INT8 NOT NULL default 0,
+						pending_object_count INT8 NOT NULL default 0,
+						segment_count        INT8 NOT NULL default 0,
+						total_encrypted_size INT8 NOT NULL default 0,
+						metadata_size        INT8 NOT NULL default 0,
+
+						updated_at TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name)
+					)`,
+					`CREATE TABLE bucket_stats_deltas (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						id          BYTEA NOT NULL,
+
+						object_count         INT8 NOT NULL default 0,
+						pending_object_count INT8 NOT NULL default 0,
+						segment_count        INT8 NOT NULL default 0,
+						total_encrypted_size INT8 NOT NULL default 0,
+						metadata_size        INT8 NOT NULL default 0,
+
+						created_at TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name, id)
+					)`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add table for fencing buckets",
+				Version:     18,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_fences (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						created_at  TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name)
+					)`,
+				},
+			},
 		},
 	}
 }
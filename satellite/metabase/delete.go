@@ -181,7 +181,7 @@ WITH deleted_objects AS (
 	%s
 	RETURNING
 		stream_id
-		-- extra properties only returned when deleting single object
+		-- extra properties, which are only returned by some of the queries
 		%s
 ),
 deleted_segments AS (
@@ -227,7 +227,7 @@ SELECT
 	deleted_segments.remote_alias_pieces,
 	-- if set, caller needs to promote this stream_id to new ancestor or else object contents will be lost
 	promoted_ancestors.new_ancestor_stream_id
-	-- extra properties only returned when deleting single object
+	-- extra properties, which are only returned by some of the queries
 	%s
 FROM deleted_objects
 LEFT JOIN deleted_segments
@@ -406,6 +406,14 @@ func (db *DB) deleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 		return DeleteObjectResult{}, err
 	}
 
+	deltas := bucketStatsDeltas{}
+	for _, object := range result.Objects {
+		deltas.remove(object)
+	}
+	if err := insertBucketStatsDeltas(ctx, tx, deltas); err != nil {
+		return DeleteObjectResult{}, err
+	}
+
 	mon.Meter("object_delete").Mark(len(result.Objects))
 	mon.Meter("segment_delete").Mark(len(result.Segments))
 
@@ -515,6 +523,11 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 		return DeleteObjectResult{}, err
 	}
 
+	deltaID, err := uuid.New()
+	if err != nil {
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+
 	err = withRows(db.db.QueryContext(ctx, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
@@ -526,6 +539,7 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 					stream_id    = $5 AND
 					status       = `+pendingStatus+`
 				RETURNING
+					project_id, bucket_name,
 					version, stream_id,
 					created_at, expires_at,
 					status, segment_count,
@@ -536,7 +550,7 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 				DELETE FROM segments
 				WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
 				RETURNING segments.stream_id,segments.root_piece_id, segments.remote_alias_pieces
-			)
+			), `+bucketStatsDeltaSQL("deleted_stats", "deleted_objects", "-", "$6")+`
 			SELECT
 				deleted_objects.version, deleted_objects.stream_id,
 				deleted_objects.created_at, deleted_objects.expires_at,
@@ -547,7 +561,7 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 				deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID))(func(rows tagsql.Rows) error {
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID, deltaID))(func(rows tagsql.Rows) error {
 		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.Location(), rows)
 		return err
 	})
@@ -578,6 +592,11 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 		return DeleteObjectResult{}, err
 	}
 
+	deltaID, err := uuid.New()
+	if err != nil {
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+
 	err = withRows(db.db.QueryContext(ctx, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
@@ -586,6 +605,7 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 				bucket_name  = $2 AND
 				object_key   = $3
 				RETURNING
+					project_id, bucket_name,
 					version, stream_id,
 					created_at, expires_at,
 					status, segment_count,
@@ -596,7 +616,7 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 				DELETE FROM segments
 				WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
 				RETURNING segments.stream_id,segments.root_piece_id, segments.remote_alias_pieces
-			)
+			), `+bucketStatsDeltaSQL("deleted_stats", "deleted_objects", "-", "$4")+`
 			SELECT
 				deleted_objects.version, deleted_objects.stream_id,
 				deleted_objects.created_at, deleted_objects.expires_at,
@@ -607,7 +627,7 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 				deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey))(func(rows tagsql.Rows) error {
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, deltaID))(func(rows tagsql.Rows) error {
 		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
 		return err
 	})
@@ -657,6 +677,12 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 	sort.Slice(objectKeys, func(i, j int) bool {
 		return bytes.Compare(objectKeys[i], objectKeys[j]) < 0
 	})
+
+	deltaID, err := uuid.New()
+	if err != nil {
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+
 	err = withRows(db.db.QueryContext(ctx, `
 				WITH deleted_objects AS (
 					DELETE FROM objects
@@ -677,7 +703,7 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 					DELETE FROM segments
 					WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
 					RETURNING segments.stream_id,segments.root_piece_id, segments.remote_alias_pieces
-				)
+				), `+bucketStatsDeltaSQL("deleted_stats", "deleted_objects", "-", "$4")+`
 				SELECT
 					deleted_objects.project_id, deleted_objects.bucket_name,
 					deleted_objects.object_key,deleted_objects.version, deleted_objects.stream_id,
@@ -689,7 +715,7 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 					deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 				FROM deleted_objects
 				LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-			`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
+			`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys), deltaID))(func(rows tagsql.Rows) error {
 		result.Objects, result.Segments, err = db.scanMultipleObjectsDeletion(ctx, rows)
 		return err
 	})
@@ -945,6 +971,14 @@ func (db *DB) deleteObjectLastCommitted(ctx context.Context, opts DeleteObjectLa
 		return DeleteObjectResult{}, err
 	}
 
+	deltas := bucketStatsDeltas{}
+	for _, object := range result.Objects {
+		deltas.remove(object)
+	}
+	if err := insertBucketStatsDeltas(ctx, tx, deltas); err != nil {
+		return DeleteObjectResult{}, err
+	}
+
 	mon.Meter("object_delete").Mark(len(result.Objects))
 	mon.Meter("segment_delete").Mark(len(result.Segments))
 
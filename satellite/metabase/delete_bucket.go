@@ -47,15 +47,28 @@ WHERE (objects.project_id, objects.bucket_name) IN (
 	LIMIT $3
 )`
 
+// the properties of the deleted objects, which are needed to update the
+// bucket statistics.
+var (
+	deleteBucketObjectsReturningSQL = `,status,
+		segment_count,
+		total_encrypted_size,
+		encrypted_metadata`
+	deleteBucketObjectsSelectSQL = `,deleted_objects.status,
+		deleted_objects.segment_count,
+		deleted_objects.total_encrypted_size,
+		deleted_objects.encrypted_metadata`
+)
+
 var deleteBucketObjectsWithCopyFeaturePostgresSQL = fmt.Sprintf(
 	deleteBucketObjectsWithCopyFeatureSQL,
 	deleteObjectsPostgresSubSQL,
-	"", "",
+	deleteBucketObjectsReturningSQL, deleteBucketObjectsSelectSQL,
 )
 var deleteBucketObjectsWithCopyFeatureCockroachSQL = fmt.Sprintf(
 	deleteBucketObjectsWithCopyFeatureSQL,
 	deleteObjectsCockroachSubSQL,
-	"", "",
+	deleteBucketObjectsReturningSQL, deleteBucketObjectsSelectSQL,
 )
 
 func getDeleteBucketObjectsSQLWithCopyFeature(impl dbutil.Implementation) (string, error) {
@@ -85,7 +98,6 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 	if db.config.ServerSideCopy {
 		return db.deleteBucketObjectsWithCopyFeatureEnabled(ctx, opts)
 	}
-
 	return db.deleteBucketObjectsWithCopyFeatureDisabled(ctx, opts)
 }
 
@@ -128,7 +140,15 @@ func (db *DB) deleteBucketObjectBatchWithCopyFeatureEnabled(ctx context.Context,
 			return err
 		}
 
-		return db.promoteNewAncestors(ctx, tx, objects)
+		if err := db.promoteNewAncestors(ctx, tx, objects); err != nil {
+			return err
+		}
+
+		deltas := bucketStatsDeltas{}
+		for _, object := range objects {
+			deltas.remove(object.Object)
+		}
+		return insertBucketStatsDeltas(ctx, tx, deltas)
 	})
 
 	deletedObjectCount = int64(len(objects))
@@ -181,6 +201,10 @@ func (db *DB) scanBucketObjectsDeletionServerSideCopy(ctx context.Context, locat
 			&rootPieceID,
 			&aliasPieces,
 			&object.PromotedAncestor,
+			&object.Status,
+			&object.SegmentCount,
+			&object.TotalEncryptedSize,
+			&object.EncryptedMetadata,
 		)
 		if err != nil {
 			return nil, Error.New("unable to delete bucket objects: %w", err)
@@ -218,8 +242,10 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 		WITH deleted_objects AS (
 			DELETE FROM objects
 			WHERE project_id = $1 AND bucket_name = $2 LIMIT $3
-			RETURNING objects.stream_id
-		)
+			RETURNING
+				project_id, bucket_name, stream_id,
+				status, segment_count, total_encrypted_size, encrypted_metadata
+		), ` + bucketStatsDeltaSQL("deleted_stats", "deleted_objects", "-", "$4") + `
 		DELETE FROM segments
 		WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
 		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
@@ -233,8 +259,10 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 				WHERE project_id = $1 AND bucket_name = $2
 				LIMIT $3
 			)
-			RETURNING objects.stream_id
-		)
+			RETURNING
+				project_id, bucket_name, stream_id,
+				status, segment_count, total_encrypted_size, encrypted_metadata
+		), ` + bucketStatsDeltaSQL("deleted_stats", "deleted_objects", "-", "$4") + `
 		DELETE FROM segments
 		WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
 		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
@@ -250,10 +278,15 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 			return 0, err
 		}
 
+		deltaID, err := uuid.New()
+		if err != nil {
+			return deletedObjectCount, Error.Wrap(err)
+		}
+
 		deletedSegments = deletedSegments[:0]
 		deletedObjects := 0
 		err = withRows(db.db.QueryContext(ctx, query,
-			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize))(func(rows tagsql.Rows) error {
+			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize, deltaID))(func(rows tagsql.Rows) error {
 			ids := map[uuid.UUID]struct{}{} // TODO: avoid map here
 			for rows.Next() {
 				var streamID uuid.UUID
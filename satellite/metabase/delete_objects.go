@@ -12,6 +12,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/uuid"
 	"storj.io/private/dbutil/pgxutil"
 	"storj.io/private/tagsql"
 )
@@ -195,15 +196,22 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 		for _, obj := range objects {
 			obj := obj
 
+			deltaID, err := uuid.New()
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
 			batch.Queue(`
 				WITH deleted_objects AS (
 					DELETE FROM objects
 					WHERE (project_id, bucket_name, object_key, version, stream_id) = ($1::BYTEA, $2, $3, $4, $5::BYTEA)
-					RETURNING stream_id
-				)
+					RETURNING
+						project_id, bucket_name, stream_id,
+						status, segment_count, total_encrypted_size, encrypted_metadata
+				), `+bucketStatsDeltaSQL("deleted_stats", "deleted_objects", "-", "$6")+`
 				DELETE FROM segments
 				WHERE segments.stream_id = $5::BYTEA
-			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
+			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID, deltaID)
 		}
 
 		results := conn.SendBatch(ctx, &batch)
@@ -247,6 +255,11 @@ func (db *DB) deleteInactiveObjectsAndSegments(ctx context.Context, objects []Ob
 	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) error {
 		var batch pgx.Batch
 		for _, obj := range objects {
+			deltaID, err := uuid.New()
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
 			batch.Queue(`
 				WITH deleted_objects AS (
 					DELETE FROM objects
@@ -259,13 +272,15 @@ func (db *DB) deleteInactiveObjectsAndSegments(ctx context.Context, objects []Ob
 							-- check that all segments where created before inactive time
 							NOT EXISTS (SELECT stream_id FROM segments WHERE stream_id = $5::BYTEA AND created_at > $6)
 						)
-						RETURNING version -- return anything
-				)
+						RETURNING
+							project_id, bucket_name,
+							status, segment_count, total_encrypted_size, encrypted_metadata
+				), `+bucketStatsDeltaSQL("deleted_stats", "deleted_objects", "-", "$7")+`
 				DELETE FROM segments
 				WHERE
 					segments.stream_id = $5::BYTEA AND
 					NOT EXISTS (SELECT stream_id FROM segments WHERE stream_id = $5::BYTEA AND created_at > $6)
-			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID, inactiveDeadline)
+			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID, inactiveDeadline, deltaID)
 		}
 
 		results := conn.SendBatch(ctx, &batch)
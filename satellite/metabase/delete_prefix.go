@@ -0,0 +1,269 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// DeleteObjectsByPrefix contains arguments for deleting all objects
+// with the specified prefix.
+type DeleteObjectsByPrefix struct {
+	Bucket    BucketLocation
+	Prefix    ObjectKey
+	BatchSize int
+
+	// Limit is the maximum number of objects deleted by a single call.
+	// Zero means that all objects with the prefix are deleted.
+	Limit int
+
+	// DeletePieces is called for every batch of objects.
+	DeletePieces func(ctx context.Context, segments []DeletedSegmentInfo) error
+}
+
+// Verify verifies delete objects by prefix fields.
+func (opts *DeleteObjectsByPrefix) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	switch {
+	case opts.Prefix == "":
+		return ErrInvalidRequest.New("Prefix missing")
+	case opts.Limit < 0:
+		return ErrInvalidRequest.New("Limit is negative")
+	}
+	return nil
+}
+
+// DeleteObjectsByPrefixResult contains the progress of deleting objects
+// by prefix.
+type DeleteObjectsByPrefixResult struct {
+	DeletedObjectCount  int64
+	DeletedSegmentCount int64
+
+	// More is set when the limit was reached and there are objects
+	// with the prefix left.
+	More bool
+}
+
+var deleteObjectsByPrefixSubSQL = `
+DELETE FROM objects
+WHERE
+	project_id  = $1 AND
+	bucket_name = $2 AND
+	(object_key, version) IN (
+		SELECT object_key, version FROM objects
+		WHERE
+			project_id  = $1 AND
+			bucket_name = $2 AND
+			object_key >= $3 AND ($4 = ''::BYTEA OR object_key < $4)
+		ORDER BY object_key, version
+		LIMIT $5
+	)
+`
+
+var deleteObjectsByPrefixWithCopyFeatureSQL = fmt.Sprintf(
+	deleteBucketObjectsWithCopyFeatureSQL,
+	deleteObjectsByPrefixSubSQL,
+	`,object_key,
+		version,
+		status,
+		segment_count,
+		total_encrypted_size,
+		encrypted_metadata`,
+	`,deleted_objects.object_key,
+		deleted_objects.version,
+		deleted_objects.status,
+		deleted_objects.segment_count,
+		deleted_objects.total_encrypted_size,
+		deleted_objects.encrypted_metadata,
+		deleted_segments.repaired_at`,
+)
+
+// DeleteObjectsByPrefix deletes all objects, including pending ones, whose
+// key starts with the prefix. Deletion performs in batches, so in case of
+// error while processing, the result contains the progress to the moment
+// when the error occurred.
+//
+// The query always takes server-side copies into account, because the
+// segments of a deleted ancestor have to be promoted to one of its copies,
+// which aren't necessarily under the same prefix.
+func (db *DB) DeleteObjectsByPrefix(ctx context.Context, opts DeleteObjectsByPrefix) (result DeleteObjectsByPrefixResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return DeleteObjectsByPrefixResult{}, err
+	}
+
+	deleteBatchSizeLimit.Ensure(&opts.BatchSize)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		batchSize := opts.BatchSize
+		if opts.Limit > 0 {
+			left := opts.Limit - int(result.DeletedObjectCount)
+			if left <= 0 {
+				break
+			}
+			if left < batchSize {
+				batchSize = left
+			}
+		}
+
+		deletedObjects, deletedSegments, err := db.deleteObjectsByPrefixBatch(ctx, opts, batchSize)
+		result.DeletedObjectCount += deletedObjects
+		result.DeletedSegmentCount += deletedSegments
+		if err != nil || deletedObjects == 0 {
+			return result, err
+		}
+	}
+
+	result.More, err = db.prefixHasObjects(ctx, opts.Bucket, opts.Prefix)
+	return result, err
+}
+
+// deleteObjectsByPrefixBatch deletes a single batch from metabase.
+// This function has been factored out for metric purposes.
+func (db *DB) deleteObjectsByPrefixBatch(ctx context.Context, opts DeleteObjectsByPrefix, batchSize int) (deletedObjects, deletedSegments int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var objects []deletedObjectInfo
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		err = withRows(
+			tx.QueryContext(ctx, deleteObjectsByPrefixWithCopyFeatureSQL,
+				opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName),
+				[]byte(opts.Prefix), []byte(prefixLimit(opts.Prefix)),
+				batchSize),
+		)(func(rows tagsql.Rows) error {
+			objects, err = db.scanPrefixObjectsDeletion(ctx, opts.Bucket, rows)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := db.promoteNewAncestors(ctx, tx, objects); err != nil {
+			return err
+		}
+
+		deltas := bucketStatsDeltas{}
+		for _, object := range objects {
+			deltas.remove(object.Object)
+		}
+		return insertBucketStatsDeltas(ctx, tx, deltas)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	segments := make([]DeletedSegmentInfo, 0, len(objects))
+	for _, object := range objects {
+		deletedSegments += int64(object.SegmentCount)
+
+		if object.PromotedAncestor != nil {
+			// don't remove pieces, they are now linked to the new ancestor
+			continue
+		}
+		for _, segment := range object.Segments {
+			segments = append(segments, DeletedSegmentInfo{
+				RootPieceID: segment.RootPieceID,
+				Pieces:      segment.Pieces,
+			})
+		}
+	}
+	deletedObjects = int64(len(objects))
+
+	mon.Meter("object_delete").Mark64(deletedObjects)
+	mon.Meter("segment_delete").Mark64(deletedSegments)
+
+	if opts.DeletePieces == nil || len(segments) == 0 {
+		// no callback, this should only be in test path
+		return deletedObjects, deletedSegments, nil
+	}
+
+	return deletedObjects, deletedSegments, opts.DeletePieces(ctx, segments)
+}
+
+func (db *DB) scanPrefixObjectsDeletion(ctx context.Context, location BucketLocation, rows tagsql.Rows) (result []deletedObjectInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	result = make([]deletedObjectInfo, 0, 10)
+	var rootPieceID *storj.PieceID
+	var object deletedObjectInfo
+	var segment deletedRemoteSegmentInfo
+	var aliasPieces AliasPieces
+	var segmentPosition *SegmentPosition
+
+	for rows.Next() {
+		object.ProjectID = location.ProjectID
+		object.BucketName = location.BucketName
+
+		err = rows.Scan(
+			&object.StreamID,
+			&segmentPosition,
+			&rootPieceID,
+			&aliasPieces,
+			&object.PromotedAncestor,
+			&object.ObjectKey,
+			&object.Version,
+			&object.Status,
+			&object.SegmentCount,
+			&object.TotalEncryptedSize,
+			&object.EncryptedMetadata,
+			&segment.RepairedAt,
+		)
+		if err != nil {
+			return nil, Error.New("unable to delete objects by prefix: %w", err)
+		}
+
+		if len(result) == 0 || result[len(result)-1].StreamID != object.StreamID {
+			result = append(result, object)
+		}
+		if rootPieceID != nil {
+			segment.Position = *segmentPosition
+			segment.RootPieceID = *rootPieceID
+			segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			if len(segment.Pieces) > 0 {
+				result[len(result)-1].Segments = append(result[len(result)-1].Segments, segment)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.New("unable to delete objects by prefix: %w", err)
+	}
+	return result, nil
+}
+
+// prefixHasObjects returns whether there are any objects with the prefix.
+func (db *DB) prefixHasObjects(ctx context.Context, bucket BucketLocation, prefix ObjectKey) (exists bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id  = $1 AND
+				bucket_name = $2 AND
+				object_key >= $3 AND ($4 = ''::BYTEA OR object_key < $4)
+		)
+	`, bucket.ProjectID, []byte(bucket.BucketName), []byte(prefix), []byte(prefixLimit(prefix))).Scan(&exists)
+	if err != nil {
+		return false, Error.New("unable to check objects with prefix: %w", err)
+	}
+	return exists, nil
+}
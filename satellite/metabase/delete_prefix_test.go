@@ -0,0 +1,241 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestDeleteObjectsByPrefix(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		bucket := obj.Location().Bucket()
+
+		objectAt := func(key metabase.ObjectKey) metabase.ObjectStream {
+			stream := metabasetest.RandObjectStream()
+			stream.ProjectID = bucket.ProjectID
+			stream.BucketName = bucket.BucketName
+			stream.ObjectKey = key
+			return stream
+		}
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: metabase.BucketLocation{BucketName: "bucket"},
+					Prefix: "a/",
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: bucket,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Prefix missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: bucket,
+					Prefix: "a/",
+					Limit:  -1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Limit is negative",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("no objects with prefix", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			outside := metabasetest.CreateObject(ctx, t, db, objectAt("b/1"), 1)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: bucket,
+					Prefix: "a/",
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						return errors.New("shouldn't be called")
+					},
+				},
+			}.Check(ctx, t, db)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Equal(t, []metabase.Object{outside}, objects)
+		})
+
+		t.Run("prefix ending with 0xFF", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, objectAt("a\xFF\xFF"), 0)
+			metabasetest.CreateObject(ctx, t, db, objectAt("a\xFF1"), 0)
+			metabasetest.CreateObject(ctx, t, db, objectAt("\xFF\xFF1"), 0)
+			outside := metabasetest.CreateObject(ctx, t, db, objectAt("b"), 0)
+
+			for _, test := range []struct {
+				prefix  metabase.ObjectKey
+				deleted int64
+			}{
+				{"a\xFF", 2},
+				// no key follows all the keys with the prefix.
+				{"\xFF", 1},
+			} {
+				metabasetest.DeleteObjectsByPrefix{
+					Opts: metabase.DeleteObjectsByPrefix{
+						Bucket: bucket,
+						Prefix: test.prefix,
+					},
+					Result: metabase.DeleteObjectsByPrefixResult{
+						DeletedObjectCount: test.deleted,
+					},
+				}.Check(ctx, t, db)
+			}
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Equal(t, []metabase.Object{outside}, objects)
+		})
+
+		t.Run("delete prefix", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, objectAt("a/1"), 2)
+			metabasetest.CreateObject(ctx, t, db, objectAt("a/2"), 0)
+			metabasetest.CreateObject(ctx, t, db, objectAt("a/b/3"), 1)
+			metabasetest.CreatePendingObject(ctx, t, db, objectAt("a/4"), 0)
+			before := metabasetest.CreateObject(ctx, t, db, objectAt("a"), 1)
+			after := metabasetest.CreateObject(ctx, t, db, objectAt("a0"), 1)
+
+			nSegments := 0
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket:    bucket,
+					Prefix:    "a/",
+					BatchSize: 2,
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						nSegments += len(segments)
+						return nil
+					},
+				},
+				Result: metabase.DeleteObjectsByPrefixResult{
+					DeletedObjectCount:  4,
+					DeletedSegmentCount: 3,
+				},
+			}.Check(ctx, t, db)
+
+			require.Equal(t, 3, nSegments)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []metabase.Object{before, after}, objects)
+
+			metabasetest.GetBucketStats{
+				Opts: metabase.GetBucketStats{BucketLocation: bucket},
+				Result: metabase.BucketStats{
+					ObjectCount:        2,
+					SegmentCount:       2,
+					TotalEncryptedSize: 2048,
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("limit", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			for _, key := range []metabase.ObjectKey{"a/1", "a/2", "a/3"} {
+				metabasetest.CreateObject(ctx, t, db, objectAt(key), 1)
+			}
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket:    bucket,
+					Prefix:    "a/",
+					BatchSize: 1,
+					Limit:     2,
+				},
+				Result: metabase.DeleteObjectsByPrefixResult{
+					DeletedObjectCount:  2,
+					DeletedSegmentCount: 2,
+					More:                true,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: bucket,
+					Prefix: "a/",
+					Limit:  1,
+				},
+				Result: metabase.DeleteObjectsByPrefixResult{
+					DeletedObjectCount:  1,
+					DeletedSegmentCount: 1,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("delete prefix with ancestor", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObjStream := objectAt("a/original")
+			originalObj, originalSegments := metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream:                  originalObjStream,
+					EncryptedMetadata:             testrand.Bytes(64),
+					EncryptedMetadataNonce:        testrand.Nonce().Bytes(),
+					EncryptedMetadataEncryptedKey: testrand.Bytes(265),
+				},
+			}.Run(ctx, t, db, originalObjStream, 2)
+
+			copyObjectStream := objectAt("b/copy")
+			copyObj, _, copySegments := metabasetest.CreateObjectCopy{
+				OriginalObject:   originalObj,
+				CopyObjectStream: &copyObjectStream,
+			}.Run(ctx, t, db)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: bucket,
+					Prefix: "a/",
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						return errors.New("pieces are used by the copy")
+					},
+				},
+				Result: metabase.DeleteObjectsByPrefixResult{
+					DeletedObjectCount:  1,
+					DeletedSegmentCount: 2,
+				},
+			}.Check(ctx, t, db)
+
+			for i := range copySegments {
+				copySegments[i].Pieces = originalSegments[i].Pieces
+			}
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(copyObj),
+				},
+				Segments: copySegments,
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -0,0 +1,525 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+const exportBatchSizeLimit = intLimitRange(1000)
+
+// ExportProject contains arguments for exporting the metadata of a project.
+type ExportProject struct {
+	ProjectID uuid.UUID
+	BatchSize int
+}
+
+// Verify verifies export project request fields.
+func (opts *ExportProject) Verify() error {
+	if opts.ProjectID.IsZero() {
+		return ErrInvalidRequest.New("ProjectID missing")
+	}
+	return nil
+}
+
+// ProjectExporter receives the exported metadata of a project.
+type ProjectExporter interface {
+	// Object is called for every object.
+	Object(ctx context.Context, object RawObject) error
+	// Segment is called for every segment of the preceding object.
+	Segment(ctx context.Context, segment RawSegment) error
+	// Copy is called after the segments, when the preceding object is a
+	// server-side copy.
+	Copy(ctx context.Context, copy RawCopy) error
+}
+
+// ExportProject passes all objects of the project, with their segments and
+// copies, to the exporter. The pieces of the segments contain the node ids
+// instead of the node aliases, which are specific to the database.
+//
+// The ancestor of a copy may belong to another project, which isn't exported.
+// The segments of such a copy get the pieces of the ancestor segments instead,
+// and the copy isn't passed to the exporter. Finding the project of the
+// ancestors relies on the objects stream_id index, see CreateStreamIDIndex.
+//
+// The objects are exported in batches, so the export is consistent only
+// when the project isn't modified at the same time.
+func (db *DB) ExportProject(ctx context.Context, opts ExportProject, exporter ProjectExporter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	exportBatchSizeLimit.Ensure(&opts.BatchSize)
+
+	var cursor ObjectLocation
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		objects, err := db.exportObjectsBatch(ctx, opts, cursor)
+		if err != nil {
+			return err
+		}
+		if len(objects) == 0 {
+			return nil
+		}
+
+		streamIDs := make([]uuid.UUID, len(objects))
+		for i, object := range objects {
+			streamIDs[i] = object.StreamID
+		}
+
+		segments, err := db.exportSegments(ctx, streamIDs)
+		if err != nil {
+			return err
+		}
+		ancestors, err := db.exportCopies(ctx, streamIDs)
+		if err != nil {
+			return err
+		}
+		err = db.materializeForeignCopies(ctx, opts.ProjectID, ancestors, segments)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range objects {
+			if err := exporter.Object(ctx, object); err != nil {
+				return err
+			}
+			for _, segment := range segments[object.StreamID] {
+				if err := exporter.Segment(ctx, segment); err != nil {
+					return err
+				}
+			}
+			if ancestor, ok := ancestors[object.StreamID]; ok {
+				err := exporter.Copy(ctx, RawCopy{
+					StreamID:         object.StreamID,
+					AncestorStreamID: ancestor,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		last := objects[len(objects)-1]
+		cursor = last.Location()
+		cursor.ObjectKey = last.ObjectKey + "\x00"
+		if len(objects) < opts.BatchSize {
+			return nil
+		}
+	}
+}
+
+// exportObjectsBatch returns the objects of the project from the cursor
+// location, inclusive.
+func (db *DB) exportObjectsBatch(ctx context.Context, opts ExportProject, cursor ObjectLocation) (objects []RawObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	objects = make([]RawObject, 0, opts.BatchSize)
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			project_id, bucket_name, object_key, version, stream_id,
+			created_at, expires_at,
+			status, segment_count,
+			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+			tags,
+			total_plain_size, total_encrypted_size, fixed_segment_size,
+			encryption,
+			zombie_deletion_deadline
+		FROM objects
+		WHERE
+			project_id = $1 AND
+			(bucket_name, object_key) >= ($2, $3)
+		ORDER BY project_id, bucket_name, object_key, version
+		LIMIT $4
+	`, opts.ProjectID, []byte(cursor.BucketName), []byte(cursor.ObjectKey), opts.BatchSize,
+	))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var object RawObject
+			err := rows.Scan(
+				&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID,
+				&object.CreatedAt, &object.ExpiresAt,
+				&object.Status, &object.SegmentCount,
+				&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+				&object.Tags,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+				&object.ZombieDeletionDeadline,
+			)
+			if err != nil {
+				return Error.New("unable to scan object: %w", err)
+			}
+			objects = append(objects, object)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to export objects: %w", err)
+	}
+
+	// the last object key may have more versions than fit into the batch,
+	// so they are fetched separately.
+	if len(objects) == opts.BatchSize {
+		last := objects[len(objects)-1]
+		versions, err := db.exportObjectVersions(ctx, last)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, versions...)
+	}
+
+	return objects, nil
+}
+
+// exportObjectVersions returns the versions of the object key, which are
+// newer than the object.
+func (db *DB) exportObjectVersions(ctx context.Context, object RawObject) (objects []RawObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			project_id, bucket_name, object_key, version, stream_id,
+			created_at, expires_at,
+			status, segment_count,
+			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+			tags,
+			total_plain_size, total_encrypted_size, fixed_segment_size,
+			encryption,
+			zombie_deletion_deadline
+		FROM objects
+		WHERE
+			project_id = $1 AND
+			bucket_name = $2 AND
+			object_key = $3 AND
+			version > $4
+		ORDER BY version
+	`, object.ProjectID, []byte(object.BucketName), []byte(object.ObjectKey), object.Version,
+	))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var object RawObject
+			err := rows.Scan(
+				&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID,
+				&object.CreatedAt, &object.ExpiresAt,
+				&object.Status, &object.SegmentCount,
+				&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+				&object.Tags,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+				&object.ZombieDeletionDeadline,
+			)
+			if err != nil {
+				return Error.New("unable to scan object: %w", err)
+			}
+			objects = append(objects, object)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to export object versions: %w", err)
+	}
+	return objects, nil
+}
+
+// exportSegments returns the segments of the streams.
+func (db *DB) exportSegments(ctx context.Context, streamIDs []uuid.UUID) (segments map[uuid.UUID][]RawSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segments = make(map[uuid.UUID][]RawSegment, len(streamIDs))
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			stream_id, position,
+			created_at, repaired_at, expires_at,
+			root_piece_id, encrypted_key_nonce, encrypted_key,
+			encrypted_size,
+			plain_offset, plain_size,
+			encrypted_etag,
+			redundancy,
+			inline_data, remote_alias_pieces,
+			placement
+		FROM segments
+		WHERE stream_id = ANY ($1::BYTEA[])
+		ORDER BY stream_id, position
+	`, pgutil.UUIDArray(streamIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var segment RawSegment
+			var aliasPieces AliasPieces
+			err := rows.Scan(
+				&segment.StreamID, &segment.Position,
+				&segment.CreatedAt, &segment.RepairedAt, &segment.ExpiresAt,
+				&segment.RootPieceID, &segment.EncryptedKeyNonce, &segment.EncryptedKey,
+				&segment.EncryptedSize,
+				&segment.PlainOffset, &segment.PlainSize,
+				&segment.EncryptedETag,
+				redundancyScheme{&segment.Redundancy},
+				&segment.InlineData, &aliasPieces,
+				&segment.Placement,
+			)
+			if err != nil {
+				return Error.New("unable to scan segment: %w", err)
+			}
+
+			segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+			if err != nil {
+				return Error.New("unable to convert aliases to pieces: %w", err)
+			}
+
+			segments[segment.StreamID] = append(segments[segment.StreamID], segment)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to export segments: %w", err)
+	}
+	return segments, nil
+}
+
+// exportCopies returns the ancestors of the streams, which are copies.
+func (db *DB) exportCopies(ctx context.Context, streamIDs []uuid.UUID) (ancestors map[uuid.UUID]uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ancestors = map[uuid.UUID]uuid.UUID{}
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT stream_id, ancestor_stream_id
+		FROM segment_copies
+		WHERE stream_id = ANY ($1::BYTEA[])
+	`, pgutil.UUIDArray(streamIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID, ancestorStreamID uuid.UUID
+			if err := rows.Scan(&streamID, &ancestorStreamID); err != nil {
+				return Error.New("unable to scan copy: %w", err)
+			}
+			ancestors[streamID] = ancestorStreamID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to export copies: %w", err)
+	}
+	return ancestors, nil
+}
+
+// ImportBatch contains the exported metadata, which is inserted together.
+type ImportBatch struct {
+	Objects  []RawObject
+	Segments []RawSegment
+	Copies   []RawCopy
+}
+
+// ImportBatch inserts the exported objects, segments and server-side copies
+// in a single transaction and updates the statistics of the buckets. It
+// fails when any of the objects or segments exists already. Node aliases are
+// created for the nodes of the pieces, which don't have them yet.
+func (db *DB) ImportBatch(ctx context.Context, batch ImportBatch) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, object := range batch.Objects {
+		if err := object.ObjectStream.Verify(); err != nil {
+			return err
+		}
+	}
+
+	aliasPieces := make([]AliasPieces, len(batch.Segments))
+	for i, segment := range batch.Segments {
+		aliasPieces[i], err = db.aliasCache.ConvertPiecesToAliases(ctx, segment.Pieces)
+		if err != nil {
+			return Error.New("unable to convert pieces to aliases: %w", err)
+		}
+	}
+
+	return txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		if err := importSegments(ctx, tx, batch.Segments, aliasPieces); err != nil {
+			return err
+		}
+		if err := importCopies(ctx, tx, batch.Copies); err != nil {
+			return err
+		}
+		return importObjects(ctx, tx, batch.Objects)
+	})
+}
+
+// importObjects inserts the exported objects and the deltas of the bucket
+// statistics.
+func importObjects(ctx context.Context, tx tagsql.Tx, objects []RawObject) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deltas := bucketStatsDeltas{}
+	for _, object := range objects {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				created_at, expires_at,
+				status, segment_count,
+				encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+				tags,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption,
+				zombie_deletion_deadline
+			) VALUES (
+				$1, $2, $3, $4, $5,
+				$6, $7,
+				$8, $9,
+				$10, $11, $12,
+				$13::JSONB,
+				$14, $15, $16,
+				$17,
+				$18
+			)
+		`, object.ProjectID, []byte(object.BucketName), object.ObjectKey, object.Version, object.StreamID,
+			object.CreatedAt, object.ExpiresAt,
+			object.Status, object.SegmentCount,
+			object.EncryptedMetadataNonce, object.EncryptedMetadata, object.EncryptedMetadataEncryptedKey,
+			object.Tags,
+			object.TotalPlainSize, object.TotalEncryptedSize, object.FixedSegmentSize,
+			encryptionParameters{&object.Encryption},
+			object.ZombieDeletionDeadline,
+		)
+		if err != nil {
+			return Error.New("unable to import object: %w", err)
+		}
+		deltas.add(Object(object))
+	}
+	return insertBucketStatsDeltas(ctx, tx, deltas)
+}
+
+// importSegments inserts the exported segments with the node aliases of
+// their pieces.
+func importSegments(ctx context.Context, tx tagsql.Tx, segments []RawSegment, aliasPieces []AliasPieces) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for i, segment := range segments {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO segments (
+				stream_id, position,
+				created_at, repaired_at, expires_at,
+				root_piece_id, encrypted_key_nonce, encrypted_key,
+				encrypted_size,
+				plain_offset, plain_size,
+				encrypted_etag,
+				redundancy,
+				inline_data, remote_alias_pieces,
+				placement
+			) VALUES (
+				$1, $2,
+				$3, $4, $5,
+				$6, $7, $8,
+				$9,
+				$10, $11,
+				$12,
+				$13,
+				$14, $15,
+				$16
+			)
+		`, segment.StreamID, segment.Position,
+			segment.CreatedAt, segment.RepairedAt, segment.ExpiresAt,
+			segment.RootPieceID, segment.EncryptedKeyNonce, segment.EncryptedKey,
+			segment.EncryptedSize,
+			segment.PlainOffset, segment.PlainSize,
+			segment.EncryptedETag,
+			redundancyScheme{&segment.Redundancy},
+			segment.InlineData, aliasPieces[i],
+			segment.Placement,
+		)
+		if err != nil {
+			return Error.New("unable to import segment: %w", err)
+		}
+	}
+	return nil
+}
+
+// importCopies inserts the exported server-side copies.
+func importCopies(ctx context.Context, tx tagsql.Tx, copies []RawCopy) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(copies) == 0 {
+		return nil
+	}
+
+	streamIDs := make([]uuid.UUID, len(copies))
+	ancestorStreamIDs := make([]uuid.UUID, len(copies))
+	for i, copy := range copies {
+		streamIDs[i] = copy.StreamID
+		ancestorStreamIDs[i] = copy.AncestorStreamID
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO segment_copies (stream_id, ancestor_stream_id)
+		SELECT UNNEST($1::BYTEA[]), UNNEST($2::BYTEA[])
+	`, pgutil.UUIDArray(streamIDs), pgutil.UUIDArray(ancestorStreamIDs))
+	if err != nil {
+		return Error.New("unable to import copies: %w", err)
+	}
+	return nil
+}
+
+// materializeForeignCopies replaces the copies, whose ancestors don't belong
+// to the project, with the pieces of the ancestor segments. The replaced
+// copies are removed from ancestors.
+func (db *DB) materializeForeignCopies(ctx context.Context, projectID uuid.UUID, ancestors map[uuid.UUID]uuid.UUID, segments map[uuid.UUID][]RawSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(ancestors) == 0 {
+		return nil
+	}
+
+	ancestorStreamIDs := make([]uuid.UUID, 0, len(ancestors))
+	for _, ancestor := range ancestors {
+		ancestorStreamIDs = append(ancestorStreamIDs, ancestor)
+	}
+	projects, err := db.GetStreamProjectIDs(ctx, ancestorStreamIDs)
+	if err != nil {
+		return err
+	}
+
+	foreign := map[uuid.UUID]uuid.UUID{}
+	var foreignAncestors []uuid.UUID
+	for streamID, ancestor := range ancestors {
+		if projects[ancestor] != projectID {
+			foreign[streamID] = ancestor
+			foreignAncestors = append(foreignAncestors, ancestor)
+		}
+	}
+	if len(foreign) == 0 {
+		return nil
+	}
+
+	ancestorSegments, err := db.exportSegments(ctx, foreignAncestors)
+	if err != nil {
+		return err
+	}
+
+	for streamID, ancestor := range foreign {
+		byPosition := make(map[SegmentPosition]RawSegment, len(ancestorSegments[ancestor]))
+		for _, segment := range ancestorSegments[ancestor] {
+			byPosition[segment.Position] = segment
+		}
+
+		copySegments := segments[streamID]
+		for i, segment := range copySegments {
+			// the segments of a copy have only the inline data, the pieces
+			// are in the ancestor segments.
+			if segment.EncryptedSize == 0 || len(segment.InlineData) > 0 || len(segment.Pieces) > 0 {
+				continue
+			}
+			ancestorSegment, ok := byPosition[segment.Position]
+			if !ok {
+				return Error.New("segment %v of ancestor %s of copy %s is missing", segment.Position, ancestor, streamID)
+			}
+			copySegments[i].RootPieceID = ancestorSegment.RootPieceID
+			copySegments[i].RepairedAt = ancestorSegment.RepairedAt
+			copySegments[i].Pieces = ancestorSegment.Pieces
+		}
+		delete(ancestors, streamID)
+	}
+
+	return nil
+}
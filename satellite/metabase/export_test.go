@@ -0,0 +1,115 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+type collectingExporter struct {
+	metabase.RawState
+}
+
+func (exporter *collectingExporter) Object(ctx context.Context, object metabase.RawObject) error {
+	exporter.Objects = append(exporter.Objects, object)
+	return nil
+}
+
+func (exporter *collectingExporter) Segment(ctx context.Context, segment metabase.RawSegment) error {
+	exporter.Segments = append(exporter.Segments, segment)
+	return nil
+}
+
+func (exporter *collectingExporter) Copy(ctx context.Context, copy metabase.RawCopy) error {
+	exporter.Copies = append(exporter.Copies, copy)
+	return nil
+}
+
+func TestExportProject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			err := db.ExportProject(ctx, metabase.ExportProject{}, &collectingExporter{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+			require.Contains(t, err.Error(), "ProjectID missing")
+		})
+
+		t.Run("export", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			// another project isn't exported.
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			var streams []metabase.ObjectStream
+			for _, bucketName := range []string{"bucket-a", "bucket-b"} {
+				for _, key := range []metabase.ObjectKey{"a", "b", "c"} {
+					stream := metabasetest.RandObjectStream()
+					stream.ProjectID = obj.ProjectID
+					stream.BucketName = bucketName
+					stream.ObjectKey = key
+					streams = append(streams, stream)
+				}
+			}
+
+			// several versions of the same key around the batch boundary.
+			streams[1].Version = 1
+			streams[2].ObjectKey = streams[1].ObjectKey
+			streams[2].Version = 2
+
+			original := metabasetest.CreateObject(ctx, t, db, streams[0], 2)
+			metabasetest.CreateObject(ctx, t, db, streams[1], 1)
+			metabasetest.CreatePendingObject(ctx, t, db, streams[2], 1)
+			metabasetest.CreateObject(ctx, t, db, streams[3], 0)
+			metabasetest.CreateObject(ctx, t, db, streams[4], 3)
+
+			copyStream := streams[5]
+			metabasetest.CreateObjectCopy{
+				OriginalObject:   original,
+				CopyObjectStream: &copyStream,
+			}.Run(ctx, t, db)
+
+			state, err := db.TestingGetState(ctx)
+			require.NoError(t, err)
+
+			expected := metabase.RawState{Copies: state.Copies}
+			inProject := map[uuid.UUID]bool{}
+			for _, object := range state.Objects {
+				if object.ProjectID == obj.ProjectID {
+					expected.Objects = append(expected.Objects, object)
+					inProject[object.StreamID] = true
+				}
+			}
+			for _, segment := range state.Segments {
+				if inProject[segment.StreamID] {
+					expected.Segments = append(expected.Segments, segment)
+				}
+			}
+
+			exporter := &collectingExporter{}
+			err = db.ExportProject(ctx, metabase.ExportProject{
+				ProjectID: obj.ProjectID,
+				BatchSize: 2,
+			}, exporter)
+			require.NoError(t, err)
+
+			require.ElementsMatch(t, expected.Objects, exporter.Objects)
+			require.ElementsMatch(t, expected.Segments, exporter.Segments)
+			require.ElementsMatch(t, expected.Copies, exporter.Copies)
+			require.Len(t, exporter.Objects, 6)
+			require.Len(t, exporter.Segments, 9)
+			require.Len(t, exporter.Copies, 1)
+		})
+	})
+}
@@ -6,6 +6,7 @@ package metabase
 import (
 	"bytes"
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/zeebo/errs"
@@ -27,6 +28,9 @@ type objectsIterator struct {
 	recursive             bool
 	includeCustomMetadata bool
 	includeSystemMetadata bool
+	tags                  ObjectTags
+	delimiter             byte
+	reverse               bool
 
 	curIndex int
 	curRows  tagsql.Rows
@@ -49,6 +53,11 @@ type iterateCursor struct {
 func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	delimiter := opts.Delimiter
+	if delimiter == 0 {
+		delimiter = Delimiter
+	}
+
 	it := &objectsIterator{
 		db: db,
 
@@ -61,19 +70,44 @@ func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjec
 		recursive:             opts.Recursive,
 		includeCustomMetadata: opts.IncludeCustomMetadata,
 		includeSystemMetadata: opts.IncludeSystemMetadata,
+		tags:                  opts.Tags,
+		delimiter:             delimiter,
+		reverse:               opts.Reverse,
 
 		curIndex: 0,
-		cursor:   firstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
+		cursor:   firstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix, delimiter, opts.Reverse),
 
 		doNextQuery: doNextQueryAllVersionsWithStatus,
 	}
 
+	if opts.Reverse {
+		// start from either the cursor or the end of the prefix, depending on which is smaller.
+		// Empty cursor means the end of the bucket.
+		if it.cursor.Key == "" || (it.prefixLimit != "" && !lessKey(it.cursor.Key, it.prefixLimit)) {
+			it.cursor.Key = it.prefixLimit
+			it.cursor.Version = 0
+		}
+		// versions start from 1, so all the versions of StartAfter are skipped.
+		if opts.StartAfter != "" && (it.cursor.Key == "" || !lessKey(it.cursor.Key, opts.StartAfter)) {
+			it.cursor.Key = opts.StartAfter
+			it.cursor.Version = 0
+		}
+
+		return iterate(ctx, it, fn)
+	}
+
 	// start from either the cursor or prefix, depending on which is larger
 	if lessKey(it.cursor.Key, opts.Prefix) {
 		it.cursor.Key = opts.Prefix
 		it.cursor.Version = -1
 		it.cursor.Inclusive = true
 	}
+	// the first key after StartAfter is StartAfter with a zero byte appended.
+	if opts.StartAfter != "" && !lessKey(opts.StartAfter, it.cursor.Key) {
+		it.cursor.Key = opts.StartAfter + "\x00"
+		it.cursor.Version = -1
+		it.cursor.Inclusive = true
+	}
 
 	return iterate(ctx, it, fn)
 }
@@ -98,6 +132,7 @@ func iteratePendingObjectsByKey(ctx context.Context, db *DB, opts IteratePending
 		recursive:             true,
 		includeCustomMetadata: true,
 		includeSystemMetadata: true,
+		delimiter:             Delimiter,
 
 		curIndex: 0,
 		cursor: iterateCursor{
@@ -155,7 +190,7 @@ func (it *objectsIterator) Next(ctx context.Context, item *ObjectEntry) bool {
 	}
 
 	// should this be treated as a prefix?
-	p := strings.IndexByte(string(item.ObjectKey), Delimiter)
+	p := strings.IndexByte(string(item.ObjectKey), it.delimiter)
 	if p >= 0 {
 		it.skipPrefix = item.ObjectKey[:p+1]
 		*item = ObjectEntry{
@@ -182,9 +217,14 @@ func (it *objectsIterator) next(ctx context.Context, item *ObjectEntry) bool {
 
 		if !it.recursive {
 			afterPrefix := it.cursor.Key[len(it.prefix):]
-			p := bytes.IndexByte([]byte(afterPrefix), Delimiter)
+			p := bytes.IndexByte([]byte(afterPrefix), it.delimiter)
 			if p >= 0 {
-				it.cursor.Key = it.prefix + prefixLimit(afterPrefix[:p+1])
+				if it.reverse {
+					// all the keys with the prefix are larger than the prefix.
+					it.cursor.Key = it.prefix + afterPrefix[:p+1]
+				} else {
+					it.cursor.Key = it.prefix + prefixLimit(afterPrefix[:p+1])
+				}
 				it.cursor.StreamID = uuid.UUID{}
 				it.cursor.Version = 0
 			}
@@ -256,7 +296,12 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 		querySelectFields += `
 			,encrypted_metadata_nonce
 			,encrypted_metadata
-			,encrypted_metadata_encrypted_key`
+			,encrypted_metadata_encrypted_key
+			,tags`
+	}
+
+	if it.reverse {
+		return doNextQueryReverse(ctx, it, querySelectFields)
 	}
 
 	cursorCompare := ">"
@@ -265,6 +310,19 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 	}
 
 	if it.prefixLimit == "" {
+		args := []interface{}{
+			it.projectID, it.bucketName,
+			it.status,
+			[]byte(it.cursor.Key), int(it.cursor.Version),
+			it.batchSize,
+			nextBucket(it.bucketName),
+		}
+		tagsFilter := ""
+		if len(it.tags) > 0 {
+			tagsFilter = `AND tags @> $8::JSONB`
+			args = append(args, it.tags)
+		}
+
 		return it.db.db.QueryContext(ctx, `
 			SELECT
 				`+querySelectFields+`
@@ -274,14 +332,24 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 				AND (project_id, bucket_name) < ($1, $7)
 				AND status = $3
 				AND (expires_at IS NULL OR expires_at > now())
+				`+tagsFilter+`
 				ORDER BY (project_id, bucket_name, object_key, version) ASC
 			LIMIT $6
-			`, it.projectID, it.bucketName,
-			it.status,
-			[]byte(it.cursor.Key), int(it.cursor.Version),
-			it.batchSize,
-			nextBucket(it.bucketName),
-		)
+			`, args...)
+	}
+
+	args := []interface{}{
+		it.projectID, it.bucketName,
+		it.status,
+		[]byte(it.cursor.Key), int(it.cursor.Version),
+		[]byte(it.prefixLimit),
+		it.batchSize,
+		// len(it.prefix)+1, // TODO uncomment when CRDB issue will be fixed
+	}
+	tagsFilter := ""
+	if len(it.tags) > 0 {
+		tagsFilter = `AND tags @> $8::JSONB`
+		args = append(args, it.tags)
 	}
 
 	// TODO this query should use SUBSTRING(object_key from $8) but there is a problem how it
@@ -295,15 +363,50 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 			AND (project_id, bucket_name, object_key) < ($1, $2, $6)
 			AND status = $3
 			AND (expires_at IS NULL OR expires_at > now())
+			`+tagsFilter+`
 			ORDER BY (project_id, bucket_name, object_key, version) ASC
 		LIMIT $7
-	`, it.projectID, it.bucketName,
+	`, args...)
+}
+
+// doNextQueryReverse executes query to fetch the next batch of a descending
+// iteration.
+func doNextQueryReverse(ctx context.Context, it *objectsIterator, querySelectFields string) (_ tagsql.Rows, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	args := []interface{}{
+		it.projectID, it.bucketName,
 		it.status,
-		[]byte(it.cursor.Key), int(it.cursor.Version),
-		[]byte(it.prefixLimit),
+		[]byte(it.prefix),
 		it.batchSize,
-		// len(it.prefix)+1, // TODO uncomment when CRDB issue will be fixed
-	)
+	}
+
+	// empty cursor key means the end of the bucket.
+	upperBound := `(project_id, bucket_name) <= ($1, $2)`
+	if it.cursor.Key != "" {
+		args = append(args, []byte(it.cursor.Key), int(it.cursor.Version))
+		upperBound = `(project_id, bucket_name, object_key, version) < ($1, $2, $6, $7)`
+	}
+
+	tagsFilter := ""
+	if len(it.tags) > 0 {
+		args = append(args, it.tags)
+		tagsFilter = `AND tags @> $` + strconv.Itoa(len(args)) + `::JSONB`
+	}
+
+	return it.db.db.QueryContext(ctx, `
+		SELECT
+			`+querySelectFields+`
+		FROM objects
+		WHERE
+			`+upperBound+`
+			AND (project_id, bucket_name, object_key) >= ($1, $2, $4)
+			AND status = $3
+			AND (expires_at IS NULL OR expires_at > now())
+			`+tagsFilter+`
+			ORDER BY project_id DESC, bucket_name DESC, object_key DESC, version DESC
+		LIMIT $5
+	`, args...)
 }
 
 // nextBucket returns the lexicographically next bucket.
@@ -323,7 +426,8 @@ func doNextQueryStreamsByKey(ctx context.Context, it *objectsIterator) (_ tagsql
 				created_at, expires_at,
 				segment_count,
 				total_plain_size, total_encrypted_size, fixed_segment_size,
-				encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key
+				encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+				tags
 			FROM objects
 			WHERE
 				project_id = $1 AND bucket_name = $2
@@ -367,6 +471,7 @@ func (it *objectsIterator) scanItem(item *ObjectEntry) (err error) {
 			&item.EncryptedMetadataNonce,
 			&item.EncryptedMetadata,
 			&item.EncryptedMetadataEncryptedKey,
+			&item.Tags,
 		)
 	}
 
@@ -378,16 +483,22 @@ func (it *objectsIterator) scanItem(item *ObjectEntry) (err error) {
 	return nil
 }
 
+// prefixLimit returns the smallest key, which is larger than all the keys
+// with the prefix. It returns an empty key, when there's no such key, i.e.
+// the prefix consists only of 0xFF bytes.
 func prefixLimit(a ObjectKey) ObjectKey {
-	if a == "" {
-		return ""
+	// the keys with the prefix can continue with 0xFF, so the trailing 0xFF
+	// bytes can't be incremented.
+	end := len(a)
+	for end > 0 && a[end-1] == 0xFF {
+		end--
 	}
-	if a[len(a)-1] == 0xFF {
-		return a + "\x00"
+	if end == 0 {
+		return ""
 	}
 
-	key := []byte(a)
-	key[len(key)-1]++
+	key := []byte(a[:end])
+	key[end-1]++
 	return ObjectKey(key)
 }
 
@@ -399,7 +510,7 @@ func lessKey(a, b ObjectKey) bool {
 // firstIterateCursor adjust the cursor for a non-recursive iteration.
 // The cursor is non-inclusive and we need to adjust to handle prefix as cursor properly.
 // We return the next possible key from the prefix.
-func firstIterateCursor(recursive bool, cursor IterateCursor, prefix ObjectKey) iterateCursor {
+func firstIterateCursor(recursive bool, cursor IterateCursor, prefix ObjectKey, delimiter byte, reverse bool) iterateCursor {
 	if recursive {
 		return iterateCursor{
 			Key:     cursor.Key,
@@ -419,9 +530,10 @@ func firstIterateCursor(recursive bool, cursor IterateCursor, prefix ObjectKey)
 	//   prefix: x/y/
 	//   cursor: x/y/z/w
 	// In this case, we want the skip prefix to be `x/y/z` + string('/' + 1).
+	// A descending iteration continues before `x/y/z/` instead.
 
 	cursorWithoutPrefix := cursor.Key[len(prefix):]
-	p := strings.IndexByte(string(cursorWithoutPrefix), Delimiter)
+	p := strings.IndexByte(string(cursorWithoutPrefix), delimiter)
 	if p < 0 {
 		// The cursor is not a prefix, but instead a path inside the prefix,
 		// so we can use it directly.
@@ -431,9 +543,16 @@ func firstIterateCursor(recursive bool, cursor IterateCursor, prefix ObjectKey)
 		}
 	}
 
+	if reverse {
+		return iterateCursor{
+			Key:     cursor.Key[:len(prefix)+p+1],
+			Version: 0,
+		}
+	}
+
 	// return the next prefix given a scoped path
 	return iterateCursor{
-		Key:       cursor.Key[:len(prefix)+p] + ObjectKey(Delimiter+1),
+		Key:       prefixLimit(cursor.Key[:len(prefix)+p+1]),
 		Version:   -1,
 		Inclusive: true,
 	}
@@ -604,6 +604,277 @@ func TestIterateObjectsWithStatus(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("reverse", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+			projectID, bucketName := uuid.UUID{1}, "bucky"
+
+			objects := createObjectsWithKeys(ctx, t, db, projectID, bucketName, []metabase.ObjectKey{
+				"a",
+				"b/1",
+				"b/2",
+				"b/3",
+				"c",
+				"c/",
+				"c//",
+				"c/1",
+				"g",
+			})
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Recursive:             true,
+					BatchSize:             2,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+					Reverse:               true,
+				},
+				Result: []metabase.ObjectEntry{
+					objects["g"],
+					objects["c/1"],
+					objects["c//"],
+					objects["c/"],
+					objects["c"],
+					objects["b/3"],
+					objects["b/2"],
+					objects["b/1"],
+					objects["a"],
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					BatchSize:             1,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+					Reverse:               true,
+				},
+				Result: []metabase.ObjectEntry{
+					objects["g"],
+					prefixEntry("c/", metabase.Committed),
+					objects["c"],
+					prefixEntry("b/", metabase.Committed),
+					objects["a"],
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+					Reverse:               true,
+
+					Cursor: metabase.IterateCursor{Key: "c/", Version: 1},
+				},
+				Result: []metabase.ObjectEntry{
+					objects["c"],
+					prefixEntry("b/", metabase.Committed),
+					objects["a"],
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+					Reverse:               true,
+
+					Prefix: "b/",
+				},
+				Result: withoutPrefix("b/",
+					objects["b/3"],
+					objects["b/2"],
+					objects["b/1"],
+				),
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("start after", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+			projectID, bucketName := uuid.UUID{1}, "bucky"
+
+			objects := createObjectsWithKeys(ctx, t, db, projectID, bucketName, []metabase.ObjectKey{
+				"a",
+				"b/1",
+				"b/2",
+				"b/3",
+				"c",
+				"c/",
+				"c//",
+				"c/1",
+				"g",
+			})
+
+			// unlike the cursor, StartAfter doesn't skip the rest of the prefix.
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+
+					StartAfter: "b/1",
+				},
+				Result: []metabase.ObjectEntry{
+					prefixEntry("b/", metabase.Committed),
+					objects["c"],
+					prefixEntry("c/", metabase.Committed),
+					objects["g"],
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+
+					Cursor: metabase.IterateCursor{Key: "b/1", Version: 1},
+				},
+				Result: []metabase.ObjectEntry{
+					objects["c"],
+					prefixEntry("c/", metabase.Committed),
+					objects["g"],
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Recursive:             true,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+
+					StartAfter: "c/",
+				},
+				Result: []metabase.ObjectEntry{
+					objects["c//"],
+					objects["c/1"],
+					objects["g"],
+				},
+			}.Check(ctx, t, db)
+
+			// the larger of the cursor and StartAfter is used.
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Recursive:             true,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+
+					Cursor:     metabase.IterateCursor{Key: "c/1", Version: 1},
+					StartAfter: "c/",
+				},
+				Result: []metabase.ObjectEntry{
+					objects["g"],
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Recursive:             true,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+					Reverse:               true,
+
+					StartAfter: "c/",
+				},
+				Result: []metabase.ObjectEntry{
+					objects["c"],
+					objects["b/3"],
+					objects["b/2"],
+					objects["b/1"],
+					objects["a"],
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("custom delimiter", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+			projectID, bucketName := uuid.UUID{1}, "bucky"
+
+			objects := createObjectsWithKeys(ctx, t, db, projectID, bucketName, []metabase.ObjectKey{
+				"a|1",
+				"a|2",
+				"b",
+				"c|x/y",
+				"d/e",
+			})
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					BatchSize:             1,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+					Delimiter:             '|',
+				},
+				Result: []metabase.ObjectEntry{
+					prefixEntry("a|", metabase.Committed),
+					objects["b"],
+					prefixEntry("c|", metabase.Committed),
+					objects["d/e"],
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+					Delimiter:             '|',
+
+					Prefix: "c|",
+				},
+				Result: withoutPrefix("c|",
+					objects["c|x/y"],
+				),
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+					Delimiter:             '|',
+					Reverse:               true,
+				},
+				Result: []metabase.ObjectEntry{
+					objects["d/e"],
+					prefixEntry("c|", metabase.Committed),
+					objects["b"],
+					prefixEntry("a|", metabase.Committed),
+				},
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("boundaries", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 			projectID, bucketName := uuid.UUID{1}, "bucky"
@@ -736,6 +1007,86 @@ func TestIterateObjectsWithStatus(t *testing.T) {
 			}
 		})
 
+		t.Run("filter by tags", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			projectID, bucketName := uuid.UUID{1}, "bucky"
+			tags := map[metabase.ObjectKey]metabase.ObjectTags{
+				"p/a": {"project": "apollo", "stage": "1"},
+				"p/b": {"project": "apollo", "stage": "2"},
+				"p/c": {"project": "gemini", "stage": "1"},
+				"p/d": nil,
+				"q/a": {"project": "apollo", "stage": "1"},
+			}
+
+			objects := map[metabase.ObjectKey]metabase.Object{}
+			for _, key := range []metabase.ObjectKey{"p/a", "p/b", "p/c", "p/d", "q/a"} {
+				obj := metabasetest.RandObjectStream()
+				obj.ProjectID = projectID
+				obj.BucketName = bucketName
+				obj.ObjectKey = key
+				objects[key], _ = metabasetest.CreateTestObject{
+					CommitObject: &metabase.CommitObject{
+						ObjectStream: obj,
+						Encryption:   metabasetest.DefaultEncryption,
+						Tags:         tags[key],
+					},
+				}.Run(ctx, t, db, obj, 0)
+			}
+
+			entry := func(key metabase.ObjectKey) metabase.ObjectEntry {
+				object := objects["p/"+key]
+				return metabase.ObjectEntry{
+					ObjectKey:  key,
+					Version:    object.Version,
+					StreamID:   object.StreamID,
+					CreatedAt:  object.CreatedAt,
+					Status:     metabase.Committed,
+					Encryption: metabasetest.DefaultEncryption,
+					Tags:       tags["p/"+key],
+				}
+			}
+
+			for _, test := range []struct {
+				tags     metabase.ObjectTags
+				expected []metabase.ObjectEntry
+			}{
+				{nil, []metabase.ObjectEntry{entry("a"), entry("b"), entry("c"), entry("d")}},
+				{metabase.ObjectTags{"project": "apollo"}, []metabase.ObjectEntry{entry("a"), entry("b")}},
+				{metabase.ObjectTags{"stage": "1"}, []metabase.ObjectEntry{entry("a"), entry("c")}},
+				{metabase.ObjectTags{"project": "apollo", "stage": "2"}, []metabase.ObjectEntry{entry("b")}},
+				{metabase.ObjectTags{"project": "mercury"}, nil},
+			} {
+				metabasetest.IterateObjectsWithStatus{
+					Opts: metabase.IterateObjectsWithStatus{
+						ProjectID:             projectID,
+						BucketName:            bucketName,
+						Prefix:                "p/",
+						Recursive:             true,
+						BatchSize:             1,
+						Status:                metabase.Committed,
+						IncludeCustomMetadata: true,
+						IncludeSystemMetadata: true,
+						Tags:                  test.tags,
+					},
+					Result: test.expected,
+				}.Check(ctx, t, db)
+			}
+
+			// the tags aren't indexed, so the filter requires a prefix.
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:  projectID,
+					BucketName: bucketName,
+					Recursive:  true,
+					Status:     metabase.Committed,
+					Tags:       metabase.ObjectTags{"project": "apollo"},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Tags filter requires a Prefix",
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("exclude custom metadata", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -1449,6 +1800,7 @@ func objectEntryFromRaw(m metabase.RawObject) metabase.ObjectEntry {
 		EncryptedMetadataNonce:        m.EncryptedMetadataNonce,
 		EncryptedMetadata:             m.EncryptedMetadata,
 		EncryptedMetadataEncryptedKey: m.EncryptedMetadataEncryptedKey,
+		Tags:                          m.Tags,
 		TotalEncryptedSize:            m.TotalEncryptedSize,
 		FixedSegmentSize:              m.FixedSegmentSize,
 		Encryption:                    m.Encryption,
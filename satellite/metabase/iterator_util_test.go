@@ -19,11 +19,14 @@ func TestPrefixLimit(t *testing.T) {
 		{"", ""},
 		{"a", "b"},
 		{"\xF1", "\xF2"},
-		{"\xFF", "\xFF\x00"},
+		{"a\xFF", "b"},
+		{"a\xF1\xFF\xFF", "a\xF2"},
+		{"\xFF", ""},
+		{"\xFF\xFF", ""},
 	}
 	for _, test := range tests {
 		require.Equal(t, test.exp, prefixLimit(test.in))
-		if test.in != "" {
+		if test.exp != "" {
 			require.True(t, lessKey(test.in, test.exp))
 		}
 	}
@@ -34,25 +37,47 @@ func TestFirstIterateCursor(t *testing.T) {
 
 	assert.Equal(t,
 		iterateCursor{Key: "a"},
-		firstIterateCursor(false, IterateCursor{Key: "a"}, ""))
+		firstIterateCursor(false, IterateCursor{Key: "a"}, "", Delimiter, false))
 
 	assert.Equal(t,
 		iterateCursor{Key: "a" + afterDelimiter, Version: -1, Inclusive: true},
-		firstIterateCursor(false, IterateCursor{Key: "a/"}, ""))
+		firstIterateCursor(false, IterateCursor{Key: "a/"}, "", Delimiter, false))
 
 	assert.Equal(t,
 		iterateCursor{Key: "a" + afterDelimiter, Version: -1, Inclusive: true},
-		firstIterateCursor(false, IterateCursor{Key: "a/x/y"}, ""))
+		firstIterateCursor(false, IterateCursor{Key: "a/x/y"}, "", Delimiter, false))
 
 	assert.Equal(t,
 		iterateCursor{Key: "a/x/y"},
-		firstIterateCursor(false, IterateCursor{Key: "a/x/y"}, "a/x/"))
+		firstIterateCursor(false, IterateCursor{Key: "a/x/y"}, "a/x/", Delimiter, false))
 
 	assert.Equal(t,
 		iterateCursor{Key: "2017/05/08" + afterDelimiter, Version: -1, Inclusive: true},
-		firstIterateCursor(false, IterateCursor{Key: "2017/05/08/"}, "2017/05/"))
+		firstIterateCursor(false, IterateCursor{Key: "2017/05/08/"}, "2017/05/", Delimiter, false))
 
 	assert.Equal(t,
 		iterateCursor{Key: "2017/05/08" + afterDelimiter, Version: -1, Inclusive: true},
-		firstIterateCursor(false, IterateCursor{Key: "2017/05/08/x/y"}, "2017/05/"))
+		firstIterateCursor(false, IterateCursor{Key: "2017/05/08/x/y"}, "2017/05/", Delimiter, false))
+
+	// custom delimiter.
+	assert.Equal(t,
+		iterateCursor{Key: "a" + ObjectKey('|'+1), Version: -1, Inclusive: true},
+		firstIterateCursor(false, IterateCursor{Key: "a|x/y"}, "", '|', false))
+
+	assert.Equal(t,
+		iterateCursor{Key: "a/x/y"},
+		firstIterateCursor(false, IterateCursor{Key: "a/x/y"}, "", '|', false))
+
+	// descending iteration continues before the prefix.
+	assert.Equal(t,
+		iterateCursor{Key: "a"},
+		firstIterateCursor(false, IterateCursor{Key: "a"}, "", Delimiter, true))
+
+	assert.Equal(t,
+		iterateCursor{Key: "2017/05/08/"},
+		firstIterateCursor(false, IterateCursor{Key: "2017/05/08/x/y"}, "2017/05/", Delimiter, true))
+
+	assert.Equal(t,
+		iterateCursor{Key: "a/x/y", Version: 3},
+		firstIterateCursor(true, IterateCursor{Key: "a/x/y", Version: 3}, "", Delimiter, true))
 }
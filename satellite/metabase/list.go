@@ -29,6 +29,8 @@ type ObjectEntry struct {
 	EncryptedMetadata             []byte
 	EncryptedMetadataEncryptedKey []byte
 
+	Tags ObjectTags
+
 	TotalPlainSize     int64
 	TotalEncryptedSize int64
 	FixedSegmentSize   int32
@@ -72,6 +74,22 @@ type IterateObjectsWithStatus struct {
 	Status                ObjectStatus
 	IncludeCustomMetadata bool
 	IncludeSystemMetadata bool
+
+	// Tags limits the iteration to the objects, which have all the tags.
+	// The tags aren't indexed, so the filter requires a Prefix, which
+	// limits the scanned objects.
+	Tags ObjectTags
+
+	// StartAfter limits the iteration to the keys after it. Unlike the
+	// cursor, it doesn't skip the rest of a prefix in a non-recursive
+	// iteration.
+	StartAfter ObjectKey
+	// Delimiter separates the prefixes in a non-recursive iteration. Zero
+	// value uses the default Delimiter.
+	Delimiter byte
+	// Reverse iterates the objects in descending order. The cursor and
+	// StartAfter are upper bounds in that case.
+	Reverse bool
 }
 
 // IterateObjectsAllVersionsWithStatus iterates through all versions of all objects with specified status.
@@ -94,8 +112,13 @@ func (opts *IterateObjectsWithStatus) Verify() error {
 		return ErrInvalidRequest.New("BatchSize is negative")
 	case !(opts.Status == Pending || opts.Status == Committed):
 		return ErrInvalidRequest.New("Status %v is not supported", opts.Status)
+	case opts.Delimiter == 0xFF:
+		return ErrInvalidRequest.New("Delimiter 0xFF is not supported")
+	case len(opts.Tags) > 0 && opts.Prefix == "":
+		// the tags aren't indexed, so the filter is limited to a prefix.
+		return ErrInvalidRequest.New("Tags filter requires a Prefix")
 	}
-	return nil
+	return opts.Tags.Verify()
 }
 
 // IteratePendingObjectsByKey iterates through all streams of pending objects with the same ObjectKey.
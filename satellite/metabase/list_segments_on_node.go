@@ -0,0 +1,92 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+)
+
+// StreamPosition identifies a segment by its stream and position.
+type StreamPosition struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+}
+
+// ListSegmentsOnNode contains arguments necessary for listing the segments
+// which have a piece on a node.
+type ListSegmentsOnNode struct {
+	NodeID storj.NodeID
+	// Cursor is the last segment scanned by the previous call.
+	Cursor StreamPosition
+	// Limit is the number of segments scanned, not the number of segments
+	// returned.
+	Limit int
+}
+
+// ListSegmentsOnNodeResult result of listing the segments on a node.
+type ListSegmentsOnNodeResult struct {
+	Segments []StreamPosition
+	// Cursor is the last segment scanned.
+	Cursor StreamPosition
+	More   bool
+}
+
+// ListSegmentsOnNode scans the segments after the cursor and returns the ones
+// which have a piece on the node. The segments table isn't indexed by node, so
+// every segment needs to be scanned to find all of them.
+func (db *DB) ListSegmentsOnNode(ctx context.Context, opts ListSegmentsOnNode) (result ListSegmentsOnNodeResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.NodeID.IsZero() {
+		return ListSegmentsOnNodeResult{}, ErrInvalidRequest.New("NodeID missing")
+	}
+	if opts.Limit < 0 {
+		return ListSegmentsOnNodeResult{}, ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+	loopIteratorBatchSizeLimit.Ensure(&opts.Limit)
+
+	aliases, err := db.aliasCache.Aliases(ctx, []storj.NodeID{opts.NodeID})
+	if err != nil {
+		return ListSegmentsOnNodeResult{}, Error.Wrap(err)
+	}
+	alias := aliases[0]
+
+	result.Cursor = opts.Cursor
+	scanned := 0
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT stream_id, position, remote_alias_pieces
+		FROM segments
+		WHERE (stream_id, position) > ($1, $2)
+		ORDER BY (stream_id, position) ASC
+		LIMIT $3
+	`, opts.Cursor.StreamID, opts.Cursor.Position, opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var location StreamPosition
+			var aliasPieces AliasPieces
+			if err := rows.Scan(&location.StreamID, &location.Position, &aliasPieces); err != nil {
+				return Error.New("failed to scan segments: %w", err)
+			}
+			scanned++
+			result.Cursor = location
+
+			for _, piece := range aliasPieces {
+				if piece.Alias == alias {
+					result.Segments = append(result.Segments, location)
+					break
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return ListSegmentsOnNodeResult{}, Error.Wrap(err)
+	}
+
+	result.More = scanned == opts.Limit
+	return result, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestListSegmentsOnNode(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("NodeID missing", func(t *testing.T) {
+			_, err := db.ListSegmentsOnNode(ctx, metabase.ListSegmentsOnNode{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("segments on node", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			for i := 0; i < 3; i++ {
+				metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+			}
+
+			result, err := db.ListSegmentsOnNode(ctx, metabase.ListSegmentsOnNode{
+				NodeID: storj.NodeID{3},
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Segments)
+			require.False(t, result.More)
+
+			// the segments created by metabasetest have a piece on node 2.
+			result, err = db.ListSegmentsOnNode(ctx, metabase.ListSegmentsOnNode{
+				NodeID: storj.NodeID{2},
+				Limit:  4,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Segments, 4)
+			require.True(t, result.More)
+			require.Equal(t, result.Segments[3], result.Cursor)
+
+			result, err = db.ListSegmentsOnNode(ctx, metabase.ListSegmentsOnNode{
+				NodeID: storj.NodeID{2},
+				Cursor: result.Cursor,
+				Limit:  4,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Segments, 2)
+			require.False(t, result.More)
+		})
+	})
+}
@@ -208,6 +208,11 @@ type LoopSegmentEntry struct {
 	Redundancy    storj.RedundancyScheme
 	Pieces        Pieces
 	Placement     storj.PlacementConstraint
+
+	// MissingAliases are the node aliases of the segment, which aren't in the
+	// database. Their pieces are left out of Pieces. They are only set, when
+	// the iteration includes them, see IterateLoopSegments.IncludeMissingAliases.
+	MissingAliases []NodeAlias
 }
 
 // Inline returns true if segment is inline.
@@ -225,6 +230,17 @@ type IterateLoopSegments struct {
 	BatchSize          int
 	AsOfSystemTime     time.Time
 	AsOfSystemInterval time.Duration
+
+	// StartStreamID and EndStreamID limit the iteration to the segments of
+	// the streams in [StartStreamID, EndStreamID). Zero EndStreamID means
+	// that there is no upper limit.
+	StartStreamID uuid.UUID
+	EndStreamID   uuid.UUID
+
+	// IncludeMissingAliases returns the segments with node aliases, which
+	// aren't in the database, without their pieces and with the aliases in
+	// MissingAliases. Otherwise such a segment fails the iteration.
+	IncludeMissingAliases bool
 }
 
 // Verify verifies segments request fields.
@@ -232,6 +248,9 @@ func (opts *IterateLoopSegments) Verify() error {
 	if opts.BatchSize < 0 {
 		return ErrInvalidRequest.New("BatchSize is negative")
 	}
+	if !opts.EndStreamID.IsZero() && opts.EndStreamID.Compare(opts.StartStreamID) < 0 {
+		return ErrInvalidRequest.New("EndStreamID is smaller than StartStreamID")
+	}
 	return nil
 }
 
@@ -249,9 +268,15 @@ func (db *DB) IterateLoopSegments(ctx context.Context, opts IterateLoopSegments,
 		asOfSystemTime:     opts.AsOfSystemTime,
 		asOfSystemInterval: opts.AsOfSystemInterval,
 		batchSize:          opts.BatchSize,
+		endStreamID:        opts.EndStreamID,
+
+		includeMissingAliases: opts.IncludeMissingAliases,
 
 		curIndex: 0,
-		cursor:   loopSegmentIteratorCursor{},
+		cursor: loopSegmentIteratorCursor{
+			StreamID: opts.StartStreamID,
+		},
+		includeCursor: true,
 	}
 
 	loopIteratorBatchSizeLimit.Ensure(&it.batchSize)
@@ -278,10 +303,16 @@ type loopSegmentIterator struct {
 	batchSize          int
 	asOfSystemTime     time.Time
 	asOfSystemInterval time.Duration
+	endStreamID        uuid.UUID
+
+	includeMissingAliases bool
 
 	curIndex int
 	curRows  tagsql.Rows
 	cursor   loopSegmentIteratorCursor
+	// includeCursor is set for the first query, which includes the segment
+	// at the starting cursor.
+	includeCursor bool
 
 	// failErr is set when either scan or next query fails during iteration.
 	failErr error
@@ -338,6 +369,19 @@ func (it *loopSegmentIterator) Next(ctx context.Context, item *LoopSegmentEntry)
 func (it *loopSegmentIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	cursorCondition := `(stream_id, position) > ($1, $2)`
+	if it.includeCursor {
+		cursorCondition = `(stream_id, position) >= ($1, $2)`
+		it.includeCursor = false
+	}
+
+	args := []interface{}{it.cursor.StreamID, it.cursor.Position, it.batchSize}
+	endCondition := ""
+	if !it.endStreamID.IsZero() {
+		endCondition = `AND stream_id < $4`
+		args = append(args, it.endStreamID)
+	}
+
 	return it.db.db.QueryContext(ctx, `
 		SELECT
 			stream_id, position,
@@ -351,11 +395,11 @@ func (it *loopSegmentIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows,
 		FROM segments
 		`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
 		WHERE
-			(stream_id, position) > ($1, $2)
+			`+cursorCondition+`
+			`+endCondition+`
 		ORDER BY (stream_id, position) ASC
 		LIMIT $3
-		`, it.cursor.StreamID, it.cursor.Position,
-		it.batchSize,
+		`, args...,
 	)
 }
 
@@ -376,7 +420,11 @@ func (it *loopSegmentIterator) scanItem(ctx context.Context, item *LoopSegmentEn
 		return Error.New("failed to scan segments: %w", err)
 	}
 
-	item.Pieces, err = it.db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+	if it.includeMissingAliases {
+		item.Pieces, item.MissingAliases, err = it.db.aliasCache.convertAliasesToKnownPieces(ctx, aliasPieces)
+	} else {
+		item.Pieces, err = it.db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+	}
 	if err != nil {
 		return Error.New("failed to convert aliases to pieces: %w", err)
 	}
@@ -324,6 +324,19 @@ func TestIterateLoopSegments(t *testing.T) {
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
 
+		t.Run("invalid stream ID range", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+			metabasetest.IterateLoopSegments{
+				Opts: metabase.IterateLoopSegments{
+					StartStreamID: uuid.UUID{2},
+					EndStreamID:   uuid.UUID{1},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "EndStreamID is smaller than StartStreamID",
+			}.Check(ctx, t, db)
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
 		t.Run("no segments", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -413,6 +426,58 @@ func TestIterateLoopSegments(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("stream ID range", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var expected []metabase.LoopSegmentEntry
+			for _, streamID := range []uuid.UUID{{1}, {2}, {3}} {
+				obj := metabasetest.RandObjectStream()
+				obj.StreamID = streamID
+				metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+				for i := 0; i < 2; i++ {
+					expected = append(expected, metabase.LoopSegmentEntry{
+						StreamID:      streamID,
+						Position:      metabase.SegmentPosition{0, uint32(i)},
+						RootPieceID:   storj.PieceID{1},
+						Pieces:        metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}},
+						CreatedAt:     now,
+						EncryptedSize: 1024,
+						PlainSize:     512,
+						PlainOffset:   int64(i) * 512,
+						Redundancy:    metabasetest.DefaultRedundancy,
+					})
+				}
+			}
+
+			for _, batchSize := range []int{1, 10} {
+				metabasetest.IterateLoopSegments{
+					Opts: metabase.IterateLoopSegments{
+						BatchSize:     batchSize,
+						StartStreamID: uuid.UUID{2},
+						EndStreamID:   uuid.UUID{3},
+					},
+					Result: expected[2:4],
+				}.Check(ctx, t, db)
+
+				metabasetest.IterateLoopSegments{
+					Opts: metabase.IterateLoopSegments{
+						BatchSize:     batchSize,
+						StartStreamID: uuid.UUID{2},
+					},
+					Result: expected[2:],
+				}.Check(ctx, t, db)
+
+				metabasetest.IterateLoopSegments{
+					Opts: metabase.IterateLoopSegments{
+						BatchSize:   batchSize,
+						EndStreamID: uuid.UUID{2},
+					},
+					Result: expected[:2],
+				}.Check(ctx, t, db)
+			}
+		})
+
 		t.Run("batch size", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 			numberOfSegments := 5
@@ -316,6 +316,8 @@ type CreateObjectCopy struct {
 	OriginalSegments []metabase.Segment
 	FinishObject     *metabase.FinishCopyObject
 	CopyObjectStream *metabase.ObjectStream
+	// if set, the copy is created in the project
+	NewProjectID uuid.UUID
 }
 
 // Run creates the copy.
@@ -364,6 +366,7 @@ func (cc CreateObjectCopy) Run(ctx *testcontext.Context, t testing.TB, db *metab
 	if opts == nil {
 		opts = &metabase.FinishCopyObject{
 			ObjectStream:                 cc.OriginalObject.ObjectStream,
+			NewProjectID:                 cc.NewProjectID,
 			NewStreamID:                  copyStream.StreamID,
 			NewBucket:                    copyStream.BucketName,
 			NewSegmentKeys:               newEncryptedKeysNonces,
@@ -154,6 +154,36 @@ func (step DeleteBucketObjects) Check(ctx *testcontext.Context, t testing.TB, db
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// DeleteObjectsByPrefix is for testing metabase.DeleteObjectsByPrefix.
+type DeleteObjectsByPrefix struct {
+	Opts     metabase.DeleteObjectsByPrefix
+	Result   metabase.DeleteObjectsByPrefixResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step DeleteObjectsByPrefix) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.DeleteObjectsByPrefix(ctx, step.Opts)
+	require.Equal(t, step.Result, result)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+// MoveBucketObjects is for testing metabase.MoveBucketObjects.
+type MoveBucketObjects struct {
+	Opts     metabase.MoveBucketObjects
+	Moved    int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step MoveBucketObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	moved, err := db.MoveBucketObjects(ctx, step.Opts)
+	require.Equal(t, step.Moved, moved)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
 // UpdateObjectMetadata is for testing metabase.UpdateObjectMetadata.
 type UpdateObjectMetadata struct {
 	Opts     metabase.UpdateObjectMetadata
@@ -604,6 +634,55 @@ func (step GetTableStats) Check(ctx *testcontext.Context, t testing.TB, db *meta
 	return result
 }
 
+// GetBucketStats is for testing metabase.GetBucketStats.
+type GetBucketStats struct {
+	Opts     metabase.GetBucketStats
+	Result   metabase.BucketStats
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetBucketStats) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.BucketStats {
+	result, err := db.GetBucketStats(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+
+	return result
+}
+
+// CompactBucketStats is for testing metabase.CompactBucketStats.
+type CompactBucketStats struct {
+	Opts     metabase.CompactBucketStats
+	Result   int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step CompactBucketStats) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	compacted, err := db.CompactBucketStats(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	require.Equal(t, step.Result, compacted)
+}
+
+// ReconcileBucketStats is for testing metabase.ReconcileBucketStats.
+type ReconcileBucketStats struct {
+	Opts     metabase.ReconcileBucketStats
+	Result   metabase.ReconcileBucketStatsResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step ReconcileBucketStats) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.ReconcileBucketStats(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	require.Equal(t, step.Result, result)
+}
+
 // BeginMoveObject is for testing metabase.BeginMoveObject.
 type BeginMoveObject struct {
 	Opts     metabase.BeginMoveObject
@@ -690,3 +769,16 @@ func (step DeleteObjectLastCommitted) Check(ctx *testcontext.Context, t testing.
 	diff := cmp.Diff(step.Result, result, DefaultTimeDiff(), cmpopts.EquateEmpty())
 	require.Zero(t, diff)
 }
+
+// UpdateSegmentRedundancy is for testing metabase.UpdateSegmentRedundancy.
+type UpdateSegmentRedundancy struct {
+	Opts     metabase.UpdateSegmentRedundancy
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step UpdateSegmentRedundancy) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.UpdateSegmentRedundancy(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
@@ -10,6 +10,8 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
 )
 
 // UpdateObjectMetadata contains arguments necessary for replacing an object metadata.
@@ -22,6 +24,11 @@ type UpdateObjectMetadata struct {
 	EncryptedMetadata             []byte
 	EncryptedMetadataNonce        []byte
 	EncryptedMetadataEncryptedKey []byte
+
+	// OverrideTags controls whether the tags of the object are replaced
+	// with Tags. Empty Tags remove the existing tags.
+	OverrideTags bool
+	Tags         ObjectTags
 }
 
 // Verify object stream fields.
@@ -36,7 +43,7 @@ func (obj *UpdateObjectMetadata) Verify() error {
 	case obj.StreamID.IsZero():
 		return ErrInvalidRequest.New("StreamID missing")
 	}
-	return nil
+	return obj.Tags.Verify()
 }
 
 // UpdateObjectMetadata updates an object metadata.
@@ -52,38 +59,68 @@ func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadat
 	// to CommitObject, they will need to account for them being optional.
 	// Leading to scenarios where uplink calls update metadata, but wants to clear them
 	// during commit object.
-	result, err := db.db.ExecContext(ctx, `
-		UPDATE objects SET
-			encrypted_metadata_nonce         = $5,
-			encrypted_metadata               = $6,
-			encrypted_metadata_encrypted_key = $7
-		WHERE
-			project_id   = $1 AND
-			bucket_name  = $2 AND
-			object_key   = $3 AND
-			version IN (SELECT version FROM objects WHERE
+	var affected int64
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		var previousMetadataSize int64
+		err := tx.QueryRowContext(ctx, `
+			SELECT coalesce(sum(length(encrypted_metadata)), 0)::INT8
+			FROM objects
+			WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
 				object_key   = $3 AND
-				status       = `+committedStatus+` AND
-				(expires_at IS NULL OR expires_at > now())
-				ORDER BY version desc
-			) AND
-			stream_id    = $4 AND
-			status       = `+committedStatus,
-		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID,
-		opts.EncryptedMetadataNonce, opts.EncryptedMetadata, opts.EncryptedMetadataEncryptedKey)
-	if err != nil {
-		return Error.New("unable to update object metadata: %w", err)
-	}
+				stream_id    = $4 AND
+				status       = `+committedStatus,
+			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID,
+		).Scan(&previousMetadataSize)
+		if err != nil {
+			return Error.New("unable to query object metadata: %w", err)
+		}
 
-	affected, err := result.RowsAffected()
-	if err != nil {
-		return Error.New("failed to get rows affected: %w", err)
-	}
+		result, err := tx.ExecContext(ctx, `
+			UPDATE objects SET
+				encrypted_metadata_nonce         = $5,
+				encrypted_metadata               = $6,
+				encrypted_metadata_encrypted_key = $7,
+				tags = CASE WHEN $8 THEN $9::JSONB ELSE objects.tags END
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version IN (SELECT version FROM objects WHERE
+					project_id   = $1 AND
+					bucket_name  = $2 AND
+					object_key   = $3 AND
+					status       = `+committedStatus+` AND
+					(expires_at IS NULL OR expires_at > now())
+					ORDER BY version desc
+				) AND
+				stream_id    = $4 AND
+				status       = `+committedStatus,
+			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID,
+			opts.EncryptedMetadataNonce, opts.EncryptedMetadata, opts.EncryptedMetadataEncryptedKey,
+			opts.OverrideTags, opts.Tags)
+		if err != nil {
+			return Error.New("unable to update object metadata: %w", err)
+		}
+
+		affected, err = result.RowsAffected()
+		if err != nil {
+			return Error.New("failed to get rows affected: %w", err)
+		}
 
-	if affected == 0 {
-		return storj.ErrObjectNotFound.New("object with specified version and committed status is missing")
+		if affected == 0 {
+			return storj.ErrObjectNotFound.New("object with specified version and committed status is missing")
+		}
+
+		return insertBucketStatsDeltas(ctx, tx, bucketStatsDeltas{
+			BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.BucketName}: {
+				MetadataSize: affected*int64(len(opts.EncryptedMetadata)) - previousMetadataSize,
+			},
+		})
+	})
+	if err != nil {
+		return err
 	}
 
 	if affected > 1 {
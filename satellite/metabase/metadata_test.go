@@ -117,6 +117,83 @@ func TestUpdateObjectMetadata(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("Update tags", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+					Tags:         metabase.ObjectTags{"project": "apollo"},
+				},
+			}.Run(ctx, t, db, obj, 0)
+
+			encryptedMetadata := testrand.Bytes(1024)
+			encryptedMetadataNonce := testrand.Nonce()
+			encryptedMetadataKey := testrand.Bytes(265)
+
+			// the tags are kept without OverrideTags.
+			metabasetest.UpdateObjectMetadata{
+				Opts: metabase.UpdateObjectMetadata{
+					ProjectID:                     obj.ProjectID,
+					BucketName:                    obj.BucketName,
+					ObjectKey:                     obj.ObjectKey,
+					StreamID:                      obj.StreamID,
+					EncryptedMetadata:             encryptedMetadata,
+					EncryptedMetadataNonce:        encryptedMetadataNonce[:],
+					EncryptedMetadataEncryptedKey: encryptedMetadataKey,
+				},
+			}.Check(ctx, t, db)
+
+			object := metabase.RawObject{
+				ObjectStream: obj,
+				CreatedAt:    now,
+				Status:       metabase.Committed,
+				Encryption:   metabasetest.DefaultEncryption,
+
+				EncryptedMetadata:             encryptedMetadata,
+				EncryptedMetadataNonce:        encryptedMetadataNonce[:],
+				EncryptedMetadataEncryptedKey: encryptedMetadataKey,
+
+				Tags: metabase.ObjectTags{"project": "apollo"},
+			}
+			metabasetest.Verify{Objects: []metabase.RawObject{object}}.Check(ctx, t, db)
+
+			metabasetest.UpdateObjectMetadata{
+				Opts: metabase.UpdateObjectMetadata{
+					ProjectID:                     obj.ProjectID,
+					BucketName:                    obj.BucketName,
+					ObjectKey:                     obj.ObjectKey,
+					StreamID:                      obj.StreamID,
+					EncryptedMetadata:             encryptedMetadata,
+					EncryptedMetadataNonce:        encryptedMetadataNonce[:],
+					EncryptedMetadataEncryptedKey: encryptedMetadataKey,
+					OverrideTags:                  true,
+					Tags:                          metabase.ObjectTags{"project": "gemini", "stage": "2"},
+				},
+			}.Check(ctx, t, db)
+
+			object.Tags = metabase.ObjectTags{"project": "gemini", "stage": "2"}
+			metabasetest.Verify{Objects: []metabase.RawObject{object}}.Check(ctx, t, db)
+
+			// empty tags remove the existing tags.
+			metabasetest.UpdateObjectMetadata{
+				Opts: metabase.UpdateObjectMetadata{
+					ProjectID:                     obj.ProjectID,
+					BucketName:                    obj.BucketName,
+					ObjectKey:                     obj.ObjectKey,
+					StreamID:                      obj.StreamID,
+					EncryptedMetadata:             encryptedMetadata,
+					EncryptedMetadataNonce:        encryptedMetadataNonce[:],
+					EncryptedMetadataEncryptedKey: encryptedMetadataKey,
+					OverrideTags:                  true,
+				},
+			}.Check(ctx, t, db)
+
+			object.Tags = nil
+			metabasetest.Verify{Objects: []metabase.RawObject{object}}.Check(ctx, t, db)
+		})
+
 		t.Run("Update metadata with version != 1", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
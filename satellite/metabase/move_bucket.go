@@ -0,0 +1,274 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+const moveBucketBatchSizeLimit = intLimitRange(1000)
+
+var (
+	// ErrBucketFenced is returned when objects are created in a fenced bucket.
+	ErrBucketFenced = errs.Class("bucket is fenced")
+	// ErrPendingObjects is returned when a bucket with pending objects is moved.
+	ErrPendingObjects = errs.Class("bucket has pending objects")
+)
+
+// FenceBucket prevents creating new objects in the bucket until it's
+// unfenced. Existing objects can still be read and deleted.
+//
+// Fencing an already fenced bucket returns ErrConflict.
+func (db *DB) FenceBucket(ctx context.Context, bucket BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := bucket.Verify(); err != nil {
+		return err
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		INSERT INTO bucket_fences (project_id, bucket_name)
+		VALUES ($1, $2)
+		ON CONFLICT (project_id, bucket_name) DO NOTHING
+	`, bucket.ProjectID, []byte(bucket.BucketName))
+	if err != nil {
+		return Error.New("unable to fence bucket: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.New("unable to fence bucket: %w", err)
+	}
+	if affected == 0 {
+		return ErrConflict.New("bucket is already fenced")
+	}
+
+	return nil
+}
+
+// UnfenceBucket removes the fence of the bucket.
+func (db *DB) UnfenceBucket(ctx context.Context, bucket BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := bucket.Verify(); err != nil {
+		return err
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_fences
+		WHERE project_id = $1 AND bucket_name = $2
+	`, bucket.ProjectID, []byte(bucket.BucketName))
+	if err != nil {
+		return Error.New("unable to unfence bucket: %w", err)
+	}
+	return nil
+}
+
+// checkBucketFence returns ErrBucketFenced when the bucket is fenced.
+func checkBucketFence(ctx context.Context, tx tagsql.Tx, bucket BucketLocation) (err error) {
+	fenced, err := bucketFenced(ctx, tx, bucket)
+	if err != nil {
+		return err
+	}
+	if fenced {
+		return ErrBucketFenced.New("%s", bucket.BucketName)
+	}
+	return nil
+}
+
+// bucketFenced returns whether the bucket is fenced.
+func bucketFenced(ctx context.Context, tx tagsql.Tx, bucket BucketLocation) (fenced bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM bucket_fences
+			WHERE project_id = $1 AND bucket_name = $2
+		)
+	`, bucket.ProjectID, []byte(bucket.BucketName)).Scan(&fenced)
+	if err != nil {
+		return false, Error.New("unable to check bucket fence: %w", err)
+	}
+	return fenced, nil
+}
+
+// MoveBucketObjects contains arguments for moving all objects of a bucket
+// to another bucket.
+type MoveBucketObjects struct {
+	Bucket    BucketLocation
+	NewBucket BucketLocation
+	BatchSize int
+}
+
+// Verify verifies move bucket objects request fields.
+func (opts *MoveBucketObjects) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	if err := opts.NewBucket.Verify(); err != nil {
+		return err
+	}
+	if opts.Bucket == opts.NewBucket {
+		return ErrInvalidRequest.New("NewBucket is the same as Bucket")
+	}
+	return nil
+}
+
+// MoveBucketObjects re-keys all objects of the bucket, and the statistics
+// of the bucket, to the new bucket. Segments are keyed by the stream id, so
+// they don't need to change.
+//
+// Both buckets must be fenced. The objects are moved in batches, so in case
+// of an error the objects are split between the buckets and the move can be
+// repeated in either direction. Objects that are already in the new bucket
+// are kept, it's up to the caller to check that the new bucket is empty.
+//
+// Buckets with pending objects are refused with ErrPendingObjects, because
+// the stream id of an upload encodes the bucket, so the uploads couldn't be
+// committed after the move. No new uploads can begin in a fenced bucket.
+func (db *DB) MoveBucketObjects(ctx context.Context, opts MoveBucketObjects) (movedObjectCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return 0, err
+	}
+
+	moveBucketBatchSizeLimit.Ensure(&opts.BatchSize)
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		for _, bucket := range []BucketLocation{opts.Bucket, opts.NewBucket} {
+			fenced, err := bucketFenced(ctx, tx, bucket)
+			if err != nil {
+				return err
+			}
+			if !fenced {
+				return ErrConflict.New("bucket %q must be fenced", bucket.BucketName)
+			}
+		}
+
+		var pending bool
+		err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM objects
+				WHERE project_id = $1 AND bucket_name = $2 AND status = `+pendingStatus+`
+			)
+		`, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName)).Scan(&pending)
+		if err != nil {
+			return Error.New("unable to check pending objects: %w", err)
+		}
+		if pending {
+			return ErrPendingObjects.New("%s", opts.Bucket.BucketName)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return movedObjectCount, err
+		}
+
+		moved, err := db.moveBucketObjectsBatch(ctx, opts)
+		movedObjectCount += moved
+		if err != nil {
+			return movedObjectCount, err
+		}
+		if moved == 0 {
+			break
+		}
+	}
+
+	return movedObjectCount, db.moveBucketStats(ctx, opts.Bucket, opts.NewBucket)
+}
+
+// moveBucketObjectsBatch moves a single batch of objects.
+// This function has been factored out for metric purposes.
+func (db *DB) moveBucketObjectsBatch(ctx context.Context, opts MoveBucketObjects) (moved int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE objects SET
+			project_id  = $3,
+			bucket_name = $4
+		WHERE
+			project_id = $1 AND bucket_name = $2 AND
+			(object_key, version) IN (
+				SELECT object_key, version FROM objects
+				WHERE project_id = $1 AND bucket_name = $2
+				LIMIT $5
+			)
+	`, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName),
+		opts.NewBucket.ProjectID, []byte(opts.NewBucket.BucketName),
+		opts.BatchSize)
+	if err != nil {
+		return 0, Error.New("unable to move bucket objects: %w", err)
+	}
+
+	moved, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to move bucket objects: %w", err)
+	}
+
+	mon.Meter("bucket_objects_moved").Mark64(moved)
+
+	return moved, nil
+}
+
+// moveBucketStats merges the statistics of the bucket into the statistics
+// of the new bucket.
+func (db *DB) moveBucketStats(ctx context.Context, bucket, newBucket BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO bucket_stats (
+				project_id, bucket_name,
+				object_count, pending_object_count, segment_count,
+				total_encrypted_size, metadata_size
+			)
+			SELECT
+				$3, $4,
+				object_count, pending_object_count, segment_count,
+				total_encrypted_size, metadata_size
+			FROM bucket_stats
+			WHERE project_id = $1 AND bucket_name = $2
+			ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+				object_count         = bucket_stats.object_count + EXCLUDED.object_count,
+				pending_object_count = bucket_stats.pending_object_count + EXCLUDED.pending_object_count,
+				segment_count        = bucket_stats.segment_count + EXCLUDED.segment_count,
+				total_encrypted_size = bucket_stats.total_encrypted_size + EXCLUDED.total_encrypted_size,
+				metadata_size        = bucket_stats.metadata_size + EXCLUDED.metadata_size,
+				updated_at           = now()
+		`, bucket.ProjectID, []byte(bucket.BucketName), newBucket.ProjectID, []byte(newBucket.BucketName))
+		if err != nil {
+			return Error.New("unable to move bucket statistics: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM bucket_stats
+			WHERE project_id = $1 AND bucket_name = $2
+		`, bucket.ProjectID, []byte(bucket.BucketName))
+		if err != nil {
+			return Error.New("unable to move bucket statistics: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE bucket_stats_deltas SET
+				project_id  = $3,
+				bucket_name = $4
+			WHERE project_id = $1 AND bucket_name = $2
+		`, bucket.ProjectID, []byte(bucket.BucketName), newBucket.ProjectID, []byte(newBucket.BucketName))
+		if err != nil {
+			return Error.New("unable to move bucket statistics: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,244 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestFenceBucket(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		bucket := obj.Location().Bucket()
+
+		t.Run("fence twice", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			require.NoError(t, db.FenceBucket(ctx, bucket))
+
+			err := db.FenceBucket(ctx, bucket)
+			require.True(t, metabase.ErrConflict.Has(err), err)
+
+			require.NoError(t, db.UnfenceBucket(ctx, bucket))
+			require.NoError(t, db.FenceBucket(ctx, bucket))
+		})
+
+		t.Run("fenced bucket rejects new objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			require.NoError(t, db.FenceBucket(ctx, bucket))
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				ErrClass: &metabase.ErrBucketFenced,
+			}.Check(ctx, t, db)
+
+			next := obj
+			next.Version = metabase.NextVersion
+			metabasetest.BeginObjectNextVersion{
+				Opts: metabase.BeginObjectNextVersion{
+					ObjectStream: next,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version:  -1,
+				ErrClass: &metabase.ErrBucketFenced,
+			}.Check(ctx, t, db)
+
+			// moving an object into a fenced bucket creates a new object.
+			source := metabasetest.RandObjectStream()
+			source.ProjectID = bucket.ProjectID
+			metabasetest.CreateObject(ctx, t, db, source, 0)
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          source,
+					NewBucket:             bucket.BucketName,
+					NewEncryptedObjectKey: []byte("moved"),
+				},
+				ErrClass: &metabase.ErrBucketFenced,
+			}.Check(ctx, t, db)
+
+			require.NoError(t, db.UnfenceBucket(ctx, bucket))
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj.Version,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestMoveBucketObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		bucket := obj.Location().Bucket()
+		newBucket := metabase.BucketLocation{
+			ProjectID:  testrand.UUID(),
+			BucketName: "new-bucket",
+		}
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket: metabase.BucketLocation{BucketName: "bucket"},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket:    bucket,
+					NewBucket: bucket,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewBucket is the same as Bucket",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("buckets not fenced", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket:    bucket,
+					NewBucket: newBucket,
+				},
+				ErrClass: &metabase.ErrConflict,
+			}.Check(ctx, t, db)
+
+			require.NoError(t, db.FenceBucket(ctx, bucket))
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket:    bucket,
+					NewBucket: newBucket,
+				},
+				ErrClass: &metabase.ErrConflict,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("pending objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			pending := metabasetest.RandObjectStream()
+			pending.ProjectID = bucket.ProjectID
+			pending.BucketName = bucket.BucketName
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 0)
+
+			require.NoError(t, db.FenceBucket(ctx, bucket))
+			require.NoError(t, db.FenceBucket(ctx, newBucket))
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket:    bucket,
+					NewBucket: newBucket,
+				},
+				ErrClass: &metabase.ErrPendingObjects,
+			}.Check(ctx, t, db)
+
+			empty, err := db.BucketEmpty(ctx, metabase.BucketEmpty{
+				ProjectID:  newBucket.ProjectID,
+				BucketName: newBucket.BucketName,
+			})
+			require.NoError(t, err)
+			require.True(t, empty)
+		})
+
+		t.Run("move", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			second := metabasetest.RandObjectStream()
+			second.ProjectID = bucket.ProjectID
+			second.BucketName = bucket.BucketName
+			third := metabasetest.RandObjectStream()
+			third.ProjectID = bucket.ProjectID
+			third.BucketName = bucket.BucketName
+
+			first := metabasetest.CreateObject(ctx, t, db, obj, 2)
+			metabasetest.CreateObject(ctx, t, db, second, 1)
+			metabasetest.CreateObject(ctx, t, db, third, 0)
+
+			// part of the statistics is compacted and part is in the deltas.
+			_, err := db.CompactBucketStats(ctx, metabase.CompactBucketStats{})
+			require.NoError(t, err)
+			err = db.UpdateObjectMetadata(ctx, metabase.UpdateObjectMetadata{
+				ProjectID:                     second.ProjectID,
+				BucketName:                    second.BucketName,
+				ObjectKey:                     second.ObjectKey,
+				StreamID:                      second.StreamID,
+				EncryptedMetadata:             testrand.Bytes(10),
+				EncryptedMetadataNonce:        testrand.Nonce().Bytes(),
+				EncryptedMetadataEncryptedKey: testrand.Bytes(32),
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, db.FenceBucket(ctx, bucket))
+			require.NoError(t, db.FenceBucket(ctx, newBucket))
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket:    bucket,
+					NewBucket: newBucket,
+					BatchSize: 2,
+				},
+				Moved: 3,
+			}.Check(ctx, t, db)
+
+			empty, err := db.BucketEmpty(ctx, metabase.BucketEmpty{
+				ProjectID:  bucket.ProjectID,
+				BucketName: bucket.BucketName,
+			})
+			require.NoError(t, err)
+			require.True(t, empty)
+
+			moved := first
+			moved.ProjectID = newBucket.ProjectID
+			moved.BucketName = newBucket.BucketName
+			metabasetest.GetObjectLastCommitted{
+				Opts: metabase.GetObjectLastCommitted{
+					ObjectLocation: moved.Location(),
+				},
+				Result: moved,
+			}.Check(ctx, t, db)
+
+			// segments are keyed by the stream id and stay in place.
+			segments, err := db.ListSegments(ctx, metabase.ListSegments{
+				StreamID: first.StreamID,
+			})
+			require.NoError(t, err)
+			require.Len(t, segments.Segments, 2)
+
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: bucket},
+				Result: metabase.BucketStats{},
+			}.Check(ctx, t, db)
+			metabasetest.GetBucketStats{
+				Opts: metabase.GetBucketStats{BucketLocation: newBucket},
+				Result: metabase.BucketStats{
+					ObjectCount:        3,
+					SegmentCount:       3,
+					TotalEncryptedSize: 3072,
+					MetadataSize:       10,
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -135,6 +135,11 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 	}
 
 	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		err = checkBucketFence(ctx, tx, BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.NewBucket})
+		if err != nil {
+			return err
+		}
+
 		updateObjectsQuery := `
 			UPDATE objects SET
 				bucket_name = $1,
@@ -155,15 +160,17 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 			RETURNING
 				segment_count, 
 				objects.encrypted_metadata IS NOT NULL AND LENGTH(objects.encrypted_metadata) > 0 AS has_metadata,
-				stream_id
+				stream_id,
+				status, total_encrypted_size, encrypted_metadata
         `
 
 		var segmentsCount int
 		var hasMetadata bool
 		var streamID uuid.UUID
+		var moved Object
 
 		row := tx.QueryRowContext(ctx, updateObjectsQuery, []byte(opts.NewBucket), opts.NewEncryptedObjectKey, opts.NewEncryptedMetadataKey, opts.NewEncryptedMetadataKeyNonce, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version)
-		if err = row.Scan(&segmentsCount, &hasMetadata, &streamID); err != nil {
+		if err = row.Scan(&segmentsCount, &hasMetadata, &streamID, &moved.Status, &moved.TotalEncryptedSize, &moved.EncryptedMetadata); err != nil {
 			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
 				return Error.Wrap(ErrObjectAlreadyExists.New(""))
 			} else if errors.Is(err, sql.ErrNoRows) {
@@ -219,7 +226,20 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 		if affected != int64(len(newSegmentKeys.Positions)) {
 			return Error.New("segment is missing")
 		}
-		return nil
+
+		if opts.NewBucket == opts.BucketName {
+			return nil
+		}
+
+		moved.ProjectID = opts.ProjectID
+		moved.SegmentCount = int32(segmentsCount)
+
+		deltas := bucketStatsDeltas{}
+		moved.BucketName = opts.BucketName
+		deltas.remove(moved)
+		moved.BucketName = opts.NewBucket
+		deltas.add(moved)
+		return insertBucketStatsDeltas(ctx, tx, deltas)
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,262 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectexport
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+const defaultImportBatchSize = 100
+
+// ImportOptions contains the options of importing an export stream.
+type ImportOptions struct {
+	// ProjectID replaces the project id of the export, when it's set. When
+	// it differs from the project of the export, the stream ids are replaced
+	// too, so the export can be imported into the database, which holds the
+	// exported project. The uploads of the pending objects can't be
+	// committed after that, because the stream ids of the uploads change.
+	ProjectID uuid.UUID
+	// BatchSize is the number of objects inserted in a single transaction.
+	BatchSize int
+}
+
+// Import inserts the metadata of the export stream into the metabase. The
+// objects are verified before they are inserted, however, the references of
+// the copies can be verified only at the end of the stream. Use Verify to
+// check the whole stream before importing it.
+func Import(ctx context.Context, db *metabase.DB, r io.Reader, opts ImportOptions) (summary Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultImportBatchSize
+	}
+
+	batch := &importBatch{db: db}
+	summary, err = readVerified(ctx, r, opts.ProjectID, func(entry Entry) error {
+		batch.add(entry)
+		if len(batch.Objects) < opts.BatchSize {
+			return nil
+		}
+		return batch.flush(ctx)
+	})
+	if err != nil {
+		return summary, err
+	}
+	return summary, batch.flush(ctx)
+}
+
+// Verify checks the export stream without importing it.
+func Verify(ctx context.Context, r io.Reader) (summary Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return readVerified(ctx, r, uuid.UUID{}, func(Entry) error { return nil })
+}
+
+// importBatch collects the values, which are inserted together.
+type importBatch struct {
+	db *metabase.DB
+	metabase.ImportBatch
+}
+
+func (batch *importBatch) add(entry Entry) {
+	batch.Objects = append(batch.Objects, entry.Object)
+	batch.Segments = append(batch.Segments, entry.Segments...)
+	if entry.Copy != nil {
+		batch.Copies = append(batch.Copies, *entry.Copy)
+	}
+}
+
+// flush inserts the collected values in a single transaction, so that an
+// interrupted import doesn't leave objects without segments.
+func (batch *importBatch) flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(batch.Objects) == 0 {
+		return nil
+	}
+	if err := batch.db.ImportBatch(ctx, batch.ImportBatch); err != nil {
+		return Error.Wrap(err)
+	}
+
+	batch.Objects = batch.Objects[:0]
+	batch.Segments = batch.Segments[:0]
+	batch.Copies = batch.Copies[:0]
+	return nil
+}
+
+// streamIDMap maps the stream ids of the export to new stream ids.
+type streamIDMap map[uuid.UUID]uuid.UUID
+
+// get returns the new stream id, generating it on the first use.
+func (ids streamIDMap) get(streamID uuid.UUID) (uuid.UUID, error) {
+	if newStreamID, ok := ids[streamID]; ok {
+		return newStreamID, nil
+	}
+	newStreamID, err := uuid.New()
+	if err != nil {
+		return uuid.UUID{}, Error.Wrap(err)
+	}
+	ids[streamID] = newStreamID
+	return newStreamID, nil
+}
+
+// remap replaces the stream ids of the entry. The ancestor of a copy may
+// appear later in the stream, so it gets its new stream id on the first use.
+func (ids streamIDMap) remap(entry *Entry) error {
+	streamID, err := ids.get(entry.Object.StreamID)
+	if err != nil {
+		return err
+	}
+
+	entry.Object.StreamID = streamID
+	for i := range entry.Segments {
+		entry.Segments[i].StreamID = streamID
+	}
+	if entry.Copy != nil {
+		ancestorStreamID, err := ids.get(entry.Copy.AncestorStreamID)
+		if err != nil {
+			return err
+		}
+		entry.Copy = &metabase.RawCopy{
+			StreamID:         streamID,
+			AncestorStreamID: ancestorStreamID,
+		}
+	}
+	return nil
+}
+
+// readVerified reads and verifies the export stream, and passes every object
+// to fn. When projectID isn't zero, it replaces the project id of the objects,
+// and when it differs from the exported project, the stream ids too.
+func readVerified(ctx context.Context, r io.Reader, projectID uuid.UUID, fn func(Entry) error) (summary Summary, err error) {
+	reader, err := NewReader(r)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	header := reader.Header()
+	var remapped streamIDMap
+	if !projectID.IsZero() && projectID != header.ProjectID {
+		remapped = streamIDMap{}
+	}
+	streams := map[uuid.UUID]struct{}{}
+	ancestors := map[uuid.UUID]struct{}{}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		entry, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return summary, err
+		}
+
+		if entry.Object.ProjectID != header.ProjectID {
+			return summary, Error.New("object %s belongs to another project", entry.Object.StreamID)
+		}
+		if err := verifyEntry(entry); err != nil {
+			return summary, err
+		}
+		if _, ok := streams[entry.Object.StreamID]; ok {
+			return summary, Error.New("duplicate stream %s", entry.Object.StreamID)
+		}
+		streams[entry.Object.StreamID] = struct{}{}
+		if entry.Copy != nil {
+			ancestors[entry.Copy.AncestorStreamID] = struct{}{}
+		}
+
+		if !projectID.IsZero() {
+			entry.Object.ProjectID = projectID
+		}
+		if remapped != nil {
+			if err := remapped.remap(&entry); err != nil {
+				return summary, err
+			}
+		}
+		if err := fn(entry); err != nil {
+			return summary, err
+		}
+
+		summary.Objects++
+		summary.Segments += int64(len(entry.Segments))
+		if entry.Copy != nil {
+			summary.Copies++
+		}
+	}
+
+	for ancestor := range ancestors {
+		if _, ok := streams[ancestor]; !ok {
+			return summary, Error.New("ancestor %s of a copy is missing", ancestor)
+		}
+	}
+
+	return summary, nil
+}
+
+// verifyEntry checks the consistency of the object with its segments, like
+// metabase-verify does.
+func verifyEntry(entry Entry) error {
+	object := entry.Object
+	if err := object.ObjectStream.Verify(); err != nil {
+		return Error.New("object %s: %w", object.StreamID, err)
+	}
+
+	if object.Status == metabase.Committed && int(object.SegmentCount) != len(entry.Segments) {
+		return Error.New("object %s: has %d segments, expected %d",
+			object.StreamID, len(entry.Segments), object.SegmentCount)
+	}
+
+	var expectedOffset int64
+	for i, segment := range entry.Segments {
+		if segment.StreamID != object.StreamID {
+			return Error.New("object %s: segment of stream %s", object.StreamID, segment.StreamID)
+		}
+		if i > 0 && segment.Position.Less(entry.Segments[i-1].Position) {
+			return Error.New("object %s: segments are not ordered", object.StreamID)
+		}
+		if i > 0 && segment.Position == entry.Segments[i-1].Position {
+			return Error.New("object %s: duplicate segment %v", object.StreamID, segment.Position)
+		}
+		if segment.PlainSize > segment.EncryptedSize {
+			return Error.New("object %s: segment %v: plain size larger than encrypted size",
+				object.StreamID, segment.Position)
+		}
+		if object.Status == metabase.Committed {
+			if segment.PlainOffset != expectedOffset {
+				return Error.New("object %s: segment %v: invalid offset %d, expected %d",
+					object.StreamID, segment.Position, segment.PlainOffset, expectedOffset)
+			}
+			expectedOffset += int64(segment.PlainSize)
+		}
+
+		if len(segment.InlineData) > 0 && len(segment.Pieces) > 0 {
+			return Error.New("object %s: segment %v: inline segment with pieces", object.StreamID, segment.Position)
+		}
+		if len(segment.Pieces) > 0 {
+			if err := segment.Pieces.Verify(); err != nil {
+				return Error.New("object %s: segment %v: %w", object.StreamID, segment.Position, err)
+			}
+		}
+	}
+
+	if entry.Copy != nil {
+		if entry.Copy.StreamID != object.StreamID {
+			return Error.New("object %s: copy of stream %s", object.StreamID, entry.Copy.StreamID)
+		}
+		if entry.Copy.AncestorStreamID == object.StreamID {
+			return Error.New("object %s: copy of itself", object.StreamID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package projectexport implements exporting the metabase metadata of a
+// project and importing it into the metabase of another satellite.
+//
+// The export is a stream of gob encoded values. It starts with a Header,
+// which is followed by a record for every object, segment and copy. The
+// segments and the copy of an object follow the object. The pieces of the
+// segments contain the node ids, since the node aliases are specific to
+// a database.
+package projectexport
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the package.
+	Error = errs.Class("projectexport")
+)
+
+const (
+	// Magic identifies the export stream.
+	Magic = "storj-metabase-project-export"
+	// Version is the version of the format, which is written by Export.
+	Version = 1
+)
+
+// Header is the first value of the export stream.
+type Header struct {
+	Magic     string
+	Version   int
+	ProjectID uuid.UUID
+	CreatedAt time.Time
+}
+
+// record contains exactly one of the exported values.
+type record struct {
+	Object  *metabase.RawObject
+	Segment *metabase.RawSegment
+	Copy    *metabase.RawCopy
+}
+
+// Summary contains the number of the exported or imported values.
+type Summary struct {
+	Objects  int64
+	Segments int64
+	Copies   int64
+}
+
+// Export writes the metadata of the project to w. The copies of objects in
+// other projects are written with the pieces of their ancestors, so the export
+// doesn't depend on other projects.
+func Export(ctx context.Context, db *metabase.DB, projectID uuid.UUID, w io.Writer) (summary Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encoder := gob.NewEncoder(w)
+	err = encoder.Encode(Header{
+		Magic:     Magic,
+		Version:   Version,
+		ProjectID: projectID,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return Summary{}, Error.Wrap(err)
+	}
+
+	exporter := &streamExporter{encoder: encoder}
+	err = db.ExportProject(ctx, metabase.ExportProject{ProjectID: projectID}, exporter)
+	return exporter.summary, Error.Wrap(err)
+}
+
+// streamExporter encodes the exported values.
+type streamExporter struct {
+	encoder *gob.Encoder
+	summary Summary
+}
+
+// Object implements metabase.ProjectExporter.
+func (exporter *streamExporter) Object(ctx context.Context, object metabase.RawObject) error {
+	exporter.summary.Objects++
+	return exporter.encoder.Encode(record{Object: &object})
+}
+
+// Segment implements metabase.ProjectExporter.
+func (exporter *streamExporter) Segment(ctx context.Context, segment metabase.RawSegment) error {
+	exporter.summary.Segments++
+	return exporter.encoder.Encode(record{Segment: &segment})
+}
+
+// Copy implements metabase.ProjectExporter.
+func (exporter *streamExporter) Copy(ctx context.Context, copy metabase.RawCopy) error {
+	exporter.summary.Copies++
+	return exporter.encoder.Encode(record{Copy: &copy})
+}
+
+// Reader reads the export stream object by object.
+type Reader struct {
+	decoder *gob.Decoder
+	header  Header
+	next    *record
+}
+
+// Entry is an exported object with its segments and copy.
+type Entry struct {
+	Object   metabase.RawObject
+	Segments []metabase.RawSegment
+	// Copy is set when the object is a server-side copy.
+	Copy *metabase.RawCopy
+}
+
+// NewReader reads the header of the export stream.
+func NewReader(r io.Reader) (*Reader, error) {
+	reader := &Reader{decoder: gob.NewDecoder(r)}
+	if err := reader.decoder.Decode(&reader.header); err != nil {
+		return nil, Error.New("invalid header: %w", err)
+	}
+	if reader.header.Magic != Magic {
+		return nil, Error.New("not a project export")
+	}
+	if reader.header.Version != Version {
+		return nil, Error.New("unsupported version %d", reader.header.Version)
+	}
+	return reader, nil
+}
+
+// Header returns the header of the export stream.
+func (reader *Reader) Header() Header { return reader.header }
+
+// Next returns the next object. It returns io.EOF at the end of the stream.
+func (reader *Reader) Next() (entry Entry, err error) {
+	rec, err := reader.read()
+	if err != nil {
+		return Entry{}, err
+	}
+	if rec.Object == nil {
+		return Entry{}, Error.New("expected object")
+	}
+	entry.Object = *rec.Object
+
+	for {
+		rec, err := reader.read()
+		if errors.Is(err, io.EOF) {
+			return entry, nil
+		}
+		if err != nil {
+			return Entry{}, err
+		}
+
+		switch {
+		case rec.Object != nil:
+			reader.next = rec
+			return entry, nil
+		case rec.Segment != nil:
+			if entry.Copy != nil {
+				return Entry{}, Error.New("segment after copy")
+			}
+			entry.Segments = append(entry.Segments, *rec.Segment)
+		case rec.Copy != nil:
+			if entry.Copy != nil {
+				return Entry{}, Error.New("duplicate copy")
+			}
+			entry.Copy = rec.Copy
+		default:
+			return Entry{}, Error.New("empty record")
+		}
+	}
+}
+
+// read returns the next record.
+func (reader *Reader) read() (*record, error) {
+	if reader.next != nil {
+		rec := reader.next
+		reader.next = nil
+		return rec, nil
+	}
+
+	var rec record
+	if err := reader.decoder.Decode(&rec); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, Error.Wrap(err)
+	}
+	return &rec, nil
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectexport_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/satellite/metabase/projectexport"
+)
+
+func TestExportImport(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		bucket := obj.Location().Bucket()
+
+		t.Run("invalid stream", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := projectexport.Verify(ctx, bytes.NewReader([]byte("not an export")))
+			require.Error(t, err)
+
+			_, err = projectexport.Import(ctx, db, bytes.NewReader(nil), projectexport.ImportOptions{})
+			require.Error(t, err)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("round trip", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			second := obj
+			second.ObjectKey = "second"
+			second.StreamID = testrand.UUID()
+			pending := obj
+			pending.ObjectKey = "pending"
+			pending.StreamID = testrand.UUID()
+
+			original := metabasetest.CreateObject(ctx, t, db, obj, 2)
+			metabasetest.CreateObject(ctx, t, db, second, 0)
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 1)
+
+			copyStream := metabasetest.RandObjectStream()
+			copyStream.ProjectID = obj.ProjectID
+			metabasetest.CreateObjectCopy{
+				OriginalObject:   original,
+				CopyObjectStream: &copyStream,
+			}.Run(ctx, t, db)
+
+			state, err := db.TestingGetState(ctx)
+			require.NoError(t, err)
+			stats, err := db.GetBucketStats(ctx, metabase.GetBucketStats{BucketLocation: bucket})
+			require.NoError(t, err)
+
+			var export bytes.Buffer
+			summary, err := projectexport.Export(ctx, db, obj.ProjectID, &export)
+			require.NoError(t, err)
+			expectedSummary := projectexport.Summary{Objects: 4, Segments: 5, Copies: 1}
+			require.Equal(t, expectedSummary, summary)
+
+			summary, err = projectexport.Verify(ctx, bytes.NewReader(export.Bytes()))
+			require.NoError(t, err)
+			require.Equal(t, expectedSummary, summary)
+
+			// import into an empty metabase.
+			require.NoError(t, db.TestingDeleteAll(ctx))
+
+			summary, err = projectexport.Import(ctx, db, bytes.NewReader(export.Bytes()), projectexport.ImportOptions{
+				BatchSize: 2,
+			})
+			require.NoError(t, err)
+			require.Equal(t, expectedSummary, summary)
+
+			metabasetest.Verify(*state).Check(ctx, t, db)
+			metabasetest.GetBucketStats{
+				Opts:   metabase.GetBucketStats{BucketLocation: bucket},
+				Result: stats,
+			}.Check(ctx, t, db)
+
+			// importing again fails, because the objects exist.
+			_, err = projectexport.Import(ctx, db, bytes.NewReader(export.Bytes()), projectexport.ImportOptions{})
+			require.Error(t, err)
+		})
+
+		t.Run("import into another project", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			original := metabasetest.CreateObject(ctx, t, db, obj, 1)
+			copyStream := obj
+			copyStream.ObjectKey = "copy"
+			copyStream.StreamID = testrand.UUID()
+			metabasetest.CreateObjectCopy{
+				OriginalObject:   original,
+				CopyObjectStream: &copyStream,
+			}.Run(ctx, t, db)
+
+			var export bytes.Buffer
+			expected, err := projectexport.Export(ctx, db, obj.ProjectID, &export)
+			require.NoError(t, err)
+
+			// the exported project stays in the metabase, so the stream
+			// ids of the imported objects have to be replaced.
+			newProjectID := testrand.UUID()
+			summary, err := projectexport.Import(ctx, db, &export, projectexport.ImportOptions{
+				ProjectID: newProjectID,
+			})
+			require.NoError(t, err)
+			require.Equal(t, expected, summary)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 4)
+
+			imported := map[metabase.ObjectKey]metabase.Object{}
+			for _, object := range objects {
+				if object.ProjectID == newProjectID {
+					imported[object.ObjectKey] = object
+				}
+			}
+			require.Len(t, imported, 2)
+			require.NotEqual(t, obj.StreamID, imported[obj.ObjectKey].StreamID)
+			require.NotEqual(t, copyStream.StreamID, imported[copyStream.ObjectKey].StreamID)
+
+			// the copy refers to the imported ancestor.
+			var ancestor []byte
+			err = db.UnderlyingTagSQL().QueryRowContext(ctx, `
+				SELECT ancestor_stream_id FROM segment_copies WHERE stream_id = $1
+			`, imported[copyStream.ObjectKey].StreamID).Scan(&ancestor)
+			require.NoError(t, err)
+			require.Equal(t, imported[obj.ObjectKey].StreamID.Bytes(), ancestor)
+
+			// exporting the imported project returns the same objects.
+			summary, err = projectexport.Export(ctx, db, newProjectID, io.Discard)
+			require.NoError(t, err)
+			require.Equal(t, expected, summary)
+		})
+
+		t.Run("copy of another project", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			original := metabasetest.CreateObject(ctx, t, db, obj, 2)
+			copyStream := metabasetest.RandObjectStream()
+			copyObj, _, _ := metabasetest.CreateObjectCopy{
+				OriginalObject:   original,
+				CopyObjectStream: &copyStream,
+				NewProjectID:     copyStream.ProjectID,
+			}.Run(ctx, t, db)
+
+			// the ancestor isn't exported, so the copy is exported with
+			// the pieces of the ancestor.
+			var export bytes.Buffer
+			summary, err := projectexport.Export(ctx, db, copyObj.ProjectID, &export)
+			require.NoError(t, err)
+			expectedSummary := projectexport.Summary{Objects: 1, Segments: 2}
+			require.Equal(t, expectedSummary, summary)
+
+			summary, err = projectexport.Verify(ctx, bytes.NewReader(export.Bytes()))
+			require.NoError(t, err)
+			require.Equal(t, expectedSummary, summary)
+
+			newProjectID := testrand.UUID()
+			summary, err = projectexport.Import(ctx, db, bytes.NewReader(export.Bytes()), projectexport.ImportOptions{
+				ProjectID: newProjectID,
+			})
+			require.NoError(t, err)
+			require.Equal(t, expectedSummary, summary)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+
+			originalPieces := map[metabase.SegmentPosition]metabase.Pieces{}
+			for _, segment := range segments {
+				if segment.StreamID == original.StreamID {
+					originalPieces[segment.Position] = segment.Pieces
+				}
+			}
+
+			imported := 0
+			for _, segment := range segments {
+				if segment.StreamID == original.StreamID || segment.StreamID == copyObj.StreamID {
+					continue
+				}
+				require.NotEmpty(t, segment.Pieces)
+				require.Equal(t, originalPieces[segment.Position], segment.Pieces)
+				imported++
+			}
+			require.Equal(t, 2, imported)
+
+			// the imported object doesn't depend on the ancestor.
+			summary, err = projectexport.Export(ctx, db, newProjectID, io.Discard)
+			require.NoError(t, err)
+			require.Equal(t, expectedSummary, summary)
+		})
+	})
+}
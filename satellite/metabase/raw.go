@@ -27,6 +27,9 @@ type RawObject struct {
 	EncryptedMetadata             []byte
 	EncryptedMetadataEncryptedKey []byte
 
+	// Tags are the unencrypted tags of the object.
+	Tags ObjectTags
+
 	// TotalPlainSize is 0 for a migrated object.
 	TotalPlainSize     int64
 	TotalEncryptedSize int64
@@ -110,6 +113,9 @@ func (db *DB) TestingDeleteAll(ctx context.Context) (err error) {
 		DELETE FROM objects;
 		DELETE FROM segments;
 		DELETE FROM segment_copies;
+		DELETE FROM bucket_stats;
+		DELETE FROM bucket_stats_deltas;
+		DELETE FROM bucket_fences;
 		DELETE FROM node_aliases;
 		SELECT setval('node_alias_seq', 1, false);
 	`)
@@ -127,6 +133,7 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 			created_at, expires_at,
 			status, segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+			tags,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
 			encryption,
 			zombie_deletion_deadline
@@ -155,6 +162,7 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 			&obj.EncryptedMetadataNonce,
 			&obj.EncryptedMetadata,
 			&obj.EncryptedMetadataEncryptedKey,
+			&obj.Tags,
 
 			&obj.TotalPlainSize,
 			&obj.TotalEncryptedSize,
@@ -0,0 +1,148 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentloop
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// ErrCheckpointNotFound is returned when the loop doesn't have a checkpoint.
+var ErrCheckpointNotFound = errs.Class("segment loop checkpoint not found")
+
+// Checkpoint is the saved progress of a loop, which allows continuing the
+// loop after a restart.
+type Checkpoint struct {
+	// Started is the time of the database snapshot, which the loop iterates.
+	Started time.Time
+	// StreamID is the first stream, which hasn't been processed. All the
+	// streams before it have been processed.
+	StreamID uuid.UUID
+	// Segments is the number of processed segments.
+	Segments int64
+	// EstimatedSegments is the number of segments at the start of the loop.
+	EstimatedSegments int64
+	// ObserverStates are the serialized states of the observers by their
+	// name. The state of an observer is split into chunks, so a single chunk
+	// stays small, even when the whole state is large.
+	ObserverStates map[string][][]byte
+	// UpdatedAt is the time the checkpoint was saved.
+	UpdatedAt time.Time
+}
+
+// CheckpointDB stores the checkpoints of the loops.
+//
+// architecture: Database
+type CheckpointDB interface {
+	// Get returns the checkpoint of the loop.
+	Get(ctx context.Context, name string) (Checkpoint, error)
+	// Set saves the checkpoint of the loop.
+	Set(ctx context.Context, name string, checkpoint Checkpoint) error
+	// Delete removes the checkpoint of the loop.
+	Delete(ctx context.Context, name string) error
+}
+
+// CheckpointedObserver is an observer, whose state can be saved and restored.
+// The loop is resumed from a checkpoint only when all the joined observers
+// support it.
+type CheckpointedObserver interface {
+	Observer
+
+	// SaveState serializes the state of the observer into at least one
+	// chunk, e.g. a chunk per node. It's called between streams, so the
+	// state contains all the segments of the processed streams.
+	SaveState(ctx context.Context) ([][]byte, error)
+	// RestoreState restores the state of the observer from the chunks
+	// returned by SaveState. It's called before LoopStarted.
+	RestoreState(ctx context.Context, state [][]byte) error
+}
+
+// observerName returns the name, which identifies the state of the observer.
+func observerName(observer Observer) string {
+	return fmt.Sprintf("%T", observer)
+}
+
+// checkpointed returns whether the states of the observers can be saved.
+func checkpointed(observers []*observerContext) bool {
+	names := map[string]struct{}{}
+	for _, observer := range observers {
+		if _, ok := observer.observer.(CheckpointedObserver); !ok {
+			return false
+		}
+		// the states of the observers with the same name can't be told
+		// apart.
+		name := observerName(observer.observer)
+		if _, ok := names[name]; ok {
+			return false
+		}
+		names[name] = struct{}{}
+	}
+	return len(observers) > 0
+}
+
+// checkpointsEnabled returns whether the loop saves checkpoints.
+func (loop *Service) checkpointsEnabled() bool {
+	return loop.checkpoints != nil && loop.config.CheckpointInterval > 0
+}
+
+// loadCheckpoint returns the checkpoint of the loop, when the observers can
+// continue from it. It returns nil, when the loop needs to start over.
+func (loop *Service) loadCheckpoint(ctx context.Context, observers []*observerContext, now time.Time) (_ *Checkpoint, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	checkpoint, err := loop.checkpoints.Get(ctx, loop.name)
+	if err != nil {
+		if ErrCheckpointNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	if age := now.Sub(checkpoint.Started); age > loop.config.CheckpointMaxAge {
+		loop.log.Info("checkpoint is too old to resume",
+			zap.Time("started", checkpoint.Started), zap.Duration("age", age))
+		return nil, nil
+	}
+	if len(checkpoint.ObserverStates) != len(observers) {
+		loop.log.Info("checkpoint was saved with different observers")
+		return nil, nil
+	}
+	for _, observer := range observers {
+		if _, ok := checkpoint.ObserverStates[observerName(observer.observer)]; !ok {
+			loop.log.Info("checkpoint was saved with different observers")
+			return nil, nil
+		}
+	}
+	return &checkpoint, nil
+}
+
+// saveCheckpoint saves the states of the observers and the position of the
+// loop.
+func (loop *Service) saveCheckpoint(ctx context.Context, observers []*observerContext, checkpoint Checkpoint) (_ []*observerContext, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	checkpoint.ObserverStates = make(map[string][][]byte, len(observers))
+	observers = withObservers(ctx, observers, func(ctx context.Context, observer *observerContext) bool {
+		state, err := observer.observer.(CheckpointedObserver).SaveState(ctx)
+		if err == nil && len(state) == 0 {
+			err = Error.New("%s saved an empty state", observerName(observer.observer))
+		}
+		if observer.HandleError(err) {
+			return false
+		}
+		checkpoint.ObserverStates[observerName(observer.observer)] = state
+		return true
+	})
+	if len(observers) == 0 {
+		return observers, errNoObservers
+	}
+
+	return observers, Error.Wrap(loop.checkpoints.Set(ctx, loop.name, checkpoint))
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentloop_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestCheckpointDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		checkpoints := db.SegmentLoopCheckpoints()
+
+		_, err := checkpoints.Get(ctx, "core")
+		require.True(t, segmentloop.ErrCheckpointNotFound.Has(err))
+
+		checkpoint := segmentloop.Checkpoint{
+			Started:           time.Now().Add(-time.Hour).Truncate(time.Microsecond).UTC(),
+			StreamID:          testrand.UUID(),
+			Segments:          100,
+			EstimatedSegments: 1000,
+			ObserverStates: map[string][][]byte{
+				"*gc.PieceTracker": {testrand.BytesInt(8), testrand.BytesInt(100), testrand.BytesInt(100)},
+				"*other.Observer":  {{0}},
+			},
+		}
+		require.NoError(t, checkpoints.Set(ctx, "core", checkpoint))
+
+		saved, err := checkpoints.Get(ctx, "core")
+		require.NoError(t, err)
+		require.False(t, saved.UpdatedAt.IsZero())
+		require.True(t, checkpoint.Started.Equal(saved.Started))
+		require.Equal(t, checkpoint.StreamID, saved.StreamID)
+		require.Equal(t, checkpoint.Segments, saved.Segments)
+		require.Equal(t, checkpoint.EstimatedSegments, saved.EstimatedSegments)
+		require.Equal(t, checkpoint.ObserverStates, saved.ObserverStates)
+
+		// the loops have separate checkpoints.
+		_, err = checkpoints.Get(ctx, "gc")
+		require.True(t, segmentloop.ErrCheckpointNotFound.Has(err))
+
+		checkpoint.StreamID = testrand.UUID()
+		checkpoint.Segments = 200
+		// the chunks of the previous checkpoint are replaced.
+		checkpoint.ObserverStates = map[string][][]byte{"*gc.PieceTracker": {{1, 2, 3}}}
+		require.NoError(t, checkpoints.Set(ctx, "core", checkpoint))
+
+		saved, err = checkpoints.Get(ctx, "core")
+		require.NoError(t, err)
+		require.Equal(t, checkpoint.StreamID, saved.StreamID)
+		require.EqualValues(t, 200, saved.Segments)
+		require.Equal(t, checkpoint.ObserverStates, saved.ObserverStates)
+
+		require.NoError(t, checkpoints.Delete(ctx, "core"))
+		_, err = checkpoints.Get(ctx, "core")
+		require.True(t, segmentloop.ErrCheckpointNotFound.Has(err))
+
+		// deleting a missing checkpoint isn't an error.
+		require.NoError(t, checkpoints.Delete(ctx, "core"))
+	})
+}
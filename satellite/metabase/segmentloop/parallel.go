@@ -0,0 +1,179 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentloop
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Partial processes the segments of a single stream ID range of a parallel
+// loop. The segments of a range are passed in order and from a single
+// goroutine.
+type Partial interface {
+	RemoteSegment(context.Context, *Segment) error
+	InlineSegment(context.Context, *Segment) error
+}
+
+// PartitionedObserver is an observer, which supports processing the stream ID
+// ranges of a parallel loop concurrently.
+//
+// Before the iteration, Fork is called once for every range. When a range is
+// finished, its partial is merged back into the observer with Join. The calls
+// to Fork and Join aren't concurrent, but Join may be called while the
+// partials of the other ranges are still processing segments.
+type PartitionedObserver interface {
+	Observer
+
+	// Fork creates a partial for processing a single range.
+	Fork(ctx context.Context) (Partial, error)
+	// Join merges the results of the partial into the observer.
+	Join(ctx context.Context, partial Partial) error
+}
+
+// streamRange is a range of stream IDs [Start, End). Zero End means that the
+// range doesn't have an upper limit.
+type streamRange struct {
+	Start uuid.UUID
+	End   uuid.UUID
+}
+
+// splitStreamIDs splits the stream ID keyspace into n ranges of equal size.
+// Stream IDs are random, so the ranges contain about the same number of
+// segments.
+func splitStreamIDs(n int) []streamRange {
+	ranges := make([]streamRange, n)
+	step := math.MaxUint64 / uint64(n)
+	for i := 1; i < n; i++ {
+		var boundary uuid.UUID
+		binary.BigEndian.PutUint64(boundary[:8], step*uint64(i))
+		ranges[i-1].End = boundary
+		ranges[i].Start = boundary
+	}
+	return ranges
+}
+
+// partitioned returns whether all observers support parallel iteration.
+func partitioned(observers []*observerContext) bool {
+	for _, observer := range observers {
+		if _, ok := observer.observer.(PartitionedObserver); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// iterateRanges iterates the stream ID ranges concurrently. Every range is
+// processed by its own partials of the observers.
+func (loop *Service) iterateRanges(ctx context.Context, observers []*observerContext, ranges []streamRange, opts metabase.IterateLoopSegments, rateLimiter *rate.Limiter) (processed processedStats, _ []*observerContext, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	partials := make([][]Partial, len(ranges))
+	for i := range ranges {
+		partials[i] = make([]Partial, len(observers))
+		for k, observer := range observers {
+			if observer.failed() {
+				continue
+			}
+			partial, err := observer.observer.(PartitionedObserver).Fork(ctx)
+			if err != nil {
+				observer.HandleError(err)
+				continue
+			}
+			partials[i][k] = partial
+		}
+	}
+
+	var joinMu sync.Mutex
+	group, groupCtx := errgroup.WithContext(ctx)
+	for i, streamRange := range ranges {
+		rangeOpts := opts
+		rangeOpts.StartStreamID = streamRange.Start
+		rangeOpts.EndStreamID = streamRange.End
+		rangePartials := partials[i]
+
+		group.Go(func() error {
+			err := loop.iterateRange(groupCtx, observers, rangePartials, rangeOpts, rateLimiter)
+			if err != nil {
+				return err
+			}
+
+			joinMu.Lock()
+			defer joinMu.Unlock()
+
+			for k, partial := range rangePartials {
+				if partial == nil || observers[k].failed() {
+					continue
+				}
+				if err := observers[k].observer.(PartitionedObserver).Join(groupCtx, partial); err != nil {
+					observers[k].HandleError(err)
+				}
+			}
+			loop.progress.rangeDone()
+			return nil
+		})
+	}
+	err = group.Wait()
+	processed.segments = loop.progress.get().Segments
+
+	observers = withObservers(ctx, observers, func(ctx context.Context, observer *observerContext) bool {
+		return !observer.failed()
+	})
+	if err == nil && len(observers) == 0 {
+		err = errNoObservers
+	}
+	return processed, observers, err
+}
+
+// iterateRange passes the segments of a single range to the partials.
+func (loop *Service) iterateRange(ctx context.Context, observers []*observerContext, partials []Partial, opts metabase.IterateLoopSegments, rateLimiter *rate.Limiter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return loop.metabaseDB.IterateLoopSegments(ctx, opts, func(ctx context.Context, iterator metabase.LoopSegmentsIterator) error {
+		var entry metabase.LoopSegmentEntry
+		for iterator.Next(ctx, &entry) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return err
+			}
+
+			active := 0
+			for k, partial := range partials {
+				if partial == nil {
+					continue
+				}
+				if observers[k].failed() {
+					partials[k] = nil
+					continue
+				}
+
+				segment := Segment(entry)
+				if err := handleSegment(ctx, observers[k], partial, &segment); err != nil {
+					observers[k].HandleError(err)
+					partials[k] = nil
+					continue
+				}
+				active++
+			}
+			if active == 0 {
+				return errNoObservers
+			}
+
+			processed := loop.progress.segmentProcessed()
+			mon.IntVal("segmentsProcessed").Observe(processed) //mon:locked
+		}
+		return nil
+	})
+}
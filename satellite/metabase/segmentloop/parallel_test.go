@@ -0,0 +1,41 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentloop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+)
+
+func TestSplitStreamIDs(t *testing.T) {
+	require.Equal(t, []streamRange{{}}, splitStreamIDs(1))
+
+	for _, n := range []int{2, 3, 7, 16} {
+		ranges := splitStreamIDs(n)
+		require.Len(t, ranges, n)
+
+		// the ranges cover the whole keyspace without gaps.
+		require.True(t, ranges[0].Start.IsZero())
+		require.True(t, ranges[n-1].End.IsZero())
+		for i := 1; i < n; i++ {
+			require.Equal(t, ranges[i-1].End, ranges[i].Start)
+			require.Equal(t, -1, ranges[i-1].Start.Compare(ranges[i].Start))
+		}
+
+		// every stream ID is in exactly one range.
+		for k := 0; k < 100; k++ {
+			streamID := testrand.UUID()
+			found := 0
+			for _, r := range ranges {
+				if streamID.Compare(r.Start) >= 0 && (r.End.IsZero() || streamID.Compare(r.End) < 0) {
+					found++
+				}
+			}
+			require.Equal(t, 1, found, streamID)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentloop
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Progress describes the progress of the segments loop.
+type Progress struct {
+	// Running is true while the loop iterates the segments.
+	Running bool
+	// Started is the time the iteration started at.
+	Started time.Time
+	// Ranges is the number of stream ID ranges, which are iterated
+	// concurrently. It is one for a serial loop.
+	Ranges int
+	// RangesDone is the number of finished ranges.
+	RangesDone int
+	// Segments is the number of processed segments.
+	Segments int64
+	// EstimatedSegments is the number of segments at the start of the loop.
+	EstimatedSegments int64
+
+	// Resumed is true, when the loop continued from a checkpoint.
+	Resumed bool
+	// ResumedSegments is the number of segments, which were processed
+	// before the loop was resumed.
+	ResumedSegments int64
+	// IterationStarted is the time this process started the iteration. It
+	// differs from Started, when the loop was resumed.
+	IterationStarted time.Time
+}
+
+// ETA estimates the remaining duration of the loop from the processing rate
+// of the current iteration. It returns zero, when the rate isn't known yet.
+func (progress Progress) ETA(now time.Time) time.Duration {
+	elapsed := now.Sub(progress.IterationStarted)
+	processed := progress.Segments - progress.ResumedSegments
+	if !progress.Running || elapsed <= 0 || processed <= 0 {
+		return 0
+	}
+
+	remaining := progress.EstimatedSegments - progress.Segments
+	if remaining <= 0 {
+		return 0
+	}
+	return time.Duration(float64(elapsed) / float64(processed) * float64(remaining))
+}
+
+// progressTracker tracks the progress of the current iteration.
+type progressTracker struct {
+	// segments is updated atomically, since it changes for every segment.
+	segments int64
+
+	mu       sync.Mutex
+	progress Progress
+}
+
+// start starts tracking a new iteration, the progress contains the values
+// at the start.
+func (tracker *progressTracker) start(progress Progress) {
+	atomic.StoreInt64(&tracker.segments, progress.Segments)
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	progress.Running = true
+	progress.RangesDone = 0
+	progress.ResumedSegments = progress.Segments
+	progress.IterationStarted = time.Now()
+	tracker.progress = progress
+}
+
+func (tracker *progressTracker) segmentProcessed() int64 {
+	return atomic.AddInt64(&tracker.segments, 1)
+}
+
+func (tracker *progressTracker) rangeDone() {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.progress.RangesDone++
+}
+
+func (tracker *progressTracker) finish() {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.progress.Running = false
+}
+
+func (tracker *progressTracker) get() Progress {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	progress := tracker.progress
+	progress.Segments = atomic.LoadInt64(&tracker.segments)
+	return progress
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentloop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressETA(t *testing.T) {
+	now := time.Now()
+
+	progress := Progress{
+		Running:           true,
+		EstimatedSegments: 1000,
+		Segments:          250,
+		IterationStarted:  now.Add(-time.Minute),
+	}
+	require.Equal(t, 3*time.Minute, progress.ETA(now))
+
+	// only the segments processed after resuming count for the rate.
+	progress.Resumed = true
+	progress.ResumedSegments = 200
+	require.Equal(t, 15*time.Minute, progress.ETA(now))
+
+	// the rate isn't known before processing any segments.
+	progress.Segments = 200
+	require.Zero(t, progress.ETA(now))
+
+	// the estimate can be lower than the processed segments.
+	progress.Segments = 1200
+	require.Zero(t, progress.ETA(now))
+
+	progress.Running = false
+	progress.Segments = 500
+	require.Zero(t, progress.ETA(now))
+}
+
+func TestProgressTracker(t *testing.T) {
+	var tracker progressTracker
+
+	started := time.Now().Add(-time.Hour)
+	tracker.start(Progress{Started: started, Ranges: 2, Segments: 10, EstimatedSegments: 100, Resumed: true})
+	tracker.segmentProcessed()
+	tracker.segmentProcessed()
+	tracker.rangeDone()
+
+	progress := tracker.get()
+	require.True(t, progress.Running)
+	require.Equal(t, started, progress.Started)
+	require.EqualValues(t, 12, progress.Segments)
+	require.EqualValues(t, 10, progress.ResumedSegments)
+	require.Equal(t, 1, progress.RangesDone)
+	require.False(t, progress.IterationStarted.Before(started))
+
+	tracker.finish()
+	require.False(t, tracker.get().Running)
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -16,6 +18,7 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/common/sync2"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -83,7 +86,13 @@ type observerContext struct {
 
 	ctx  context.Context
 	done chan error
+	// failedFlag is set atomically, when the observer has been finished with
+	// an error.
+	failedFlag int32
 
+	// mu protects the durations, which are updated concurrently by a
+	// parallel loop.
+	mu     sync.Mutex
 	remote *monkit.DurationDist
 	inline *monkit.DurationDist
 }
@@ -103,34 +112,52 @@ func newObserverContext(ctx context.Context, obs Observer) *observerContext {
 	}
 }
 
-func (observer *observerContext) RemoteSegment(ctx context.Context, segment *Segment) error {
+func (observer *observerContext) RemoteSegment(ctx context.Context, partial Partial, segment *Segment) error {
 	start := time.Now()
-	defer func() { observer.remote.Insert(time.Since(start)) }()
+	defer func() { observer.insert(observer.remote, time.Since(start)) }()
 
-	return observer.observer.RemoteSegment(ctx, segment)
+	return partial.RemoteSegment(ctx, segment)
 }
 
-func (observer *observerContext) InlineSegment(ctx context.Context, segment *Segment) error {
+func (observer *observerContext) InlineSegment(ctx context.Context, partial Partial, segment *Segment) error {
 	start := time.Now()
-	defer func() { observer.inline.Insert(time.Since(start)) }()
+	defer func() { observer.insert(observer.inline, time.Since(start)) }()
 
-	return observer.observer.InlineSegment(ctx, segment)
+	return partial.InlineSegment(ctx, segment)
 }
 
+func (observer *observerContext) insert(dist *monkit.DurationDist, duration time.Duration) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	dist.Insert(duration)
+}
+
+// HandleError finishes the observer with the error. Only the first error
+// finishes the observer, so it's safe to call concurrently.
 func (observer *observerContext) HandleError(err error) bool {
 	if err != nil {
-		observer.done <- err
-		observer.Finish()
+		if atomic.CompareAndSwapInt32(&observer.failedFlag, 0, 1) {
+			observer.done <- err
+			observer.Finish()
+		}
 		return true
 	}
 	return false
 }
 
+// failed returns whether the observer was finished with an error.
+func (observer *observerContext) failed() bool {
+	return atomic.LoadInt32(&observer.failedFlag) != 0
+}
+
 func (observer *observerContext) Finish() {
 	close(observer.done)
 
 	name := fmt.Sprintf("%T", observer.observer)
 	stats := allObserverStatsCollectors.GetStats(name)
+
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
 	stats.Observe(observer)
 }
 
@@ -147,6 +174,11 @@ type Config struct {
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
 
 	SuspiciousProcessedRatio float64 `help:"ratio where to consider processed count as supicious" default:"0.03"`
+
+	Parallelism int `help:"number of stream ID ranges to iterate concurrently, the loop is serial when an observer doesn't support partitioning" default:"1"`
+
+	CheckpointInterval time.Duration `help:"how often to save the progress of a serial loop, which allows resuming it after a restart, zero disables checkpoints" releaseDefault:"15m" devDefault:"0s"`
+	CheckpointMaxAge   time.Duration `help:"maximum age of a loop, which can be resumed from a checkpoint; the resumed loop reads the remaining segments from a fresh snapshot" default:"12h"`
 }
 
 // MetabaseDB contains iterators for the metabase data.
@@ -164,22 +196,42 @@ type MetabaseDB interface {
 //
 // architecture: Service
 type Service struct {
-	log        *zap.Logger
-	config     Config
-	metabaseDB MetabaseDB
-	join       chan *observerContext
-	done       chan struct{}
+	log         *zap.Logger
+	config      Config
+	metabaseDB  MetabaseDB
+	checkpoints CheckpointDB
+	name        string
+	join        chan *observerContext
+	done        chan struct{}
+
+	includeMissingAliases bool
+
+	progress progressTracker
+}
+
+// New creates a new segments loop service. The name identifies the checkpoints
+// and the progress metrics of the loop. The loop doesn't save checkpoints,
+// when checkpoints is nil.
+func New(log *zap.Logger, config Config, metabaseDB MetabaseDB, checkpoints CheckpointDB, name string) *Service {
+	loop := &Service{
+		log:         log,
+		metabaseDB:  metabaseDB,
+		checkpoints: checkpoints,
+		name:        name,
+		config:      config,
+		join:        make(chan *observerContext),
+		done:        make(chan struct{}),
+	}
+	allProgressStats.Register(name, &loop.progress)
+	return loop
 }
 
-// New creates a new segments loop service.
-func New(log *zap.Logger, config Config, metabaseDB MetabaseDB) *Service {
-	return &Service{
-		log:        log,
-		metabaseDB: metabaseDB,
-		config:     config,
-		join:       make(chan *observerContext),
-		done:       make(chan struct{}),
-	}
+// IncludeMissingAliases makes the loop pass the segments with node aliases,
+// which aren't in the database, to the observers without their pieces, instead
+// of failing. The observers must check Segment.MissingAliases. It must be
+// called before Run.
+func (loop *Service) IncludeMissingAliases() {
+	loop.includeMissingAliases = true
 }
 
 // Join will join the looper for one full cycle until completion and then returns.
@@ -343,6 +395,12 @@ func stopTimer(t *time.Timer) {
 	}
 }
 
+// Progress returns the progress of the current or the last loop.
+// Safe to be called concurrently.
+func (loop *Service) Progress() Progress {
+	return loop.progress.get()
+}
+
 // Wait waits for run to be finished.
 // Safe to be called concurrently.
 func (loop *Service) Wait() {
@@ -370,7 +428,7 @@ func (loop *Service) iterateDatabase(ctx context.Context, observers []*observerC
 	}
 
 	var processed processedStats
-	processed, observers, err = loop.iterateSegments(ctx, observers)
+	processed, observers, err = loop.iterateSegments(ctx, observers, before.SegmentCount)
 	if errors.Is(err, errNoObservers) {
 		return nil
 	}
@@ -433,7 +491,7 @@ type processedStats struct {
 	segments int64
 }
 
-func (loop *Service) iterateSegments(ctx context.Context, observers []*observerContext) (processed processedStats, _ []*observerContext, err error) {
+func (loop *Service) iterateSegments(ctx context.Context, observers []*observerContext, estimatedSegments int64) (processed processedStats, _ []*observerContext, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	rateLimiter := rate.NewLimiter(rate.Limit(loop.config.RateLimit), 1)
@@ -452,6 +510,66 @@ func (loop *Service) iterateSegments(ctx context.Context, observers []*observerC
 		return processed, observers, Error.Wrap(err)
 	}
 
+	parallel := loop.config.Parallelism > 1 && partitioned(observers)
+	if loop.config.Parallelism > 1 && !parallel {
+		mon.Event("segmentloop_serial_fallback")
+	}
+
+	// only the serial loop saves checkpoints.
+	checkpointing := !parallel && loop.checkpointsEnabled() && checkpointed(observers)
+
+	var resume *Checkpoint
+	if checkpointing {
+		resume, err = loop.loadCheckpoint(ctx, observers, startingTime)
+		if err != nil {
+			return processed, observers, err
+		}
+	}
+
+	progress := Progress{
+		Started:           startingTime,
+		Ranges:            1,
+		EstimatedSegments: estimatedSegments,
+	}
+	opts := metabase.IterateLoopSegments{
+		BatchSize:          limit,
+		AsOfSystemTime:     startingTime,
+		AsOfSystemInterval: loop.config.AsOfSystemInterval,
+
+		IncludeMissingAliases: loop.includeMissingAliases,
+	}
+
+	if resume != nil {
+		loop.log.Info("resuming loop from checkpoint",
+			zap.Time("started", resume.Started),
+			zap.Stringer("stream id", resume.StreamID),
+			zap.Int64("segments", resume.Segments))
+		mon.Event("segmentloop_resumed")
+
+		observers = withObservers(ctx, observers, func(ctx context.Context, observer *observerContext) bool {
+			state := resume.ObserverStates[observerName(observer.observer)]
+			err := observer.observer.(CheckpointedObserver).RestoreState(ctx, state)
+			return !observer.HandleError(err)
+		})
+
+		// the observers continue the loop, which started at resume.Started,
+		// but the remaining streams are read from a fresh snapshot, so the
+		// reads stay within AsOfSystemInterval like in a loop, which wasn't
+		// resumed. Segments deleted since the loop started are skipped
+		// and segments created since then are included. Both are fine for
+		// the observers: e.g. gc bloom filters only cover the pieces created
+		// before the creation date of the filter and the segments created
+		// later are protected by it.
+		startingTime = resume.Started
+		opts.StartStreamID = resume.StreamID
+		processed.segments = resume.Segments
+
+		progress.Started = resume.Started
+		progress.EstimatedSegments = resume.EstimatedSegments
+		progress.Segments = resume.Segments
+		progress.Resumed = true
+	}
+
 	observers = withObservers(ctx, observers, func(ctx context.Context, observer *observerContext) bool {
 		err := observer.observer.LoopStarted(ctx, LoopInfo{Started: startingTime})
 		return !observer.HandleError(err)
@@ -461,11 +579,22 @@ func (loop *Service) iterateSegments(ctx context.Context, observers []*observerC
 		return processed, observers, errNoObservers
 	}
 
-	err = loop.metabaseDB.IterateLoopSegments(ctx, metabase.IterateLoopSegments{
-		BatchSize:          limit,
-		AsOfSystemTime:     startingTime,
-		AsOfSystemInterval: loop.config.AsOfSystemInterval,
-	}, func(ctx context.Context, iterator metabase.LoopSegmentsIterator) error {
+	if parallel {
+		ranges := splitStreamIDs(loop.config.Parallelism)
+		progress.Ranges = len(ranges)
+		loop.progress.start(progress)
+		defer loop.progress.finish()
+
+		return loop.iterateRanges(ctx, observers, ranges, opts, rateLimiter)
+	}
+
+	loop.progress.start(progress)
+	defer loop.progress.finish()
+
+	lastCheckpoint := time.Now()
+	var lastStreamID uuid.UUID
+
+	err = loop.metabaseDB.IterateLoopSegments(ctx, opts, func(ctx context.Context, iterator metabase.LoopSegmentsIterator) error {
 		defer mon.TaskNamed("iterateLoopSegmentsCB")(&ctx)(&err)
 
 		var entry metabase.LoopSegmentEntry
@@ -474,6 +603,29 @@ func (loop *Service) iterateSegments(ctx context.Context, observers []*observerC
 				return err
 			}
 
+			// the checkpoints are saved between the streams, so the observers
+			// don't need to keep the state of partially processed streams.
+			if checkpointing && entry.StreamID != lastStreamID && time.Since(lastCheckpoint) >= loop.config.CheckpointInterval {
+				var err error
+				observers, err = loop.saveCheckpoint(ctx, observers, Checkpoint{
+					Started:           startingTime,
+					StreamID:          entry.StreamID,
+					Segments:          processed.segments,
+					EstimatedSegments: progress.EstimatedSegments,
+				})
+				if errors.Is(err, errNoObservers) {
+					return err
+				}
+				if err != nil {
+					// the loop can continue, it just can't be resumed from
+					// this position.
+					loop.log.Warn("failed to save checkpoint", zap.Error(err))
+					mon.Event("segmentloop_checkpoint_failed")
+				}
+				lastCheckpoint = time.Now()
+			}
+			lastStreamID = entry.StreamID
+
 			timer := mon.Timer("iterateLoopSegmentsRateLimit").Start()
 			if err := rateLimiter.Wait(ctx); err != nil {
 				// We don't really execute concurrent batches so we should never
@@ -486,17 +638,28 @@ func (loop *Service) iterateSegments(ctx context.Context, observers []*observerC
 
 			observers = withObservers(ctx, observers, func(ctx context.Context, observer *observerContext) bool {
 				segment := Segment(entry)
-				return !observer.HandleError(handleSegment(ctx, observer, &segment))
+				return !observer.HandleError(handleSegment(ctx, observer, observer.observer, &segment))
 			})
 			if len(observers) == 0 {
 				return errNoObservers
 			}
 
 			processed.segments++
+			loop.progress.segmentProcessed()
 			mon.IntVal("segmentsProcessed").Observe(processed.segments) //mon:locked
 		}
 		return nil
 	})
+	if err == nil {
+		loop.progress.rangeDone()
+
+		if checkpointing {
+			// the loop finished, so there's nothing to resume.
+			if err := loop.checkpoints.Delete(ctx, loop.name); err != nil {
+				loop.log.Warn("failed to delete checkpoint", zap.Error(err))
+			}
+		}
+	}
 
 	return processed, observers, err
 }
@@ -513,15 +676,15 @@ func withObservers(ctx context.Context, observers []*observerContext, handleObse
 	return nextObservers
 }
 
-func handleSegment(ctx context.Context, observer *observerContext, segment *Segment) (err error) {
+func handleSegment(ctx context.Context, observer *observerContext, partial Partial, segment *Segment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if segment.Inline() {
-		if err := observer.InlineSegment(ctx, segment); err != nil {
+		if err := observer.InlineSegment(ctx, partial, segment); err != nil {
 			return err
 		}
 	} else {
-		if err := observer.RemoteSegment(ctx, segment); err != nil {
+		if err := observer.RemoteSegment(ctx, partial, segment); err != nil {
 			return err
 		}
 	}
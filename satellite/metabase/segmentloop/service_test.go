@@ -8,6 +8,7 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -135,6 +136,131 @@ func TestSegmentsLoop_AllData(t *testing.T) {
 	})
 }
 
+func TestSegmentsLoop_Parallel(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 4,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.SegmentLoop.CoalesceDuration = 1 * time.Second
+				config.Metainfo.SegmentLoop.ListLimit = 2
+				config.Metainfo.SegmentLoop.Parallelism = 4
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		for i := 0; i < 10; i++ {
+			err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "bucket", "remote/"+strconv.Itoa(i), testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+		for i := 0; i < 3; i++ {
+			err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "bucket", "inline/"+strconv.Itoa(i), testrand.Bytes(1*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		loop := planet.Satellites[0].Metabase.SegmentLoop
+
+		partitioned := &partitionedTestObserver{testObserver: newTestObserver(nil)}
+		require.NoError(t, loop.Join(ctx, partitioned))
+
+		require.Equal(t, 10, partitioned.remoteSegCount)
+		require.Equal(t, 3, partitioned.inlineSegCount)
+		require.Len(t, partitioned.uniqueKeys, 13)
+		require.Equal(t, 4, partitioned.joined)
+
+		progress := loop.Progress()
+		require.False(t, progress.Running)
+		require.Equal(t, 4, progress.Ranges)
+		require.Equal(t, 4, progress.RangesDone)
+		require.EqualValues(t, 13, progress.Segments)
+
+		// the loop is serial, when any of the observers doesn't support
+		// partitioning.
+		partitioned = &partitionedTestObserver{testObserver: newTestObserver(nil)}
+		serial := newTestObserver(nil)
+
+		var group errgroup.Group
+		group.Go(func() error {
+			return loop.Join(ctx, partitioned)
+		})
+		group.Go(func() error {
+			return loop.Join(ctx, serial)
+		})
+		require.NoError(t, group.Wait())
+
+		for _, obs := range []*testObserver{partitioned.testObserver, serial} {
+			require.Equal(t, 10, obs.remoteSegCount)
+			require.Equal(t, 3, obs.inlineSegCount)
+			require.Len(t, obs.uniqueKeys, 13)
+		}
+		require.Equal(t, 0, partitioned.joined)
+		require.Equal(t, 1, loop.Progress().Ranges)
+	})
+}
+
+func TestSegmentsLoop_Checkpoint(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		for i := 0; i < 10; i++ {
+			err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "bucket", "remote/"+strconv.Itoa(i), testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		checkpoints := &memoryCheckpoints{}
+		config := segmentloop.Config{
+			CoalesceDuration:   time.Nanosecond,
+			ListLimit:          2,
+			CheckpointInterval: time.Nanosecond,
+			CheckpointMaxAge:   time.Hour,
+		}
+
+		runOnce := func(obs segmentloop.Observer) error {
+			loop := segmentloop.New(zaptest.NewLogger(t), config, planet.Satellites[0].Metabase.DB, checkpoints, "test")
+
+			var group errgroup.Group
+			group.Go(func() error {
+				return loop.RunOnce(ctx)
+			})
+			joinErr := loop.Join(ctx, obs)
+			require.NoError(t, group.Wait())
+			return joinErr
+		}
+
+		// the first loop stops in the middle of the iteration.
+		first := &checkpointTestObserver{testObserver: newTestObserver(nil)}
+		first.onSegment = func(ctx context.Context) error {
+			if first.remoteSegCount > 5 {
+				return errors.New("stop")
+			}
+			return nil
+		}
+		require.Error(t, runOnce(first))
+
+		checkpoint, err := checkpoints.Get(ctx, "test")
+		require.NoError(t, err)
+		require.EqualValues(t, 5, checkpoint.Segments)
+		require.EqualValues(t, 10, checkpoint.EstimatedSegments)
+
+		// the second loop continues from the checkpoint.
+		second := &checkpointTestObserver{testObserver: newTestObserver(nil)}
+		require.NoError(t, runOnce(second))
+		require.True(t, second.restored)
+		require.Len(t, second.uniqueKeys, 10)
+		require.Equal(t, 10, second.remoteSegCount)
+
+		// the finished loop doesn't leave a checkpoint.
+		_, err = checkpoints.Get(ctx, "test")
+		require.True(t, segmentloop.ErrCheckpointNotFound.Has(err))
+
+		// the loop starts over without a checkpoint.
+		third := &checkpointTestObserver{testObserver: newTestObserver(nil)}
+		require.NoError(t, runOnce(third))
+		require.False(t, third.restored)
+		require.Len(t, third.uniqueKeys, 10)
+	})
+}
+
 // TestsegmentsLoopObserverCancel does the following:
 // * upload 3 remote segments
 // * hook three observers up to segments loop
@@ -254,7 +380,7 @@ func TestSegmentsLoopCancel(t *testing.T) {
 		loop := segmentloop.New(zaptest.NewLogger(t), segmentloop.Config{
 			CoalesceDuration: 1 * time.Second,
 			ListLimit:        10000,
-		}, satellite.Metabase.DB)
+		}, satellite.Metabase.DB, nil, "test")
 
 		// create a cancelable context to pass into metaLoop.Run
 		loopCtx, cancel := context.WithCancel(ctx)
@@ -326,7 +452,7 @@ func TestSegmentsLoop_MonitorCancel(t *testing.T) {
 		loop := segmentloop.New(zaptest.NewLogger(t), segmentloop.Config{
 			CoalesceDuration: time.Nanosecond,
 			ListLimit:        10000,
-		}, satellite.Metabase.DB)
+		}, satellite.Metabase.DB, nil, "test")
 
 		obs1 := newTestObserver(func(ctx context.Context) error {
 			return errors.New("test error")
@@ -424,3 +550,98 @@ func (obs *testObserver) InlineSegment(ctx context.Context, segment *segmentloop
 	obs.uniqueKeys[key] = struct{}{}
 	return nil
 }
+
+type partitionedTestObserver struct {
+	*testObserver
+	joined int
+}
+
+func (obs *partitionedTestObserver) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return newTestObserver(nil), nil
+}
+
+func (obs *partitionedTestObserver) Join(ctx context.Context, partial segmentloop.Partial) error {
+	fork := partial.(*testObserver)
+	obs.remoteSegCount += fork.remoteSegCount
+	obs.inlineSegCount += fork.inlineSegCount
+	for key := range fork.uniqueKeys {
+		if _, ok := obs.uniqueKeys[key]; ok {
+			return errors.New("segment was processed by more than one range")
+		}
+		obs.uniqueKeys[key] = struct{}{}
+	}
+	obs.joined++
+	return nil
+}
+
+type checkpointTestObserver struct {
+	*testObserver
+	restored bool
+}
+
+func (obs *checkpointTestObserver) SaveState(ctx context.Context) ([][]byte, error) {
+	state := [][]byte{[]byte(strconv.Itoa(obs.remoteSegCount))}
+	for key := range obs.uniqueKeys {
+		chunk := append([]byte(nil), key.StreamID[:]...)
+		chunk = append(chunk, strconv.FormatUint(key.Position.Encode(), 10)...)
+		state = append(state, chunk)
+	}
+	return state, nil
+}
+
+func (obs *checkpointTestObserver) RestoreState(ctx context.Context, state [][]byte) (err error) {
+	if len(state) == 0 {
+		return errors.New("empty state")
+	}
+	for _, chunk := range state[1:] {
+		var key testKey
+		copy(key.StreamID[:], chunk)
+
+		position, err := strconv.ParseUint(string(chunk[len(key.StreamID):]), 10, 64)
+		if err != nil {
+			return err
+		}
+		key.Position = metabase.SegmentPositionFromEncoded(position)
+
+		obs.uniqueKeys[key] = struct{}{}
+	}
+	obs.remoteSegCount, err = strconv.Atoi(string(state[0]))
+	obs.restored = true
+	return err
+}
+
+type memoryCheckpoints struct {
+	mu          sync.Mutex
+	checkpoints map[string]segmentloop.Checkpoint
+}
+
+func (db *memoryCheckpoints) Get(ctx context.Context, name string) (segmentloop.Checkpoint, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	checkpoint, ok := db.checkpoints[name]
+	if !ok {
+		return segmentloop.Checkpoint{}, segmentloop.ErrCheckpointNotFound.New("%s", name)
+	}
+	return checkpoint, nil
+}
+
+func (db *memoryCheckpoints) Set(ctx context.Context, name string, checkpoint segmentloop.Checkpoint) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.checkpoints == nil {
+		db.checkpoints = map[string]segmentloop.Checkpoint{}
+	}
+	checkpoint.UpdatedAt = time.Now()
+	db.checkpoints[name] = checkpoint
+	return nil
+}
+
+func (db *memoryCheckpoints) Delete(ctx context.Context, name string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	delete(db.checkpoints, name)
+	return nil
+}
@@ -77,3 +77,73 @@ func (stats *observerStats) Stats(cb func(key monkit.SeriesKey, field string, va
 		stats.remote.Stats(cb)
 	}
 }
+
+var allProgressStats = newProgressStatsCollectors()
+
+type progressStatsCollectors struct {
+	mu   sync.Mutex
+	loop map[string]*progressStats
+}
+
+func newProgressStatsCollectors() *progressStatsCollectors {
+	return &progressStatsCollectors{
+		loop: make(map[string]*progressStats),
+	}
+}
+
+// Register reports the progress of the tracker under the loop name. A loop
+// registered later with the same name replaces the earlier one.
+func (list *progressStatsCollectors) Register(name string, tracker *progressTracker) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	stats, ok := list.loop[name]
+	if !ok {
+		stats = &progressStats{
+			key: monkit.NewSeriesKey("segment-loop-progress").WithTag("name", name),
+		}
+		mon.Chain(stats)
+		list.loop[name] = stats
+	}
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	stats.tracker = tracker
+}
+
+// progressStats reports the progress of a loop.
+type progressStats struct {
+	mu      sync.Mutex
+	key     monkit.SeriesKey
+	tracker *progressTracker
+}
+
+// Stats implements the monkit.StatSource interface.
+func (stats *progressStats) Stats(cb func(key monkit.SeriesKey, field string, val float64)) {
+	stats.mu.Lock()
+	tracker := stats.tracker
+	stats.mu.Unlock()
+
+	progress := tracker.get()
+	running, resumed := 0.0, 0.0
+	if progress.Running {
+		running = 1
+	}
+	if progress.Resumed {
+		resumed = 1
+	}
+
+	var ratio float64
+	if progress.EstimatedSegments > 0 {
+		ratio = float64(progress.Segments) / float64(progress.EstimatedSegments)
+	}
+
+	cb(stats.key, "running", running)
+	cb(stats.key, "resumed", resumed)
+	cb(stats.key, "segments", float64(progress.Segments))
+	cb(stats.key, "estimated_segments", float64(progress.EstimatedSegments))
+	cb(stats.key, "ratio", ratio)
+	cb(stats.key, "ranges", float64(progress.Ranges))
+	cb(stats.key, "ranges_done", float64(progress.RangesDone))
+	cb(stats.key, "eta_seconds", progress.ETA(time.Now()).Seconds())
+}
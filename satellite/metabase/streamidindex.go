@@ -0,0 +1,87 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/private/dbutil"
+)
+
+// streamIDIndex is the name of the index on the stream_id of the objects.
+const streamIDIndex = "objects_stream_id_index"
+
+// CreateStreamIDIndex creates the index on the stream_id of the objects,
+// which is needed for looking up the objects of the segments, e.g. by the
+// consistency checks and by the repair checker for attributing segments to
+// projects.
+//
+// The index isn't created by the migration, because building it takes long
+// on a large database. It's built without blocking the writes to the objects
+// and it's safe to call it again. An invalid index, which was left behind by
+// an interrupted build, is rebuilt.
+func (db *DB) CreateStreamIDIndex(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch db.impl {
+	case dbutil.Postgres:
+		exists, err := db.HasStreamIDIndex(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+
+		// CONCURRENTLY cannot run in a transaction, so these statements
+		// cannot be a migration step.
+		_, err = db.db.ExecContext(ctx, `DROP INDEX CONCURRENTLY IF EXISTS `+streamIDIndex)
+		if err != nil {
+			return Error.New("unable to drop invalid stream id index: %w", err)
+		}
+		_, err = db.db.ExecContext(ctx, `CREATE INDEX CONCURRENTLY `+streamIDIndex+` ON objects (stream_id)`)
+		if err != nil {
+			return Error.New("unable to create stream id index: %w", err)
+		}
+		return nil
+	case dbutil.Cockroach:
+		// schema changes don't block the writes in cockroach.
+		_, err = db.db.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS `+streamIDIndex+` ON objects (stream_id)`)
+		if err != nil {
+			return Error.New("unable to create stream id index: %w", err)
+		}
+		return nil
+	default:
+		return Error.New("unhandled database: %v", db.impl)
+	}
+}
+
+// HasStreamIDIndex returns whether the index on the stream_id of the objects
+// exists and is usable.
+func (db *DB) HasStreamIDIndex(ctx context.Context) (exists bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch db.impl {
+	case dbutil.Postgres:
+		err = db.db.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM pg_index
+				WHERE indexrelid = to_regclass($1) AND indisvalid
+			)
+		`, streamIDIndex).Scan(&exists)
+	case dbutil.Cockroach:
+		err = db.db.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM [SHOW INDEXES FROM objects]
+				WHERE index_name = $1
+			)
+		`, streamIDIndex).Scan(&exists)
+	default:
+		return false, Error.New("unhandled database: %v", db.impl)
+	}
+	if err != nil {
+		return false, Error.New("unable to check stream id index: %w", err)
+	}
+	return exists, nil
+}
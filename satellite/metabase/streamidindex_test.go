@@ -0,0 +1,35 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestCreateStreamIDIndex(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		exists, err := db.HasStreamIDIndex(ctx)
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		require.NoError(t, db.CreateStreamIDIndex(ctx))
+
+		exists, err = db.HasStreamIDIndex(ctx)
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		// creating the index again is a no-op.
+		require.NoError(t, db.CreateStreamIDIndex(ctx))
+
+		exists, err = db.HasStreamIDIndex(ctx)
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+}
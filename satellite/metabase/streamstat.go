@@ -10,6 +10,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
 )
 
@@ -63,3 +64,35 @@ func (db *DB) GetStreamPieceCountByNodeID(ctx context.Context, opts GetStreamPie
 
 	return result, nil
 }
+
+// GetStreamProjectIDs returns the project of each of the streams. Streams
+// without an object are missing from the result.
+//
+// It relies on the objects stream_id index, see CreateStreamIDIndex.
+func (db *DB) GetStreamProjectIDs(ctx context.Context, streamIDs []uuid.UUID) (result map[uuid.UUID]uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result = make(map[uuid.UUID]uuid.UUID, len(streamIDs))
+	if len(streamIDs) == 0 {
+		return result, nil
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT stream_id, project_id
+		FROM   objects
+		WHERE  stream_id = ANY($1::BYTEA[])
+	`, pgutil.UUIDArray(streamIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID, projectID uuid.UUID
+			if err := rows.Scan(&streamID, &projectID); err != nil {
+				return Error.New("failed to scan objects: %w", err)
+			}
+			result[streamID] = projectID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to fetch stream projects: %w", err)
+	}
+	return result, nil
+}
@@ -6,9 +6,12 @@ package metabase_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
 )
@@ -152,3 +155,24 @@ func TestGetStreamPieceCountByNodeID(t *testing.T) {
 		})
 	})
 }
+
+func TestGetStreamProjectIDs(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		first := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+		second := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+		missing := testrand.UUID()
+
+		result, err := db.GetStreamProjectIDs(ctx, nil)
+		require.NoError(t, err)
+		require.Empty(t, result)
+
+		result, err = db.GetStreamProjectIDs(ctx, []uuid.UUID{first.StreamID, second.StreamID, missing})
+		require.NoError(t, err)
+		require.Equal(t, map[uuid.UUID]uuid.UUID{
+			first.StreamID:  first.ProjectID,
+			second.StreamID: second.ProjectID,
+		}, result)
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ObjectTags are unencrypted key/value pairs attached to an object. Unlike
+// the encrypted metadata, the satellite can read them, which allows listing
+// objects by their tags.
+type ObjectTags map[string]string
+
+// Size returns the total length of the keys and values.
+func (tags ObjectTags) Size() int {
+	size := 0
+	for key, value := range tags {
+		size += len(key) + len(value)
+	}
+	return size
+}
+
+// Verify checks that the tags can be stored. The keys and values need to be
+// valid UTF-8 without NUL characters, because JSONB can't contain them.
+func (tags ObjectTags) Verify() error {
+	for key, value := range tags {
+		switch {
+		case key == "":
+			return ErrInvalidRequest.New("Tags key is empty")
+		case !utf8.ValidString(key) || !utf8.ValidString(value):
+			return ErrInvalidRequest.New("Tags contain invalid UTF-8")
+		case strings.IndexByte(key, 0) >= 0 || strings.IndexByte(value, 0) >= 0:
+			return ErrInvalidRequest.New("Tags contain a NUL character")
+		}
+	}
+	return nil
+}
+
+// Keys returns the sorted keys of the tags.
+func (tags ObjectTags) Keys() []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Value implements sql/driver.Valuer interface.
+func (tags ObjectTags) Value() (driver.Value, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(map[string]string(tags))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner interface.
+func (tags *ObjectTags) Scan(value interface{}) error {
+	var data []byte
+	switch value := value.(type) {
+	case nil:
+		*tags = nil
+		return nil
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	default:
+		return Error.New("unable to scan %T into ObjectTags", value)
+	}
+
+	var scanned map[string]string
+	if err := json.Unmarshal(data, &scanned); err != nil {
+		return Error.New("unable to scan ObjectTags: %v", err)
+	}
+	if len(scanned) == 0 {
+		scanned = nil
+	}
+	*tags = scanned
+	return nil
+}
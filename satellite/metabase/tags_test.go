@@ -0,0 +1,29 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestObjectTagsVerify(t *testing.T) {
+	require.NoError(t, metabase.ObjectTags(nil).Verify())
+	require.NoError(t, metabase.ObjectTags{"project": "apollo", "name": "Łódź"}.Verify())
+
+	for _, tags := range []metabase.ObjectTags{
+		{"": "apollo"},
+		{"project\x00": "apollo"},
+		{"project": "apo\x00llo"},
+		{"project\xff": "apollo"},
+		{"project": "apollo\xc3"},
+	} {
+		err := tags.Verify()
+		require.Error(t, err)
+		require.True(t, metabase.ErrInvalidRequest.Has(err))
+	}
+}
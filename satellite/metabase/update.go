@@ -13,6 +13,8 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
 	"storj.io/storj/storage"
 )
 
@@ -110,3 +112,135 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 
 	return nil
 }
+
+// UpdateSegmentRedundancy contains arguments necessary for re-encoding a
+// segment with a different redundancy scheme.
+type UpdateSegmentRedundancy struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+
+	OldRootPieceID storj.PieceID
+	OldPieces      Pieces
+
+	NewRedundancy  storj.RedundancyScheme
+	NewRootPieceID storj.PieceID
+	NewPieces      Pieces
+}
+
+// UpdateSegmentRedundancy atomically replaces the redundancy scheme, the root
+// piece id and the pieces of the segment. If the provided old root piece id
+// and pieces don't match the current database state, the update fails.
+//
+// Server-side copies share the pieces of their ancestor segment, so the
+// update is applied to the ancestor and the redundancy of all its copies is
+// replaced as well.
+func (db *DB) UpdateSegmentRedundancy(ctx context.Context, opts UpdateSegmentRedundancy) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case opts.StreamID.IsZero():
+		return ErrInvalidRequest.New("StreamID missing")
+	case opts.OldRootPieceID.IsZero():
+		return ErrInvalidRequest.New("OldRootPieceID missing")
+	case opts.NewRootPieceID.IsZero():
+		return ErrInvalidRequest.New("NewRootPieceID missing")
+	case opts.NewRootPieceID == opts.OldRootPieceID:
+		return ErrInvalidRequest.New("NewRootPieceID must differ from OldRootPieceID")
+	case opts.NewRedundancy.IsZero():
+		return ErrInvalidRequest.New("NewRedundancy zero")
+	}
+
+	if err := opts.OldPieces.Verify(); err != nil {
+		if ErrInvalidRequest.Has(err) {
+			return ErrInvalidRequest.New("OldPieces: %v", errs.Unwrap(err))
+		}
+		return err
+	}
+
+	if len(opts.NewPieces) < int(opts.NewRedundancy.RepairShares) {
+		return ErrInvalidRequest.New("number of new pieces is less than new redundancy repair shares value")
+	}
+
+	if err := opts.NewPieces.Verify(); err != nil {
+		if ErrInvalidRequest.Has(err) {
+			return ErrInvalidRequest.New("NewPieces: %v", errs.Unwrap(err))
+		}
+		return err
+	}
+
+	oldPieces, err := db.aliasCache.ConvertPiecesToAliases(ctx, opts.OldPieces)
+	if err != nil {
+		return Error.New("unable to convert pieces to aliases: %w", err)
+	}
+
+	newPieces, err := db.aliasCache.ConvertPiecesToAliases(ctx, opts.NewPieces)
+	if err != nil {
+		return Error.New("unable to convert pieces to aliases: %w", err)
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		streamID := opts.StreamID
+		err := tx.QueryRowContext(ctx, `
+			SELECT ancestor_stream_id FROM segment_copies WHERE stream_id = $1
+		`, opts.StreamID).Scan(&streamID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return Error.New("unable to query segment ancestor: %w", err)
+		}
+
+		var resultRootPieceID storj.PieceID
+		var resultPieces AliasPieces
+		err = tx.QueryRowContext(ctx, `
+			UPDATE segments SET
+				root_piece_id = CASE
+					WHEN root_piece_id = $3 AND remote_alias_pieces = $4 THEN $5
+					ELSE root_piece_id
+				END,
+				redundancy = CASE
+					WHEN root_piece_id = $3 AND remote_alias_pieces = $4 THEN $6
+					ELSE redundancy
+				END,
+				remote_alias_pieces = CASE
+					WHEN root_piece_id = $3 AND remote_alias_pieces = $4 THEN $7
+					ELSE remote_alias_pieces
+				END
+			WHERE
+				stream_id     = $1 AND
+				position      = $2
+			RETURNING root_piece_id, remote_alias_pieces
+			`, streamID, opts.Position, opts.OldRootPieceID, oldPieces,
+			opts.NewRootPieceID, redundancyScheme{&opts.NewRedundancy}, newPieces).
+			Scan(&resultRootPieceID, &resultPieces)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrSegmentNotFound.New("segment missing")
+			}
+			return Error.New("unable to update segment redundancy: %w", err)
+		}
+
+		if resultRootPieceID != opts.NewRootPieceID || !EqualAliasPieces(newPieces, resultPieces) {
+			return storage.ErrValueChanged.New("segment root_piece_id or remote_alias_pieces field was changed")
+		}
+
+		// copies take the root piece id and the pieces from the ancestor
+		// segment, but store their own redundancy.
+		_, err = tx.ExecContext(ctx, `
+			UPDATE segments SET
+				redundancy = $3
+			WHERE
+				stream_id IN (SELECT stream_id FROM segment_copies WHERE ancestor_stream_id = $1) AND
+				position = $2
+			`, streamID, opts.Position, redundancyScheme{&opts.NewRedundancy})
+		if err != nil {
+			return Error.New("unable to update redundancy of segment copies: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	mon.Meter("segment_update").Mark(1)
+
+	return nil
+}
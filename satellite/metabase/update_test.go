@@ -13,6 +13,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
 	"storj.io/storj/storage"
@@ -418,3 +419,253 @@ func TestUpdateSegmentPieces(t *testing.T) {
 		})
 	})
 }
+
+func TestUpdateSegmentRedundancy(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		now := time.Now()
+
+		oldPieces := metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}}
+
+		newRedundancy := storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      256,
+			RequiredShares: 1,
+			RepairShares:   1,
+			OptimalShares:  2,
+			TotalShares:    3,
+		}
+		newPieces := metabase.Pieces{
+			{Number: 0, StorageNode: testrand.NodeID()},
+			{Number: 1, StorageNode: testrand.NodeID()},
+		}
+
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			for _, tc := range []struct {
+				opts    metabase.UpdateSegmentRedundancy
+				errText string
+			}{
+				{metabase.UpdateSegmentRedundancy{}, "StreamID missing"},
+				{metabase.UpdateSegmentRedundancy{
+					StreamID: obj.StreamID,
+				}, "OldRootPieceID missing"},
+				{metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+				}, "NewRootPieceID missing"},
+				{metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+					NewRootPieceID: storj.PieceID{1},
+				}, "NewRootPieceID must differ from OldRootPieceID"},
+				{metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+					NewRootPieceID: storj.PieceID{2},
+				}, "NewRedundancy zero"},
+				{metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+					NewRootPieceID: storj.PieceID{2},
+					NewRedundancy:  newRedundancy,
+				}, "OldPieces: pieces missing"},
+				{metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+					OldPieces:      oldPieces,
+					NewRootPieceID: storj.PieceID{2},
+					NewRedundancy:  newRedundancy,
+				}, "number of new pieces is less than new redundancy repair shares value"},
+				{metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+					OldPieces:      oldPieces,
+					NewRootPieceID: storj.PieceID{2},
+					NewRedundancy:  newRedundancy,
+					NewPieces:      metabase.Pieces{{Number: 1, StorageNode: storj.NodeID{}}},
+				}, "NewPieces: piece number 1 is missing storage node id"},
+			} {
+				metabasetest.UpdateSegmentRedundancy{
+					Opts:     tc.opts,
+					ErrClass: &metabase.ErrInvalidRequest,
+					ErrText:  tc.errText,
+				}.Check(ctx, t, db)
+			}
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("segment not found", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.UpdateSegmentRedundancy{
+				Opts: metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					Position:       metabase.SegmentPosition{Index: 1},
+					OldRootPieceID: storj.PieceID{1},
+					OldPieces:      oldPieces,
+					NewRootPieceID: storj.PieceID{2},
+					NewRedundancy:  newRedundancy,
+					NewPieces:      newPieces,
+				},
+				ErrClass: &metabase.ErrSegmentNotFound,
+				ErrText:  "segment missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("segment was changed", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			expectedSegment := metabase.RawSegment{
+				StreamID:          obj.StreamID,
+				RootPieceID:       storj.PieceID{1},
+				CreatedAt:         now,
+				EncryptedKey:      []byte{3},
+				EncryptedKeyNonce: []byte{4},
+				EncryptedETag:     []byte{5},
+				EncryptedSize:     1024,
+				PlainOffset:       0,
+				PlainSize:         512,
+
+				Redundancy: metabasetest.DefaultRedundancy,
+				Pieces:     oldPieces,
+			}
+
+			// root piece id doesn't match.
+			metabasetest.UpdateSegmentRedundancy{
+				Opts: metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{3},
+					OldPieces:      oldPieces,
+					NewRootPieceID: storj.PieceID{2},
+					NewRedundancy:  newRedundancy,
+					NewPieces:      newPieces,
+				},
+				ErrClass: &storage.ErrValueChanged,
+				ErrText:  "segment root_piece_id or remote_alias_pieces field was changed",
+			}.Check(ctx, t, db)
+
+			// pieces don't match.
+			metabasetest.UpdateSegmentRedundancy{
+				Opts: metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+					OldPieces:      metabase.Pieces{{Number: 1, StorageNode: storj.NodeID{2}}},
+					NewRootPieceID: storj.PieceID{2},
+					NewRedundancy:  newRedundancy,
+					NewPieces:      newPieces,
+				},
+				ErrClass: &storage.ErrValueChanged,
+				ErrText:  "segment root_piece_id or remote_alias_pieces field was changed",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+				Segments: []metabase.RawSegment{expectedSegment},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("update redundancy", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			metabasetest.UpdateSegmentRedundancy{
+				Opts: metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+					OldPieces:      oldPieces,
+					NewRootPieceID: storj.PieceID{2},
+					NewRedundancy:  newRedundancy,
+					NewPieces:      newPieces,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+				Segments: []metabase.RawSegment{
+					{
+						StreamID:          obj.StreamID,
+						RootPieceID:       storj.PieceID{2},
+						CreatedAt:         now,
+						EncryptedKey:      []byte{3},
+						EncryptedKeyNonce: []byte{4},
+						EncryptedETag:     []byte{5},
+						EncryptedSize:     1024,
+						PlainOffset:       0,
+						PlainSize:         512,
+
+						Redundancy: newRedundancy,
+						Pieces:     newPieces,
+					},
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("update redundancy of copies", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+			copyObj, _, _ := metabasetest.CreateObjectCopy{
+				OriginalObject: object,
+			}.Run(ctx, t, db)
+
+			metabasetest.UpdateSegmentRedundancy{
+				Opts: metabase.UpdateSegmentRedundancy{
+					StreamID:       obj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+					OldPieces:      oldPieces,
+					NewRootPieceID: storj.PieceID{2},
+					NewRedundancy:  newRedundancy,
+					NewPieces:      newPieces,
+				},
+			}.Check(ctx, t, db)
+
+			segment, err := db.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+				StreamID: copyObj.StreamID,
+			})
+			require.NoError(t, err)
+			require.Equal(t, storj.PieceID{2}, segment.RootPieceID)
+			require.Equal(t, newRedundancy, segment.Redundancy)
+			require.Equal(t, newPieces, segment.Pieces)
+		})
+
+		t.Run("update redundancy through a copy", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+			copyObj, _, _ := metabasetest.CreateObjectCopy{
+				OriginalObject: object,
+			}.Run(ctx, t, db)
+
+			metabasetest.UpdateSegmentRedundancy{
+				Opts: metabase.UpdateSegmentRedundancy{
+					StreamID:       copyObj.StreamID,
+					OldRootPieceID: storj.PieceID{1},
+					OldPieces:      oldPieces,
+					NewRootPieceID: storj.PieceID{2},
+					NewRedundancy:  newRedundancy,
+					NewPieces:      newPieces,
+				},
+			}.Check(ctx, t, db)
+
+			for _, streamID := range []uuid.UUID{obj.StreamID, copyObj.StreamID} {
+				segment, err := db.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+					StreamID: streamID,
+				})
+				require.NoError(t, err)
+				require.Equal(t, storj.PieceID{2}, segment.RootPieceID)
+				require.Equal(t, newRedundancy, segment.Redundancy)
+				require.Equal(t, newPieces, segment.Pieces)
+			}
+		})
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// listVerifyStreamsLimit is the maximum number of streams in a single query.
+const listVerifyStreamsLimit = 1000
+
+// ListVerifyStreams contains arguments necessary for listing the streams for
+// consistency checks.
+type ListVerifyStreams struct {
+	StreamIDs []uuid.UUID
+
+	AsOfSystemInterval time.Duration
+}
+
+// Verify verifies the request fields.
+func (opts *ListVerifyStreams) Verify() error {
+	switch {
+	case len(opts.StreamIDs) == 0:
+		return ErrInvalidRequest.New("StreamIDs missing")
+	case len(opts.StreamIDs) > listVerifyStreamsLimit:
+		return ErrInvalidRequest.New("StreamIDs is larger than %d", listVerifyStreamsLimit)
+	}
+	return nil
+}
+
+// VerifyStream contains the object, the copy and the segment totals of
+// a stream. All the values are read from the same snapshot of the database,
+// so they can be compared with each other.
+type VerifyStream struct {
+	StreamID uuid.UUID
+
+	// ObjectExists is false, when the segments of the stream don't have an
+	// object.
+	ObjectExists       bool
+	Status             ObjectStatus
+	SegmentCount       int32
+	TotalPlainSize     int64
+	TotalEncryptedSize int64
+
+	// AncestorStreamID is set, when the stream is a server-side copy.
+	AncestorStreamID uuid.UUID
+	// AncestorExists is whether the object of the ancestor exists.
+	AncestorExists bool
+
+	// Segments is the number of segments in the segments table.
+	Segments              int64
+	SegmentsPlainSize     int64
+	SegmentsEncryptedSize int64
+}
+
+// ListVerifyStreams returns the objects, copies and segment totals of the
+// streams. A stream without an object and segments isn't returned.
+func (db *DB) ListVerifyStreams(ctx context.Context, opts ListVerifyStreams) (streams []VerifyStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			ids.stream_id,
+			objects.stream_id IS NOT NULL,
+			coalesce(objects.status, 0),
+			coalesce(objects.segment_count, 0),
+			coalesce(objects.total_plain_size, 0),
+			coalesce(objects.total_encrypted_size, 0),
+			segment_copies.ancestor_stream_id,
+			segment_copies.ancestor_stream_id IS NOT NULL AND EXISTS (
+				SELECT 1 FROM objects AS ancestors
+				WHERE ancestors.stream_id = segment_copies.ancestor_stream_id
+			),
+			coalesce(totals.count, 0),
+			coalesce(totals.plain_size, 0),
+			coalesce(totals.encrypted_size, 0)
+		FROM UNNEST($1::BYTEA[]) AS ids(stream_id)
+		LEFT JOIN objects ON objects.stream_id = ids.stream_id
+		LEFT JOIN segment_copies ON segment_copies.stream_id = ids.stream_id
+		LEFT JOIN (
+			SELECT
+				stream_id,
+				count(*)::INT8 AS count,
+				sum(plain_size)::INT8 AS plain_size,
+				sum(encrypted_size)::INT8 AS encrypted_size
+			FROM segments
+			WHERE stream_id = ANY($1::BYTEA[])
+			GROUP BY stream_id
+		) AS totals ON totals.stream_id = ids.stream_id
+		`+db.asOfTime(time.Time{}, opts.AsOfSystemInterval)+`
+		WHERE objects.stream_id IS NOT NULL OR totals.stream_id IS NOT NULL
+		ORDER BY ids.stream_id
+	`, pgutil.UUIDArray(opts.StreamIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var stream VerifyStream
+			var ancestorStreamID uuid.NullUUID
+			err := rows.Scan(
+				&stream.StreamID,
+				&stream.ObjectExists, &stream.Status,
+				&stream.SegmentCount, &stream.TotalPlainSize, &stream.TotalEncryptedSize,
+				&ancestorStreamID, &stream.AncestorExists,
+				&stream.Segments, &stream.SegmentsPlainSize, &stream.SegmentsEncryptedSize,
+			)
+			if err != nil {
+				return err
+			}
+			if ancestorStreamID.Valid {
+				stream.AncestorStreamID = ancestorStreamID.UUID
+			}
+			streams = append(streams, stream)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list streams: %w", err)
+	}
+	return streams, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestListVerifyStreams(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid options", func(t *testing.T) {
+			_, err := db.ListVerifyStreams(ctx, metabase.ListVerifyStreams{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+			require.Contains(t, err.Error(), "StreamIDs missing")
+
+			_, err = db.ListVerifyStreams(ctx, metabase.ListVerifyStreams{
+				StreamIDs: make([]uuid.UUID, 1001),
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("streams", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			original := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+			copyObj, _, _ := metabasetest.CreateObjectCopy{
+				OriginalObject: original,
+			}.Run(ctx, t, db)
+
+			pending := metabasetest.RandObjectStream()
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 0)
+
+			orphaned := metabase.RawSegment{
+				StreamID:          testrand.UUID(),
+				CreatedAt:         time.Now(),
+				RootPieceID:       testrand.PieceID(),
+				EncryptedKey:      testrand.Bytes(32),
+				EncryptedKeyNonce: testrand.Bytes(32),
+				EncryptedSize:     1024,
+				PlainSize:         512,
+				Redundancy:        metabasetest.DefaultRedundancy,
+				Pieces:            metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}},
+			}
+			require.NoError(t, db.ImportBatch(ctx, metabase.ImportBatch{Segments: []metabase.RawSegment{orphaned}}))
+
+			streams, err := db.ListVerifyStreams(ctx, metabase.ListVerifyStreams{
+				StreamIDs: []uuid.UUID{
+					original.StreamID, copyObj.StreamID, pending.StreamID,
+					orphaned.StreamID, testrand.UUID(),
+				},
+			})
+			require.NoError(t, err)
+
+			byStreamID := map[uuid.UUID]metabase.VerifyStream{}
+			for _, stream := range streams {
+				byStreamID[stream.StreamID] = stream
+			}
+			require.Len(t, byStreamID, 4)
+
+			require.Equal(t, metabase.VerifyStream{
+				StreamID:              original.StreamID,
+				ObjectExists:          true,
+				Status:                metabase.Committed,
+				SegmentCount:          2,
+				TotalPlainSize:        1024,
+				TotalEncryptedSize:    2048,
+				Segments:              2,
+				SegmentsPlainSize:     1024,
+				SegmentsEncryptedSize: 2048,
+			}, byStreamID[original.StreamID])
+
+			require.Equal(t, metabase.VerifyStream{
+				StreamID:              copyObj.StreamID,
+				ObjectExists:          true,
+				Status:                metabase.Committed,
+				SegmentCount:          2,
+				TotalPlainSize:        1024,
+				TotalEncryptedSize:    2048,
+				AncestorStreamID:      original.StreamID,
+				AncestorExists:        true,
+				Segments:              2,
+				SegmentsPlainSize:     1024,
+				SegmentsEncryptedSize: 2048,
+			}, byStreamID[copyObj.StreamID])
+
+			require.Equal(t, metabase.VerifyStream{
+				StreamID:     pending.StreamID,
+				ObjectExists: true,
+				Status:       metabase.Pending,
+			}, byStreamID[pending.StreamID])
+
+			require.Equal(t, metabase.VerifyStream{
+				StreamID:              orphaned.StreamID,
+				Segments:              1,
+				SegmentsPlainSize:     512,
+				SegmentsEncryptedSize: 1024,
+			}, byStreamID[orphaned.StreamID])
+		})
+	})
+}
@@ -126,6 +126,8 @@ type Config struct {
 	MaxEncryptedObjectKeyLength int                  `default:"1280" help:"maximum encrypted object key length"`
 	MaxSegmentSize              memory.Size          `default:"64MiB" help:"maximum segment size"`
 	MaxMetadataSize             memory.Size          `default:"2KiB" help:"maximum segment metadata size"`
+	MaxObjectTags               int                  `default:"10" help:"maximum number of unencrypted tags of an object"`
+	MaxObjectTagsSize           memory.Size          `default:"1KiB" help:"maximum total size of the keys and values of the object tags"`
 	MaxCommitInterval           time.Duration        `default:"48h" testDefault:"1h" help:"maximum time allowed to pass between creating and committing a segment"`
 	MinPartSize                 memory.Size          `default:"5MiB" testDefault:"0" help:"minimum allowed part size (last part has no minimum size limit)"`
 	MaxNumberOfParts            int                  `default:"10000" help:"maximum number of parts object can contain"`
@@ -39,6 +39,10 @@ const (
 	satIDExpiration = 48 * time.Hour
 
 	deleteObjectPiecesSuccessThreshold = 0.75
+
+	// deletePrefixObjectLimit is the maximum number of objects deleted by
+	// a single delete by prefix request.
+	deletePrefixObjectLimit = 10000
 )
 
 var (
@@ -297,6 +301,8 @@ func (endpoint *Endpoint) convertMetabaseErr(err error) error {
 		return rpcstatus.Error(rpcstatus.AlreadyExists, err.Error())
 	case metabase.ErrPendingObjectMissing.Has(err):
 		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case metabase.ErrBucketFenced.Has(err):
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
 	default:
 		endpoint.log.Error("internal", zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -17,7 +17,9 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/metainfoextpb"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -27,6 +29,17 @@ func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetReques
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	extResp, err := endpoint.getBucket(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+	return extResp.Response, nil
+}
+
+// getBucket returns a bucket and optionally its statistics.
+func (endpoint *Endpoint) getBucket(ctx context.Context, req *pb.BucketGetRequest, withStats bool) (resp *metainfoextpb.BucketGetResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:     macaroon.ActionRead,
 		Bucket: req.Name,
@@ -48,12 +61,36 @@ func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetReques
 	// override RS to fit satellite settings
 	convBucket, err := convertBucketToProto(bucket, endpoint.defaultRS, endpoint.config.MaxSegmentSize)
 	if err != nil {
-		return resp, err
+		return nil, err
 	}
 
-	return &pb.BucketGetResponse{
-		Bucket: convBucket,
-	}, nil
+	resp = &metainfoextpb.BucketGetResponse{
+		Response: &pb.BucketGetResponse{
+			Bucket: convBucket,
+		},
+	}
+
+	if withStats {
+		stats, err := endpoint.metabase.GetBucketStats(ctx, metabase.GetBucketStats{
+			BucketLocation: metabase.BucketLocation{
+				ProjectID:  keyInfo.ProjectID,
+				BucketName: string(req.Name),
+			},
+		})
+		if err != nil {
+			endpoint.log.Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, "unable to get bucket stats")
+		}
+		resp.Stats = &metainfoextpb.BucketStats{
+			ObjectCount:        stats.ObjectCount,
+			PendingObjectCount: stats.PendingObjectCount,
+			SegmentCount:       stats.SegmentCount,
+			TotalEncryptedSize: stats.TotalEncryptedSize,
+			MetadataSize:       stats.MetadataSize,
+		}
+	}
+
+	return resp, nil
 }
 
 // CreateBucket creates a new bucket.
@@ -93,18 +130,9 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 	if err != nil {
 		return nil, err
 	}
-	maxBuckets := project.MaxBuckets
-	if maxBuckets == nil {
-		defaultMaxBuckets := endpoint.config.ProjectLimits.MaxBuckets
-		maxBuckets = &defaultMaxBuckets
-	}
-	bucketCount, err := endpoint.buckets.CountBuckets(ctx, keyInfo.ProjectID)
-	if err != nil {
+	if err := endpoint.checkBucketLimit(ctx, project); err != nil {
 		return nil, err
 	}
-	if bucketCount >= *maxBuckets {
-		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, fmt.Sprintf("number of allocated buckets (%d) exceeded", endpoint.config.ProjectLimits.MaxBuckets))
-	}
 
 	bucketReq, err := convertProtoToBucket(req, keyInfo.ProjectID)
 	if err != nil {
@@ -137,6 +165,136 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 	}, nil
 }
 
+// checkBucketLimit checks whether the project can have one more bucket.
+func (endpoint *Endpoint) checkBucketLimit(ctx context.Context, project *console.Project) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	maxBuckets := project.MaxBuckets
+	if maxBuckets == nil {
+		defaultMaxBuckets := endpoint.config.ProjectLimits.MaxBuckets
+		maxBuckets = &defaultMaxBuckets
+	}
+	bucketCount, err := endpoint.buckets.CountBuckets(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+	if bucketCount >= *maxBuckets {
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, fmt.Sprintf("number of allocated buckets (%d) exceeded", endpoint.config.ProjectLimits.MaxBuckets))
+	}
+	return nil
+}
+
+// moveBucket renames the source bucket, or moves it from another project, to
+// the new bucket. The API key of the request must allow writing the new
+// bucket, and the API key of the source must allow reading, listing and
+// deleting the source bucket.
+func (endpoint *Endpoint) moveBucket(ctx context.Context, req *metainfoextpb.BucketMoveRequest) (resp *metainfoextpb.BucketMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	source := req.SourceName
+	if len(source) == 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "source bucket name is missing")
+	}
+
+	sourceHeader := &pb.RequestHeader{
+		ApiKey:    req.Header.ApiKey,
+		UserAgent: req.Header.UserAgent,
+	}
+	if len(req.SourceApiKey) > 0 {
+		sourceHeader.ApiKey = req.SourceApiKey
+	}
+
+	sourceKeyInfo, err := endpoint.validateAuthN(ctx, sourceHeader,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionRead,
+				Bucket: source,
+				Time:   now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionList,
+				Bucket: source,
+				Time:   now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionDelete,
+				Bucket: source,
+				Time:   now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if sourceKeyInfo.ProjectID != keyInfo.ProjectID {
+		project, err := endpoint.projects.Get(ctx, keyInfo.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if err := endpoint.checkBucketLimit(ctx, project); err != nil {
+			return nil, err
+		}
+	}
+
+	movedObjectCount, err := endpoint.buckets.MoveBucket(ctx, metabase.BucketLocation{
+		ProjectID:  sourceKeyInfo.ProjectID,
+		BucketName: string(source),
+	}, metabase.BucketLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Name),
+	})
+	if err != nil {
+		switch {
+		case storj.ErrBucketNotFound.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		case buckets.ErrBucketAlreadyExists.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket already exists")
+		case buckets.ErrBucketNotEmpty.Has(err), metabase.ErrConflict.Has(err), metabase.ErrPendingObjects.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
+		endpoint.log.Error("error while moving bucket", zap.ByteString("bucketName", source), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to move bucket")
+	}
+
+	bucket, err := endpoint.buckets.GetMinimalBucket(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	convBucket, err := convertBucketToProto(bucket, endpoint.defaultRS, endpoint.config.MaxSegmentSize)
+	if err != nil {
+		endpoint.log.Error("error while converting bucket to proto", zap.ByteString("bucketName", req.Name), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to move bucket")
+	}
+
+	return &metainfoextpb.BucketMoveResponse{
+		Bucket:           convBucket,
+		MovedObjectCount: movedObjectCount,
+	}, nil
+}
+
 // DeleteBucket deletes a bucket.
 func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDeleteRequest) (resp *pb.BucketDeleteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
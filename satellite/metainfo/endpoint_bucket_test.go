@@ -12,15 +12,21 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/errs2"
+	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/metainfoextpb"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/uplink"
 	"storj.io/uplink/private/metaclient"
 )
@@ -257,3 +263,129 @@ func TestListBucketsWithAttribution(t *testing.T) {
 		}
 	})
 }
+
+func TestGetBucketStats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()].SerializeRaw()
+
+		for _, key := range []string{"a", "b"} {
+			err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", key, testrand.Bytes(256))
+			require.NoError(t, err)
+		}
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		expected := metainfoextpb.BucketStats{ObjectCount: 2}
+		for _, object := range objects {
+			expected.SegmentCount += int64(object.SegmentCount)
+			expected.TotalEncryptedSize += object.TotalEncryptedSize
+			expected.MetadataSize += int64(len(object.EncryptedMetadata))
+		}
+
+		resp, err := satellite.API.Metainfo.ExtEndpoint.GetBucket(ctx, &pb.BucketGetRequest{
+			Header: &pb.RequestHeader{ApiKey: apiKey},
+			Name:   []byte("testbucket"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte("testbucket"), resp.Response.Bucket.Name)
+		require.Equal(t, &expected, resp.Stats)
+
+		// the statistics match the tally, which iterates the objects.
+		tallies := map[bool]accounting.BucketTally{}
+		for _, useBucketStats := range []bool{false, true} {
+			config := satellite.Config.Tally
+			config.UseBucketStats = useBucketStats
+			collector := tally.NewBucketTallyCollector(zaptest.NewLogger(t), time.Now(), satellite.Metabase.DB, config)
+			require.NoError(t, collector.Run(ctx))
+			require.Len(t, collector.Bucket, 1)
+			for _, bucket := range collector.Bucket {
+				tallies[useBucketStats] = *bucket
+			}
+		}
+		require.Equal(t, tallies[false], tallies[true])
+	})
+}
+
+func TestMoveBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		sourceAPIKey := planet.Uplinks[0].APIKey[satellite.ID()]
+		targetAPIKey := planet.Uplinks[1].APIKey[satellite.ID()]
+		sourceProjectID := planet.Uplinks[0].Projects[0].ID
+		targetProjectID := planet.Uplinks[1].Projects[0].ID
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "source", "object", testrand.Bytes(256))
+		require.NoError(t, err)
+
+		moveBucket := func(apiKey *macaroon.APIKey, name, sourceName string, sourceAPIKey *macaroon.APIKey) (*metainfoextpb.BucketMoveResponse, error) {
+			req := &metainfoextpb.BucketMoveRequest{
+				Header:     &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Name:       []byte(name),
+				SourceName: []byte(sourceName),
+			}
+			if sourceAPIKey != nil {
+				req.SourceApiKey = sourceAPIKey.SerializeRaw()
+			}
+			return satellite.API.Metainfo.ExtEndpoint.MoveBucket(ctx, req)
+		}
+
+		requireObjectIn := func(projectID uuid.UUID, bucketName string) {
+			objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+			require.Equal(t, projectID, objects[0].ProjectID)
+			require.Equal(t, bucketName, objects[0].BucketName)
+		}
+
+		// the source bucket doesn't exist.
+		_, err = moveBucket(sourceAPIKey, "renamed", "missing", nil)
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound), err)
+
+		// the source bucket is required.
+		_, err = moveBucket(sourceAPIKey, "renamed", "", nil)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument), err)
+
+		// rename within the project.
+		resp, err := moveBucket(sourceAPIKey, "renamed", "source", nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("renamed"), resp.Bucket.Name)
+		require.EqualValues(t, 1, resp.MovedObjectCount)
+		requireObjectIn(sourceProjectID, "renamed")
+
+		// the new bucket exists.
+		err = planet.Uplinks[0].CreateBucket(ctx, satellite, "existing")
+		require.NoError(t, err)
+		_, err = moveBucket(sourceAPIKey, "existing", "renamed", nil)
+		require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists), err)
+
+		// the source API key must allow deleting the source bucket.
+		readOnly, err := sourceAPIKey.Restrict(macaroon.Caveat{DisallowDeletes: true})
+		require.NoError(t, err)
+		_, err = moveBucket(targetAPIKey, "transferred", "renamed", readOnly)
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied), err)
+
+		// the target API key must allow writing the new bucket.
+		noWrites, err := targetAPIKey.Restrict(macaroon.Caveat{DisallowWrites: true})
+		require.NoError(t, err)
+		_, err = moveBucket(noWrites, "transferred", "renamed", sourceAPIKey)
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied), err)
+		requireObjectIn(sourceProjectID, "renamed")
+
+		// transfer to another project.
+		_, err = moveBucket(targetAPIKey, "transferred", "renamed", sourceAPIKey)
+		require.NoError(t, err)
+		requireObjectIn(targetProjectID, "transferred")
+
+		_, err = satellite.API.Buckets.Service.GetBucket(ctx, []byte("renamed"), sourceProjectID)
+		require.True(t, storj.ErrBucketNotFound.Has(err), err)
+		_, err = satellite.API.Buckets.Service.GetBucket(ctx, []byte("transferred"), targetProjectID)
+		require.NoError(t, err)
+	})
+}
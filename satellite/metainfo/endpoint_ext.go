@@ -0,0 +1,151 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sort"
+
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/metainfoextpb"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ensures that ExtEndpoint implements metainfoextpb.DRPCMetainfoExtServer.
+var _ metainfoextpb.DRPCMetainfoExtServer = (*ExtEndpoint)(nil)
+
+// ExtEndpoint implements the metainfo requests, which need fields that the
+// common protobuf definitions don't have yet. The requests wrap the common
+// requests and are handled by the metainfo endpoint.
+//
+// architecture: Endpoint
+type ExtEndpoint struct {
+	metainfoextpb.DRPCMetainfoExtUnimplementedServer
+
+	endpoint *Endpoint
+}
+
+// NewExtEndpoint creates new metainfo extensions endpoint instance.
+func NewExtEndpoint(endpoint *Endpoint) *ExtEndpoint {
+	return &ExtEndpoint{endpoint: endpoint}
+}
+
+// CommitObject commits an object with tags.
+func (ext *ExtEndpoint) CommitObject(ctx context.Context, req *metainfoextpb.ObjectCommitRequest) (resp *pb.ObjectCommitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if req.Request == nil {
+		return nil, errMissingRequest
+	}
+	ext.endpoint.versionCollector.collect(req.Request.Header.UserAgent, mon.Func().ShortName())
+
+	return ext.endpoint.commitObject(ctx, req)
+}
+
+// UpdateObjectMetadata replaces object metadata and tags.
+func (ext *ExtEndpoint) UpdateObjectMetadata(ctx context.Context, req *metainfoextpb.ObjectUpdateMetadataRequest) (resp *pb.ObjectUpdateMetadataResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if req.Request == nil {
+		return nil, errMissingRequest
+	}
+	ext.endpoint.versionCollector.collect(req.Request.Header.UserAgent, mon.Func().ShortName())
+
+	return ext.endpoint.updateObjectMetadata(ctx, req)
+}
+
+// GetObject gets single object metadata with the tags of the object.
+func (ext *ExtEndpoint) GetObject(ctx context.Context, req *pb.ObjectGetRequest) (resp *metainfoextpb.ObjectGetResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ext.endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	return ext.endpoint.getObject(ctx, req)
+}
+
+// ListObjects lists objects with their tags, optionally filtered by tags.
+func (ext *ExtEndpoint) ListObjects(ctx context.Context, req *metainfoextpb.ObjectListRequest) (resp *metainfoextpb.ObjectListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if req.Request == nil {
+		return nil, errMissingRequest
+	}
+	ext.endpoint.versionCollector.collect(req.Request.Header.UserAgent, mon.Func().ShortName())
+
+	return ext.endpoint.listObjects(ctx, req)
+}
+
+// GetBucket returns a bucket with its statistics.
+func (ext *ExtEndpoint) GetBucket(ctx context.Context, req *pb.BucketGetRequest) (resp *metainfoextpb.BucketGetResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ext.endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	return ext.endpoint.getBucket(ctx, req, true)
+}
+
+// MoveBucket renames a bucket, or moves it from another project.
+func (ext *ExtEndpoint) MoveBucket(ctx context.Context, req *metainfoextpb.BucketMoveRequest) (resp *metainfoextpb.BucketMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ext.endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	return ext.endpoint.moveBucket(ctx, req)
+}
+
+// DeleteObjectsByPrefix deletes the objects with a prefix in batches.
+func (ext *ExtEndpoint) DeleteObjectsByPrefix(ctx context.Context, req *metainfoextpb.ObjectDeleteByPrefixRequest) (resp *metainfoextpb.ObjectDeleteByPrefixResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ext.endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	return ext.endpoint.deleteObjectsByPrefix(ctx, req)
+}
+
+// BeginCopyObject begins copying an object, optionally from another project.
+func (ext *ExtEndpoint) BeginCopyObject(ctx context.Context, req *metainfoextpb.ObjectBeginCopyRequest) (resp *pb.ObjectBeginCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if req.Request == nil {
+		return nil, errMissingRequest
+	}
+	ext.endpoint.versionCollector.collect(req.Request.Header.UserAgent, mon.Func().ShortName())
+
+	return ext.endpoint.beginCopyObject(ctx, req)
+}
+
+// FinishCopyObject finishes copying an object, optionally from another
+// project.
+func (ext *ExtEndpoint) FinishCopyObject(ctx context.Context, req *metainfoextpb.ObjectFinishCopyRequest) (resp *pb.ObjectFinishCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if req.Request == nil {
+		return nil, errMissingRequest
+	}
+	ext.endpoint.versionCollector.collect(req.Request.Header.UserAgent, mon.Func().ShortName())
+
+	return ext.endpoint.finishCopyObject(ctx, req)
+}
+
+// errMissingRequest is returned when an extended request doesn't contain the
+// common request.
+var errMissingRequest = rpcstatus.Error(rpcstatus.InvalidArgument, "request is missing")
+
+// objectTagsToProto converts the object tags to protobuf. The tags are sorted
+// by key. It returns nil when the object doesn't have tags.
+func objectTagsToProto(tags metabase.ObjectTags) *metainfoextpb.ObjectTags {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	pbTags := &metainfoextpb.ObjectTags{}
+	for key, value := range tags {
+		pbTags.Tags = append(pbTags.Tags, &metainfoextpb.ObjectTag{Key: key, Value: value})
+	}
+	sort.Slice(pbTags.Tags, func(i, k int) bool {
+		return pbTags.Tags[i].Key < pbTags.Tags[k].Key
+	})
+	return pbTags
+}
@@ -19,6 +19,8 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/metainfoextpb"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
@@ -193,6 +195,15 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	return endpoint.commitObject(ctx, &metainfoextpb.ObjectCommitRequest{Request: req})
+}
+
+// commitObject commits an object with the tags of the request.
+func (endpoint *Endpoint) commitObject(ctx context.Context, extReq *metainfoextpb.ObjectCommitRequest) (resp *pb.ObjectCommitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req := extReq.Request
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -255,6 +266,11 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		return nil, err
 	}
 
+	request.Tags, err = endpoint.convertObjectTags(extReq.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = endpoint.metabase.CommitObject(ctx, request)
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
@@ -269,6 +285,17 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	extResp, err := endpoint.getObject(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return extResp.Response, nil
+}
+
+// getObject gets single object metadata with the tags of the object.
+func (endpoint *Endpoint) getObject(ctx context.Context, req *pb.ObjectGetRequest) (resp *metainfoextpb.ObjectGetResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionRead,
 		Bucket:        req.Bucket,
@@ -334,7 +361,10 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 	endpoint.log.Info("Object Get", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "get"), zap.String("type", "object"))
 	mon.Meter("req_get_object").Mark(1)
 
-	return &pb.ObjectGetResponse{Object: object}, nil
+	return &metainfoextpb.ObjectGetResponse{
+		Response: &pb.ObjectGetResponse{Object: object},
+		Tags:     objectTagsToProto(mbObject.Tags),
+	}, nil
 }
 
 // DownloadObject gets object information, creates a download for segments and lists the object segments.
@@ -696,6 +726,25 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	extResp, err := endpoint.listObjects(ctx, &metainfoextpb.ObjectListRequest{Request: req})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &pb.ObjectListResponse{More: extResp.More}
+	for _, item := range extResp.Items {
+		resp.Items = append(resp.Items, item.Item)
+	}
+	return resp, nil
+}
+
+// listObjects lists objects according to the parameters of the request. The
+// listed objects are returned with their tags.
+func (endpoint *Endpoint) listObjects(ctx context.Context, extReq *metainfoextpb.ObjectListRequest) (resp *metainfoextpb.ObjectListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req := extReq.Request
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionList,
 		Bucket:        req.Bucket,
@@ -727,11 +776,23 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 	}
 	metabase.ListLimit.Ensure(&limit)
 
+	listOptions := extReq.Options
+
+	delimiter := byte(metabase.Delimiter)
+	if listOptions.GetDelimiter() != 0 {
+		// 0xFF can't be a delimiter, because no key follows all the keys
+		// with a prefix ending with it.
+		if listOptions.Delimiter >= 0xFF {
+			return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "invalid delimiter %d", listOptions.Delimiter)
+		}
+		delimiter = byte(listOptions.Delimiter)
+	}
+
 	var prefix metabase.ObjectKey
 	if len(req.EncryptedPrefix) != 0 {
 		prefix = metabase.ObjectKey(req.EncryptedPrefix)
-		if prefix[len(prefix)-1] != metabase.Delimiter {
-			prefix += metabase.ObjectKey(metabase.Delimiter)
+		if prefix[len(prefix)-1] != delimiter {
+			prefix += metabase.ObjectKey(delimiter)
 		}
 	}
 
@@ -746,6 +807,11 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		cursor = string(prefix) + cursor
 	}
 
+	var startAfter metabase.ObjectKey
+	if len(listOptions.GetStartAfter()) != 0 {
+		startAfter = prefix + metabase.ObjectKey(listOptions.StartAfter)
+	}
+
 	includeCustomMetadata := true
 	includeSystemMetadata := true
 	if req.UseObjectIncludes {
@@ -753,7 +819,17 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		includeSystemMetadata = !req.ObjectIncludes.ExcludeSystemMetadata
 	}
 
-	resp = &pb.ObjectListResponse{}
+	tags, err := endpoint.convertObjectTags(extReq.TagFilter)
+	if err != nil {
+		return nil, err
+	}
+	if tags != nil && prefix == "" {
+		// the tags aren't indexed, so listing a whole bucket by tags would
+		// scan all of its objects.
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "Object tag filter requires a prefix")
+	}
+
+	resp = &metainfoextpb.ObjectListResponse{}
 	// TODO: Replace with IterateObjectsLatestVersion when ready
 	err = endpoint.metabase.IterateObjectsAllVersionsWithStatus(ctx,
 		metabase.IterateObjectsWithStatus{
@@ -769,6 +845,10 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 			Status:                status,
 			IncludeCustomMetadata: includeCustomMetadata,
 			IncludeSystemMetadata: includeSystemMetadata,
+			Tags:                  tags,
+			StartAfter:            startAfter,
+			Delimiter:             delimiter,
+			Reverse:               listOptions.GetReverse(),
 		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
 			entry := metabase.ObjectEntry{}
 			for len(resp.Items) < limit && it.Next(ctx, &entry) {
@@ -776,7 +856,11 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 				if err != nil {
 					return err
 				}
-				resp.Items = append(resp.Items, item)
+				extItem := &metainfoextpb.ObjectListItem{Item: item}
+				if includeCustomMetadata {
+					extItem.Tags = objectTagsToProto(entry.Tags)
+				}
+				resp.Items = append(resp.Items, extItem)
 			}
 			resp.More = it.Next(ctx, &entry)
 			return nil
@@ -1064,6 +1148,16 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	return endpoint.updateObjectMetadata(ctx, &metainfoextpb.ObjectUpdateMetadataRequest{Request: req})
+}
+
+// updateObjectMetadata replaces object metadata and the tags of the object,
+// when the request has them.
+func (endpoint *Endpoint) updateObjectMetadata(ctx context.Context, extReq *metainfoextpb.ObjectUpdateMetadataRequest) (resp *pb.ObjectUpdateMetadataResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req := extReq.Request
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionWrite,
 		Bucket:        req.Bucket,
@@ -1083,6 +1177,12 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 		return nil, err
 	}
 
+	// the tags are kept, when the request doesn't have them.
+	tags, err := endpoint.convertObjectTags(extReq.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1107,6 +1207,8 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 		EncryptedMetadata:             req.EncryptedMetadata,
 		EncryptedMetadataNonce:        encryptedMetadataNonce,
 		EncryptedMetadataEncryptedKey: req.EncryptedMetadataEncryptedKey,
+		OverrideTags:                  tags != nil,
+		Tags:                          tags,
 	})
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
@@ -1199,7 +1301,6 @@ func (endpoint *Endpoint) objectToProto(ctx context.Context, object metabase.Obj
 
 		RedundancyScheme: rs,
 	}
-
 	return result, nil
 }
 
@@ -1428,6 +1529,105 @@ func (endpoint *Endpoint) deleteSegmentPieces(ctx context.Context, segments []me
 	}
 }
 
+// deleteObjectsByPrefix deletes the objects, whose encrypted key starts with
+// the encrypted prefix of the request. The number of deleted objects is limited
+// and the client repeats the request until the response reports that there
+// are no objects left.
+func (endpoint *Endpoint) deleteObjectsByPrefix(ctx context.Context, req *metainfoextpb.ObjectDeleteByPrefixRequest) (resp *metainfoextpb.ObjectDeleteByPrefixResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the caveats allow the action only when their path prefix is a prefix
+	// of the requested prefix, i.e. when every object with the requested
+	// prefix may be deleted.
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionDelete,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedPrefix,
+				Time:          time.Now(),
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	if len(req.EncryptedPrefix) == 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "prefix is missing")
+	}
+	if req.Limit < 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "limit is negative")
+	}
+
+	limit := deletePrefixObjectLimit
+	if req.Limit > 0 && req.Limit < deletePrefixObjectLimit {
+		limit = int(req.Limit)
+	}
+
+	result, err := endpoint.metabase.DeleteObjectsByPrefix(ctx, metabase.DeleteObjectsByPrefix{
+		Bucket: metabase.BucketLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+		},
+		Prefix: metabase.ObjectKey(req.EncryptedPrefix),
+		Limit:  limit,
+		DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+			endpoint.deleteSegmentPiecesAsync(ctx, segments)
+			return nil
+		},
+	})
+	if err != nil && result.DeletedObjectCount == 0 {
+		return nil, endpoint.convertMetabaseErr(err)
+	}
+	if err != nil {
+		// the client repeats the request to delete the rest.
+		endpoint.log.Warn("unable to delete all objects by prefix",
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.Int64("Deleted", result.DeletedObjectCount),
+			zap.Error(err),
+		)
+		result.More = true
+	}
+
+	endpoint.log.Info("Object Delete", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "delete"), zap.String("type", "prefix"))
+	mon.Meter("req_delete_prefix").Mark(1)
+	mon.Meter("req_delete_prefix_objects").Mark64(result.DeletedObjectCount)
+
+	return &metainfoextpb.ObjectDeleteByPrefixResponse{
+		DeletedObjectCount:  result.DeletedObjectCount,
+		DeletedSegmentCount: result.DeletedSegmentCount,
+		More:                result.More,
+	}, nil
+}
+
+// deleteSegmentPiecesAsync queues the pieces of the segments for deletion
+// without waiting for the storage nodes.
+func (endpoint *Endpoint) deleteSegmentPiecesAsync(ctx context.Context, segments []metabase.DeletedSegmentInfo) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var requests []piecedeletion.Request
+	for node, pieces := range groupPiecesByNodeID(segments) {
+		requests = append(requests, piecedeletion.Request{
+			Node: storj.NodeURL{
+				ID: node,
+			},
+			Pieces: pieces,
+		})
+	}
+
+	// Pieces, which fail to be deleted, are collected by garbage collector.
+	err = endpoint.deletePieces.DeleteAsync(ctx, requests)
+	if err != nil {
+		endpoint.log.Error("failed to queue pieces for deletion", zap.Error(err))
+	}
+}
+
 // groupPiecesByNodeID returns a map that contains pieces with node id as the key.
 func groupPiecesByNodeID(segments []metabase.DeletedSegmentInfo) map[storj.NodeID][]storj.PieceID {
 	piecesToDelete := map[storj.NodeID][]storj.PieceID{}
@@ -1676,39 +1876,56 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeginCopyRequest) (resp *pb.ObjectBeginCopyResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	return endpoint.beginCopyObject(ctx, &metainfoextpb.ObjectBeginCopyRequest{Request: req})
+}
+
+// beginCopyObject begins copying object to different key, optionally from
+// the project of the source API key of the request.
+func (endpoint *Endpoint) beginCopyObject(ctx context.Context, extReq *metainfoextpb.ObjectBeginCopyRequest) (resp *pb.ObjectBeginCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if !endpoint.config.ServerSideCopy || endpoint.config.ServerSideCopyDisabled {
 		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "Unimplemented")
 	}
 
-	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+	req := extReq.Request
 
 	now := time.Now()
-	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
-		verifyPermission{
-			action: macaroon.Action{
-				Op:            macaroon.ActionRead,
-				Bucket:        req.Bucket,
-				EncryptedPath: req.EncryptedObjectKey,
-				Time:          now,
+	var keyInfo, sourceKeyInfo *console.APIKeyInfo
+	if len(extReq.SourceApiKey) == 0 {
+		keyInfo, err = endpoint.validateAuthN(ctx, req.Header,
+			verifyPermission{
+				action: macaroon.Action{
+					Op:            macaroon.ActionRead,
+					Bucket:        req.Bucket,
+					EncryptedPath: req.EncryptedObjectKey,
+					Time:          now,
+				},
 			},
-		},
-		verifyPermission{
-			action: macaroon.Action{
-				Op:            macaroon.ActionWrite,
-				Bucket:        req.NewBucket,
-				EncryptedPath: req.NewEncryptedObjectKey,
-				Time:          now,
+			verifyPermission{
+				action: macaroon.Action{
+					Op:            macaroon.ActionWrite,
+					Bucket:        req.NewBucket,
+					EncryptedPath: req.NewEncryptedObjectKey,
+					Time:          now,
+				},
 			},
-		},
-		verifyPermission{
-			action: macaroon.Action{
-				Op:            macaroon.ActionWrite,
-				Bucket:        req.NewBucket,
-				EncryptedPath: req.NewEncryptedObjectKey,
-				Time:          now,
+			verifyPermission{
+				action: macaroon.Action{
+					Op:            macaroon.ActionWrite,
+					Bucket:        req.NewBucket,
+					EncryptedPath: req.NewEncryptedObjectKey,
+					Time:          now,
+				},
 			},
-		},
-	)
+		)
+		sourceKeyInfo = keyInfo
+	} else {
+		keyInfo, sourceKeyInfo, err = endpoint.validateCopyFromProject(ctx, req.Header, extReq.SourceApiKey,
+			req.Bucket, req.EncryptedObjectKey, req.NewBucket, req.NewEncryptedObjectKey, now)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1721,9 +1938,9 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 	}
 
 	// if source and target buckets are different, we need to check their geofencing configs
-	if !bytes.Equal(req.Bucket, req.NewBucket) {
+	if sourceKeyInfo.ProjectID != keyInfo.ProjectID || !bytes.Equal(req.Bucket, req.NewBucket) {
 		// TODO we may try to combine those two DB calls into single one
-		oldBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
+		oldBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, sourceKeyInfo.ProjectID)
 		if err != nil {
 			if storj.ErrBucketNotFound.Has(err) {
 				return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
@@ -1746,7 +1963,7 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 
 	result, err := endpoint.metabase.BeginCopyObject(ctx, metabase.BeginCopyObject{
 		ObjectLocation: metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
+			ProjectID:  sourceKeyInfo.ProjectID,
 			BucketName: string(req.Bucket),
 			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
 		},
@@ -1779,7 +1996,7 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	endpoint.log.Info("Object Copy Begins", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "copy"), zap.String("type", "object"))
+	endpoint.log.Info("Object Copy Begins", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Stringer("Source Project ID", sourceKeyInfo.ProjectID), zap.String("operation", "copy"), zap.String("type", "object"))
 	mon.Meter("req_copy_object_begins").Mark(1)
 
 	response.StreamId = satStreamID
@@ -1845,25 +2062,45 @@ func convertBeginCopyObjectResults(result metabase.BeginCopyObjectResult) (*pb.O
 func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFinishCopyRequest) (resp *pb.ObjectFinishCopyResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	return endpoint.finishCopyObject(ctx, &metainfoextpb.ObjectFinishCopyRequest{Request: req})
+}
+
+// finishCopyObject finishes copying an object, optionally from the project of
+// the source API key of the request.
+func (endpoint *Endpoint) finishCopyObject(ctx context.Context, extReq *metainfoextpb.ObjectFinishCopyRequest) (resp *pb.ObjectFinishCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if !endpoint.config.ServerSideCopy || endpoint.config.ServerSideCopyDisabled {
 		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "Unimplemented")
 	}
 
-	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+	req := extReq.Request
 
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:            macaroon.ActionWrite,
-		Time:          time.Now(),
-		Bucket:        req.NewBucket,
-		EncryptedPath: req.NewEncryptedMetadataKey,
-	})
-	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	var keyInfo, sourceKeyInfo *console.APIKeyInfo
+	if len(extReq.SourceApiKey) == 0 {
+		keyInfo, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+			Op:            macaroon.ActionWrite,
+			Time:          time.Now(),
+			Bucket:        req.NewBucket,
+			EncryptedPath: req.NewEncryptedMetadataKey,
+		})
+		if err != nil {
+			return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+		}
+		sourceKeyInfo = keyInfo
+	} else {
+		keyInfo, sourceKeyInfo, err = endpoint.validateCopyFromProject(ctx, req.Header, extReq.SourceApiKey,
+			streamID.Bucket, streamID.EncryptedObjectKey, req.NewBucket, req.NewEncryptedObjectKey, time.Now())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = endpoint.validateBucket(ctx, req.NewBucket)
@@ -1895,12 +2132,13 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 
 	object, err := endpoint.metabase.FinishCopyObject(ctx, metabase.FinishCopyObject{
 		ObjectStream: metabase.ObjectStream{
-			ProjectID:  keyInfo.ProjectID,
+			ProjectID:  sourceKeyInfo.ProjectID,
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
 			Version:    metabase.Version(streamID.Version),
 			StreamID:   streamUUID,
 		},
+		NewProjectID:                 keyInfo.ProjectID,
 		NewStreamID:                  newStreamID,
 		NewSegmentKeys:               protobufkeysToMetabase(req.NewSegmentKeys),
 		NewBucket:                    string(req.NewBucket),
@@ -1924,7 +2162,7 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	endpoint.log.Info("Object Copy Finished", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "copy"), zap.String("type", "object"))
+	endpoint.log.Info("Object Copy Finished", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Stringer("Source Project ID", sourceKeyInfo.ProjectID), zap.String("operation", "copy"), zap.String("type", "object"))
 	mon.Meter("req_copy_object_finished").Mark(1)
 
 	return &pb.ObjectFinishCopyResponse{
@@ -1932,6 +2170,49 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 	}, nil
 }
 
+// validateCopyFromProject validates the API keys of copying an object from
+// another project. The API key of the request must allow writing the copy,
+// and the API key of the source must allow reading the source object. It
+// returns the API key info of the request and of the source.
+func (endpoint *Endpoint) validateCopyFromProject(ctx context.Context, header *pb.RequestHeader, sourceAPIKey []byte,
+	bucket, encryptedObjectKey, newBucket, newEncryptedObjectKey []byte, now time.Time) (keyInfo, sourceKeyInfo *console.APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err = endpoint.validateAuthN(ctx, header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionWrite,
+				Bucket:        newBucket,
+				EncryptedPath: newEncryptedObjectKey,
+				Time:          now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sourceHeader := &pb.RequestHeader{
+		ApiKey:    sourceAPIKey,
+		UserAgent: header.UserAgent,
+	}
+	sourceKeyInfo, err = endpoint.validateAuthN(ctx, sourceHeader,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionRead,
+				Bucket:        bucket,
+				EncryptedPath: encryptedObjectKey,
+				Time:          now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keyInfo, sourceKeyInfo, nil
+}
+
 // protobufkeysToMetabase converts []*pb.EncryptedKeyAndNonce to []metabase.EncryptedKeyAndNonce.
 func protobufkeysToMetabase(protoKeys []*pb.EncryptedKeyAndNonce) []metabase.EncryptedKeyAndNonce {
 	keys := make([]metabase.EncryptedKeyAndNonce, len(protoKeys))
@@ -9,6 +9,7 @@ import (
 	"net"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -20,6 +21,7 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/common/identity"
+	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
@@ -28,11 +30,13 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/metainfoextpb"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/uplink"
 	"storj.io/uplink/private/metaclient"
@@ -1490,6 +1494,134 @@ func TestEndpoint_CopyObject(t *testing.T) {
 	})
 }
 
+func TestEndpoint_CopyObjectFromProject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		sourceAPIKey := planet.Uplinks[0].APIKey[satellite.ID()]
+		targetAPIKey := planet.Uplinks[1].APIKey[satellite.ID()]
+		sourceProjectID := planet.Uplinks[0].Projects[0].ID
+		targetProjectID := planet.Uplinks[1].Projects[0].ID
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "source", "object", testrand.Bytes(1*memory.KiB))
+		require.NoError(t, err)
+		err = planet.Uplinks[1].CreateBucket(ctx, satellite, "target")
+		require.NoError(t, err)
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		original := objects[0]
+
+		beginCopy := func(apiKey *macaroon.APIKey, sourceAPIKey []byte) (*pb.ObjectBeginCopyResponse, error) {
+			req := &pb.ObjectBeginCopyRequest{
+				Header:                &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket:                []byte("source"),
+				EncryptedObjectKey:    []byte(original.ObjectKey),
+				NewBucket:             []byte("target"),
+				NewEncryptedObjectKey: []byte("copy"),
+			}
+			return satellite.API.Metainfo.ExtEndpoint.BeginCopyObject(ctx, &metainfoextpb.ObjectBeginCopyRequest{
+				Request:      req,
+				SourceApiKey: sourceAPIKey,
+			})
+		}
+
+		// without the source API key the source is looked up in the project
+		// of the request.
+		_, err = beginCopy(targetAPIKey, nil)
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound), err)
+
+		// the source API key must allow reading the source object.
+		noReads, err := sourceAPIKey.Restrict(macaroon.Caveat{DisallowReads: true})
+		require.NoError(t, err)
+		_, err = beginCopy(targetAPIKey, noReads.SerializeRaw())
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied), err)
+
+		// the API key of the request must allow writing the copy.
+		noWrites, err := targetAPIKey.Restrict(macaroon.Caveat{DisallowWrites: true})
+		require.NoError(t, err)
+		_, err = beginCopy(noWrites, sourceAPIKey.SerializeRaw())
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied), err)
+
+		// the copy is limited by the storage limit of the target project.
+		err = satellite.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, targetProjectID, 500)
+		require.NoError(t, err)
+		_, err = beginCopy(targetAPIKey, sourceAPIKey.SerializeRaw())
+		assertRPCStatusCode(t, err, rpcstatus.ResourceExhausted)
+		assert.EqualError(t, err, "Exceeded Storage Limit")
+
+		err = satellite.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, targetProjectID, memory.GiB)
+		require.NoError(t, err)
+
+		sourceUsage, err := satellite.Accounting.ProjectUsage.GetProjectStorageTotals(ctx, sourceProjectID)
+		require.NoError(t, err)
+
+		beginResp, err := beginCopy(targetAPIKey, sourceAPIKey.SerializeRaw())
+		require.NoError(t, err)
+		require.Len(t, beginResp.SegmentKeys, 1)
+
+		finishReq := &pb.ObjectFinishCopyRequest{
+			Header:                       &pb.RequestHeader{ApiKey: targetAPIKey.SerializeRaw()},
+			StreamId:                     beginResp.StreamId,
+			NewBucket:                    []byte("target"),
+			NewEncryptedObjectKey:        []byte("copy"),
+			NewEncryptedMetadataKeyNonce: testrand.Nonce(),
+			NewEncryptedMetadataKey:      []byte("encryptedmetadatakey"),
+			NewSegmentKeys: []*pb.EncryptedKeyAndNonce{{
+				Position:          beginResp.SegmentKeys[0].Position,
+				EncryptedKeyNonce: testrand.Nonce(),
+				EncryptedKey:      []byte("newencryptedkey"),
+			}},
+		}
+		_, err = satellite.API.Metainfo.ExtEndpoint.FinishCopyObject(ctx, &metainfoextpb.ObjectFinishCopyRequest{
+			Request:      finishReq,
+			SourceApiKey: sourceAPIKey.SerializeRaw(),
+		})
+		require.NoError(t, err)
+
+		copyObj, err := satellite.Metabase.DB.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+			ObjectLocation: metabase.ObjectLocation{
+				ProjectID:  targetProjectID,
+				BucketName: "target",
+				ObjectKey:  "copy",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, original.TotalEncryptedSize, copyObj.TotalEncryptedSize)
+
+		// the copy is charged to the target project.
+		targetUsage, err := satellite.Accounting.ProjectUsage.GetProjectStorageTotals(ctx, targetProjectID)
+		require.NoError(t, err)
+		require.Equal(t, original.TotalEncryptedSize, targetUsage)
+		usage, err := satellite.Accounting.ProjectUsage.GetProjectStorageTotals(ctx, sourceProjectID)
+		require.NoError(t, err)
+		require.Equal(t, sourceUsage, usage)
+
+		// the copy outlives the deleted source bucket.
+		_, err = satellite.API.Metainfo.Endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+			Header:    &pb.RequestHeader{ApiKey: sourceAPIKey.SerializeRaw()},
+			Name:      []byte("source"),
+			DeleteAll: true,
+		})
+		require.NoError(t, err)
+
+		getResp, err := satellite.API.Metainfo.Endpoint.GetObject(ctx, &pb.ObjectGetRequest{
+			Header:        &pb.RequestHeader{ApiKey: targetAPIKey.SerializeRaw()},
+			Bucket:        []byte("target"),
+			EncryptedPath: []byte("copy"),
+		})
+		require.NoError(t, err)
+		_, err = satellite.API.Metainfo.Endpoint.DownloadSegment(ctx, &pb.SegmentDownloadRequest{
+			Header:         &pb.RequestHeader{ApiKey: targetAPIKey.SerializeRaw()},
+			StreamId:       getResp.Object.StreamId,
+			CursorPosition: beginResp.SegmentKeys[0].Position,
+		})
+		require.NoError(t, err)
+	})
+}
+
 func TestEndpoint_ParallelDeletes(t *testing.T) {
 	t.Skip("to be fixed - creating deadlocks")
 	testplanet.Run(t, testplanet.Config{
@@ -1655,3 +1787,280 @@ func TestEndpoint_UpdateObjectMetadata(t *testing.T) {
 		require.Equal(t, validKey, objects[0].EncryptedMetadataEncryptedKey)
 	})
 }
+
+func TestEndpoint_ObjectTags(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()].SerializeRaw()
+
+		for _, key := range []string{"dir/a", "dir/b"} {
+			err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", key, testrand.Bytes(256))
+			require.NoError(t, err)
+		}
+
+		objects, err := satellite.API.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		// the encrypted prefix of the objects.
+		key := string(objects[0].ObjectKey)
+		prefix := key[:strings.IndexByte(key, '/')+1]
+
+		updateTags := func(object metabase.Object, tags ...*metainfoextpb.ObjectTag) error {
+			getObjectResponse, err := satellite.API.Metainfo.Endpoint.GetObject(ctx, &pb.ObjectGetRequest{
+				Header:        &pb.RequestHeader{ApiKey: apiKey},
+				Bucket:        []byte("testbucket"),
+				EncryptedPath: []byte(object.ObjectKey),
+				Version:       int32(object.Version),
+			})
+			require.NoError(t, err)
+
+			req := &pb.ObjectUpdateMetadataRequest{
+				Header:                        &pb.RequestHeader{ApiKey: apiKey},
+				Bucket:                        []byte("testbucket"),
+				EncryptedObjectKey:            []byte(object.ObjectKey),
+				Version:                       int32(object.Version),
+				StreamId:                      getObjectResponse.Object.StreamId,
+				EncryptedMetadata:             object.EncryptedMetadata,
+				EncryptedMetadataNonce:        getObjectResponse.Object.EncryptedMetadataNonce,
+				EncryptedMetadataEncryptedKey: object.EncryptedMetadataEncryptedKey,
+			}
+			_, err = satellite.API.Metainfo.ExtEndpoint.UpdateObjectMetadata(ctx, &metainfoextpb.ObjectUpdateMetadataRequest{
+				Request: req,
+				Tags:    &metainfoextpb.ObjectTags{Tags: tags},
+			})
+			return err
+		}
+
+		apollo := &metainfoextpb.ObjectTag{Key: "project", Value: "apollo"}
+		gemini := &metainfoextpb.ObjectTag{Key: "project", Value: "gemini"}
+		require.NoError(t, updateTags(objects[0], apollo))
+		require.NoError(t, updateTags(objects[1], gemini))
+
+		// too many tags
+		var tooMany []*metainfoextpb.ObjectTag
+		for i := 0; i <= satellite.Config.Metainfo.MaxObjectTags; i++ {
+			tooMany = append(tooMany, &metainfoextpb.ObjectTag{Key: strconv.Itoa(i), Value: "x"})
+		}
+		err = updateTags(objects[0], tooMany...)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		// too large tags
+		err = updateTags(objects[0], &metainfoextpb.ObjectTag{Key: "large", Value: string(testrand.BytesInt(satellite.Config.Metainfo.MaxObjectTagsSize.Int()))})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		// duplicate keys
+		err = updateTags(objects[0], apollo, gemini)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		// tags, which can't be stored.
+		err = updateTags(objects[0], &metainfoextpb.ObjectTag{Key: "project", Value: "apo\x00llo"})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		err = updateTags(objects[0], &metainfoextpb.ObjectTag{Key: "project\xff", Value: "apollo"})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		// the tags are returned with the object.
+		getObjectResponse, err := satellite.API.Metainfo.ExtEndpoint.GetObject(ctx, &pb.ObjectGetRequest{
+			Header:        &pb.RequestHeader{ApiKey: apiKey},
+			Bucket:        []byte("testbucket"),
+			EncryptedPath: []byte(objects[0].ObjectKey),
+			Version:       int32(objects[0].Version),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte(objects[0].ObjectKey), getObjectResponse.Response.Object.EncryptedPath)
+		require.Equal(t, &metainfoextpb.ObjectTags{Tags: []*metainfoextpb.ObjectTag{apollo}}, getObjectResponse.Tags)
+
+		// listing returns only the objects with the tags.
+		listReq := &metainfoextpb.ObjectListRequest{
+			Request: &pb.ObjectListRequest{
+				Header:          &pb.RequestHeader{ApiKey: apiKey},
+				Bucket:          []byte("testbucket"),
+				EncryptedPrefix: []byte(prefix),
+				Recursive:       true,
+			},
+			TagFilter: &metainfoextpb.ObjectTags{Tags: []*metainfoextpb.ObjectTag{gemini}},
+		}
+		listResponse, err := satellite.API.Metainfo.ExtEndpoint.ListObjects(ctx, listReq)
+		require.NoError(t, err)
+		require.Len(t, listResponse.Items, 1)
+		require.Equal(t, []byte(strings.TrimPrefix(string(objects[1].ObjectKey), prefix)), listResponse.Items[0].Item.EncryptedPath)
+		require.Equal(t, &metainfoextpb.ObjectTags{Tags: []*metainfoextpb.ObjectTag{gemini}}, listResponse.Items[0].Tags)
+
+		// empty tags remove the existing tags.
+		require.NoError(t, updateTags(objects[1]))
+		listResponse, err = satellite.API.Metainfo.ExtEndpoint.ListObjects(ctx, listReq)
+		require.NoError(t, err)
+		require.Empty(t, listResponse.Items)
+
+		// the tags aren't indexed, so the filter requires a prefix.
+		listReq.Request.EncryptedPrefix = nil
+		_, err = satellite.API.Metainfo.ExtEndpoint.ListObjects(ctx, listReq)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
+
+func TestEndpoint_ListObjectsOptions(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()].SerializeRaw()
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "testbucket"))
+
+		// the objects are created directly in the metabase, so the keys
+		// aren't encrypted.
+		for _, key := range []string{"a", "b|1", "b|2", "c/d", "e"} {
+			obj := metabasetest.RandObjectStream()
+			obj.ProjectID = projectID
+			obj.BucketName = "testbucket"
+			obj.ObjectKey = metabase.ObjectKey(key)
+			metabasetest.CreateObject(ctx, t, satellite.Metabase.DB, obj, 0)
+		}
+
+		listWithError := func(recursive bool, prefix string, opts *metainfoextpb.ObjectListOptions) ([]string, error) {
+			resp, err := satellite.API.Metainfo.ExtEndpoint.ListObjects(ctx, &metainfoextpb.ObjectListRequest{
+				Request: &pb.ObjectListRequest{
+					Header:          &pb.RequestHeader{ApiKey: apiKey},
+					Bucket:          []byte("testbucket"),
+					EncryptedPrefix: []byte(prefix),
+					Recursive:       recursive,
+				},
+				Options: opts,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			var keys []string
+			for _, item := range resp.Items {
+				keys = append(keys, string(item.Item.EncryptedPath))
+			}
+			return keys, nil
+		}
+		list := func(recursive bool, prefix string, opts *metainfoextpb.ObjectListOptions) []string {
+			keys, err := listWithError(recursive, prefix, opts)
+			require.NoError(t, err)
+			return keys
+		}
+
+		require.Equal(t, []string{"a", "b|1", "b|2", "c/", "e"}, list(false, "", nil))
+		require.Equal(t, []string{"e", "c/", "b|2", "b|1", "a"}, list(false, "", &metainfoextpb.ObjectListOptions{Reverse: true}))
+		require.Equal(t, []string{"a", "b|", "c/d", "e"}, list(false, "", &metainfoextpb.ObjectListOptions{Delimiter: '|'}))
+		require.Equal(t, []string{"1", "2"}, list(false, "b", &metainfoextpb.ObjectListOptions{Delimiter: '|'}))
+		require.Equal(t, []string{"b|2", "c/d", "e"}, list(true, "", &metainfoextpb.ObjectListOptions{StartAfter: []byte("b|1")}))
+		require.Equal(t, []string{"1"}, list(true, "b|", &metainfoextpb.ObjectListOptions{StartAfter: []byte("2"), Delimiter: '|', Reverse: true}))
+
+		// 0xFF and values, which aren't a byte, aren't valid delimiters.
+		for _, delimiter := range []uint32{0xFF, 0x100} {
+			_, err := listWithError(false, "", &metainfoextpb.ObjectListOptions{Delimiter: delimiter})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		}
+	})
+}
+
+func TestEndpoint_DeleteObjectsByPrefix(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "testbucket"))
+
+		// the objects are created directly in the metabase, so the keys
+		// aren't encrypted.
+		for _, key := range []string{"a/1", "a/b/1", "a/b/2", "a/b/3", "c/1"} {
+			obj := metabasetest.RandObjectStream()
+			obj.ProjectID = projectID
+			obj.BucketName = "testbucket"
+			obj.ObjectKey = metabase.ObjectKey(key)
+			metabasetest.CreateObject(ctx, t, satellite.Metabase.DB, obj, 0)
+		}
+
+		deletePrefix := func(apiKey *macaroon.APIKey, prefix string, limit int64) (*metainfoextpb.ObjectDeleteByPrefixResponse, error) {
+			return satellite.API.Metainfo.ExtEndpoint.DeleteObjectsByPrefix(ctx, &metainfoextpb.ObjectDeleteByPrefixRequest{
+				Header:          &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket:          []byte("testbucket"),
+				EncryptedPrefix: []byte(prefix),
+				Limit:           limit,
+			})
+		}
+
+		requireKeys := func(expected ...string) {
+			objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			var keys []string
+			for _, object := range objects {
+				keys = append(keys, string(object.ObjectKey))
+			}
+			require.ElementsMatch(t, expected, keys)
+		}
+
+		_, err := deletePrefix(apiKey, "", 0)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument), err)
+
+		_, err = deletePrefix(apiKey, "a/", -1)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument), err)
+
+		// the caveat must allow deleting everything with the prefix.
+		restricted, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{
+				Bucket:              []byte("testbucket"),
+				EncryptedPathPrefix: []byte("a/b/"),
+			}},
+		})
+		require.NoError(t, err)
+
+		_, err = deletePrefix(restricted, "a/", 0)
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied), err)
+		requireKeys("a/1", "a/b/1", "a/b/2", "a/b/3", "c/1")
+
+		progress, err := deletePrefix(restricted, "a/b/", 2)
+		require.NoError(t, err)
+		require.Equal(t, &metainfoextpb.ObjectDeleteByPrefixResponse{DeletedObjectCount: 2, More: true}, progress)
+		requireKeys("a/1", "a/b/3", "c/1")
+
+		progress, err = deletePrefix(apiKey, "a/", 0)
+		require.NoError(t, err)
+		require.Equal(t, &metainfoextpb.ObjectDeleteByPrefixResponse{DeletedObjectCount: 2}, progress)
+		requireKeys("c/1")
+
+		// the pieces of the deleted objects are deleted asynchronously.
+		err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "dir/object", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		var encryptedPrefix string
+		for _, object := range objects {
+			if object.ObjectKey != "c/1" {
+				encryptedPath := string(object.ObjectKey)
+				encryptedPrefix = encryptedPath[:strings.IndexByte(encryptedPath, '/')+1]
+			}
+		}
+		require.NotEmpty(t, encryptedPrefix)
+
+		progress, err = deletePrefix(apiKey, encryptedPrefix, 0)
+		require.NoError(t, err)
+		require.Equal(t, &metainfoextpb.ObjectDeleteByPrefixResponse{DeletedObjectCount: 1, DeletedSegmentCount: 1}, progress)
+		requireKeys("c/1")
+
+		require.Eventually(t, func() bool {
+			planet.WaitForStorageNodeDeleters(ctx)
+
+			for _, node := range planet.StorageNodes {
+				piecesTotal, _, err := node.Storage2.Store.SpaceUsedForPieces(ctx)
+				require.NoError(t, err)
+				if piecesTotal != 0 {
+					return false
+				}
+			}
+			return true
+		}, 30*time.Second, 100*time.Millisecond)
+	})
+}
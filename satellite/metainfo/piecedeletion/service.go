@@ -5,6 +5,7 @@ package piecedeletion
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -178,6 +179,94 @@ func (service *Service) Delete(ctx context.Context, requests []Request, successT
 	}
 	defer service.concurrentRequests.Release(int64(totalPieceCount))
 
+	nodesReqs, err := service.resolveNodes(ctx, requests)
+	if err != nil {
+		// Pieces will be collected by garbage collector
+		return Error.Wrap(err)
+	}
+
+	threshold, err := sync2.NewSuccessThreshold(len(nodesReqs), successThreshold)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, req := range nodesReqs {
+		service.combiner.Enqueue(req.Node, Job{
+			Pieces:  req.Pieces,
+			Resolve: threshold,
+		})
+	}
+
+	threshold.Wait(ctx)
+
+	return nil
+}
+
+// DeleteAsync queues the pieces specified in the requests for deletion
+// without waiting for the storage nodes to respond. Pieces that fail to be
+// deleted will be collected by garbage collection.
+//
+// The pieces count towards MaxConcurrentPieces until all of the storage nodes
+// have responded. When the limit is reached, the pieces are left to garbage
+// collection instead of blocking the caller.
+func (service *Service) DeleteAsync(ctx context.Context, requests []Request) (err error) {
+	defer mon.Task()(&ctx, len(requests), requestsPieceCount(requests))(&err)
+
+	if len(requests) == 0 {
+		return nil
+	}
+
+	// wait for combiner and dialer to set themselves up.
+	if !service.running.Wait(ctx) {
+		return Error.Wrap(ctx.Err())
+	}
+
+	for i, req := range requests {
+		if !req.IsValid() {
+			return Error.New("request #%d is invalid", i)
+		}
+	}
+
+	// When number of pieces are more than the maximum limit, we let it overflow,
+	// so we don't have to split requests in to separate batches.
+	totalPieceCount := requestsPieceCount(requests)
+	if totalPieceCount > service.config.MaxConcurrentPieces {
+		totalPieceCount = service.config.MaxConcurrentPieces
+	}
+
+	if !service.concurrentRequests.TryAcquire(int64(totalPieceCount)) {
+		mon.Counter("delete_async_dropped_pieces").Inc(int64(requestsPieceCount(requests)))
+		return nil
+	}
+	release := func() {
+		service.concurrentRequests.Release(int64(totalPieceCount))
+	}
+
+	nodesReqs, err := service.resolveNodes(ctx, requests)
+	if err != nil {
+		release()
+		return Error.Wrap(err)
+	}
+
+	promise := &releasePromise{
+		remaining: int64(len(nodesReqs)),
+		release:   release,
+	}
+	for _, req := range nodesReqs {
+		service.combiner.Enqueue(req.Node, Job{
+			Pieces:  req.Pieces,
+			Resolve: promise,
+		})
+	}
+
+	return nil
+}
+
+// resolveNodes returns the requests keyed by the node id, filling in
+// missing node addresses.
+func (service *Service) resolveNodes(ctx context.Context, requests []Request) (_ map[storj.NodeID]Request, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	// Create a map for matching node information with the corresponding
 	// request.
 	nodesReqs := make(map[storj.NodeID]Request, len(requests))
@@ -192,8 +281,7 @@ func (service *Service) Delete(ctx context.Context, requests []Request, successT
 	if len(nodeIDs) > 0 {
 		nodes, err := service.nodesDB.GetNodes(ctx, nodeIDs)
 		if err != nil {
-			// Pieces will be collected by garbage collector
-			return Error.Wrap(err)
+			return nil, err
 		}
 
 		for _, node := range nodes {
@@ -209,21 +297,26 @@ func (service *Service) Delete(ctx context.Context, requests []Request, successT
 		}
 	}
 
-	threshold, err := sync2.NewSuccessThreshold(len(nodesReqs), successThreshold)
-	if err != nil {
-		return Error.Wrap(err)
-	}
+	return nodesReqs, nil
+}
 
-	for _, req := range nodesReqs {
-		service.combiner.Enqueue(req.Node, Job{
-			Pieces:  req.Pieces,
-			Resolve: threshold,
-		})
-	}
+// releasePromise is used for jobs whose outcome nobody waits for. It calls
+// release, when all of the jobs are resolved.
+type releasePromise struct {
+	remaining int64
+	release   func()
+}
 
-	threshold.Wait(ctx)
+// Success implements Promise.
+func (promise *releasePromise) Success() { promise.resolve() }
 
-	return nil
+// Failure implements Promise.
+func (promise *releasePromise) Failure() { promise.resolve() }
+
+func (promise *releasePromise) resolve() {
+	if atomic.AddInt64(&promise.remaining, -1) == 0 {
+		promise.release()
+	}
 }
 
 // Request defines a deletion requests for a node.
@@ -174,6 +174,73 @@ func TestService_DeletePieces_AllNodesUp(t *testing.T) {
 	})
 }
 
+func TestService_DeleteAsync(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				testplanet.ReconfigureRS(2, 2, 4, 4),
+				testplanet.MaxSegmentSize(15*memory.KiB),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplnk := planet.Uplinks[0]
+		satelliteSys := planet.Satellites[0]
+
+		data := testrand.Bytes(10 * memory.KiB)
+		err := uplnk.Upload(ctx, satelliteSys, "a-bucket", "object-filename", data)
+		require.NoError(t, err)
+
+		// ensure that no requests return an error
+		err = satelliteSys.API.Metainfo.PieceDeletion.DeleteAsync(ctx, nil)
+		require.NoError(t, err)
+
+		var requests []piecedeletion.Request
+		for _, sn := range planet.StorageNodes {
+			nodePieces := piecedeletion.Request{Node: sn.NodeURL()}
+			err = sn.Storage2.Store.WalkSatellitePieces(ctx, satelliteSys.ID(),
+				func(store pieces.StoredPieceAccess) error {
+					nodePieces.Pieces = append(nodePieces.Pieces, store.PieceID())
+					return nil
+				},
+			)
+			require.NoError(t, err)
+
+			requests = append(requests, nodePieces)
+		}
+
+		err = satelliteSys.API.Metainfo.PieceDeletion.DeleteAsync(ctx, requests)
+		require.NoError(t, err)
+
+		// the pieces are deleted in the background.
+		require.Eventually(t, func() bool {
+			planet.WaitForStorageNodeDeleters(ctx)
+
+			for _, sn := range planet.StorageNodes {
+				piecesTotal, _, err := sn.Storage2.Store.SpaceUsedForPieces(ctx)
+				require.NoError(t, err)
+				if piecesTotal != 0 {
+					return false
+				}
+			}
+			return true
+		}, 30*time.Second, 100*time.Millisecond)
+
+		// the pieces are released, when the storage nodes have responded,
+		// so a deletion of the maximum number of pieces doesn't block.
+		maxPieces := piecedeletion.Request{Node: planet.StorageNodes[0].NodeURL()}
+		for i := 0; i < satelliteSys.Config.Metainfo.PieceDeletion.MaxConcurrentPieces; i++ {
+			maxPieces.Pieces = append(maxPieces.Pieces, testrand.PieceID())
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+		err = satelliteSys.API.Metainfo.PieceDeletion.Delete(timeoutCtx, []piecedeletion.Request{maxPieces}, 1)
+		require.NoError(t, err)
+		require.NoError(t, timeoutCtx.Err())
+	})
+}
+
 func TestService_DeletePieces_SomeNodesDown(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -23,6 +23,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/metainfoextpb"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
@@ -213,6 +214,11 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 func (endpoint *Endpoint) validateBucket(ctx context.Context, bucket []byte) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return ValidateBucketName(bucket)
+}
+
+// ValidateBucketName validates the bucket name.
+func ValidateBucketName(bucket []byte) error {
 	if len(bucket) == 0 {
 		return Error.Wrap(storj.ErrNoBucket.New(""))
 	}
@@ -225,7 +231,7 @@ func (endpoint *Endpoint) validateBucket(ctx context.Context, bucket []byte) (er
 	// https://gist.github.com/mniewrzal/49de3af95f36e63e88fac24f565e444c
 	labels := bytes.Split(bucket, []byte("."))
 	for _, label := range labels {
-		err = validateBucketLabel(label)
+		err := validateBucketLabel(label)
 		if err != nil {
 			return err
 		}
@@ -443,6 +449,36 @@ func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, projectI
 	return nil
 }
 
+// convertObjectTags converts the object tags of a request and checks them
+// against the limits. It returns nil when the request doesn't have the tags.
+func (endpoint *Endpoint) convertObjectTags(pbTags *metainfoextpb.ObjectTags) (metabase.ObjectTags, error) {
+	if pbTags == nil {
+		return nil, nil
+	}
+
+	if len(pbTags.Tags) > endpoint.config.MaxObjectTags {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "Too many object tags, got %v, maximum allowed is %v", len(pbTags.Tags), endpoint.config.MaxObjectTags)
+	}
+
+	tags := make(metabase.ObjectTags, len(pbTags.Tags))
+	for _, tag := range pbTags.Tags {
+		if _, ok := tags[tag.Key]; ok {
+			return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "Object tag %q is defined more than once", tag.Key)
+		}
+		tags[tag.Key] = tag.Value
+	}
+
+	if size := memory.Size(tags.Size()); size > endpoint.config.MaxObjectTagsSize {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "Object tags are too large, got %v, maximum allowed is %v", size, endpoint.config.MaxObjectTagsSize)
+	}
+	// tags with an empty key, invalid UTF-8 or NUL characters can't be
+	// stored.
+	if err := tags.Verify(); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	return tags, nil
+}
+
 // checkEncryptedMetadata checks encrypted metadata and it's encrypted key sizes. Metadata encrypted key nonce
 // is serialized to storj.Nonce automatically.
 func (endpoint *Endpoint) checkEncryptedMetadataSize(encryptedMetadata, encryptedKey []byte) error {
@@ -14,6 +14,11 @@ type Criteria struct {
 	AutoExcludeSubnets   map[string]struct{} // initialize it with empty map to keep only one node per subnet.
 	Placement            storj.PlacementConstraint
 	ExcludedCountryCodes []location.CountryCode
+	// PlacementRule is used instead of Placement when it's set.
+	PlacementRule *PlacementRule
+	// Diversity limits the selected nodes per network and operator. It keeps
+	// the counts of the selection.
+	Diversity *Diversity
 }
 
 // MatchInclude returns with true if node is selected.
@@ -22,7 +27,15 @@ func (c *Criteria) MatchInclude(node *Node) bool {
 		return false
 	}
 
-	if !c.Placement.AllowedCountry(node.CountryCode) {
+	if c.PlacementRule != nil {
+		if !c.PlacementRule.MatchNode(node) {
+			return false
+		}
+	} else if !c.Placement.AllowedCountry(node.CountryCode) {
+		return false
+	}
+
+	if c.Diversity != nil && !c.Diversity.Allowed(node) {
 		return false
 	}
 
@@ -42,6 +55,10 @@ func (c *Criteria) MatchInclude(node *Node) bool {
 		}
 	}
 
+	if c.Diversity != nil {
+		c.Diversity.Add(node)
+	}
+
 	return true
 }
 
@@ -4,6 +4,8 @@
 package uploadselection
 
 import (
+	"strconv"
+
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
 )
@@ -14,14 +16,39 @@ type Node struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	// ASN is the autonomous system of the node, zero when unknown.
+	ASN    uint32
+	Email  string
+	Wallet string
+	Tags   map[string]string
+}
+
+// Network returns the network of the node, which is the autonomous system or
+// the subnet when the autonomous system isn't known.
+func (node *Node) Network() string {
+	if node.ASN != 0 {
+		return "AS" + strconv.FormatUint(uint64(node.ASN), 10)
+	}
+	return node.LastNet
 }
 
 // Clone returns a deep clone of the selected node.
 func (node *Node) Clone() *Node {
+	var tags map[string]string
+	if node.Tags != nil {
+		tags = make(map[string]string, len(node.Tags))
+		for key, value := range node.Tags {
+			tags[key] = value
+		}
+	}
 	return &Node{
 		NodeURL:     node.NodeURL,
 		LastNet:     node.LastNet,
 		LastIPPort:  node.LastIPPort,
 		CountryCode: node.CountryCode,
+		ASN:         node.ASN,
+		Email:       node.Email,
+		Wallet:      node.Wallet,
+		Tags:        tags,
 	}
 }
@@ -0,0 +1,367 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	"strconv"
+	"strings"
+
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+)
+
+// CountrySet is a set of countries.
+type CountrySet map[location.CountryCode]struct{}
+
+// NewCountrySet creates a set from the countries.
+func NewCountrySet(countries ...location.CountryCode) CountrySet {
+	set := CountrySet{}
+	for _, country := range countries {
+		set[country] = struct{}{}
+	}
+	return set
+}
+
+// Contains returns whether the set contains the country.
+func (set CountrySet) Contains(country location.CountryCode) bool {
+	_, ok := set[country]
+	return ok
+}
+
+// regions are the country groups which can be used in the placement rules.
+var regions = map[string]func() CountrySet{
+	"EU": func() CountrySet {
+		return NewCountrySet(location.EuCountries...)
+	},
+	"EEA": func() CountrySet {
+		set := NewCountrySet(location.EuCountries...)
+		for _, country := range location.EeaNonEuCountries {
+			set[country] = struct{}{}
+		}
+		return set
+	},
+	"US": func() CountrySet {
+		return NewCountrySet(location.UnitedStates)
+	},
+}
+
+// PlacementRule is a placement constraint, which the nodes and the node
+// selections of a placement have to satisfy.
+//
+// A rule is written as terms joined with &&, for example:
+//
+//	region("EU") && country("DE","AT") && tag("tier","ssd") && min_asns(3) && max_per_wallet(1)
+//
+// The supported terms are:
+//
+//	country(codes...)   the node is in one of the countries, given as ISO codes.
+//	region(names...)    the node is in one of the regions: EU, EEA or US.
+//	tag(key, value)     the node has the tag with the value.
+//	min_asns(n)         the selected nodes are from at least n networks.
+//	max_per_email(n)    at most n selected nodes have the same operator email.
+//	max_per_wallet(n)   at most n selected nodes have the same operator wallet.
+//
+// The networks are identified by the autonomous system of the nodes, or by
+// their subnet when the autonomous system isn't known.
+type PlacementRule struct {
+	// Countries are the country allowlists. The country of the node has to be
+	// in all of them.
+	Countries []CountrySet
+	// Tags are the tags which the node needs to have.
+	Tags map[string]string
+
+	// MinASNs is the minimum number of distinct networks of a selection.
+	MinASNs int
+	// MaxPerEmail is the maximum number of nodes per operator email in a
+	// selection, zero is unlimited.
+	MaxPerEmail int
+	// MaxPerWallet is the maximum number of nodes per operator wallet in a
+	// selection, zero is unlimited.
+	MaxPerWallet int
+}
+
+// PlacementRuleFromConstraint returns the rule for the predefined placement.
+func PlacementRuleFromConstraint(placement storj.PlacementConstraint) *PlacementRule {
+	switch placement {
+	case storj.EveryCountry:
+		return &PlacementRule{}
+	case storj.EU:
+		return &PlacementRule{Countries: []CountrySet{regions["EU"]()}}
+	case storj.EEA:
+		return &PlacementRule{Countries: []CountrySet{regions["EEA"]()}}
+	case storj.US:
+		return &PlacementRule{Countries: []CountrySet{regions["US"]()}}
+	case storj.DE:
+		return &PlacementRule{Countries: []CountrySet{NewCountrySet(location.Germany)}}
+	default:
+		// unknown placements don't allow any node.
+		return &PlacementRule{Countries: []CountrySet{{}}}
+	}
+}
+
+// ParsePlacementRule parses a placement rule.
+func ParsePlacementRule(s string) (*PlacementRule, error) {
+	rule := &PlacementRule{}
+	if strings.TrimSpace(s) == "" {
+		return nil, Error.New("empty placement rule")
+	}
+
+	for _, term := range strings.Split(s, "&&") {
+		term = strings.TrimSpace(term)
+
+		open := strings.IndexByte(term, '(')
+		if open < 0 || !strings.HasSuffix(term, ")") {
+			return nil, Error.New("invalid term %q", term)
+		}
+		name := strings.TrimSpace(term[:open])
+		args := splitPlacementArgs(term[open+1 : len(term)-1])
+
+		switch name {
+		case "country":
+			if len(args) == 0 {
+				return nil, Error.New("%s needs at least one country", name)
+			}
+			set := CountrySet{}
+			for _, arg := range args {
+				country := location.ToCountryCode(arg)
+				if country == 0 {
+					return nil, Error.New("invalid country %q", arg)
+				}
+				set[country] = struct{}{}
+			}
+			rule.Countries = append(rule.Countries, set)
+		case "region":
+			if len(args) == 0 {
+				return nil, Error.New("%s needs at least one region", name)
+			}
+			set := CountrySet{}
+			for _, arg := range args {
+				region, ok := regions[strings.ToUpper(arg)]
+				if !ok {
+					return nil, Error.New("unknown region %q", arg)
+				}
+				for country := range region() {
+					set[country] = struct{}{}
+				}
+			}
+			rule.Countries = append(rule.Countries, set)
+		case "tag":
+			if len(args) != 2 || args[0] == "" {
+				return nil, Error.New("%s needs a key and a value", name)
+			}
+			if rule.Tags == nil {
+				rule.Tags = map[string]string{}
+			}
+			if value, ok := rule.Tags[args[0]]; ok && value != args[1] {
+				return nil, Error.New("tag %q can't be both %q and %q", args[0], value, args[1])
+			}
+			rule.Tags[args[0]] = args[1]
+		case "min_asns", "max_per_email", "max_per_wallet":
+			if len(args) != 1 {
+				return nil, Error.New("%s needs a single number", name)
+			}
+			n, err := strconv.Atoi(args[0])
+			if err != nil || n <= 0 {
+				return nil, Error.New("%s needs a positive number, got %q", name, args[0])
+			}
+			switch name {
+			case "min_asns":
+				rule.MinASNs = n
+			case "max_per_email":
+				rule.MaxPerEmail = n
+			case "max_per_wallet":
+				rule.MaxPerWallet = n
+			}
+		default:
+			return nil, Error.New("unknown term %q", name)
+		}
+	}
+
+	return rule, nil
+}
+
+// splitPlacementArgs splits the arguments of a term, removing the quotes.
+func splitPlacementArgs(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	args := strings.Split(s, ",")
+	for i, arg := range args {
+		args[i] = strings.Trim(strings.TrimSpace(arg), `"`)
+	}
+	return args
+}
+
+// MatchNode returns whether the node may store the pieces of the placement.
+func (rule *PlacementRule) MatchNode(node *Node) bool {
+	for _, countries := range rule.Countries {
+		if !countries.Contains(node.CountryCode) {
+			return false
+		}
+	}
+	for key, value := range rule.Tags {
+		if actual, ok := node.Tags[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// OutOfPlacement returns the indexes of the nodes which don't satisfy the
+// rule, because they don't match its node constraints or because they exceed
+// its diversity limits. The nodes are the holders of a segment's pieces, when
+// several nodes exceed a limit the first ones are kept.
+func (rule *PlacementRule) OutOfPlacement(nodes []*Node) (outOfPlacement []int) {
+	var matching int
+	for _, node := range nodes {
+		if rule.MatchNode(node) {
+			matching++
+		}
+	}
+
+	diversity := rule.Diversity(matching)
+	for i, node := range nodes {
+		if !rule.MatchNode(node) {
+			outOfPlacement = append(outOfPlacement, i)
+			continue
+		}
+		if diversity == nil {
+			continue
+		}
+		if !diversity.Allowed(node) {
+			outOfPlacement = append(outOfPlacement, i)
+			continue
+		}
+		diversity.Add(node)
+	}
+	return outOfPlacement
+}
+
+// Diversity returns the limits for selecting count nodes.
+func (rule *PlacementRule) Diversity(count int) *Diversity {
+	if rule.MinASNs <= 0 && rule.MaxPerEmail <= 0 && rule.MaxPerWallet <= 0 {
+		return nil
+	}
+
+	diversity := NewDiversity()
+	if rule.MinASNs > 0 {
+		// limiting the nodes per network to count/MinASNs guarantees at least
+		// MinASNs networks when count nodes are selected.
+		diversity.MaxPerNetwork = count / rule.MinASNs
+		if diversity.MaxPerNetwork < 1 {
+			diversity.MaxPerNetwork = 1
+		}
+	}
+	diversity.MaxPerEmail = rule.MaxPerEmail
+	diversity.MaxPerWallet = rule.MaxPerWallet
+	return diversity
+}
+
+// Diversity limits how many of the selected nodes may share a network or an
+// operator. It keeps the counts of a single selection.
+type Diversity struct {
+	// MaxPerNetwork, MaxPerEmail and MaxPerWallet are the limits, zero is
+	// unlimited.
+	MaxPerNetwork int
+	MaxPerEmail   int
+	MaxPerWallet  int
+
+	networks map[string]int
+	emails   map[string]int
+	wallets  map[string]int
+}
+
+// NewDiversity creates diversity limits without any limit.
+func NewDiversity() *Diversity {
+	return &Diversity{
+		networks: map[string]int{},
+		emails:   map[string]int{},
+		wallets:  map[string]int{},
+	}
+}
+
+// Allowed returns whether selecting the node keeps the selection within the
+// limits.
+func (diversity *Diversity) Allowed(node *Node) bool {
+	if diversity.MaxPerNetwork > 0 && diversity.networks[node.Network()] >= diversity.MaxPerNetwork {
+		return false
+	}
+	if email := normalizeOperator(node.Email); email != "" && diversity.MaxPerEmail > 0 && diversity.emails[email] >= diversity.MaxPerEmail {
+		return false
+	}
+	if wallet := normalizeOperator(node.Wallet); wallet != "" && diversity.MaxPerWallet > 0 && diversity.wallets[wallet] >= diversity.MaxPerWallet {
+		return false
+	}
+	return true
+}
+
+// Add counts the node as selected.
+func (diversity *Diversity) Add(node *Node) {
+	diversity.networks[node.Network()]++
+	if email := normalizeOperator(node.Email); email != "" {
+		diversity.emails[email]++
+	}
+	if wallet := normalizeOperator(node.Wallet); wallet != "" {
+		diversity.wallets[wallet]++
+	}
+}
+
+// Networks returns the number of distinct networks of the selected nodes.
+func (diversity *Diversity) Networks() int {
+	return len(diversity.networks)
+}
+
+func normalizeOperator(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// PlacementRules are the custom placement rules of a satellite.
+type PlacementRules map[storj.PlacementConstraint]*PlacementRule
+
+// ParsePlacementRules parses placement rules separated by semicolons, where
+// every rule is prefixed with its placement ID, for example:
+//
+//	10:country("DE","AT") && min_asns(3);11:region("EU") && max_per_wallet(1)
+//
+// The predefined placements can't be redefined.
+func ParsePlacementRules(s string) (PlacementRules, error) {
+	rules := PlacementRules{}
+	for _, definition := range strings.Split(s, ";") {
+		definition = strings.TrimSpace(definition)
+		if definition == "" {
+			continue
+		}
+
+		colon := strings.IndexByte(definition, ':')
+		if colon < 0 {
+			return nil, Error.New("placement definition %q has no ID", definition)
+		}
+		id, err := strconv.ParseUint(strings.TrimSpace(definition[:colon]), 10, 16)
+		if err != nil {
+			return nil, Error.New("invalid placement ID %q", definition[:colon])
+		}
+		placement := storj.PlacementConstraint(id)
+		if placement <= storj.InvalidPlacement {
+			return nil, Error.New("placement %d is predefined", placement)
+		}
+		if _, exists := rules[placement]; exists {
+			return nil, Error.New("placement %d is defined more than once", placement)
+		}
+
+		rule, err := ParsePlacementRule(definition[colon+1:])
+		if err != nil {
+			return nil, Error.New("placement %d: %v", placement, err)
+		}
+		rules[placement] = rule
+	}
+	return rules, nil
+}
+
+// Rule returns the rule of the placement.
+func (rules PlacementRules) Rule(placement storj.PlacementConstraint) *PlacementRule {
+	if rule, ok := rules[placement]; ok {
+		return rule
+	}
+	return PlacementRuleFromConstraint(placement)
+}
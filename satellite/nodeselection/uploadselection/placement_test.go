@@ -0,0 +1,186 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
+)
+
+func TestParsePlacementRule(t *testing.T) {
+	rule, err := uploadselection.ParsePlacementRule(`region("EU") && country("DE", AT) && tag("tier","ssd") && min_asns(3) && max_per_email(2) && max_per_wallet(1)`)
+	require.NoError(t, err)
+	require.Len(t, rule.Countries, 2)
+	require.Equal(t, uploadselection.NewCountrySet(location.Germany, location.Austria), rule.Countries[1])
+	require.Equal(t, map[string]string{"tier": "ssd"}, rule.Tags)
+	require.Equal(t, 3, rule.MinASNs)
+	require.Equal(t, 2, rule.MaxPerEmail)
+	require.Equal(t, 1, rule.MaxPerWallet)
+
+	require.True(t, rule.MatchNode(&uploadselection.Node{CountryCode: location.Germany, Tags: map[string]string{"tier": "ssd"}}))
+	require.False(t, rule.MatchNode(&uploadselection.Node{CountryCode: location.Germany}))
+	require.False(t, rule.MatchNode(&uploadselection.Node{CountryCode: location.Germany, Tags: map[string]string{"tier": "hdd"}}))
+	require.False(t, rule.MatchNode(&uploadselection.Node{CountryCode: location.France, Tags: map[string]string{"tier": "ssd"}}))
+	require.False(t, rule.MatchNode(&uploadselection.Node{CountryCode: location.UnitedStates, Tags: map[string]string{"tier": "ssd"}}))
+
+	for _, invalid := range []string{
+		``,
+		`country`,
+		`country()`,
+		`country("XYZ")`,
+		`region("MARS")`,
+		`tag("tier")`,
+		`tag("tier","ssd") && tag("tier","hdd")`,
+		`min_asns(0)`,
+		`min_asns(x)`,
+		`max_per_wallet(1,2)`,
+		`unknown(1)`,
+		`country("DE") &&`,
+	} {
+		_, err := uploadselection.ParsePlacementRule(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestParsePlacementRules(t *testing.T) {
+	rules, err := uploadselection.ParsePlacementRules(`10:country("DE");  11:region("US") && min_asns(2);`)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	require.Equal(t, 2, rules[11].MinASNs)
+
+	require.True(t, rules.Rule(10).MatchNode(&uploadselection.Node{CountryCode: location.Germany}))
+	require.False(t, rules.Rule(10).MatchNode(&uploadselection.Node{CountryCode: location.Austria}))
+
+	// predefined placements
+	require.True(t, rules.Rule(storj.EveryCountry).MatchNode(&uploadselection.Node{}))
+	require.True(t, rules.Rule(storj.EU).MatchNode(&uploadselection.Node{CountryCode: location.Austria}))
+	require.False(t, rules.Rule(storj.EU).MatchNode(&uploadselection.Node{CountryCode: location.Norway}))
+	require.True(t, rules.Rule(storj.EEA).MatchNode(&uploadselection.Node{CountryCode: location.Norway}))
+	require.False(t, rules.Rule(storj.InvalidPlacement).MatchNode(&uploadselection.Node{CountryCode: location.Germany}))
+	require.False(t, rules.Rule(12).MatchNode(&uploadselection.Node{CountryCode: location.Germany}))
+
+	empty, err := uploadselection.ParsePlacementRules("")
+	require.NoError(t, err)
+	require.Empty(t, empty)
+
+	for _, invalid := range []string{
+		`country("DE")`,
+		`x:country("DE")`,
+		`1:country("DE")`,
+		`10:country("DE");10:country("AT")`,
+		`10:unknown(1)`,
+	} {
+		_, err := uploadselection.ParsePlacementRules(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestPlacementRule_OutOfPlacement(t *testing.T) {
+	rule, err := uploadselection.ParsePlacementRule(`country("DE") && min_asns(2) && max_per_wallet(1)`)
+	require.NoError(t, err)
+
+	nodes := []*uploadselection.Node{
+		{CountryCode: location.Germany, ASN: 1, Wallet: "0x1"},
+		{CountryCode: location.Germany, ASN: 1, Wallet: "0x2"},
+		{CountryCode: location.Germany, ASN: 1, Wallet: "0x3"},
+		{CountryCode: location.Austria, ASN: 2, Wallet: "0x4"},
+		{CountryCode: location.Germany, ASN: 2, Wallet: "0x5"},
+		{CountryCode: location.Germany, ASN: 3, Wallet: "0X1"},
+	}
+
+	// five matching nodes from two networks allow two nodes per network,
+	// the third node of network 1 and the second node of wallet 0x1 exceed
+	// the limits, and the Austrian node doesn't match.
+	require.Equal(t, []int{2, 3, 5}, rule.OutOfPlacement(nodes))
+
+	// rules without diversity limits only check the node constraints.
+	rule, err = uploadselection.ParsePlacementRule(`country("DE")`)
+	require.NoError(t, err)
+	require.Equal(t, []int{3}, rule.OutOfPlacement(nodes))
+}
+
+func TestState_Select_PlacementRule(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	// synthetic network: 4 autonomous systems with 5 nodes each, half of
+	// them in Germany, every autonomous system run by a single operator.
+	var nodes []*uploadselection.Node
+	for asn := 1; asn <= 4; asn++ {
+		for i := 0; i < 5; i++ {
+			country := location.Germany
+			if i%2 == 1 {
+				country = location.UnitedStates
+			}
+			subnet := "10." + strconv.Itoa(asn) + "." + strconv.Itoa(i)
+			nodes = append(nodes, &uploadselection.Node{
+				NodeURL:     storj.NodeURL{ID: testrand.NodeID(), Address: subnet + ".1:8080"},
+				LastNet:     subnet,
+				LastIPPort:  subnet + ".1:8080",
+				CountryCode: country,
+				ASN:         uint32(asn),
+				Email:       "operator" + strconv.Itoa(asn) + "@mail.test",
+				Wallet:      "0x" + strconv.Itoa(asn),
+				Tags:        map[string]string{"tier": []string{"ssd", "hdd"}[i%2]},
+			})
+		}
+	}
+	state := uploadselection.NewState(nodes, nil)
+
+	selectNodes := func(count int, rule string) ([]*uploadselection.Node, error) {
+		parsed, err := uploadselection.ParsePlacementRule(rule)
+		require.NoError(t, err)
+		return state.Select(ctx, uploadselection.Request{
+			Count:         count,
+			Distinct:      true,
+			PlacementRule: parsed,
+		})
+	}
+
+	for i := 0; i < 10; i++ {
+		selected, err := selectNodes(8, `country("DE") && min_asns(4)`)
+		require.NoError(t, err)
+		require.Len(t, selected, 8)
+		perASN := map[uint32]int{}
+		for _, node := range selected {
+			require.Equal(t, location.Germany, node.CountryCode)
+			perASN[node.ASN]++
+		}
+		require.Len(t, perASN, 4)
+
+		selected, err = selectNodes(4, `max_per_wallet(1)`)
+		require.NoError(t, err)
+		wallets := map[string]bool{}
+		for _, node := range selected {
+			require.False(t, wallets[node.Wallet])
+			wallets[node.Wallet] = true
+		}
+
+		selected, err = selectNodes(8, `tag("tier","hdd")`)
+		require.NoError(t, err)
+		for _, node := range selected {
+			require.Equal(t, "hdd", node.Tags["tier"])
+		}
+	}
+
+	// there are only 4 operators.
+	_, err := selectNodes(5, `max_per_email(1)`)
+	require.True(t, uploadselection.ErrNotEnoughNodes.Has(err))
+
+	// there are only 4 autonomous systems with 3 german nodes.
+	_, err = selectNodes(13, `country("DE") && min_asns(4)`)
+	require.True(t, uploadselection.ErrNotEnoughNodes.Has(err))
+
+	// at most 8 nodes have the tag.
+	_, err = selectNodes(9, `tag("tier","hdd")`)
+	require.True(t, uploadselection.ErrNotEnoughNodes.Has(err))
+}
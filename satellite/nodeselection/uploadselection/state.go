@@ -90,6 +90,8 @@ type Request struct {
 	ExcludedIDs          []storj.NodeID
 	Placement            storj.PlacementConstraint
 	ExcludedCountryCodes []string
+	// PlacementRule is used instead of Placement when it's set.
+	PlacementRule *PlacementRule
 }
 
 // Select selects requestedCount nodes where there will be newFraction nodes.
@@ -118,6 +120,10 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 	}
 
 	criteria.Placement = request.Placement
+	if request.PlacementRule != nil {
+		criteria.PlacementRule = request.PlacementRule
+		criteria.Diversity = request.PlacementRule.Diversity(totalCount)
+	}
 
 	if request.Distinct {
 		criteria.AutoExcludeSubnets = make(map[string]struct{})
@@ -146,6 +152,15 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 	if len(selected) < totalCount {
 		return selected, ErrNotEnoughNodes.New("requested from cache %d, found %d", totalCount, len(selected))
 	}
+	if criteria.Diversity != nil {
+		minNetworks := request.PlacementRule.MinASNs
+		if minNetworks > totalCount {
+			minNetworks = totalCount
+		}
+		if criteria.Diversity.Networks() < minNetworks {
+			return selected, ErrNotEnoughNodes.New("requested from cache %d nodes in %d networks, found %d networks", totalCount, minNetworks, criteria.Diversity.Networks())
+		}
+	}
 	return selected, nil
 }
 
@@ -243,6 +243,7 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 	storagenodeSettled := map[int32]int64{}
 	bucketSettled := map[bucketIDAction]bandwidthAmount{}
 	seenSerials := map[storj.SerialNumber]struct{}{}
+	var downloadsSettled int64
 
 	var window int64
 	var request *pb.SettlementRequest
@@ -286,6 +287,9 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 		seenSerials[serialNum] = struct{}{}
 
 		storagenodeSettled[int32(orderLimit.Action)] += order.Amount
+		if orderLimit.Action == pb.PieceAction_GET && order.Amount > 0 {
+			downloadsSettled++
+		}
 
 		metadata, err := endpoint.ordersService.DecryptOrderMetadata(ctx, orderLimit)
 		if err != nil {
@@ -373,6 +377,7 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 				log.Info("err updating bucket bandwidth settle", zap.Error(err))
 			}
 		}
+		endpoint.ordersService.DownloadsSettled(peer.ID, downloadsSettled)
 	} else {
 		mon.Event("orders_already_processed")
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"math"
 	mathrand "math/rand"
+	"sort"
 	"sync"
 	"time"
 
@@ -38,6 +39,8 @@ type Config struct {
 	FlushInterval       time.Duration  `help:"how often to flush the rollups write cache to the database" devDefault:"30s" releaseDefault:"1m" testDefault:"$TESTINTERVAL"`
 	NodeStatusLogging   bool           `hidden:"true" help:"deprecated, log the offline/disqualification status of nodes" default:"false" testDefault:"true"`
 	OrdersSemaphoreSize int            `help:"how many concurrent orders to process at once. zero is unlimited" default:"2"`
+	PreferFastNodes     bool           `help:"create download order limits for the nodes with the best download score first" default:"true"`
+	DownloadExploration float64        `help:"fraction of downloads which pick the nodes randomly, so the download scores of all nodes stay up to date" default:"0.1"`
 }
 
 // BucketsDB returns information about buckets.
@@ -60,6 +63,9 @@ type Service struct {
 
 	orderExpiration time.Duration
 
+	preferFastNodes     bool
+	downloadExploration float64
+
 	rngMu sync.Mutex
 	rng   *mathrand.Rand
 }
@@ -85,6 +91,9 @@ func NewService(
 
 		orderExpiration: config.Expiration,
 
+		preferFastNodes:     config.PreferFastNodes,
+		downloadExploration: config.DownloadExploration,
+
 		rng: mathrand.New(mathrand.NewSource(time.Now().UnixNano())),
 	}, nil
 }
@@ -156,7 +165,7 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabas
 	neededLimits := segment.Redundancy.DownloadNodes()
 
 	pieces := segment.Pieces
-	for _, pieceIndex := range service.perm(len(pieces)) {
+	for _, pieceIndex := range service.downloadOrder(ctx, pieces) {
 		piece := pieces[pieceIndex]
 		node, ok := nodes[piece.StorageNode]
 		if !ok {
@@ -189,6 +198,12 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabas
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
 
+	requested := make([]storj.NodeID, 0, len(signer.AddressedLimits))
+	for _, limit := range signer.AddressedLimits {
+		requested = append(requested, limit.Limit.StorageNodeId)
+	}
+	service.overlay.DownloadFeedback.Requested(requested...)
+
 	signer.AddressedLimits, err = sortLimits(signer.AddressedLimits, segment)
 	if err != nil {
 		return nil, storj.PiecePrivateKey{}, err
@@ -209,6 +224,46 @@ func (service *Service) perm(n int) []int {
 	return service.rng.Perm(n)
 }
 
+func (service *Service) explore() bool {
+	service.rngMu.Lock()
+	defer service.rngMu.Unlock()
+	return service.rng.Float64() < service.downloadExploration
+}
+
+// downloadOrder returns the order in which the pieces should get download
+// order limits. The pieces on the nodes with the best download score come
+// first, except when exploring, where the order is random.
+func (service *Service) downloadOrder(ctx context.Context, pieces metabase.Pieces) []int {
+	order := service.perm(len(pieces))
+	if !service.preferFastNodes || service.explore() {
+		return order
+	}
+
+	nodeIDs := make([]storj.NodeID, len(pieces))
+	for i, piece := range pieces {
+		nodeIDs[i] = piece.StorageNode
+	}
+	scores, err := service.overlay.CachedGetDownloadScores(ctx, nodeIDs)
+	if err != nil {
+		service.log.Debug("error getting download scores from overlay", zap.Error(err))
+		return order
+	}
+
+	// the random order breaks the ties between nodes with the same score.
+	sort.SliceStable(order, func(i, k int) bool {
+		return scores[pieces[order[i]].StorageNode] > scores[pieces[order[k]].StorageNode]
+	})
+	return order
+}
+
+// DownloadsSettled records that the node settled download orders.
+func (service *Service) DownloadsSettled(nodeID storj.NodeID, count int64) {
+	if count <= 0 {
+		return
+	}
+	service.overlay.DownloadFeedback.Settled(nodeID, count)
+}
+
 // sortLimits sorts order limits and fill missing ones with nil values.
 func sortLimits(limits []*pb.AddressedOrderLimit, segment metabase.Segment) ([]*pb.AddressedOrderLimit, error) {
 	sorted := make([]*pb.AddressedOrderLimit, segment.Redundancy.TotalShares)
@@ -22,6 +22,8 @@ var (
 type Config struct {
 	Node                       NodeSelectionConfig
 	NodeSelectionCache         UploadSelectionCacheConfig
+	DownloadStats              DownloadStatsConfig
+	Placement                  string `help:"custom placement rules, e.g. 10:country(\"DE\",\"AT\") && min_asns(3);11:region(\"EU\") && max_per_wallet(1)" default:""`
 	GeoIP                      GeoIPConfig
 	UpdateStatsBatchSize       int           `help:"number of update requests to process per transaction" default:"100"`
 	NodeCheckInWaitPeriod      time.Duration `help:"the amount of time to wait before accepting a redundant check-in from a node (unmodified info since last check-in)" default:"2h" testDefault:"30s"`
@@ -51,6 +53,7 @@ type NodeSelectionConfig struct {
 // GeoIPConfig is a configuration struct that helps configure the GeoIP lookup features on the satellite.
 type GeoIPConfig struct {
 	DB            string   `help:"the location of the maxmind database containing geoip country information"`
+	ASNDB         string   `help:"the location of the maxmind database containing autonomous system information"`
 	MockCountries []string `help:"a mock list of countries the satellite will attribute to nodes (useful for testing)"`
 }
 
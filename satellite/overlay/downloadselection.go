@@ -19,6 +19,8 @@ import (
 type DownloadSelectionDB interface {
 	// SelectAllStorageNodesDownload returns nodes that are ready for downloading
 	SelectAllStorageNodesDownload(ctx context.Context, onlineWindow time.Duration, asOf AsOfSystemTimeConfig) ([]*SelectedNode, error)
+	// GetAllDownloadStats returns the download stats of all nodes.
+	GetAllDownloadStats(ctx context.Context) ([]DownloadStats, error)
 }
 
 // DownloadSelectionCacheConfig contains configuration for the selection cache.
@@ -26,6 +28,9 @@ type DownloadSelectionCacheConfig struct {
 	Staleness      time.Duration
 	OnlineWindow   time.Duration
 	AsOfSystemTime AsOfSystemTimeConfig
+	// ReferenceTTFB is the time to first byte which halves the download score
+	// of a node.
+	ReferenceTTFB time.Duration
 }
 
 // DownloadSelectionCache keeps a list of all the storage nodes that are qualified to download data from.
@@ -72,7 +77,12 @@ func (cache *DownloadSelectionCache) read(ctx context.Context) (_ interface{}, e
 
 	mon.IntVal("refresh_cache_size_online").Observe(int64(len(onlineNodes)))
 
-	return NewDownloadSelectionCacheState(onlineNodes), nil
+	stats, err := cache.db.GetAllDownloadStats(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return NewDownloadSelectionCacheStateWithStats(onlineNodes, stats, cache.config.ReferenceTTFB), nil
 }
 
 // GetNodeIPs gets the last node ip:port from the cache, refreshing when needed.
@@ -101,6 +111,20 @@ func (cache *DownloadSelectionCache) GetNodes(ctx context.Context, nodes []storj
 	return state.Nodes(nodes), nil
 }
 
+// GetScores gets the download scores of the nodes from the cache, higher is
+// better. Nodes without download stats get the default score.
+func (cache *DownloadSelectionCache) GetScores(ctx context.Context, nodes []storj.NodeID) (_ map[storj.NodeID]float64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stateAny, err := cache.cache.Get(ctx, time.Now())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	state := stateAny.(*DownloadSelectionCacheState)
+
+	return state.Scores(nodes), nil
+}
+
 // Size returns how many nodes are in the cache.
 func (cache *DownloadSelectionCache) Size(ctx context.Context) (int, error) {
 	stateAny, err := cache.cache.Get(ctx, time.Now())
@@ -115,16 +139,35 @@ func (cache *DownloadSelectionCache) Size(ctx context.Context) (int, error) {
 type DownloadSelectionCacheState struct {
 	// byID returns IP based on storj.NodeID
 	byID map[storj.NodeID]*SelectedNode // TODO: optimize, avoid pointery structures for performance
+
+	scores       map[storj.NodeID]float64
+	defaultScore float64
 }
 
 // NewDownloadSelectionCacheState creates a new state from the nodes.
 func NewDownloadSelectionCacheState(nodes []*SelectedNode) *DownloadSelectionCacheState {
+	return NewDownloadSelectionCacheStateWithStats(nodes, nil, 0)
+}
+
+// NewDownloadSelectionCacheStateWithStats creates a new state from the nodes
+// and their download stats.
+func NewDownloadSelectionCacheStateWithStats(nodes []*SelectedNode, stats []DownloadStats, referenceTTFB time.Duration) *DownloadSelectionCacheState {
 	byID := map[storj.NodeID]*SelectedNode{}
 	for _, n := range nodes {
 		byID[n.ID] = n
 	}
+
+	scores := map[storj.NodeID]float64{}
+	for _, s := range stats {
+		if _, ok := byID[s.NodeID]; ok {
+			scores[s.NodeID] = s.Score(referenceTTFB)
+		}
+	}
+
 	return &DownloadSelectionCacheState{
-		byID: byID,
+		byID:         byID,
+		scores:       scores,
+		defaultScore: DownloadStats{}.Score(referenceTTFB),
 	}
 }
 
@@ -154,3 +197,16 @@ func (state *DownloadSelectionCacheState) Nodes(nodes []storj.NodeID) map[storj.
 	}
 	return xs
 }
+
+// Scores returns the download scores of the nodes.
+func (state *DownloadSelectionCacheState) Scores(nodes []storj.NodeID) map[storj.NodeID]float64 {
+	xs := make(map[storj.NodeID]float64, len(nodes))
+	for _, nodeID := range nodes {
+		if score, ok := state.scores[nodeID]; ok {
+			xs[nodeID] = score
+		} else {
+			xs[nodeID] = state.defaultScore
+		}
+	}
+	return xs
+}
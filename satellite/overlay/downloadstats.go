@@ -0,0 +1,194 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+)
+
+// DownloadStatsConfig contains configurable values for tracking how well nodes
+// serve downloads.
+type DownloadStatsConfig struct {
+	FlushInterval time.Duration `help:"how often the collected download stats are written to the database" releaseDefault:"5m" devDefault:"30s" testDefault:"$TESTINTERVAL"`
+	Decay         float64       `help:"factor applied to the stored download stats of a node when new stats are added, so recent downloads weigh more" default:"0.99"`
+	ReferenceTTFB time.Duration `help:"time to first byte which halves the download score of a node" default:"250ms"`
+}
+
+// DownloadStats is how well a node served downloads. The values decay over
+// time, so they aren't exact counts.
+type DownloadStats struct {
+	NodeID storj.NodeID
+	// Requested is the number of order limits created for downloading from the
+	// node.
+	Requested float64
+	// Settled is the number of download orders settled by the node.
+	Settled float64
+	// TTFBSum and TTFBCount are the time to first byte measured by the
+	// satellite when downloading from the node.
+	TTFBSum   time.Duration
+	TTFBCount float64
+}
+
+// SuccessRate returns the fraction of the download order limits which were
+// settled. Nodes without stats start at 0.5.
+func (stats DownloadStats) SuccessRate() float64 {
+	rate := (stats.Settled + 1) / (stats.Requested + 2)
+	if rate > 1 {
+		// orders may be settled before the requests are flushed.
+		rate = 1
+	}
+	return rate
+}
+
+// TTFB returns the average time to first byte, or the fallback when nothing
+// was measured.
+func (stats DownloadStats) TTFB(fallback time.Duration) time.Duration {
+	if stats.TTFBCount <= 0 {
+		return fallback
+	}
+	return time.Duration(float64(stats.TTFBSum) / stats.TTFBCount)
+}
+
+// Score returns the download score of the node, higher is better. The success
+// rate is halved when the time to first byte is the reference.
+func (stats DownloadStats) Score(referenceTTFB time.Duration) float64 {
+	if referenceTTFB <= 0 {
+		return stats.SuccessRate()
+	}
+	ttfb := stats.TTFB(referenceTTFB)
+	return stats.SuccessRate() * float64(referenceTTFB) / float64(referenceTTFB+ttfb)
+}
+
+// DownloadStatsUpdate contains the download stats collected since the last
+// update.
+type DownloadStatsUpdate struct {
+	NodeID    storj.NodeID
+	Requested int64
+	Settled   int64
+	TTFBSum   time.Duration
+	TTFBCount int64
+}
+
+// DownloadStatsDB stores the download stats of the nodes.
+//
+// architecture: Database
+type DownloadStatsDB interface {
+	// UpdateDownloadStats decays the stored stats of the nodes and adds the
+	// updates.
+	UpdateDownloadStats(ctx context.Context, updates []DownloadStatsUpdate, decay float64) error
+	// GetAllDownloadStats returns the download stats of all nodes.
+	GetAllDownloadStats(ctx context.Context) ([]DownloadStats, error)
+}
+
+// DownloadFeedback collects the download stats of the nodes in memory and
+// periodically adds them to the database.
+//
+// architecture: Chore
+type DownloadFeedback struct {
+	log    *zap.Logger
+	db     DownloadStatsDB
+	config DownloadStatsConfig
+
+	mu      sync.Mutex
+	pending map[storj.NodeID]*DownloadStatsUpdate
+
+	Loop *sync2.Cycle
+}
+
+// NewDownloadFeedback creates a new download stats collector.
+func NewDownloadFeedback(log *zap.Logger, db DownloadStatsDB, config DownloadStatsConfig) *DownloadFeedback {
+	return &DownloadFeedback{
+		log:     log,
+		db:      db,
+		config:  config,
+		pending: map[storj.NodeID]*DownloadStatsUpdate{},
+		Loop:    sync2.NewCycle(config.FlushInterval),
+	}
+}
+
+// Requested records that download order limits were created for the nodes.
+func (feedback *DownloadFeedback) Requested(nodeIDs ...storj.NodeID) {
+	feedback.mu.Lock()
+	defer feedback.mu.Unlock()
+
+	for _, nodeID := range nodeIDs {
+		feedback.update(nodeID).Requested++
+	}
+}
+
+// Settled records that the node settled download orders.
+func (feedback *DownloadFeedback) Settled(nodeID storj.NodeID, count int64) {
+	feedback.mu.Lock()
+	defer feedback.mu.Unlock()
+
+	feedback.update(nodeID).Settled += count
+}
+
+// TimeToFirstByte records the time to first byte of a download from the node.
+func (feedback *DownloadFeedback) TimeToFirstByte(nodeID storj.NodeID, ttfb time.Duration) {
+	feedback.mu.Lock()
+	defer feedback.mu.Unlock()
+
+	update := feedback.update(nodeID)
+	update.TTFBSum += ttfb
+	update.TTFBCount++
+}
+
+// update returns the pending update of the node. mu must be held.
+func (feedback *DownloadFeedback) update(nodeID storj.NodeID) *DownloadStatsUpdate {
+	update, ok := feedback.pending[nodeID]
+	if !ok {
+		update = &DownloadStatsUpdate{NodeID: nodeID}
+		feedback.pending[nodeID] = update
+	}
+	return update
+}
+
+// Run periodically writes the collected stats to the database.
+func (feedback *DownloadFeedback) Run(ctx context.Context) error {
+	if feedback.config.FlushInterval <= 0 {
+		// the stats are only written by calling Flush.
+		return nil
+	}
+	return feedback.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := feedback.Flush(ctx); err != nil {
+			feedback.log.Error("failed to flush download stats", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Flush writes the collected stats to the database.
+func (feedback *DownloadFeedback) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	feedback.mu.Lock()
+	pending := feedback.pending
+	feedback.pending = map[storj.NodeID]*DownloadStatsUpdate{}
+	feedback.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	updates := make([]DownloadStatsUpdate, 0, len(pending))
+	for _, update := range pending {
+		updates = append(updates, *update)
+	}
+
+	return Error.Wrap(feedback.db.UpdateDownloadStats(ctx, updates, feedback.config.Decay))
+}
+
+// Close stops the collector.
+func (feedback *DownloadFeedback) Close() error {
+	feedback.Loop.Close()
+	return nil
+}
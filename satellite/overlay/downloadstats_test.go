@@ -0,0 +1,109 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDownloadStats_Score(t *testing.T) {
+	const reference = 100 * time.Millisecond
+
+	unknown := overlay.DownloadStats{}
+	require.Equal(t, 0.5, unknown.SuccessRate())
+	require.Equal(t, reference, unknown.TTFB(reference))
+	require.Equal(t, 0.25, unknown.Score(reference))
+
+	reliable := overlay.DownloadStats{Requested: 98, Settled: 98}
+	require.Equal(t, 0.99, reliable.SuccessRate())
+
+	settledEarly := overlay.DownloadStats{Requested: 1, Settled: 5}
+	require.Equal(t, 1.0, settledEarly.SuccessRate())
+
+	fast := overlay.DownloadStats{Requested: 98, Settled: 98, TTFBSum: time.Second, TTFBCount: 100}
+	slow := overlay.DownloadStats{Requested: 98, Settled: 98, TTFBSum: 100 * time.Second, TTFBCount: 100}
+	require.Equal(t, 10*time.Millisecond, fast.TTFB(reference))
+	require.Equal(t, time.Second, slow.TTFB(reference))
+	require.Greater(t, fast.Score(reference), slow.Score(reference))
+	require.Greater(t, fast.Score(reference), unknown.Score(reference))
+	require.Greater(t, unknown.Score(reference), slow.Score(reference))
+
+	unreliable := overlay.DownloadStats{Requested: 98, Settled: 8, TTFBSum: time.Second, TTFBCount: 100}
+	require.Greater(t, fast.Score(reference), unreliable.Score(reference))
+	require.Equal(t, unreliable.SuccessRate(), unreliable.Score(0))
+}
+
+func TestDownloadSelectionCacheState_Scores(t *testing.T) {
+	const reference = 100 * time.Millisecond
+
+	nodes := []*overlay.SelectedNode{
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+	}
+	stats := []overlay.DownloadStats{
+		{NodeID: nodes[0].ID, Requested: 10, Settled: 10, TTFBSum: 10 * time.Millisecond, TTFBCount: 1},
+		// stats of nodes which aren't online are ignored.
+		{NodeID: testrand.NodeID(), Requested: 10, Settled: 10},
+	}
+
+	state := overlay.NewDownloadSelectionCacheStateWithStats(nodes, stats, reference)
+	scores := state.Scores([]storj.NodeID{nodes[0].ID, nodes[1].ID, stats[1].NodeID})
+	require.Len(t, scores, 3)
+	require.Equal(t, stats[0].Score(reference), scores[nodes[0].ID])
+	require.Equal(t, overlay.DownloadStats{}.Score(reference), scores[nodes[1].ID])
+	require.Equal(t, overlay.DownloadStats{}.Score(reference), scores[stats[1].NodeID])
+}
+
+func TestDownloadFeedback(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		feedback := overlay.NewDownloadFeedback(zap.NewNop(), db.OverlayCache(), overlay.DownloadStatsConfig{
+			FlushInterval: time.Hour,
+			Decay:         0.5,
+		})
+		defer ctx.Check(feedback.Close)
+
+		nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+
+		// flushing without stats does nothing.
+		require.NoError(t, feedback.Flush(ctx))
+		stats, err := db.OverlayCache().GetAllDownloadStats(ctx)
+		require.NoError(t, err)
+		require.Empty(t, stats)
+
+		feedback.Requested(nodeA, nodeB, nodeA)
+		feedback.Settled(nodeA, 2)
+		feedback.TimeToFirstByte(nodeA, 100*time.Millisecond)
+		feedback.TimeToFirstByte(nodeA, 300*time.Millisecond)
+		require.NoError(t, feedback.Flush(ctx))
+
+		stats, err = db.OverlayCache().GetAllDownloadStats(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []overlay.DownloadStats{
+			{NodeID: nodeA, Requested: 2, Settled: 2, TTFBSum: 400 * time.Millisecond, TTFBCount: 2},
+			{NodeID: nodeB, Requested: 1},
+		}, stats)
+
+		// the stored stats are decayed before adding the new ones.
+		feedback.Requested(nodeA)
+		require.NoError(t, feedback.Flush(ctx))
+
+		stats, err = db.OverlayCache().GetAllDownloadStats(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []overlay.DownloadStats{
+			{NodeID: nodeA, Requested: 2, Settled: 1, TTFBSum: 200 * time.Millisecond, TTFBCount: 1},
+			{NodeID: nodeB, Requested: 1},
+		}, stats)
+	})
+}
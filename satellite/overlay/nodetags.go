@@ -0,0 +1,44 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// NodeTag is a tag of a node, which was signed by the node.
+type NodeTag struct {
+	NodeID   storj.NodeID
+	SignedAt time.Time
+	Name     string
+	Value    string
+}
+
+// NodeTags are the tags of a node.
+type NodeTags []NodeTag
+
+// Map returns the tags as a map from the name to the value.
+func (tags NodeTags) Map() map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		m[tag.Name] = tag.Value
+	}
+	return m
+}
+
+func cloneTags(tags map[string]string) map[string]string {
+	if tags == nil {
+		return nil
+	}
+	clone := make(map[string]string, len(tags))
+	for name, value := range tags {
+		clone[name] = value
+	}
+	return clone
+}
@@ -16,8 +16,10 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/nodetag"
 	"storj.io/storj/satellite/geoip"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
 
 // ErrEmptyNode is returned when the nodeID is empty.
@@ -38,6 +40,10 @@ var ErrNodeFinishedGE = errs.Class("node finished graceful exit")
 // ErrNotEnoughNodes is when selecting nodes failed with the given parameters.
 var ErrNotEnoughNodes = errs.Class("not enough nodes")
 
+// ErrNodeTagsOutdated is returned when the tags of a node were signed before
+// the stored tags.
+var ErrNodeTagsOutdated = errs.Class("node tags outdated")
+
 // DB implements the database for overlay.Service.
 //
 // architecture: Database
@@ -55,6 +61,18 @@ type DB interface {
 	// SelectAllStorageNodesDownload returns a nodes that are ready for downloading
 	SelectAllStorageNodesDownload(ctx context.Context, onlineWindow time.Duration, asOf AsOfSystemTimeConfig) ([]*SelectedNode, error)
 
+	// UpdateDownloadStats decays the stored download stats of the nodes and
+	// adds the updates.
+	UpdateDownloadStats(ctx context.Context, updates []DownloadStatsUpdate, decay float64) error
+	// GetAllDownloadStats returns the download stats of all nodes.
+	GetAllDownloadStats(ctx context.Context) ([]DownloadStats, error)
+
+	// UpdateNodeTags replaces the tags of the node with tags signed at
+	// signedAt, unless the stored tags were signed later.
+	UpdateNodeTags(ctx context.Context, nodeID storj.NodeID, signedAt time.Time, tags NodeTags) error
+	// GetNodeTags returns the tags of the node.
+	GetNodeTags(ctx context.Context, nodeID storj.NodeID) (NodeTags, error)
+
 	// Get looks up the node by nodeID
 	Get(ctx context.Context, nodeID storj.NodeID) (*NodeDossier, error)
 	// KnownOffline filters a set of nodes to offline nodes
@@ -67,6 +85,8 @@ type DB interface {
 	KnownReliable(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
 	// Reliable returns all nodes that are reliable
 	Reliable(context.Context, *NodeCriteria) (storj.NodeIDList, error)
+	// Unvetted returns all online nodes that are not vetted yet, independent of suspension.
+	Unvetted(context.Context, *NodeCriteria) (storj.NodeIDList, error)
 	// UpdateReputation updates the DB columns for all reputation fields in ReputationStatus.
 	UpdateReputation(ctx context.Context, id storj.NodeID, request ReputationUpdate) error
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
@@ -96,6 +116,12 @@ type DB interface {
 	// DisqualifyNode disqualifies a storage node.
 	DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time, reason DisqualificationReason) (err error)
 
+	// ReinstateNode clears the disqualification and the suspensions of a node
+	// which hasn't gracefully exited.
+	ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error)
+	// UnsuspendNode clears the unknown audit and offline suspensions of a node.
+	UnsuspendNode(ctx context.Context, nodeID storj.NodeID) (err error)
+
 	// DQNodesLastSeenBefore disqualifies a limited number of nodes where last_contact_success < cutoff except those already disqualified
 	// or gracefully exited or where last_contact_success = '0001-01-01 00:00:00+00'.
 	DQNodesLastSeenBefore(ctx context.Context, cutoff time.Time, limit int) (count int, err error)
@@ -260,6 +286,12 @@ type SelectedNode struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	// ASN is the autonomous system of the node, zero when unknown.
+	ASN    uint32
+	Email  string
+	Wallet string
+	// Tags are the verified tags of the node.
+	Tags map[string]string
 }
 
 // NodeReputation is used as a result for creating orders limits for audits.
@@ -279,8 +311,13 @@ func (node *SelectedNode) Clone() *SelectedNode {
 			Transport: node.Address.Transport,
 			Address:   node.Address.Address,
 		},
-		LastNet:    node.LastNet,
-		LastIPPort: node.LastIPPort,
+		LastNet:     node.LastNet,
+		LastIPPort:  node.LastIPPort,
+		CountryCode: node.CountryCode,
+		ASN:         node.ASN,
+		Email:       node.Email,
+		Wallet:      node.Wallet,
+		Tags:        cloneTags(node.Tags),
 	}
 }
 
@@ -293,8 +330,11 @@ type Service struct {
 	config Config
 
 	GeoIP                  geoip.IPToCountry
+	ASN                    geoip.IPToASN
+	PlacementRules         uploadselection.PlacementRules
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
+	DownloadFeedback       *DownloadFeedback
 }
 
 // NewService returns a new Service.
@@ -312,16 +352,32 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 		}
 	}
 
+	var asn geoip.IPToASN = geoip.NoASN{}
+	if config.GeoIP.ASNDB != "" {
+		asn, err = geoip.OpenMaxmindASNDB(config.GeoIP.ASNDB)
+		if err != nil {
+			return nil, Error.Wrap(errs.Combine(err, geoIP.Close()))
+		}
+	}
+
+	placementRules, err := uploadselection.ParsePlacementRules(config.Placement)
+	if err != nil {
+		return nil, Error.Wrap(errs.Combine(err, geoIP.Close(), asn.Close()))
+	}
+
 	uploadSelectionCache, err := NewUploadSelectionCache(log, db,
 		config.NodeSelectionCache.Staleness, config.Node,
 	)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+	uploadSelectionCache.asn = asn
+	uploadSelectionCache.placementRules = placementRules
 	downloadSelectionCache, err := NewDownloadSelectionCache(log, db, DownloadSelectionCacheConfig{
 		Staleness:      config.NodeSelectionCache.Staleness,
 		OnlineWindow:   config.Node.OnlineWindow,
 		AsOfSystemTime: config.Node.AsOfSystemTime,
+		ReferenceTTFB:  config.DownloadStats.ReferenceTTFB,
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -332,10 +388,13 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 		db:     db,
 		config: config,
 
-		GeoIP: geoIP,
+		GeoIP:          geoIP,
+		ASN:            asn,
+		PlacementRules: placementRules,
 
 		UploadSelectionCache:   uploadSelectionCache,
 		DownloadSelectionCache: downloadSelectionCache,
+		DownloadFeedback:       NewDownloadFeedback(log.Named("download-feedback"), db, config.DownloadStats),
 	}, nil
 }
 
@@ -344,12 +403,17 @@ func (service *Service) Run(ctx context.Context) error {
 	return errs.Combine(sync2.Concurrently(
 		func() error { return service.UploadSelectionCache.Run(ctx) },
 		func() error { return service.DownloadSelectionCache.Run(ctx) },
+		func() error { return service.DownloadFeedback.Run(ctx) },
 	)...)
 }
 
 // Close closes resources.
 func (service *Service) Close() error {
-	return service.GeoIP.Close()
+	return errs.Combine(
+		service.DownloadFeedback.Close(),
+		service.GeoIP.Close(),
+		service.ASN.Close(),
+	)
 }
 
 // Get looks up the provided nodeID from the overlay.
@@ -374,6 +438,13 @@ func (service *Service) CachedGetOnlineNodesForGet(ctx context.Context, nodeIDs
 	return service.DownloadSelectionCache.GetNodes(ctx, nodeIDs)
 }
 
+// CachedGetDownloadScores returns the download scores of the nodes from the
+// download selection cache, higher is better.
+func (service *Service) CachedGetDownloadScores(ctx context.Context, nodeIDs []storj.NodeID) (_ map[storj.NodeID]float64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.DownloadSelectionCache.GetScores(ctx, nodeIDs)
+}
+
 // GetOnlineNodesForAuditRepair returns a map of nodes for the supplied nodeIDs.
 func (service *Service) GetOnlineNodesForAuditRepair(ctx context.Context, nodeIDs []storj.NodeID) (_ map[storj.NodeID]*NodeReputation, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -527,6 +598,15 @@ func (service *Service) Reliable(ctx context.Context) (nodes storj.NodeIDList, e
 	return service.db.Reliable(ctx, criteria)
 }
 
+// Unvetted returns the online nodes which haven't been vetted yet.
+func (service *Service) Unvetted(ctx context.Context) (nodes storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.Unvetted(ctx, &NodeCriteria{
+		OnlineWindow: service.config.Node.OnlineWindow,
+	})
+}
+
 // UpdateReputation updates the DB columns for any of the reputation fields.
 func (service *Service) UpdateReputation(ctx context.Context, id storj.NodeID, request ReputationUpdate) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -670,6 +750,83 @@ func (service *Service) GetReliablePiecesInExcludedCountries(ctx context.Context
 	return piecesInExcluded, nil
 }
 
+// GetPiecesOutOfPlacement returns the pieces held by online nodes which don't
+// satisfy the node constraints or the diversity limits of the placement. The
+// pieces of the offline nodes are missing anyway.
+func (service *Service) GetPiecesOutOfPlacement(ctx context.Context, pieces metabase.Pieces, placement storj.PlacementConstraint) (outOfPlacement []uint16, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rule := service.PlacementRules.Rule(placement)
+	if len(rule.Countries) == 0 && len(rule.Tags) == 0 && rule.MinASNs <= 0 && rule.MaxPerEmail <= 0 && rule.MaxPerWallet <= 0 {
+		return nil, nil
+	}
+
+	nodeIDs := make([]storj.NodeID, len(pieces))
+	for i, p := range pieces {
+		nodeIDs[i] = p.StorageNode
+	}
+	nodes, err := service.DownloadSelectionCache.GetNodes(ctx, nodeIDs)
+	if err != nil {
+		return nil, Error.New("error getting nodes %s", err)
+	}
+
+	var onlinePieces []metabase.Piece
+	var onlineNodes []*uploadselection.Node
+	for _, p := range pieces {
+		node, ok := nodes[p.StorageNode]
+		if !ok {
+			continue
+		}
+		selectionNode := &uploadselection.Node{
+			LastNet:     node.LastNet,
+			LastIPPort:  node.LastIPPort,
+			CountryCode: node.CountryCode,
+			Email:       node.Email,
+			Wallet:      node.Wallet,
+			Tags:        node.Tags,
+		}
+		if node.LastIPPort != "" {
+			asn, err := service.ASN.LookupASN(node.LastIPPort)
+			if err != nil {
+				service.log.Debug("failed to look up autonomous system", zap.Stringer("Node ID", node.ID), zap.Error(err))
+			}
+			selectionNode.ASN = asn
+		}
+		onlinePieces = append(onlinePieces, p)
+		onlineNodes = append(onlineNodes, selectionNode)
+	}
+
+	// the pieces are checked against the diversity limits of the rule as
+	// well, so a segment uploaded before the limits were tightened is
+	// repaired onto more diverse nodes.
+	for _, i := range rule.OutOfPlacement(onlineNodes) {
+		outOfPlacement = append(outOfPlacement, onlinePieces[i].Number)
+	}
+	return outOfPlacement, nil
+}
+
+// UpdateNodeTags replaces the tags of the node with the verified tag set.
+func (service *Service) UpdateNodeTags(ctx context.Context, set *nodetag.TagSet) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tags := make(NodeTags, 0, len(set.Tags))
+	for _, tag := range set.Tags {
+		tags = append(tags, NodeTag{
+			NodeID:   set.NodeID,
+			SignedAt: set.SignedAt,
+			Name:     tag.Name,
+			Value:    tag.Value,
+		})
+	}
+	return service.db.UpdateNodeTags(ctx, set.NodeID, set.SignedAt, tags)
+}
+
+// GetNodeTags returns the tags of the node.
+func (service *Service) GetNodeTags(ctx context.Context, nodeID storj.NodeID) (_ NodeTags, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.db.GetNodeTags(ctx, nodeID)
+}
+
 // DisqualifyNode disqualifies a storage node.
 func (service *Service) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, reason DisqualificationReason) (err error) {
 	defer mon.Task()(&ctx)(&err)
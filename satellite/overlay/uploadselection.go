@@ -12,6 +12,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/satellite/geoip"
 	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
 
@@ -37,6 +38,10 @@ type UploadSelectionCache struct {
 	db              UploadSelectionDB
 	selectionConfig NodeSelectionConfig
 
+	// asn and placementRules are set by the overlay service.
+	asn            geoip.IPToASN
+	placementRules uploadselection.PlacementRules
+
 	cache sync2.ReadCache
 }
 
@@ -74,6 +79,11 @@ func (cache *UploadSelectionCache) read(ctx context.Context) (_ interface{}, err
 		return nil, Error.Wrap(err)
 	}
 
+	if cache.asn != nil {
+		cache.lookupASN(reputableNodes)
+		cache.lookupASN(newNodes)
+	}
+
 	state := uploadselection.NewState(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
 
 	mon.IntVal("refresh_cache_size_reputable").Observe(int64(len(reputableNodes)))
@@ -82,6 +92,21 @@ func (cache *UploadSelectionCache) read(ctx context.Context) (_ interface{}, err
 	return state, nil
 }
 
+// lookupASN sets the autonomous system of the nodes.
+func (cache *UploadSelectionCache) lookupASN(nodes []*SelectedNode) {
+	for _, node := range nodes {
+		if node.LastIPPort == "" {
+			continue
+		}
+		asn, err := cache.asn.LookupASN(node.LastIPPort)
+		if err != nil {
+			cache.log.Debug("failed to look up autonomous system", zap.Stringer("Node ID", node.ID), zap.Error(err))
+			continue
+		}
+		node.ASN = asn
+	}
+}
+
 // GetNodes selects nodes from the cache that will be used to upload a file.
 // Every node selected will be from a distinct network.
 // If the cache hasn't been refreshed recently it will do so first.
@@ -101,6 +126,7 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 		ExcludedIDs:          req.ExcludedIDs,
 		Placement:            req.Placement,
 		ExcludedCountryCodes: cache.selectionConfig.UploadExcludedCountryCodes,
+		PlacementRule:        cache.placementRules[req.Placement],
 	})
 	if uploadselection.ErrNotEnoughNodes.Has(err) {
 		err = ErrNotEnoughNodes.Wrap(err)
@@ -128,6 +154,10 @@ func convNodesToSelectedNodes(nodes []*uploadselection.Node) (xs []*SelectedNode
 			LastNet:     n.LastNet,
 			LastIPPort:  n.LastIPPort,
 			CountryCode: n.CountryCode,
+			ASN:         n.ASN,
+			Email:       n.Email,
+			Wallet:      n.Wallet,
+			Tags:        n.Tags,
 		})
 	}
 	return xs
@@ -143,6 +173,10 @@ func convSelectedNodesToNodes(nodes []*SelectedNode) (xs []*uploadselection.Node
 			LastNet:     n.LastNet,
 			LastIPPort:  n.LastIPPort,
 			CountryCode: n.CountryCode,
+			ASN:         n.ASN,
+			Email:       n.Email,
+			Wallet:      n.Wallet,
+			Tags:        n.Tags,
 		})
 	}
 	return xs
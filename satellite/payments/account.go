@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments/pricing"
 )
 
 // ErrAccountNotSetup is an error type which indicates that payment account is not created.
@@ -29,6 +30,9 @@ type Accounts interface {
 	// ProjectCharges returns how much money current user will be charged for each project.
 	ProjectCharges(ctx context.Context, userID uuid.UUID, since, before time.Time) ([]ProjectCharge, error)
 
+	// BucketCharges returns how much money current user will be charged for each bucket of the projects they own.
+	BucketCharges(ctx context.Context, userID uuid.UUID, since, before time.Time) ([]BucketCharge, error)
+
 	// CheckProjectInvoicingStatus returns error if for the given project there are outstanding project records and/or usage
 	// which have not been applied/invoiced yet (meaning sent over to stripe).
 	CheckProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) error
@@ -36,6 +40,10 @@ type Accounts interface {
 	// CheckProjectUsageStatus returns error if for the given project there is some usage for current or previous month.
 	CheckProjectUsageStatus(ctx context.Context, projectID uuid.UUID) error
 
+	// Prices returns the prices which apply to the user, taking price plans
+	// assigned to the user or to the partner of the user agent into account.
+	Prices(ctx context.Context, userID uuid.UUID, userAgent []byte) (pricing.Prices, error)
+
 	// Charges returns list of all credit card charges related to account.
 	Charges(ctx context.Context, userID uuid.UUID) ([]Charge, error)
 
@@ -50,4 +58,7 @@ type Accounts interface {
 
 	// Coupons exposes all needed functionality to manage coupons.
 	Coupons() Coupons
+
+	// Prepaid exposes all needed functionality to manage the prepaid balance.
+	Prepaid() Prepaid
 }
@@ -5,7 +5,12 @@ package payments
 
 // Balance is an entity that holds free credits and coins balance of user.
 // Earned by applying of promotional coupon and coins depositing, respectively.
+//
+// Prepaid is the balance of the ledger, which is funded by card top-ups and
+// token deposits, and Ledger lists its transactions, latest first.
 type Balance struct {
-	FreeCredits int64 `json:"freeCredits"`
-	Coins       int64 `json:"coins"`
+	FreeCredits int64                `json:"freeCredits"`
+	Coins       int64                `json:"coins"`
+	Prepaid     int64                `json:"prepaid"`
+	Ledger      []BalanceTransaction `json:"ledger"`
 }
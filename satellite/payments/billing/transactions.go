@@ -51,9 +51,11 @@ const (
 //
 // architecture: Database
 type TransactionsDB interface {
-	// Insert inserts the provided transaction.
+	// Insert inserts the provided transaction. A pending transaction doesn't
+	// change the balance until it's completed with UpdateStatus.
 	Insert(ctx context.Context, tx Transaction) (txID int64, err error)
-	// UpdateStatus updates the status of the transaction.
+	// UpdateStatus updates the status of the transaction and applies a
+	// completed pending transaction to the balance.
 	UpdateStatus(ctx context.Context, txID int64, status TransactionStatus) error
 	// UpdateMetadata updates the metadata of the transaction.
 	UpdateMetadata(ctx context.Context, txID int64, metadata []byte) error
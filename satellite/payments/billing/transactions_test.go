@@ -255,6 +255,26 @@ func TestUpdateTransactions(t *testing.T) {
 			compareTransactions(t, credit10TX, tx[0])
 		})
 	})
+
+	t.Run("complete pending", func(t *testing.T) {
+		satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+			pendingTX := credit10TX
+			pendingTX.Status = billing.TransactionStatusPending
+			txID, err := db.Billing().Insert(ctx, pendingTX)
+			require.NoError(t, err)
+
+			balance, err := db.Billing().GetBalance(ctx, userID)
+			require.NoError(t, err)
+			require.Zero(t, balance.BaseUnits())
+
+			err = db.Billing().UpdateStatus(ctx, txID, billing.TransactionStatusCompleted)
+			require.NoError(t, err)
+
+			balance, err = db.Billing().GetBalance(ctx, userID)
+			require.NoError(t, err)
+			require.EqualValues(t, 10000000, balance.BaseUnits())
+		})
+	})
 }
 
 func TestUpdateMetadata(t *testing.T) {
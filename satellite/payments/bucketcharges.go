@@ -0,0 +1,26 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"storj.io/storj/satellite/accounting"
+)
+
+// BucketCharge shows bucket usage and how much money the bucket contributes to the project charges.
+type BucketCharge struct {
+	accounting.BucketUsageRollup
+
+	ProjectName string `json:"projectName"`
+	// StorageGbHrs shows how much cents we should pay for storing GB*Hrs.
+	StorageGbHrs int64 `json:"storagePrice"`
+	// Egress shows how many cents we should pay for Egress.
+	Egress int64 `json:"egressPrice"`
+	// SegmentCount shows how many cents we should pay for objects count.
+	SegmentCount int64 `json:"segmentPrice"`
+}
+
+// Total returns how many cents the bucket contributes to the project charges.
+func (charge BucketCharge) Total() int64 {
+	return charge.StorageGbHrs + charge.Egress + charge.SegmentCount
+}
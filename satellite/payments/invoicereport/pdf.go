@@ -0,0 +1,144 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package invoicereport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// page layout of the PDF in points, A4 landscape.
+const (
+	pageWidth    = 842
+	pageHeight   = 595
+	pageMargin   = 40
+	rowHeight    = 14
+	fontSize     = 8
+	titleSize    = 14
+	maxCellRunes = 24
+)
+
+// columnOffsets are the horizontal offsets of the columns.
+var columnOffsets = []int{40, 160, 290, 375, 445, 545, 620, 690, 770}
+
+// WritePDF writes the report as a PDF document with a table per page.
+//
+// The document only uses the standard Helvetica font, so it does not
+// need any external resources to render.
+func WritePDF(w io.Writer, report Report) error {
+	rows := report.rows()
+
+	total := make([]string, len(columns))
+	total[0] = "Total"
+	total[len(total)-1] = formatCents(report.Total())
+	rows = append(rows, total)
+
+	firstRowY := pageHeight - pageMargin - 3*rowHeight
+	rowsPerPage := (firstRowY - pageMargin) / rowHeight
+
+	var pages []string
+	for len(pages) == 0 || len(rows) > 0 {
+		n := rowsPerPage
+		if n > len(rows) {
+			n = len(rows)
+		}
+
+		var content strings.Builder
+		title := fmt.Sprintf("Usage breakdown for %s", report.Period())
+		if len(pages) > 0 {
+			title += fmt.Sprintf(" (page %d)", len(pages)+1)
+		}
+		writeText(&content, pageMargin, pageHeight-pageMargin, titleSize, title)
+		writeText(&content, pageMargin, pageHeight-pageMargin-rowHeight-4, fontSize,
+			fmt.Sprintf("Period %s - %s (UTC)", report.Start.UTC().Format("2006-01-02"), report.End.UTC().Format("2006-01-02")))
+
+		writeRow(&content, firstRowY+rowHeight, columns)
+		for i, row := range rows[:n] {
+			writeRow(&content, firstRowY-i*rowHeight, row)
+		}
+		rows = rows[n:]
+
+		pages = append(pages, content.String())
+	}
+
+	return Error.Wrap(writePDFDocument(w, pages))
+}
+
+// writeRow writes the cells of a table row at the given height.
+func writeRow(content *strings.Builder, y int, row []string) {
+	for i, cell := range row {
+		if cell == "" {
+			continue
+		}
+		if runes := []rune(cell); len(runes) > maxCellRunes {
+			cell = string(runes[:maxCellRunes-3]) + "..."
+		}
+		writeText(content, columnOffsets[i], y, fontSize, cell)
+	}
+}
+
+// writeText writes a text drawing operation to the content stream.
+func writeText(content *strings.Builder, x, y, size int, text string) {
+	fmt.Fprintf(content, "BT /F1 %d Tf %d %d Td (%s) Tj ET\n", size, x, y, escapePDFString(text))
+}
+
+// escapePDFString escapes a PDF literal string. Characters outside of
+// printable ASCII are not supported by the standard font encoding.
+func escapePDFString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// writePDFDocument writes a PDF document with the given page content streams.
+func writePDFDocument(w io.Writer, pages []string) error {
+	var buf bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// objects 1-3 are the catalog, the page tree and the font,
+	// followed by a page and content stream object for every page.
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+
+	for i, content := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 5+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := buf.WriteTo(w)
+	return err
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package invoicereport renders the per-bucket breakdown of an invoice
+// period as CSV or PDF.
+package invoicereport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/payments"
+)
+
+// Error is the error class for invoice reports.
+var Error = errs.Class("invoice report")
+
+// periodLayout is the layout of invoice periods, e.g. 2022-05.
+const periodLayout = "2006-01"
+
+// ParsePeriod parses an invoice period in the form YYYY-MM and returns
+// the start of the month and the start of the following month.
+func ParsePeriod(period string) (start, end time.Time, err error) {
+	start, err = time.Parse(periodLayout, period)
+	if err != nil {
+		return time.Time{}, time.Time{}, Error.New("invalid period %q, expected YYYY-MM", period)
+	}
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// Report is the per-bucket breakdown of the usage in an invoice period.
+type Report struct {
+	Start   time.Time
+	End     time.Time
+	Charges []payments.BucketCharge
+}
+
+// New creates a report for the period with charges sorted by project and bucket name.
+func New(start, end time.Time, charges []payments.BucketCharge) Report {
+	sorted := append([]payments.BucketCharge(nil), charges...)
+	sort.SliceStable(sorted, func(i, k int) bool {
+		if sorted[i].ProjectName != sorted[k].ProjectName {
+			return sorted[i].ProjectName < sorted[k].ProjectName
+		}
+		return sorted[i].BucketName < sorted[k].BucketName
+	})
+
+	return Report{
+		Start:   start,
+		End:     end,
+		Charges: sorted,
+	}
+}
+
+// Period returns the invoice period in the form YYYY-MM.
+func (report Report) Period() string {
+	return report.Start.Format(periodLayout)
+}
+
+// Total returns the total of all charges in cents.
+func (report Report) Total() int64 {
+	var total int64
+	for _, charge := range report.Charges {
+		total += charge.Total()
+	}
+	return total
+}
+
+// columns are the column headers of the report.
+var columns = []string{
+	"Project", "Bucket",
+	"Storage (GB-Hours)", "Egress (GB)", "Segments (Segment-Hours)",
+	"Storage Price (USD)", "Egress Price (USD)", "Segment Price (USD)", "Total (USD)",
+}
+
+// rows returns the report as formatted rows, without the header.
+func (report Report) rows() [][]string {
+	rows := make([][]string, 0, len(report.Charges))
+	for _, charge := range report.Charges {
+		rows = append(rows, []string{
+			charge.ProjectName,
+			charge.BucketName,
+			strconv.FormatFloat(charge.TotalStoredData, 'f', 2, 64),
+			strconv.FormatFloat(charge.GetEgress, 'f', 2, 64),
+			strconv.FormatFloat(charge.TotalSegments, 'f', 2, 64),
+			formatCents(charge.StorageGbHrs),
+			formatCents(charge.Egress),
+			formatCents(charge.SegmentCount),
+			formatCents(charge.Total()),
+		})
+	}
+	return rows
+}
+
+// WriteCSV writes the report as CSV with a header and a final total row.
+func WriteCSV(w io.Writer, report Report) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(columns); err != nil {
+		return Error.Wrap(err)
+	}
+	rows := report.rows()
+	for _, row := range rows {
+		// project and bucket names are chosen by the users.
+		row[0] = csvText(row[0])
+		row[1] = csvText(row[1])
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return Error.Wrap(err)
+	}
+
+	total := make([]string, len(columns))
+	total[0] = "Total"
+	total[len(total)-1] = formatCents(report.Total())
+	if err := writer.Write(total); err != nil {
+		return Error.Wrap(err)
+	}
+
+	writer.Flush()
+	return Error.Wrap(writer.Error())
+}
+
+// csvText prefixes text starting like a formula with a quote, so that
+// spreadsheets don't evaluate it.
+func csvText(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// formatCents formats cents as dollars.
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
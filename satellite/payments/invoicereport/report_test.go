@@ -0,0 +1,135 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package invoicereport_test
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/invoicereport"
+)
+
+func TestParsePeriod(t *testing.T) {
+	start, end, err := invoicereport.ParsePeriod("2022-12")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), end)
+
+	_, _, err = invoicereport.ParsePeriod("2022-13")
+	require.True(t, invoicereport.Error.Has(err))
+	_, _, err = invoicereport.ParsePeriod("")
+	require.Error(t, err)
+}
+
+func newReport(buckets int) invoicereport.Report {
+	var charges []payments.BucketCharge
+	for i := buckets - 1; i >= 0; i-- {
+		charges = append(charges, payments.BucketCharge{
+			BucketUsageRollup: accounting.BucketUsageRollup{
+				BucketName:      fmt.Sprintf("bucket-%03d", i),
+				TotalStoredData: 1.5,
+				GetEgress:       2,
+				TotalSegments:   720,
+			},
+			ProjectName:  "project (one)",
+			StorageGbHrs: 100,
+			Egress:       25,
+			SegmentCount: 1,
+		})
+	}
+
+	start, end, _ := invoicereport.ParsePeriod("2022-05")
+	return invoicereport.New(start, end, charges)
+}
+
+func TestWriteCSV(t *testing.T) {
+	report := newReport(2)
+	require.EqualValues(t, 252, report.Total())
+
+	var buf bytes.Buffer
+	require.NoError(t, invoicereport.WriteCSV(&buf, report))
+
+	require.Equal(t, ""+
+		"Project,Bucket,Storage (GB-Hours),Egress (GB),Segments (Segment-Hours),Storage Price (USD),Egress Price (USD),Segment Price (USD),Total (USD)\n"+
+		"project (one),bucket-000,1.50,2.00,720.00,1.00,0.25,0.01,1.26\n"+
+		"project (one),bucket-001,1.50,2.00,720.00,1.00,0.25,0.01,1.26\n"+
+		"Total,,,,,,,,2.52\n",
+		buf.String())
+}
+
+func TestWriteCSVFormula(t *testing.T) {
+	start, end, _ := invoicereport.ParsePeriod("2022-05")
+	report := invoicereport.New(start, end, []payments.BucketCharge{
+		{
+			BucketUsageRollup: accounting.BucketUsageRollup{BucketName: "@bucket"},
+			ProjectName:       "=HYPERLINK(\"http://example.test\")",
+		},
+		{
+			BucketUsageRollup: accounting.BucketUsageRollup{BucketName: "bucket"},
+			ProjectName:       "-project",
+		},
+		{
+			BucketUsageRollup: accounting.BucketUsageRollup{BucketName: "bucket"},
+			ProjectName:       "+project",
+		},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, invoicereport.WriteCSV(&buf, report))
+
+	require.Equal(t, ""+
+		"Project,Bucket,Storage (GB-Hours),Egress (GB),Segments (Segment-Hours),Storage Price (USD),Egress Price (USD),Segment Price (USD),Total (USD)\n"+
+		"'+project,bucket,0.00,0.00,0.00,0.00,0.00,0.00,0.00\n"+
+		"'-project,bucket,0.00,0.00,0.00,0.00,0.00,0.00,0.00\n"+
+		"\"'=HYPERLINK(\"\"http://example.test\"\")\",'@bucket,0.00,0.00,0.00,0.00,0.00,0.00,0.00\n"+
+		"Total,,,,,,,,0.00\n",
+		buf.String())
+}
+
+func TestWritePDF(t *testing.T) {
+	for _, tt := range []struct {
+		buckets int
+		pages   int
+	}{
+		{buckets: 0, pages: 1},
+		{buckets: 3, pages: 1},
+		{buckets: 100, pages: 4},
+	} {
+		var buf bytes.Buffer
+		require.NoError(t, invoicereport.WritePDF(&buf, newReport(tt.buckets)))
+
+		doc := buf.Bytes()
+		require.True(t, bytes.HasPrefix(doc, []byte("%PDF-1.4\n")))
+		require.True(t, bytes.HasSuffix(doc, []byte("%%EOF\n")))
+		require.Contains(t, buf.String(), fmt.Sprintf("/Count %d", tt.pages))
+		require.Contains(t, buf.String(), "Usage breakdown for 2022-05")
+
+		if tt.buckets > 0 {
+			require.Contains(t, buf.String(), `(project \(one\))`)
+		}
+
+		// every xref entry must point to the start of its object.
+		xref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(doc)
+		require.NotNil(t, xref)
+		xrefOffset, err := strconv.Atoi(string(xref[1]))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(doc[xrefOffset:], []byte("xref\n")))
+
+		entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(doc[xrefOffset:], -1)
+		require.Len(t, entries, 3+2*tt.pages)
+		for i, entry := range entries {
+			offset, err := strconv.Atoi(string(entry[1]))
+			require.NoError(t, err)
+			require.True(t, bytes.HasPrefix(doc[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))))
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrInvalidTopUp is an error class which indicates that a top-up request is invalid.
+var ErrInvalidTopUp = errs.Class("invalid top-up")
+
+// Prepaid exposes all needed functionality to manage the prepaid balance of
+// an account. The prepaid balance is topped up with the default credit card
+// and consumed by invoices before the card is charged.
+//
+// architecture: Service
+type Prepaid interface {
+	// TopUp charges the default credit card of the user with the amount in cents
+	// and adds it to the prepaid balance.
+	TopUp(ctx context.Context, userID uuid.UUID, amount int64) (BalanceTransaction, error)
+
+	// GetAutoTopUp returns the auto top-up settings of the user.
+	GetAutoTopUp(ctx context.Context, userID uuid.UUID) (AutoTopUp, error)
+
+	// SetAutoTopUp updates the auto top-up settings of the user.
+	SetAutoTopUp(ctx context.Context, userID uuid.UUID, settings AutoTopUp) error
+}
+
+// AutoTopUp holds the settings to automatically top up the prepaid balance
+// with Amount cents when it falls below Threshold cents.
+type AutoTopUp struct {
+	Enabled   bool  `json:"enabled"`
+	Threshold int64 `json:"threshold"`
+	Amount    int64 `json:"amount"`
+}
+
+// BalanceTransaction is an entry of the balance ledger. Amount is in cents
+// and negative for transactions which consume the balance.
+type BalanceTransaction struct {
+	ID          int64     `json:"id"`
+	Amount      int64     `json:"amount"`
+	Description string    `json:"description"`
+	Source      string    `json:"source"`
+	Status      string    `json:"status"`
+	Type        string    `json:"type"`
+	Timestamp   time.Time `json:"timestamp"`
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pricing
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrPlanNotFound is the error class for a missing price plan or plan assignment.
+var ErrPlanNotFound = errs.Class("price plan not found")
+
+// Plan is a named set of prices, which can be assigned to users or partners.
+type Plan struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Prices    Prices    `json:"prices"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// DB is the interface for the database to store price plans and their
+// assignments.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a new price plan.
+	Insert(ctx context.Context, plan Plan) (Plan, error)
+	// Get returns the price plan with the id.
+	Get(ctx context.Context, id uuid.UUID) (Plan, error)
+	// List returns all price plans.
+	List(ctx context.Context) ([]Plan, error)
+	// Delete deletes the price plan together with its assignments.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// AssignUser assigns the price plan to the user.
+	AssignUser(ctx context.Context, userID, planID uuid.UUID) error
+	// UnassignUser removes the price plan assignment of the user.
+	UnassignUser(ctx context.Context, userID uuid.UUID) error
+	// GetByUser returns the price plan assigned to the user.
+	GetByUser(ctx context.Context, userID uuid.UUID) (Plan, error)
+
+	// AssignPartner assigns the price plan to the partner.
+	AssignPartner(ctx context.Context, partner string, planID uuid.UUID) error
+	// UnassignPartner removes the price plan assignment of the partner.
+	UnassignPartner(ctx context.Context, partner string) error
+	// GetByPartner returns the price plan assigned to the partner.
+	GetByPartner(ctx context.Context, partner string) (Plan, error)
+}
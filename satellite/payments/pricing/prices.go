@@ -0,0 +1,177 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package pricing implements price plans with volume tiers, which can be
+// assigned to users or to partners.
+package pricing
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the default error class for the pricing package.
+	Error = errs.Class("pricing")
+
+	// ErrInvalidPrices is the error class for invalid prices.
+	ErrInvalidPrices = errs.Class("invalid prices")
+)
+
+// mbPerTB is the number of MB in a TB.
+const mbPerTB = 1000000
+
+// Tier is a volume tier of a price. The price applies to the usage above
+// From, up to the From of the next tier.
+type Tier struct {
+	From  int64           `json:"from"`
+	Price decimal.Decimal `json:"price"`
+}
+
+// Tiers is a list of volume tiers ordered by From.
+type Tiers []Tier
+
+// Validate checks that the tiers start at zero, are in ascending order and
+// don't have negative prices.
+func (tiers Tiers) Validate() error {
+	if len(tiers) == 0 {
+		return ErrInvalidPrices.New("no tiers")
+	}
+	if tiers[0].From != 0 {
+		return ErrInvalidPrices.New("first tier must start from 0")
+	}
+	for i, tier := range tiers {
+		if tier.Price.IsNegative() {
+			return ErrInvalidPrices.New("negative price %s", tier.Price)
+		}
+		if i > 0 && tier.From <= tiers[i-1].From {
+			return ErrInvalidPrices.New("tiers must be in ascending order")
+		}
+	}
+	return nil
+}
+
+// Prices are the prices of storage, egress and segments with volume tiers.
+//
+// Storage is in USD per TB-month with tiers in TB-months, egress is in USD
+// per TB with tiers in TB and segments are in USD per segment-month with
+// tiers in segment-months.
+type Prices struct {
+	Storage Tiers `json:"storage"`
+	Egress  Tiers `json:"egress"`
+	Segment Tiers `json:"segment"`
+}
+
+// FlatPrices returns prices without volume tiers from the prices in USD,
+// as they are configured for the satellite.
+func FlatPrices(storageTBPrice, egressTBPrice, segmentPrice string) (Prices, error) {
+	storage, err := decimal.NewFromString(storageTBPrice)
+	if err != nil {
+		return Prices{}, ErrInvalidPrices.Wrap(err)
+	}
+	egress, err := decimal.NewFromString(egressTBPrice)
+	if err != nil {
+		return Prices{}, ErrInvalidPrices.Wrap(err)
+	}
+	segment, err := decimal.NewFromString(segmentPrice)
+	if err != nil {
+		return Prices{}, ErrInvalidPrices.Wrap(err)
+	}
+
+	return Prices{
+		Storage: Tiers{{Price: storage}},
+		Egress:  Tiers{{Price: egress}},
+		Segment: Tiers{{Price: segment}},
+	}, nil
+}
+
+// Validate checks the tiers of all prices.
+func (prices Prices) Validate() error {
+	if err := prices.Storage.Validate(); err != nil {
+		return ErrInvalidPrices.New("storage: %v", err)
+	}
+	if err := prices.Egress.Validate(); err != nil {
+		return ErrInvalidPrices.New("egress: %v", err)
+	}
+	if err := prices.Segment.Validate(); err != nil {
+		return ErrInvalidPrices.New("segment: %v", err)
+	}
+	return nil
+}
+
+// IsFlat returns true when none of the prices has volume tiers.
+func (prices Prices) IsFlat() bool {
+	return len(prices.Storage) == 1 && len(prices.Egress) == 1 && len(prices.Segment) == 1
+}
+
+// Item is the part of the usage which is billed with the price of a tier.
+type Item struct {
+	// Tier is the tier, whose price applies.
+	Tier Tier
+	// Quantity is in MB-months for storage, MB for egress and segment-months
+	// for segments.
+	Quantity int64
+	// UnitPriceCents is the price of a unit of Quantity in cents.
+	UnitPriceCents decimal.Decimal
+}
+
+// Total returns the price of the item in cents.
+func (item Item) Total() decimal.Decimal {
+	return item.UnitPriceCents.Mul(decimal.NewFromInt(item.Quantity))
+}
+
+// StorageItems splits the storage usage in MB-months across the storage tiers.
+func (prices Prices) StorageItems(mbMonths int64) []Item {
+	return prices.Storage.items(mbMonths, mbPerTB, -6+2)
+}
+
+// EgressItems splits the egress usage in MB across the egress tiers.
+func (prices Prices) EgressItems(mb int64) []Item {
+	return prices.Egress.items(mb, mbPerTB, -6+2)
+}
+
+// SegmentItems splits the segment usage in segment-months across the segment tiers.
+func (prices Prices) SegmentItems(segmentMonths int64) []Item {
+	return prices.Segment.items(segmentMonths, 1, 2)
+}
+
+// items splits the quantity across the tiers. unitsPerFrom is the number of
+// units of quantity in a unit of From and shift converts the tier price to
+// the price of a unit of quantity in cents. Zero usage results in a single
+// item of the first tier.
+func (tiers Tiers) items(quantity, unitsPerFrom int64, shift int32) []Item {
+	if quantity <= 0 && len(tiers) > 0 {
+		return []Item{{Tier: tiers[0], UnitPriceCents: tiers[0].Price.Shift(shift)}}
+	}
+
+	var items []Item
+	for i, tier := range tiers {
+		start := tier.From * unitsPerFrom
+		if quantity <= start {
+			break
+		}
+
+		end := quantity
+		if i+1 < len(tiers) {
+			if next := tiers[i+1].From * unitsPerFrom; next < end {
+				end = next
+			}
+		}
+
+		items = append(items, Item{
+			Tier:           tier,
+			Quantity:       end - start,
+			UnitPriceCents: tier.Price.Shift(shift),
+		})
+	}
+	return items
+}
+
+// Total returns the total price of the items in cents.
+func Total(items []Item) decimal.Decimal {
+	total := decimal.Zero
+	for _, item := range items {
+		total = total.Add(item.Total())
+	}
+	return total
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/payments/pricing"
+)
+
+func TestFlatPrices(t *testing.T) {
+	prices, err := pricing.FlatPrices("4", "7", "0.0000088")
+	require.NoError(t, err)
+	require.NoError(t, prices.Validate())
+	require.True(t, prices.IsFlat())
+
+	// 2 TB-months of storage.
+	items := prices.StorageItems(2000000)
+	require.Len(t, items, 1)
+	require.Equal(t, "800", pricing.Total(items).String())
+
+	// 1 TB of egress.
+	require.Equal(t, "700", pricing.Total(prices.EgressItems(1000000)).String())
+
+	// 1M segment-months.
+	require.Equal(t, "880", pricing.Total(prices.SegmentItems(1000000)).String())
+
+	items = prices.StorageItems(0)
+	require.Len(t, items, 1)
+	require.True(t, pricing.Total(items).IsZero())
+
+	_, err = pricing.FlatPrices("4", "abc", "0")
+	require.True(t, pricing.ErrInvalidPrices.Has(err))
+}
+
+func TestTieredPrices(t *testing.T) {
+	prices := pricing.Prices{
+		Storage: pricing.Tiers{
+			{From: 0, Price: decimal.NewFromInt(4)},
+			{From: 10, Price: decimal.NewFromInt(3)},
+			{From: 100, Price: decimal.NewFromInt(2)},
+		},
+		Egress:  pricing.Tiers{{Price: decimal.NewFromInt(7)}},
+		Segment: pricing.Tiers{{Price: decimal.Zero}},
+	}
+	require.NoError(t, prices.Validate())
+	require.False(t, prices.IsFlat())
+
+	// 5 TB-months stay in the first tier.
+	items := prices.StorageItems(5000000)
+	require.Len(t, items, 1)
+	require.Equal(t, "2000", pricing.Total(items).String())
+
+	// 150 TB-months span all tiers.
+	items = prices.StorageItems(150000000)
+	require.Len(t, items, 3)
+	require.EqualValues(t, 10000000, items[0].Quantity)
+	require.EqualValues(t, 90000000, items[1].Quantity)
+	require.EqualValues(t, 50000000, items[2].Quantity)
+	require.Equal(t, int64(10*400+90*300+50*200), pricing.Total(items).IntPart())
+}
+
+func TestPricesValidate(t *testing.T) {
+	valid := pricing.Tiers{{Price: decimal.NewFromInt(1)}}
+
+	for _, tiers := range []pricing.Tiers{
+		nil,
+		{{From: 1, Price: decimal.NewFromInt(1)}},
+		{{Price: decimal.NewFromInt(-1)}},
+		{{Price: decimal.NewFromInt(2)}, {From: 5, Price: decimal.NewFromInt(1)}, {From: 5, Price: decimal.NewFromInt(1)}},
+	} {
+		prices := pricing.Prices{Storage: tiers, Egress: valid, Segment: valid}
+		require.True(t, pricing.ErrInvalidPrices.Has(prices.Validate()), tiers)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pricing
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/useragent"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/rewards"
+)
+
+var mon = monkit.Package()
+
+// Service resolves the prices which apply to a user.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	db       DB
+	defaults Prices
+}
+
+// NewService creates a new pricing service with the default prices, which
+// apply when no plan is assigned.
+func NewService(log *zap.Logger, db DB, defaults Prices) *Service {
+	return &Service{
+		log:      log,
+		db:       db,
+		defaults: defaults,
+	}
+}
+
+// Defaults returns the default prices.
+func (service *Service) Defaults() Prices {
+	return service.defaults
+}
+
+// Prices returns the prices of the plan assigned to the user. Without one,
+// the plan of the partner from the user agent applies and otherwise the
+// default prices.
+func (service *Service) Prices(ctx context.Context, userID uuid.UUID, userAgent []byte) (_ Prices, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	plan, err := service.db.GetByUser(ctx, userID)
+	if err == nil {
+		return plan.Prices, nil
+	}
+	if !ErrPlanNotFound.Has(err) {
+		return Prices{}, Error.Wrap(err)
+	}
+
+	if partner := partnerFromUserAgent(userAgent); partner != "" {
+		plan, err := service.db.GetByPartner(ctx, partner)
+		if err == nil {
+			return plan.Prices, nil
+		}
+		if !ErrPlanNotFound.Has(err) {
+			return Prices{}, Error.Wrap(err)
+		}
+	}
+
+	return service.defaults, nil
+}
+
+// partnerFromUserAgent returns the canonical product name of the first user
+// agent entry.
+func partnerFromUserAgent(userAgent []byte) string {
+	if len(userAgent) == 0 {
+		return ""
+	}
+	entries, err := useragent.ParseEntries(userAgent)
+	if err != nil || len(entries) == 0 {
+		return ""
+	}
+	return rewards.CanonicalUserAgentProduct(entries[0].Product)
+}
+
+// CanonicalPartner returns the partner name as it's stored for assignments.
+func CanonicalPartner(partner string) string {
+	return rewards.CanonicalUserAgentProduct(partner)
+}
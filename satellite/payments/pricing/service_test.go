@@ -0,0 +1,80 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/payments/pricing"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestService_Prices(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		defaults, err := pricing.FlatPrices("4", "7", "0.0000088")
+		require.NoError(t, err)
+
+		service := pricing.NewService(zaptest.NewLogger(t), db.PricePlans(), defaults)
+
+		userPlan, err := db.PricePlans().Insert(ctx, pricing.Plan{
+			ID:   testrand.UUID(),
+			Name: "volume",
+			Prices: pricing.Prices{
+				Storage: pricing.Tiers{{From: 0, Price: decimal.NewFromInt(4)}, {From: 100, Price: decimal.NewFromInt(3)}},
+				Egress:  defaults.Egress,
+				Segment: defaults.Segment,
+			},
+		})
+		require.NoError(t, err)
+
+		partnerPlan, err := db.PricePlans().Insert(ctx, pricing.Plan{
+			ID:     testrand.UUID(),
+			Name:   "partner",
+			Prices: pricing.Prices{Storage: defaults.Storage, Egress: pricing.Tiers{{Price: decimal.NewFromInt(5)}}, Segment: defaults.Segment},
+		})
+		require.NoError(t, err)
+
+		plans, err := db.PricePlans().List(ctx)
+		require.NoError(t, err)
+		require.Len(t, plans, 2)
+
+		userID := testrand.UUID()
+		userAgent := []byte("Zenko/1.0 uplink/v1.0.0")
+
+		prices, err := service.Prices(ctx, userID, userAgent)
+		require.NoError(t, err)
+		require.Equal(t, defaults, prices)
+
+		require.NoError(t, db.PricePlans().AssignPartner(ctx, "Zenko", partnerPlan.ID))
+		prices, err = service.Prices(ctx, userID, userAgent)
+		require.NoError(t, err)
+		require.Equal(t, "5", prices.Egress[0].Price.String())
+
+		// the plan of the user takes precedence over the plan of the partner.
+		require.NoError(t, db.PricePlans().AssignUser(ctx, userID, userPlan.ID))
+		prices, err = service.Prices(ctx, userID, userAgent)
+		require.NoError(t, err)
+		require.Len(t, prices.Storage, 2)
+		require.False(t, prices.IsFlat())
+
+		require.NoError(t, db.PricePlans().UnassignUser(ctx, userID))
+		require.NoError(t, db.PricePlans().Delete(ctx, partnerPlan.ID))
+
+		_, err = db.PricePlans().GetByPartner(ctx, "zenko")
+		require.True(t, pricing.ErrPlanNotFound.Has(err))
+		_, err = db.PricePlans().Get(ctx, partnerPlan.ID)
+		require.True(t, pricing.ErrPlanNotFound.Has(err))
+
+		prices, err = service.Prices(ctx, userID, userAgent)
+		require.NoError(t, err)
+		require.Equal(t, defaults, prices)
+	})
+}
@@ -12,6 +12,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/pricing"
 )
 
 // ensures that accounts implements payments.Accounts.
@@ -107,8 +108,23 @@ func (accounts *accounts) Balance(ctx context.Context, userID uuid.UUID) (_ paym
 		return payments.Balance{}, Error.Wrap(err)
 	}
 
+	prepaidBalance, err := accounts.service.billingDB.GetBalance(ctx, userID)
+	if err != nil {
+		return payments.Balance{}, Error.Wrap(err)
+	}
+
+	txs, err := accounts.service.billingDB.List(ctx, userID)
+	if err != nil {
+		return payments.Balance{}, Error.Wrap(err)
+	}
+
 	accountBalance := payments.Balance{
-		Coins: -c.Balance,
+		Coins:   -c.Balance,
+		Prepaid: centsFromAmount(prepaidBalance),
+		Ledger:  make([]payments.BalanceTransaction, 0, len(txs)),
+	}
+	for _, tx := range txs {
+		accountBalance.Ledger = append(accountBalance.Ledger, balanceTransactionFromBilling(tx))
 	}
 
 	return accountBalance, nil
@@ -132,7 +148,12 @@ func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID,
 			return charges, Error.Wrap(err)
 		}
 
-		projectPrice := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.SegmentCount)
+		prices, err := accounts.service.pricing.Prices(ctx, project.OwnerID, project.UserAgent)
+		if err != nil {
+			return charges, Error.Wrap(err)
+		}
+
+		projectPrice := calculateProjectUsagePrice(prices, usage.Egress, usage.Storage, usage.SegmentCount)
 
 		charges = append(charges, payments.ProjectCharge{
 			ProjectUsage: *usage,
@@ -147,6 +168,73 @@ func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID,
 	return charges, nil
 }
 
+// BucketCharges returns how much money current user will be charged for each bucket of the projects they own.
+// The charges of the buckets of a project add up to its project charge.
+func (accounts *accounts) BucketCharges(ctx context.Context, userID uuid.UUID, since, before time.Time) (charges []payments.BucketCharge, err error) {
+	defer mon.Task()(&ctx, userID, since, before)(&err)
+
+	// to return empty slice instead of nil if there are no buckets
+	charges = make([]payments.BucketCharge, 0)
+
+	projects, err := accounts.service.projectsDB.GetOwn(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, project := range projects {
+		rollups, err := accounts.service.usageDB.GetBucketUsageRollups(ctx, project.ID, since, before)
+		if err != nil {
+			return charges, Error.Wrap(err)
+		}
+		if len(rollups) == 0 {
+			continue
+		}
+
+		usage, err := accounts.service.usageDB.GetProjectTotal(ctx, project.ID, since, before)
+		if err != nil {
+			return charges, Error.Wrap(err)
+		}
+
+		prices, err := accounts.service.pricing.Prices(ctx, project.OwnerID, project.UserAgent)
+		if err != nil {
+			return charges, Error.Wrap(err)
+		}
+
+		// the volume tiers apply to the usage of the whole project, so it's
+		// priced once and split between the buckets.
+		projectPrice := calculateProjectUsagePrice(prices, usage.Egress, usage.Storage, usage.SegmentCount)
+
+		buckets := make([]bucketUsage, 0, len(rollups))
+		for _, rollup := range rollups {
+			buckets = append(buckets, bucketUsageFromRollup(rollup))
+		}
+		bucketPrices := splitProjectUsagePrice(projectPrice, buckets)
+
+		for i, rollup := range rollups {
+			bucketPrice := bucketPrices[i]
+
+			charges = append(charges, payments.BucketCharge{
+				BucketUsageRollup: rollup,
+
+				ProjectName:  project.Name,
+				Egress:       bucketPrice.Egress.IntPart(),
+				SegmentCount: bucketPrice.Segments.IntPart(),
+				StorageGbHrs: bucketPrice.Storage.IntPart(),
+			})
+		}
+	}
+
+	return charges, nil
+}
+
+// Prices returns the prices which apply to the user.
+func (accounts *accounts) Prices(ctx context.Context, userID uuid.UUID, userAgent []byte) (_ pricing.Prices, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	prices, err := accounts.service.pricing.Prices(ctx, userID, userAgent)
+	return prices, Error.Wrap(err)
+}
+
 // CheckProjectInvoicingStatus returns error if for the given project there are outstanding project records and/or usage
 // which have not been applied/invoiced yet (meaning sent over to stripe).
 func (accounts *accounts) CheckProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (err error) {
@@ -264,3 +352,8 @@ func (accounts *accounts) StorjTokens() payments.StorjTokens {
 func (accounts *accounts) Coupons() payments.Coupons {
 	return &coupons{service: accounts.service}
 }
+
+// Prepaid exposes all needed functionality to manage the prepaid balance.
+func (accounts *accounts) Prepaid() payments.Prepaid {
+	return &prepaid{service: accounts.service}
+}
@@ -7,9 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/common/memory"
+	"storj.io/common/pb"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
@@ -20,8 +23,10 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/paymentsconfig"
+	"storj.io/storj/satellite/payments/pricing"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/rewards"
 )
@@ -69,6 +74,7 @@ func TestSignupCouponCodes(t *testing.T) {
 			db.Billing(),
 			db.Console().Projects(),
 			db.ProjectAccounting(),
+			db.PricePlans(),
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
@@ -134,3 +140,74 @@ func TestSignupCouponCodes(t *testing.T) {
 		}
 	})
 }
+
+func TestBucketChargesTiered(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1, UplinkCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		userID := planet.Uplinks[0].Projects[0].Owner.ID
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		defaults := sat.API.Payments.StripeService.Pricing().Defaults()
+		plan, err := sat.DB.PricePlans().Insert(ctx, pricing.Plan{
+			ID:   testrand.UUID(),
+			Name: "tiered",
+			Prices: pricing.Prices{
+				Storage: defaults.Storage,
+				Egress: pricing.Tiers{
+					{From: 0, Price: decimal.NewFromInt(7)},
+					{From: 1, Price: decimal.NewFromInt(1)},
+				},
+				Segment: defaults.Segment,
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, sat.DB.PricePlans().AssignUser(ctx, userID, plan.ID))
+
+		since := time.Now().Add(-time.Hour)
+		interval := since.Add(30 * time.Minute)
+		tallies := map[metabase.BucketLocation]*accounting.BucketTally{}
+		for i, bucket := range []struct {
+			name   string
+			egress int64
+		}{
+			{"a", 500 * memory.GB.Int64()},
+			{"b", 333 * memory.GB.Int64()},
+			{"c", 200 * memory.GB.Int64()},
+		} {
+			location := metabase.BucketLocation{ProjectID: projectID, BucketName: bucket.name}
+			tallies[location] = &accounting.BucketTally{
+				BucketLocation: location,
+				TotalBytes:     int64(i+1) * memory.TB.Int64(),
+				TotalSegments:  int64(i + 1),
+			}
+
+			err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, projectID, []byte(bucket.name),
+				pb.PieceAction_GET, bucket.egress, 0, interval)
+			require.NoError(t, err)
+		}
+		require.NoError(t, sat.DB.ProjectAccounting().SaveTallies(ctx, interval, tallies))
+		require.NoError(t, sat.DB.ProjectAccounting().SaveTallies(ctx, interval.Add(10*time.Minute), tallies))
+
+		before := time.Now().Add(time.Hour)
+		projectCharges, err := sat.API.Payments.Accounts.ProjectCharges(ctx, userID, since, before)
+		require.NoError(t, err)
+		require.Len(t, projectCharges, 1)
+
+		bucketCharges, err := sat.API.Payments.Accounts.BucketCharges(ctx, userID, since, before)
+		require.NoError(t, err)
+		require.Len(t, bucketCharges, 3)
+
+		// the buckets share the tiers of the project, so their charges add up
+		// to the project charge.
+		var egress, storage, segments int64
+		for _, charge := range bucketCharges {
+			egress += charge.Egress
+			storage += charge.StorageGbHrs
+			segments += charge.SegmentCount
+		}
+		require.NotZero(t, projectCharges[0].Egress)
+		require.Equal(t, projectCharges[0].Egress, egress)
+		require.Equal(t, projectCharges[0].StorageGbHrs, storage)
+		require.Equal(t, projectCharges[0].SegmentCount, segments)
+	})
+}
@@ -24,6 +24,7 @@ type StripeClient interface {
 	CustomerBalanceTransactions() StripeCustomerBalanceTransactions
 	Charges() StripeCharges
 	PromoCodes() StripePromoCodes
+	PaymentIntents() StripePaymentIntents
 }
 
 // StripeCustomers Stripe Customers interface.
@@ -66,6 +67,11 @@ type StripePromoCodes interface {
 	List(params *stripe.PromotionCodeListParams) *promotioncode.Iter
 }
 
+// StripePaymentIntents is the Stripe PaymentIntents interface.
+type StripePaymentIntents interface {
+	New(params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error)
+}
+
 // StripeCustomerBalanceTransactions Stripe CustomerBalanceTransactions interface.
 type StripeCustomerBalanceTransactions interface {
 	New(params *stripe.CustomerBalanceTransactionParams) (*stripe.CustomerBalanceTransaction, error)
@@ -104,6 +110,10 @@ func (s *stripeClient) PromoCodes() StripePromoCodes {
 	return s.client.PromotionCodes
 }
 
+func (s *stripeClient) PaymentIntents() StripePaymentIntents {
+	return s.client.PaymentIntents
+}
+
 // NewStripeClient creates Stripe client from configuration.
 func NewStripeClient(log *zap.Logger, config Config) StripeClient {
 	backendConfig := &stripe.BackendConfig{
@@ -13,4 +13,6 @@ type DB interface {
 	Transactions() TransactionsDB
 	// ProjectRecords is getter for invoice project records db.
 	ProjectRecords() ProjectRecordsDB
+	// AutoTopUps is getter for prepaid balance auto top-ups db.
+	AutoTopUps() AutoTopUpsDB
 }
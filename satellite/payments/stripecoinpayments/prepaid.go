@@ -0,0 +1,434 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripecoinpayments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/stripe/stripe-go/v72"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/monetary"
+)
+
+const (
+	// prepaidSource is the source of the billing transactions of the prepaid balance.
+	prepaidSource = "stripe"
+
+	topUpDescription     = "Prepaid balance top-up"
+	autoTopUpDescription = "Automatic prepaid balance top-up"
+)
+
+// AutoTopUpsDB is an interface for working with the auto top-up settings of
+// the prepaid balance.
+//
+// architecture: Database
+type AutoTopUpsDB interface {
+	// Get returns the auto top-up settings of the user. The settings are
+	// disabled if they were never set.
+	Get(ctx context.Context, userID uuid.UUID) (payments.AutoTopUp, error)
+	// Set enables the auto top-up of the user with the given threshold and amount.
+	Set(ctx context.Context, userID uuid.UUID, settings payments.AutoTopUp) error
+	// Delete disables the auto top-up of the user.
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+// ensures that prepaid implements payments.Prepaid.
+var _ payments.Prepaid = (*prepaid)(nil)
+
+// prepaid is an implementation of payments.Prepaid.
+//
+// architecture: Service
+type prepaid struct {
+	service *Service
+}
+
+// TopUp charges the default credit card of the user with the amount in cents
+// and adds it to the prepaid balance.
+func (prepaid *prepaid) TopUp(ctx context.Context, userID uuid.UUID, amount int64) (_ payments.BalanceTransaction, err error) {
+	defer mon.Task()(&ctx, userID, amount)(&err)
+
+	if err := prepaid.service.validateTopUpAmount(amount); err != nil {
+		return payments.BalanceTransaction{}, err
+	}
+
+	return prepaid.service.topUp(ctx, userID, amount, topUpDescription)
+}
+
+// GetAutoTopUp returns the auto top-up settings of the user.
+func (prepaid *prepaid) GetAutoTopUp(ctx context.Context, userID uuid.UUID) (_ payments.AutoTopUp, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	settings, err := prepaid.service.db.AutoTopUps().Get(ctx, userID)
+	return settings, Error.Wrap(err)
+}
+
+// SetAutoTopUp updates the auto top-up settings of the user.
+func (prepaid *prepaid) SetAutoTopUp(ctx context.Context, userID uuid.UUID, settings payments.AutoTopUp) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	if !settings.Enabled {
+		return Error.Wrap(prepaid.service.db.AutoTopUps().Delete(ctx, userID))
+	}
+
+	if settings.Threshold < 0 {
+		return payments.ErrInvalidTopUp.New("threshold can't be negative")
+	}
+	if err := prepaid.service.validateTopUpAmount(settings.Amount); err != nil {
+		return err
+	}
+
+	// ensure that the card can be charged once the balance falls below the threshold.
+	if _, err := prepaid.service.defaultPaymentMethod(ctx, userID); err != nil {
+		return err
+	}
+
+	return Error.Wrap(prepaid.service.db.AutoTopUps().Set(ctx, userID, settings))
+}
+
+// validateTopUpAmount checks that amount is within the configured top-up limits.
+func (service *Service) validateTopUpAmount(amount int64) error {
+	if amount < service.minTopUpAmount {
+		return payments.ErrInvalidTopUp.New("amount must be at least %d cents", service.minTopUpAmount)
+	}
+	if service.maxTopUpAmount > 0 && amount > service.maxTopUpAmount {
+		return payments.ErrInvalidTopUp.New("amount must be at most %d cents", service.maxTopUpAmount)
+	}
+	return nil
+}
+
+// defaultPaymentMethod returns the stripe customer ID and the default payment method of the user.
+func (service *Service) defaultPaymentMethod(ctx context.Context, userID uuid.UUID) (_ paymentMethod, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	customerID, err := service.db.Customers().GetCustomerID(ctx, userID)
+	if err != nil {
+		return paymentMethod{}, Error.Wrap(err)
+	}
+
+	customer, err := service.stripeClient.Customers().Get(customerID, nil)
+	if err != nil {
+		return paymentMethod{}, Error.Wrap(err)
+	}
+
+	if customer.InvoiceSettings == nil || customer.InvoiceSettings.DefaultPaymentMethod == nil {
+		return paymentMethod{}, payments.ErrInvalidTopUp.New("no default credit card")
+	}
+
+	return paymentMethod{
+		CustomerID: customerID,
+		ID:         customer.InvoiceSettings.DefaultPaymentMethod.ID,
+	}, nil
+}
+
+// paymentMethod identifies a payment method of a stripe customer.
+type paymentMethod struct {
+	CustomerID string
+	ID         string
+}
+
+// topUp charges the default credit card of the user and credits the amount to the prepaid balance.
+func (service *Service) topUp(ctx context.Context, userID uuid.UUID, amount int64, description string) (_ payments.BalanceTransaction, err error) {
+	defer mon.Task()(&ctx, userID, amount)(&err)
+
+	method, err := service.defaultPaymentMethod(ctx, userID)
+	if err != nil {
+		return payments.BalanceTransaction{}, err
+	}
+
+	tx := billing.Transaction{
+		UserID:      userID,
+		Amount:      monetary.AmountFromBaseUnits(amount, monetary.USDollars),
+		Description: description,
+		Source:      prepaidSource,
+		Status:      billing.TransactionStatusPending,
+		Type:        billing.TransactionTypeCredit,
+		Timestamp:   service.nowFn(),
+	}
+
+	tx.ID, err = service.billingDB.Insert(ctx, tx)
+	if err != nil {
+		return payments.BalanceTransaction{}, Error.Wrap(err)
+	}
+
+	return service.chargeTopUp(ctx, method, tx)
+}
+
+// chargeTopUp charges the payment method with the amount of the pending top-up
+// transaction and completes it. Charging the same transaction again doesn't
+// charge the card twice.
+func (service *Service) chargeTopUp(ctx context.Context, method paymentMethod, tx billing.Transaction) (_ payments.BalanceTransaction, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	amount := centsFromAmount(tx.Amount)
+
+	intent, err := service.stripeClient.PaymentIntents().New(&stripe.PaymentIntentParams{
+		Amount:        stripe.Int64(amount),
+		Currency:      stripe.String(string(stripe.CurrencyUSD)),
+		Customer:      stripe.String(method.CustomerID),
+		PaymentMethod: stripe.String(method.ID),
+		Description:   stripe.String(tx.Description),
+		Confirm:       stripe.Bool(true),
+		OffSession:    stripe.Bool(true),
+		Params: stripe.Params{
+			IdempotencyKey: stripe.String("prepaid-top-up-" + strconv.FormatInt(tx.ID, 10)),
+			Metadata: map[string]string{
+				"user ID":        tx.UserID.String(),
+				"transaction ID": strconv.FormatInt(tx.ID, 10),
+			},
+		},
+	})
+	if err != nil {
+		cancelErr := service.billingDB.UpdateStatus(ctx, tx.ID, billing.TransactionStatusCancelled)
+
+		var stripeErr *stripe.Error
+		if errors.As(err, &stripeErr) && stripeErr.Type == stripe.ErrorTypeCard {
+			return payments.BalanceTransaction{}, errs.Combine(payments.ErrInvalidTopUp.New("%s", stripeErr.Msg), Error.Wrap(cancelErr))
+		}
+		return payments.BalanceTransaction{}, Error.Wrap(errs.Combine(err, cancelErr))
+	}
+	if intent.Status != stripe.PaymentIntentStatusSucceeded {
+		cancelErr := service.billingDB.UpdateStatus(ctx, tx.ID, billing.TransactionStatusCancelled)
+		return payments.BalanceTransaction{}, errs.Combine(Error.New("payment intent %s has status %s", intent.ID, intent.Status), Error.Wrap(cancelErr))
+	}
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		"PaymentIntentID": intent.ID,
+	})
+	if err != nil {
+		return payments.BalanceTransaction{}, Error.Wrap(err)
+	}
+
+	err = service.billingDB.UpdateMetadata(ctx, tx.ID, metadata)
+	if err == nil {
+		err = service.billingDB.UpdateStatus(ctx, tx.ID, billing.TransactionStatusCompleted)
+	}
+	if err != nil {
+		// the card is already charged, so the transaction has to be completed manually.
+		service.log.Error("unable to credit prepaid balance top-up",
+			zap.Stringer("User ID", tx.UserID),
+			zap.Int64("Transaction ID", tx.ID),
+			zap.String("Payment Intent ID", intent.ID),
+			zap.Int64("Amount", amount),
+			zap.Error(err))
+		return payments.BalanceTransaction{}, Error.Wrap(err)
+	}
+
+	tx.Status = billing.TransactionStatusCompleted
+	tx.Metadata = metadata
+	return balanceTransactionFromBilling(tx), nil
+}
+
+// autoTopUp tops up the prepaid balance of the user when auto top-up is enabled
+// and the balance is below the threshold. It returns whether the balance was topped up.
+func (service *Service) autoTopUp(ctx context.Context, userID uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	settings, err := service.db.AutoTopUps().Get(ctx, userID)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	if !settings.Enabled {
+		return false, nil
+	}
+
+	// an auto top-up interrupted before it completed is charged again with
+	// the same transaction, so that a retried invoicing doesn't charge twice.
+	txs, err := service.billingDB.List(ctx, userID)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	for _, tx := range txs {
+		if tx.Source != prepaidSource || tx.Status != billing.TransactionStatusPending || tx.Description != autoTopUpDescription {
+			continue
+		}
+
+		method, err := service.defaultPaymentMethod(ctx, userID)
+		if err != nil {
+			return false, err
+		}
+		_, err = service.chargeTopUp(ctx, method, tx)
+		return err == nil, err
+	}
+
+	balance, err := service.billingDB.GetBalance(ctx, userID)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	if centsFromAmount(balance) >= settings.Threshold {
+		return false, nil
+	}
+
+	_, err = service.topUp(ctx, userID, settings.Amount, autoTopUpDescription)
+	return err == nil, err
+}
+
+// InvoiceApplyPrepaidBalance creates invoice line items which pay the pending
+// invoice items of every customer from their prepaid balance. Afterwards the
+// prepaid balances with auto top-up enabled are topped up if they fell below
+// the threshold.
+//
+// It should run after the project and token invoice line items are created,
+// but before the invoices are created.
+func (service *Service) InvoiceApplyPrepaidBalance(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errGrp errs.Group
+	var applied, toppedUp int
+
+	cusPage := CustomersPage{Next: true}
+	for cusPage.Next {
+		if err = ctx.Err(); err != nil {
+			return Error.Wrap(err)
+		}
+
+		cusPage, err = service.db.Customers().List(ctx, cusPage.NextOffset, service.listingLimit, service.nowFn())
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, cus := range cusPage.Customers {
+			ok, err := service.applyPrepaidBalance(ctx, cus)
+			if err != nil {
+				errGrp.Add(Error.New("unable to apply prepaid balance for user ID %s: %v", cus.UserID, err))
+				continue
+			}
+			if ok {
+				applied++
+			}
+
+			ok, err = service.autoTopUp(ctx, cus.UserID)
+			if err != nil {
+				// a declined card must not prevent the invoices of other customers.
+				service.log.Warn("unable to automatically top up prepaid balance",
+					zap.Stringer("User ID", cus.UserID), zap.Error(err))
+				continue
+			}
+			if ok {
+				toppedUp++
+			}
+		}
+	}
+
+	service.log.Info("Applied prepaid balances.", zap.Int("Applied", applied), zap.Int("Topped Up", toppedUp))
+	return errGrp.Err()
+}
+
+// applyPrepaidBalance pays the pending invoice items of the customer from their
+// prepaid balance. It returns whether any balance was applied.
+func (service *Service) applyPrepaidBalance(ctx context.Context, cus Customer) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	balance, err := service.billingDB.GetBalance(ctx, cus.UserID)
+	if err != nil {
+		return false, err
+	}
+	// stripe only has cent level precision for invoices, the remaining
+	// fraction stays on the balance.
+	available := centsFromAmount(balance)
+	if available <= 0 {
+		return false, nil
+	}
+
+	due, err := service.pendingInvoiceItemsTotal(ctx, cus.ID)
+	if err != nil {
+		return false, err
+	}
+	if due <= 0 {
+		return false, nil
+	}
+
+	amount := due
+	if available < amount {
+		amount = available
+	}
+
+	txID, err := service.billingDB.Insert(ctx, billing.Transaction{
+		UserID:      cus.UserID,
+		Amount:      monetary.AmountFromBaseUnits(-amount, monetary.USDollars),
+		Description: "Paid Stripe Invoice",
+		Source:      prepaidSource,
+		Status:      billing.TransactionStatusCompleted,
+		Type:        billing.TransactionTypeDebit,
+		Timestamp:   service.nowFn(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	item, err := service.stripeClient.InvoiceItems().New(&stripe.InvoiceItemParams{
+		Currency:    stripe.String(string(stripe.CurrencyUSD)),
+		Customer:    stripe.String(cus.ID),
+		Description: stripe.String("payment from prepaid balance"),
+		UnitAmount:  stripe.Int64(-amount),
+		Params: stripe.Params{
+			Metadata: map[string]string{
+				"transaction ID": strconv.FormatInt(txID, 10),
+			},
+		},
+	})
+	if err != nil {
+		// return the balance, since the invoice wasn't paid with it.
+		_, revertErr := service.billingDB.Insert(ctx, billing.Transaction{
+			UserID:      cus.UserID,
+			Amount:      monetary.AmountFromBaseUnits(amount, monetary.USDollars),
+			Description: "Reverted Stripe Invoice payment",
+			Source:      prepaidSource,
+			Status:      billing.TransactionStatusCompleted,
+			Type:        billing.TransactionTypeCredit,
+			Timestamp:   service.nowFn(),
+		})
+		return false, errs.Combine(err, revertErr)
+	}
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		"ItemID": item.ID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, service.billingDB.UpdateMetadata(ctx, txID, metadata)
+}
+
+// pendingInvoiceItemsTotal returns the total of the pending invoice items of
+// the customer in cents.
+func (service *Service) pendingInvoiceItemsTotal(ctx context.Context, cusID string) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	iter := service.stripeClient.InvoiceItems().List(&stripe.InvoiceItemListParams{
+		Customer: stripe.String(cusID),
+		Pending:  stripe.Bool(true),
+	})
+	for iter.Next() {
+		total += iter.InvoiceItem().Amount
+	}
+
+	return total, iter.Err()
+}
+
+// centsFromAmount truncates the monetary amount to whole cents.
+func centsFromAmount(amount monetary.Amount) int64 {
+	return amount.AsDecimal().Shift(2).IntPart()
+}
+
+// balanceTransactionFromBilling converts a billing transaction to a balance transaction.
+func balanceTransactionFromBilling(tx billing.Transaction) payments.BalanceTransaction {
+	return payments.BalanceTransaction{
+		ID:          tx.ID,
+		Amount:      centsFromAmount(tx.Amount),
+		Description: tx.Description,
+		Source:      tx.Source,
+		Status:      string(tx.Status),
+		Type:        string(tx.Type),
+		Timestamp:   tx.Timestamp,
+	}
+}
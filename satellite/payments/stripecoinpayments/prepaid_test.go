@@ -0,0 +1,201 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripecoinpayments_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/monetary"
+)
+
+func TestPrepaid_TopUp(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		accounts := satellite.API.Payments.Accounts
+		userID := planet.Uplinks[0].Projects[0].Owner.ID
+
+		_, err := accounts.Prepaid().TopUp(ctx, userID, 1)
+		require.True(t, payments.ErrInvalidTopUp.Has(err))
+		_, err = accounts.Prepaid().TopUp(ctx, userID, 1000000000)
+		require.True(t, payments.ErrInvalidTopUp.Has(err))
+
+		require.NoError(t, accounts.CreditCards().Add(ctx, userID, "test"))
+
+		tx, err := accounts.Prepaid().TopUp(ctx, userID, 2000)
+		require.NoError(t, err)
+		require.EqualValues(t, 2000, tx.Amount)
+		require.EqualValues(t, billing.TransactionTypeCredit, tx.Type)
+		require.EqualValues(t, billing.TransactionStatusCompleted, tx.Status)
+
+		balance, err := accounts.Balance(ctx, userID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2000, balance.Prepaid)
+		require.Len(t, balance.Ledger, 1)
+		require.Equal(t, tx.ID, balance.Ledger[0].ID)
+
+		charges, err := accounts.Charges(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, charges, 1)
+		require.EqualValues(t, 2000, charges[0].Amount)
+
+		t.Run("auto top-up settings", func(t *testing.T) {
+			settings, err := accounts.Prepaid().GetAutoTopUp(ctx, userID)
+			require.NoError(t, err)
+			require.False(t, settings.Enabled)
+
+			err = accounts.Prepaid().SetAutoTopUp(ctx, userID, payments.AutoTopUp{Enabled: true, Threshold: -1, Amount: 2000})
+			require.True(t, payments.ErrInvalidTopUp.Has(err))
+			err = accounts.Prepaid().SetAutoTopUp(ctx, userID, payments.AutoTopUp{Enabled: true, Threshold: 500, Amount: 1})
+			require.True(t, payments.ErrInvalidTopUp.Has(err))
+
+			expected := payments.AutoTopUp{Enabled: true, Threshold: 500, Amount: 2000}
+			require.NoError(t, accounts.Prepaid().SetAutoTopUp(ctx, userID, expected))
+			settings, err = accounts.Prepaid().GetAutoTopUp(ctx, userID)
+			require.NoError(t, err)
+			require.Equal(t, expected, settings)
+
+			require.NoError(t, accounts.Prepaid().SetAutoTopUp(ctx, userID, payments.AutoTopUp{}))
+			settings, err = accounts.Prepaid().GetAutoTopUp(ctx, userID)
+			require.NoError(t, err)
+			require.False(t, settings.Enabled)
+		})
+	})
+}
+
+func TestService_InvoiceApplyPrepaidBalance(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.API.Payments.StripeService
+		accounts := satellite.API.Payments.Accounts
+		userID := planet.Uplinks[0].Projects[0].Owner.ID
+
+		customerID, err := satellite.DB.StripeCoinPayments().Customers().GetCustomerID(ctx, userID)
+		require.NoError(t, err)
+
+		addInvoiceItem := func(amount int64) {
+			_, err := satellite.API.Payments.StripeClient.InvoiceItems().New(&stripe.InvoiceItemParams{
+				Currency:    stripe.String(string(stripe.CurrencyUSD)),
+				Customer:    stripe.String(customerID),
+				Description: stripe.String("usage"),
+				UnitAmount:  stripe.Int64(amount),
+			})
+			require.NoError(t, err)
+		}
+
+		prepaidBalance := func() int64 {
+			balance, err := accounts.Balance(ctx, userID)
+			require.NoError(t, err)
+			return balance.Prepaid
+		}
+
+		require.NoError(t, accounts.CreditCards().Add(ctx, userID, "test"))
+		_, err = accounts.Prepaid().TopUp(ctx, userID, 1500)
+		require.NoError(t, err)
+
+		// the balance covers the whole invoice
+		addInvoiceItem(1000)
+		require.NoError(t, service.InvoiceApplyPrepaidBalance(ctx))
+		require.EqualValues(t, 500, prepaidBalance())
+
+		// the balance is applied only once
+		require.NoError(t, service.InvoiceApplyPrepaidBalance(ctx))
+		require.EqualValues(t, 500, prepaidBalance())
+
+		period := time.Now().AddDate(0, -1, 0)
+		require.NoError(t, service.CreateInvoices(ctx, period))
+
+		invoices, err := accounts.Invoices().List(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, invoices, 1)
+
+		// the balance covers only a part of the invoice and falls below the
+		// auto top-up threshold, so it's topped up for the next invoices
+		require.NoError(t, accounts.Prepaid().SetAutoTopUp(ctx, userID, payments.AutoTopUp{
+			Enabled:   true,
+			Threshold: 1000,
+			Amount:    2000,
+		}))
+
+		addInvoiceItem(800)
+		require.NoError(t, service.InvoiceApplyPrepaidBalance(ctx))
+		require.EqualValues(t, 2000, prepaidBalance())
+
+		balance, err := accounts.Balance(ctx, userID)
+		require.NoError(t, err)
+		var debits int64
+		for _, tx := range balance.Ledger {
+			if tx.Type == billing.TransactionTypeDebit {
+				debits += tx.Amount
+			}
+		}
+		require.EqualValues(t, -1500, debits)
+
+		require.NoError(t, service.CreateInvoices(ctx, period))
+		invoices, err = accounts.Invoices().List(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, invoices, 2)
+	})
+}
+
+func TestService_AutoTopUpInterrupted(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.API.Payments.StripeService
+		accounts := satellite.API.Payments.Accounts
+		userID := planet.Uplinks[0].Projects[0].Owner.ID
+
+		require.NoError(t, accounts.CreditCards().Add(ctx, userID, "test"))
+		require.NoError(t, accounts.Prepaid().SetAutoTopUp(ctx, userID, payments.AutoTopUp{
+			Enabled:   true,
+			Threshold: 1000,
+			Amount:    2000,
+		}))
+
+		// an auto top-up, which was interrupted before the card was charged.
+		txID, err := satellite.DB.Billing().Insert(ctx, billing.Transaction{
+			UserID:      userID,
+			Amount:      monetary.AmountFromBaseUnits(2000, monetary.USDollars),
+			Description: "Automatic prepaid balance top-up",
+			Source:      "stripe",
+			Status:      billing.TransactionStatusPending,
+			Type:        billing.TransactionTypeCredit,
+			Timestamp:   time.Now(),
+		})
+		require.NoError(t, err)
+
+		// a pending transaction doesn't change the balance.
+		balance, err := accounts.Balance(ctx, userID)
+		require.NoError(t, err)
+		require.Zero(t, balance.Prepaid)
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, service.InvoiceApplyPrepaidBalance(ctx))
+
+			balance, err = accounts.Balance(ctx, userID)
+			require.NoError(t, err)
+			require.EqualValues(t, 2000, balance.Prepaid)
+			require.Len(t, balance.Ledger, 1)
+			require.Equal(t, txID, balance.Ledger[0].ID)
+			require.EqualValues(t, billing.TransactionStatusCompleted, balance.Ledger[0].Status)
+
+			charges, err := accounts.Charges(ctx, userID)
+			require.NoError(t, err)
+			require.Len(t, charges, 1)
+		}
+	})
+}
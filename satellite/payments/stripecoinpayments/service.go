@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -19,6 +21,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/memory"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
@@ -26,6 +29,7 @@ import (
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/coinpayments"
 	"storj.io/storj/satellite/payments/monetary"
+	"storj.io/storj/satellite/payments/pricing"
 	"storj.io/storj/satellite/payments/storjscan"
 )
 
@@ -50,6 +54,9 @@ type Config struct {
 	AccountBalanceUpdateInterval time.Duration `help:"amount of time we wait before running next account balance update loop" default:"2m" testDefault:"$TESTINTERVAL"`
 	ConversionRatesCycleInterval time.Duration `help:"amount of time we wait before running next conversion rates update loop" default:"10m" testDefault:"$TESTINTERVAL"`
 	AutoAdvance                  bool          `help:"toogle autoadvance feature for invoice creation" default:"false"`
+	BucketLineItems              bool          `help:"create invoice line items for every bucket instead of every project" default:"false"`
+	MinTopUpAmount               int64         `help:"minimum amount in cents of a prepaid balance top-up" default:"1000"`
+	MaxTopUpAmount               int64         `help:"maximum amount in cents of a prepaid balance top-up, 0 means unlimited" default:"100000"`
 	ListingLimit                 int           `help:"sets the maximum amount of items before we start paging on requests" default:"100" hidden:"true"`
 }
 
@@ -68,9 +75,8 @@ type Service struct {
 	stripeClient StripeClient
 	coinPayments *coinpayments.Client
 
-	StorageMBMonthPriceCents decimal.Decimal
-	EgressMBPriceCents       decimal.Decimal
-	SegmentMonthPriceCents   decimal.Decimal
+	pricing *pricing.Service
+
 	// BonusRate amount of percents
 	BonusRate int64
 	// Coupon Values
@@ -79,6 +85,12 @@ type Service struct {
 	// Stripe Extended Features
 	AutoAdvance bool
 
+	// BucketLineItems splits the project invoice line items per bucket.
+	BucketLineItems bool
+
+	minTopUpAmount int64
+	maxTopUpAmount int64
+
 	mu       sync.Mutex
 	rates    coinpayments.CurrencyRateInfos
 	ratesErr error
@@ -88,7 +100,7 @@ type Service struct {
 }
 
 // NewService creates a Service instance.
-func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB, walletsDB storjscan.WalletsDB, billingDB billing.TransactionsDB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, storageTBPrice, egressTBPrice, segmentPrice string, bonusRate int64) (*Service, error) {
+func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB, walletsDB storjscan.WalletsDB, billingDB billing.TransactionsDB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, pricePlans pricing.DB, storageTBPrice, egressTBPrice, segmentPrice string, bonusRate int64) (*Service, error) {
 	coinPaymentsClient := coinpayments.NewClient(
 		coinpayments.Credentials{
 			PublicKey:  config.CoinpaymentsPublicKey,
@@ -96,44 +108,37 @@ func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB
 		},
 	)
 
-	storageTBMonthDollars, err := decimal.NewFromString(storageTBPrice)
+	defaultPrices, err := pricing.FlatPrices(storageTBPrice, egressTBPrice, segmentPrice)
 	if err != nil {
 		return nil, err
 	}
-	egressTBDollars, err := decimal.NewFromString(egressTBPrice)
-	if err != nil {
-		return nil, err
-	}
-	segmentMonthDollars, err := decimal.NewFromString(segmentPrice)
-	if err != nil {
-		return nil, err
-	}
-
-	// change the precision from TB dollars to MB cents
-	storageMBMonthPriceCents := storageTBMonthDollars.Shift(-6).Shift(2)
-	egressMBPriceCents := egressTBDollars.Shift(-6).Shift(2)
-	segmentMonthPriceCents := segmentMonthDollars.Shift(2)
 
 	return &Service{
-		log:                      log,
-		db:                       db,
-		walletsDB:                walletsDB,
-		billingDB:                billingDB,
-		projectsDB:               projectsDB,
-		usageDB:                  usageDB,
-		stripeClient:             stripeClient,
-		coinPayments:             coinPaymentsClient,
-		StorageMBMonthPriceCents: storageMBMonthPriceCents,
-		EgressMBPriceCents:       egressMBPriceCents,
-		SegmentMonthPriceCents:   segmentMonthPriceCents,
-		BonusRate:                bonusRate,
-		StripeFreeTierCouponID:   config.StripeFreeTierCouponID,
-		AutoAdvance:              config.AutoAdvance,
-		listingLimit:             config.ListingLimit,
-		nowFn:                    time.Now,
+		log:                    log,
+		db:                     db,
+		walletsDB:              walletsDB,
+		billingDB:              billingDB,
+		projectsDB:             projectsDB,
+		usageDB:                usageDB,
+		stripeClient:           stripeClient,
+		coinPayments:           coinPaymentsClient,
+		pricing:                pricing.NewService(log.Named("pricing"), pricePlans, defaultPrices),
+		BonusRate:              bonusRate,
+		StripeFreeTierCouponID: config.StripeFreeTierCouponID,
+		AutoAdvance:            config.AutoAdvance,
+		BucketLineItems:        config.BucketLineItems,
+		minTopUpAmount:         config.MinTopUpAmount,
+		maxTopUpAmount:         config.MaxTopUpAmount,
+		listingLimit:           config.ListingLimit,
+		nowFn:                  time.Now,
 	}, nil
 }
 
+// Pricing returns the service which resolves the prices of users.
+func (service *Service) Pricing() *pricing.Service {
+	return service.pricing
+}
+
 // Accounts exposes all needed functionality to manage payment accounts.
 func (service *Service) Accounts() payments.Accounts {
 	return &accounts{service: service}
@@ -720,7 +725,12 @@ func (service *Service) applyProjectRecords(ctx context.Context, records []Proje
 			return errs.Wrap(err)
 		}
 
-		if err = service.createInvoiceItems(ctx, cusID, proj.Name, record); err != nil {
+		prices, err := service.pricing.Prices(ctx, proj.OwnerID, proj.UserAgent)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		if err = service.createInvoiceItems(ctx, cusID, proj.Name, record, prices); err != nil {
 			return errs.Wrap(err)
 		}
 	}
@@ -729,14 +739,24 @@ func (service *Service) applyProjectRecords(ctx context.Context, records []Proje
 }
 
 // createInvoiceItems consumes invoice project record and creates invoice line items for stripe customer.
-func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName string, record ProjectRecord) (err error) {
+func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName string, record ProjectRecord, prices pricing.Prices) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err = service.db.ProjectRecords().Consume(ctx, record.ID); err != nil {
 		return err
 	}
 
-	items := service.InvoiceItemsFromProjectRecord(projName, record)
+	var items []*stripe.InvoiceItemParams
+	if service.BucketLineItems && prices.IsFlat() {
+		rollups, err := service.usageDB.GetBucketUsageRollups(ctx, record.ProjectID, record.PeriodStart, record.PeriodEnd)
+		if err != nil {
+			return err
+		}
+		items = service.InvoiceItemsFromBucketUsage(projName, record, rollups, prices)
+	} else {
+		items = service.InvoiceItemsFromProjectRecord(projName, record, prices)
+	}
+
 	for _, item := range items {
 		item.Currency = stripe.String(string(stripe.CurrencyUSD))
 		item.Customer = stripe.String(cusID)
@@ -752,32 +772,218 @@ func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName
 }
 
 // InvoiceItemsFromProjectRecord calculates Stripe invoice item from project record.
-func (service *Service) InvoiceItemsFromProjectRecord(projName string, record ProjectRecord) (result []*stripe.InvoiceItemParams) {
-	projectItem := &stripe.InvoiceItemParams{}
-	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Segment Storage (MB-Month)", projName))
-	projectItem.Quantity = stripe.Int64(storageMBMonthDecimal(record.Storage).IntPart())
-	storagePrice, _ := service.StorageMBMonthPriceCents.Float64()
-	projectItem.UnitAmountDecimal = stripe.Float64(storagePrice)
-	result = append(result, projectItem)
-
-	projectItem = &stripe.InvoiceItemParams{}
-	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Egress Bandwidth (MB)", projName))
-	projectItem.Quantity = stripe.Int64(egressMBDecimal(record.Egress).IntPart())
-	egressPrice, _ := service.EgressMBPriceCents.Float64()
-	projectItem.UnitAmountDecimal = stripe.Float64(egressPrice)
-	result = append(result, projectItem)
-
-	projectItem = &stripe.InvoiceItemParams{}
-	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Segment Fee (Segment-Month)", projName))
-	projectItem.Quantity = stripe.Int64(segmentMonthDecimal(record.Segments).IntPart())
-	segmentPrice, _ := service.SegmentMonthPriceCents.Float64()
-	projectItem.UnitAmountDecimal = stripe.Float64(segmentPrice)
-	result = append(result, projectItem)
+//
+// With volume tiers, there is an item for every tier the usage reaches.
+func (service *Service) InvoiceItemsFromProjectRecord(projName string, record ProjectRecord, prices pricing.Prices) (result []*stripe.InvoiceItemParams) {
+	kinds := []struct {
+		description string
+		tiered      bool
+		items       []pricing.Item
+	}{
+		{
+			description: "Segment Storage (MB-Month)",
+			tiered:      len(prices.Storage) > 1,
+			items:       prices.StorageItems(storageMBMonthDecimal(record.Storage).IntPart()),
+		},
+		{
+			description: "Egress Bandwidth (MB)",
+			tiered:      len(prices.Egress) > 1,
+			items:       prices.EgressItems(egressMBDecimal(record.Egress).IntPart()),
+		},
+		{
+			description: "Segment Fee (Segment-Month)",
+			tiered:      len(prices.Segment) > 1,
+			items:       prices.SegmentItems(segmentMonthDecimal(record.Segments).IntPart()),
+		},
+	}
+
+	for _, kind := range kinds {
+		for i, tierItem := range kind.items {
+			description := fmt.Sprintf("Project %s - %s", projName, kind.description)
+			if kind.tiered {
+				description += fmt.Sprintf(" - Tier %d", i+1)
+			}
+
+			projectItem := &stripe.InvoiceItemParams{}
+			projectItem.Description = stripe.String(description)
+			projectItem.Quantity = stripe.Int64(tierItem.Quantity)
+			unitAmount, _ := tierItem.UnitPriceCents.Float64()
+			projectItem.UnitAmountDecimal = stripe.Float64(unitAmount)
+			result = append(result, projectItem)
+		}
+	}
 	service.log.Info("invoice items", zap.Any("result", result))
 
 	return result
 }
 
+// InvoiceItemsFromBucketUsage calculates Stripe invoice items for every bucket of the project record.
+//
+// Bucket quantities are rounded down and the difference to the project
+// quantity is billed as a separate item, so that the invoice total is the
+// same as with project line items. When the bucket usage exceeds the project
+// record, project line items are returned instead. With volume tiers the
+// price of the project record is split between the buckets, see
+// tieredInvoiceItemsFromBucketUsage.
+func (service *Service) InvoiceItemsFromBucketUsage(projName string, record ProjectRecord, rollups []accounting.BucketUsageRollup, prices pricing.Prices) (result []*stripe.InvoiceItemParams) {
+	buckets := make([]bucketUsage, 0, len(rollups))
+	for _, rollup := range rollups {
+		buckets = append(buckets, bucketUsageFromRollup(rollup))
+	}
+	sort.Slice(buckets, func(i, k int) bool {
+		return buckets[i].Name < buckets[k].Name
+	})
+
+	project := bucketUsage{
+		Storage:  record.Storage,
+		Egress:   record.Egress,
+		Segments: record.Segments,
+	}
+
+	if !prices.IsFlat() {
+		return tieredInvoiceItemsFromBucketUsage(projName, record, project, buckets, prices)
+	}
+
+	kinds := []struct {
+		description string
+		price       decimal.Decimal
+		quantity    func(usage bucketUsage) decimal.Decimal
+	}{
+		{
+			description: "Segment Storage (MB-Month)",
+			price:       prices.StorageItems(0)[0].UnitPriceCents,
+			quantity: func(usage bucketUsage) decimal.Decimal {
+				return decimal.NewFromFloat(usage.Storage).Shift(-6).Div(decimal.NewFromInt(hoursPerMonth))
+			},
+		},
+		{
+			description: "Egress Bandwidth (MB)",
+			price:       prices.EgressItems(0)[0].UnitPriceCents,
+			quantity: func(usage bucketUsage) decimal.Decimal {
+				return decimal.NewFromInt(usage.Egress).Shift(-6)
+			},
+		},
+		{
+			description: "Segment Fee (Segment-Month)",
+			price:       prices.SegmentItems(0)[0].UnitPriceCents,
+			quantity: func(usage bucketUsage) decimal.Decimal {
+				return decimal.NewFromFloat(usage.Segments).Div(decimal.NewFromInt(hoursPerMonth))
+			},
+		},
+	}
+
+	for _, kind := range kinds {
+		unitAmount, _ := kind.price.Float64()
+		remaining := kind.quantity(project).Round(0).IntPart()
+
+		for _, bucket := range buckets {
+			quantity := kind.quantity(bucket).Floor().IntPart()
+			if quantity == 0 {
+				continue
+			}
+			remaining -= quantity
+
+			item := &stripe.InvoiceItemParams{}
+			item.Description = stripe.String(fmt.Sprintf("Project %s - Bucket %s - %s", projName, bucket.Name, kind.description))
+			item.Quantity = stripe.Int64(quantity)
+			item.UnitAmountDecimal = stripe.Float64(unitAmount)
+			item.AddMetadata("bucketName", bucket.Name)
+			result = append(result, item)
+		}
+
+		if remaining < 0 {
+			service.log.Warn("bucket usage exceeds project record, using project line items",
+				zap.Stringer("Project ID", record.ProjectID), zap.Stringer("Record ID", record.ID))
+			return service.InvoiceItemsFromProjectRecord(projName, record, prices)
+		}
+
+		if remaining > 0 {
+			// usage of deleted buckets and rounding.
+			item := &stripe.InvoiceItemParams{}
+			item.Description = stripe.String(fmt.Sprintf("Project %s - Other Usage - %s", projName, kind.description))
+			item.Quantity = stripe.Int64(remaining)
+			item.UnitAmountDecimal = stripe.Float64(unitAmount)
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// tieredInvoiceItemsFromBucketUsage creates an item with the share of every
+// bucket in the price of the project record, because the volume tiers apply to
+// the usage of the whole project. The usage of deleted buckets and the
+// differences to the project record are billed as other usage.
+func tieredInvoiceItemsFromBucketUsage(projName string, record ProjectRecord, project bucketUsage, buckets []bucketUsage, prices pricing.Prices) (result []*stripe.InvoiceItemParams) {
+	other := project
+	for _, bucket := range buckets {
+		other.Storage -= bucket.Storage
+		other.Egress -= bucket.Egress
+		other.Segments -= bucket.Segments
+	}
+	other.Storage = math.Max(other.Storage, 0)
+	if other.Egress < 0 {
+		other.Egress = 0
+	}
+	other.Segments = math.Max(other.Segments, 0)
+
+	usages := append(append([]bucketUsage(nil), buckets...), other)
+	split := splitProjectUsagePrice(calculateProjectUsagePrice(prices, record.Egress, record.Storage, record.Segments), usages)
+
+	kinds := []struct {
+		description string
+		price       func(price projectUsagePrice) decimal.Decimal
+	}{
+		{"Segment Storage", func(price projectUsagePrice) decimal.Decimal { return price.Storage }},
+		{"Egress Bandwidth", func(price projectUsagePrice) decimal.Decimal { return price.Egress }},
+		{"Segment Fee", func(price projectUsagePrice) decimal.Decimal { return price.Segments }},
+	}
+
+	for _, kind := range kinds {
+		for i, usage := range usages {
+			cents := kind.price(split[i])
+			if cents.IsZero() {
+				continue
+			}
+			unitAmount, _ := cents.Float64()
+
+			item := &stripe.InvoiceItemParams{}
+			item.Quantity = stripe.Int64(1)
+			item.UnitAmountDecimal = stripe.Float64(unitAmount)
+			if i < len(buckets) {
+				item.Description = stripe.String(fmt.Sprintf("Project %s - Bucket %s - %s", projName, usage.Name, kind.description))
+				item.AddMetadata("bucketName", usage.Name)
+			} else {
+				item.Description = stripe.String(fmt.Sprintf("Project %s - Other Usage - %s", projName, kind.description))
+			}
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// bucketUsage holds bucket usage in the same units as project records.
+type bucketUsage struct {
+	Name string
+	// Storage is in byte-hours.
+	Storage float64
+	// Egress is in bytes.
+	Egress int64
+	// Segments is in segment-hours.
+	Segments float64
+}
+
+// bucketUsageFromRollup converts bucket usage rollup to the units of project records.
+func bucketUsageFromRollup(rollup accounting.BucketUsageRollup) bucketUsage {
+	return bucketUsage{
+		Name:     rollup.BucketName,
+		Storage:  rollup.TotalStoredData * float64(memory.GB),
+		Egress:   int64(math.Round(rollup.GetEgress * float64(memory.GB))),
+		Segments: rollup.TotalSegments,
+	}
+}
+
 // ApplyFreeTierCoupons iterates through all customers in Stripe. For each customer,
 // if that customer does not currently have a Stripe coupon, the free tier Stripe coupon
 // is applied.
@@ -975,12 +1181,80 @@ func (price projectUsagePrice) TotalInt64() int64 {
 }
 
 // calculateProjectUsagePrice calculate project usage price.
-func (service *Service) calculateProjectUsagePrice(egress int64, storage, segments float64) projectUsagePrice {
+func calculateProjectUsagePrice(prices pricing.Prices, egress int64, storage, segments float64) projectUsagePrice {
 	return projectUsagePrice{
-		Storage:  service.StorageMBMonthPriceCents.Mul(storageMBMonthDecimal(storage)).Round(0),
-		Egress:   service.EgressMBPriceCents.Mul(egressMBDecimal(egress)).Round(0),
-		Segments: service.SegmentMonthPriceCents.Mul(segmentMonthDecimal(segments)).Round(0),
+		Storage:  pricing.Total(prices.StorageItems(storageMBMonthDecimal(storage).IntPart())).Round(0),
+		Egress:   pricing.Total(prices.EgressItems(egressMBDecimal(egress).IntPart())).Round(0),
+		Segments: pricing.Total(prices.SegmentItems(segmentMonthDecimal(segments).IntPart())).Round(0),
+	}
+}
+
+// splitProjectUsagePrice splits the price of the project usage between the
+// buckets in proportion to their usage, so that the volume tiers apply once to
+// the usage of the whole project. The bucket prices add up to the project price.
+func splitProjectUsagePrice(price projectUsagePrice, buckets []bucketUsage) []projectUsagePrice {
+	storage := make([]decimal.Decimal, len(buckets))
+	egress := make([]decimal.Decimal, len(buckets))
+	segments := make([]decimal.Decimal, len(buckets))
+	for i, bucket := range buckets {
+		storage[i] = decimal.NewFromFloat(bucket.Storage)
+		egress[i] = decimal.NewFromInt(bucket.Egress)
+		segments[i] = decimal.NewFromFloat(bucket.Segments)
+	}
+	storage = splitCents(price.Storage, storage)
+	egress = splitCents(price.Egress, egress)
+	segments = splitCents(price.Segments, segments)
+
+	split := make([]projectUsagePrice, len(buckets))
+	for i := range split {
+		split[i] = projectUsagePrice{
+			Storage:  storage[i],
+			Egress:   egress[i],
+			Segments: segments[i],
+		}
+	}
+	return split
+}
+
+// splitCents splits the whole cents in proportion to the weights. The shares
+// are rounded down and the remaining cents go to the largest remainders.
+func splitCents(cents decimal.Decimal, weights []decimal.Decimal) []decimal.Decimal {
+	shares := make([]decimal.Decimal, len(weights))
+
+	sum := decimal.Zero
+	for _, weight := range weights {
+		sum = sum.Add(weight)
+	}
+	if sum.IsZero() {
+		for i := range shares {
+			shares[i] = decimal.Zero
+		}
+		return shares
+	}
+
+	remainders := make([]decimal.Decimal, len(weights))
+	order := make([]int, len(weights))
+	remaining := cents
+	for i, weight := range weights {
+		exact := cents.Mul(weight).DivRound(sum, 16)
+		shares[i] = exact.Floor()
+		remainders[i] = exact.Sub(shares[i])
+		remaining = remaining.Sub(shares[i])
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, k int) bool {
+		return remainders[order[i]].GreaterThan(remainders[order[k]])
+	})
+	for _, i := range order {
+		if !remaining.IsPositive() {
+			break
+		}
+		shares[i] = shares[i].Add(decimal.NewFromInt(1))
+		remaining = remaining.Sub(decimal.NewFromInt(1))
 	}
+
+	return shares
 }
 
 // SetNow allows tests to have the Service act as if the current time is whatever
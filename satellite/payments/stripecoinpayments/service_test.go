@@ -8,7 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72"
 	"go.uber.org/zap"
 
 	"storj.io/common/memory"
@@ -23,6 +25,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/monetary"
+	"storj.io/storj/satellite/payments/pricing"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 )
 
@@ -286,7 +289,8 @@ func TestService_InvoiceItemsFromProjectRecord(t *testing.T) {
 				Segments: tc.Segments,
 			}
 
-			items := satellite.API.Payments.StripeService.InvoiceItemsFromProjectRecord("project name", record)
+			service := satellite.API.Payments.StripeService
+			items := service.InvoiceItemsFromProjectRecord("project name", record, service.Pricing().Defaults())
 
 			require.Equal(t, tc.StorageQuantity, *items[0].Quantity)
 			require.Equal(t, expectedStoragePrice, *items[0].UnitAmountDecimal)
@@ -300,6 +304,70 @@ func TestService_InvoiceItemsFromProjectRecord(t *testing.T) {
 	})
 }
 
+func TestService_InvoiceItemsFromBucketUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		service := planet.Satellites[0].API.Payments.StripeService
+
+		record := stripecoinpayments.ProjectRecord{
+			Storage:  20000000000, // Byte-Hours, 28 Megabyte-Months
+			Egress:   3 * memory.GB.Int64(),
+			Segments: 1440, // Segment-Hours, 2 Segment-Months
+		}
+
+		rollups := []accounting.BucketUsageRollup{
+			{BucketName: "b", TotalStoredData: 9.5, GetEgress: 2, TotalSegments: 720},
+			{BucketName: "a", TotalStoredData: 10, GetEgress: 1, TotalSegments: 720},
+		}
+
+		type item struct {
+			Description string
+			Quantity    int64
+		}
+		var items []item
+		for _, params := range service.InvoiceItemsFromBucketUsage("project", record, rollups, service.Pricing().Defaults()) {
+			items = append(items, item{*params.Description, *params.Quantity})
+		}
+
+		// bucket quantities are rounded down and the remainder is billed separately.
+		require.Equal(t, []item{
+			{"Project project - Bucket a - Segment Storage (MB-Month)", 13},
+			{"Project project - Bucket b - Segment Storage (MB-Month)", 13},
+			{"Project project - Other Usage - Segment Storage (MB-Month)", 2},
+			{"Project project - Bucket a - Egress Bandwidth (MB)", 1000},
+			{"Project project - Bucket b - Egress Bandwidth (MB)", 2000},
+			{"Project project - Bucket a - Segment Fee (Segment-Month)", 1},
+			{"Project project - Bucket b - Segment Fee (Segment-Month)", 1},
+		}, items)
+
+		// bucket usage exceeding the project record falls back to project items.
+		rollups[0].GetEgress = 5
+		projectItems := service.InvoiceItemsFromBucketUsage("project", record, rollups, service.Pricing().Defaults())
+		require.Len(t, projectItems, 3)
+		require.Equal(t, "Project project - Egress Bandwidth (MB)", *projectItems[1].Description)
+		require.Equal(t, int64(3000), *projectItems[1].Quantity)
+
+		// with volume tiers the project price is split between the buckets.
+		prices := service.Pricing().Defaults()
+		prices.Egress = pricing.Tiers{
+			{From: 0, Price: decimal.NewFromInt(7000)},
+			{From: 1, Price: decimal.NewFromInt(1000)},
+		}
+		rollups[0].GetEgress = 2
+
+		total := func(items []*stripe.InvoiceItemParams) (total float64) {
+			for _, item := range items {
+				total += float64(*item.Quantity) * *item.UnitAmountDecimal
+			}
+			return total
+		}
+		bucketItems := service.InvoiceItemsFromBucketUsage("project", record, rollups, prices)
+		require.Equal(t, "Project project - Bucket a - Segment Storage", *bucketItems[0].Description)
+		require.InDelta(t, total(service.InvoiceItemsFromProjectRecord("project", record, prices)), total(bucketItems), 1e-6)
+	})
+}
+
 func TestService_InvoiceItemsFromZeroTokenBalance(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
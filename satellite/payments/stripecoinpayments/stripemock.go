@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -80,6 +81,7 @@ type mockStripeState struct {
 	customerBalanceTransactions *mockCustomerBalanceTransactions
 	charges                     *mockCharges
 	promoCodes                  *mockPromoCodes
+	paymentIntents              *mockPaymentIntents
 }
 
 type mockStripeClient struct {
@@ -108,16 +110,24 @@ func NewStripeMock(id storj.NodeID, customersDB CustomersDB, usersDB console.Use
 
 	state, ok := mocks.m[id]
 	if !ok {
+		invoiceItems := &mockInvoiceItems{}
+		paymentMethods := newMockPaymentMethods()
+		charges := &mockCharges{}
 		state = &mockStripeState{
 			customers:                   &mockCustomersState{},
-			paymentMethods:              newMockPaymentMethods(),
-			invoices:                    &mockInvoices{},
-			invoiceItems:                &mockInvoiceItems{},
+			paymentMethods:              paymentMethods,
+			invoices:                    newMockInvoices(invoiceItems),
+			invoiceItems:                invoiceItems,
 			customerBalanceTransactions: newMockCustomerBalanceTransactions(),
-			charges:                     &mockCharges{},
+			charges:                     charges,
 			promoCodes: &mockPromoCodes{
 				promoCodes: testPromoCodes,
 			},
+			paymentIntents: &mockPaymentIntents{
+				paymentMethods: paymentMethods,
+				charges:        charges,
+				intents:        make(map[string]*stripe.PaymentIntent),
+			},
 		}
 		mocks.m[id] = state
 	}
@@ -164,6 +174,10 @@ func (m *mockStripeClient) PromoCodes() StripePromoCodes {
 	return m.promoCodes
 }
 
+func (m *mockStripeClient) PaymentIntents() StripePaymentIntents {
+	return m.paymentIntents
+}
+
 type mockCustomers struct {
 	customersDB CustomersDB
 	usersDB     console.Users
@@ -416,21 +430,101 @@ func (m *mockPaymentMethods) Detach(id string, params *stripe.PaymentMethodDetac
 }
 
 type mockInvoices struct {
+	invoices     []*stripe.Invoice
+	invoiceItems *mockInvoiceItems
+}
+
+func newMockInvoices(invoiceItems *mockInvoiceItems) *mockInvoices {
+	return &mockInvoices{
+		invoiceItems: invoiceItems,
+	}
 }
 
+// New creates a draft invoice from the pending invoice items of the customer.
 func (m *mockInvoices) New(params *stripe.InvoiceParams) (*stripe.Invoice, error) {
-	return nil, nil
+	mocks.Lock()
+	defer mocks.Unlock()
+
+	invoice := &stripe.Invoice{
+		ID:       "in_" + testrand.BucketName(),
+		Customer: &stripe.Customer{ID: *params.Customer},
+		Status:   stripe.InvoiceStatusDraft,
+		Lines:    &stripe.InvoiceLineList{},
+		Created:  time.Now().Unix(),
+		Metadata: params.Metadata,
+	}
+	if params.Description != nil {
+		invoice.Description = *params.Description
+	}
+	if params.AutoAdvance != nil {
+		invoice.AutoAdvance = *params.AutoAdvance
+	}
+
+	for _, item := range m.invoiceItems.items {
+		if item.Customer.ID != invoice.Customer.ID || item.Invoice != nil {
+			continue
+		}
+		item.Invoice = invoice
+		invoice.Lines.Data = append(invoice.Lines.Data, &stripe.InvoiceLine{
+			ID:          item.ID,
+			Amount:      item.Amount,
+			Description: item.Description,
+			Metadata:    item.Metadata,
+		})
+		invoice.Total += item.Amount
+	}
+
+	if len(invoice.Lines.Data) == 0 {
+		return nil, &stripe.Error{Code: stripe.ErrorCodeInvoiceNoCustomerLineItems}
+	}
+
+	invoice.Subtotal = invoice.Total
+	if invoice.Total > 0 {
+		invoice.AmountDue = invoice.Total
+	}
+
+	m.invoices = append(m.invoices, invoice)
+	return invoice, nil
 }
 
 func (m *mockInvoices) List(listParams *stripe.InvoiceListParams) *invoice.Iter {
-	return &invoice.Iter{Iter: stripe.GetIter(listParams, mockEmptyQuery)}
+	mocks.Lock()
+	defer mocks.Unlock()
+
+	var ret []interface{}
+	for _, invoice := range m.invoices {
+		if listParams.Customer != nil && invoice.Customer.ID != *listParams.Customer {
+			continue
+		}
+		if listParams.Status != nil && string(invoice.Status) != *listParams.Status {
+			continue
+		}
+		ret = append(ret, invoice)
+	}
+
+	query := stripe.Query(func(*stripe.Params, *form.Values) ([]interface{}, stripe.ListContainer, error) {
+		return ret, newListContainer(&stripe.ListMeta{TotalCount: uint32(len(ret))}), nil
+	})
+
+	return &invoice.Iter{Iter: stripe.GetIter(listParams, query)}
 }
 
 func (m *mockInvoices) FinalizeInvoice(id string, params *stripe.InvoiceFinalizeParams) (*stripe.Invoice, error) {
-	return nil, nil
+	mocks.Lock()
+	defer mocks.Unlock()
+
+	for _, invoice := range m.invoices {
+		if invoice.ID == id && invoice.Status == stripe.InvoiceStatusDraft {
+			invoice.Status = stripe.InvoiceStatusOpen
+			return invoice, nil
+		}
+	}
+
+	return nil, &stripe.Error{HTTPStatusCode: http.StatusNotFound, Code: stripe.ErrorCodeResourceMissing}
 }
 
 type mockInvoiceItems struct {
+	items []*stripe.InvoiceItem
 }
 
 func (m *mockInvoiceItems) Update(id string, params *stripe.InvoiceItemParams) (*stripe.InvoiceItem, error) {
@@ -441,12 +535,56 @@ func (m *mockInvoiceItems) Del(id string, params *stripe.InvoiceItemParams) (*st
 	return nil, nil
 }
 
+// New creates a pending invoice item, which is added to the next invoice of the customer.
 func (m *mockInvoiceItems) New(params *stripe.InvoiceItemParams) (*stripe.InvoiceItem, error) {
-	return nil, nil
+	mocks.Lock()
+	defer mocks.Unlock()
+
+	item := &stripe.InvoiceItem{
+		ID:       "ii_" + testrand.BucketName(),
+		Customer: &stripe.Customer{ID: *params.Customer},
+		Quantity: 1,
+		Metadata: params.Metadata,
+		Date:     time.Now().Unix(),
+	}
+	if params.Description != nil {
+		item.Description = *params.Description
+	}
+	if params.Quantity != nil {
+		item.Quantity = *params.Quantity
+	}
+	switch {
+	case params.Amount != nil:
+		item.Amount = *params.Amount
+	case params.UnitAmount != nil:
+		item.UnitAmount = *params.UnitAmount
+		item.Amount = item.UnitAmount * item.Quantity
+	}
+
+	m.items = append(m.items, item)
+	return item, nil
 }
 
 func (m *mockInvoiceItems) List(listParams *stripe.InvoiceItemListParams) *invoiceitem.Iter {
-	return &invoiceitem.Iter{Iter: stripe.GetIter(listParams, mockEmptyQuery)}
+	mocks.Lock()
+	defer mocks.Unlock()
+
+	var ret []interface{}
+	for _, item := range m.items {
+		if listParams.Customer != nil && item.Customer.ID != *listParams.Customer {
+			continue
+		}
+		if listParams.Pending != nil && *listParams.Pending != (item.Invoice == nil) {
+			continue
+		}
+		ret = append(ret, item)
+	}
+
+	query := stripe.Query(func(*stripe.Params, *form.Values) ([]interface{}, stripe.ListContainer, error) {
+		return ret, newListContainer(&stripe.ListMeta{TotalCount: uint32(len(ret))}), nil
+	})
+
+	return &invoiceitem.Iter{Iter: stripe.GetIter(listParams, query)}
 }
 
 type mockCustomerBalanceTransactions struct {
@@ -501,10 +639,91 @@ func (m *mockCustomerBalanceTransactions) List(listParams *stripe.CustomerBalanc
 }
 
 type mockCharges struct {
+	charges []*stripe.Charge
 }
 
 func (m *mockCharges) List(listParams *stripe.ChargeListParams) *charge.Iter {
-	return &charge.Iter{Iter: stripe.GetIter(listParams, mockEmptyQuery)}
+	mocks.Lock()
+	defer mocks.Unlock()
+
+	var ret []interface{}
+	for _, charge := range m.charges {
+		if listParams.Customer != nil && charge.Customer.ID != *listParams.Customer {
+			continue
+		}
+		ret = append(ret, charge)
+	}
+
+	query := stripe.Query(func(*stripe.Params, *form.Values) ([]interface{}, stripe.ListContainer, error) {
+		return ret, newListContainer(&stripe.ListMeta{TotalCount: uint32(len(ret))}), nil
+	})
+
+	return &charge.Iter{Iter: stripe.GetIter(listParams, query)}
+}
+
+type mockPaymentIntents struct {
+	paymentMethods *mockPaymentMethods
+	charges        *mockCharges
+	// intents are the created payment intents by idempotency key.
+	intents map[string]*stripe.PaymentIntent
+}
+
+// New creates and immediately confirms a payment intent, which charges the payment method.
+// A request with the idempotency key of an earlier one returns the earlier payment intent.
+func (m *mockPaymentIntents) New(params *stripe.PaymentIntentParams) (*stripe.PaymentIntent, error) {
+	mocks.Lock()
+	defer mocks.Unlock()
+
+	if params.Customer == nil || params.PaymentMethod == nil || params.Amount == nil {
+		return nil, &stripe.Error{HTTPStatusCode: http.StatusBadRequest, Code: stripe.ErrorCodeParameterMissing}
+	}
+
+	if params.IdempotencyKey != nil {
+		if intent, ok := m.intents[*params.IdempotencyKey]; ok {
+			return intent, nil
+		}
+	}
+
+	card := &stripe.ChargePaymentMethodDetailsCard{Brand: "mastercard", Last4: "4444"}
+	for _, method := range m.paymentMethods.attached[*params.Customer] {
+		if method.ID == *params.PaymentMethod && method.Card != nil {
+			card = &stripe.ChargePaymentMethodDetailsCard{
+				Brand: stripe.PaymentMethodCardBrand(method.Card.Brand),
+				Last4: method.Card.Last4,
+			}
+		}
+	}
+
+	charge := &stripe.Charge{
+		ID:            "ch_" + testrand.BucketName(),
+		Amount:        *params.Amount,
+		Customer:      &stripe.Customer{ID: *params.Customer},
+		PaymentMethod: *params.PaymentMethod,
+		PaymentMethodDetails: &stripe.ChargePaymentMethodDetails{
+			Type: stripe.ChargePaymentMethodDetailsTypeCard,
+			Card: card,
+		},
+		Paid:    true,
+		Status:  string(stripe.PaymentIntentStatusSucceeded),
+		Created: time.Now().Unix(),
+	}
+	m.charges.charges = append(m.charges.charges, charge)
+
+	intent := &stripe.PaymentIntent{
+		ID:            "pi_" + testrand.BucketName(),
+		Amount:        charge.Amount,
+		Customer:      charge.Customer,
+		PaymentMethod: &stripe.PaymentMethod{ID: charge.PaymentMethod},
+		Status:        stripe.PaymentIntentStatusSucceeded,
+		Charges:       &stripe.ChargeList{Data: []*stripe.Charge{charge}},
+		Metadata:      params.Metadata,
+		Created:       charge.Created,
+	}
+	if params.IdempotencyKey != nil {
+		m.intents[*params.IdempotencyKey] = intent
+	}
+
+	return intent, nil
 }
 
 type mockPromoCodes struct {
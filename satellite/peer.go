@@ -36,12 +36,15 @@ import (
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/emailreminders"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/console/usagealerts"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
+	"storj.io/storj/satellite/metabase/consistency"
+	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
@@ -53,9 +56,11 @@ import (
 	"storj.io/storj/satellite/overlay/straynodes"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/paymentsconfig"
+	"storj.io/storj/satellite/payments/pricing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/evacuation"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/reputation"
@@ -126,6 +131,14 @@ type DB interface {
 	NodeAPIVersion() nodeapiversion.DB
 	// StorjscanPayments stores payments retrieved from storjscan.
 	StorjscanPayments() storjscan.PaymentsDB
+	// PricePlans stores price plans and their assignments to users and partners.
+	PricePlans() pricing.DB
+	// NodeEvacuations tracks the progress of moving all pieces off nodes.
+	NodeEvacuations() evacuation.DB
+	// SegmentLoopCheckpoints stores the progress of the segment loops.
+	SegmentLoopCheckpoints() segmentloop.CheckpointDB
+	// MetabaseConsistencyFindings stores the inconsistencies found in the metabase.
+	MetabaseConsistencyFindings() consistency.DB
 }
 
 // Config is the global config satellite.
@@ -145,9 +158,10 @@ type Config struct {
 
 	Reputation reputation.Config
 
-	Checker  checker.Config
-	Repairer repairer.Config
-	Audit    audit.Config
+	Checker        checker.Config
+	Repairer       repairer.Config
+	NodeEvacuation evacuation.Config
+	Audit          audit.Config
 
 	GarbageCollection   gc.Config
 	GarbageCollectionBF bloomfilter.Config
@@ -155,6 +169,8 @@ type Config struct {
 	ExpiredDeletion expireddeletion.Config
 	ZombieDeletion  zombiedeletion.Config
 
+	MetabaseConsistency consistency.Config
+
 	Tally            tally.Config
 	Rollup           rollup.Config
 	RollupArchive    rolluparchive.Config
@@ -169,6 +185,7 @@ type Config struct {
 	Console        consoleweb.Config
 	ConsoleAuth    consoleauth.Config
 	EmailReminders emailreminders.Config
+	UsageAlerts    usagealerts.Config
 
 	Version version_checker.Config
 
@@ -6,6 +6,7 @@ package checker
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -44,6 +45,7 @@ type Checker struct {
 	repairOverrides      RepairOverridesMap
 	nodeFailureRate      float64
 	repairQueueBatchSize int
+	attributeProjects    bool
 	Loop                 *sync2.Cycle
 }
 
@@ -60,6 +62,7 @@ func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, metabase *met
 		repairOverrides:      config.RepairOverrides.GetMap(),
 		nodeFailureRate:      config.NodeFailureRate,
 		repairQueueBatchSize: config.RepairQueueInsertBatchSize,
+		attributeProjects:    config.AttributeProjects,
 
 		Loop: sync2.NewCycle(config.Interval),
 	}
@@ -94,8 +97,12 @@ func (checker *Checker) getNodesEstimate(ctx context.Context) (int, error) {
 	return totalNumNodes, nil
 }
 
-func (checker *Checker) createInsertBuffer() *queue.InsertBuffer {
-	return queue.NewInsertBuffer(checker.repairQueue, checker.repairQueueBatchSize)
+func (checker *Checker) createInsertBuffer(attributeProjects bool) *queue.InsertBuffer {
+	buffer := queue.NewInsertBuffer(checker.repairQueue, checker.repairQueueBatchSize)
+	if attributeProjects {
+		buffer.SetProjectResolver(checker.metabase.GetStreamProjectIDs)
+	}
+	return buffer
 }
 
 // RefreshReliabilityCache forces refreshing node online status cache.
@@ -115,8 +122,29 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 
 	startTime := time.Now()
 
+	attributeProjects := checker.attributeProjects
+	if attributeProjects {
+		// resolving the projects without the index would scan the whole
+		// objects table for every batch.
+		exists, err := checker.metabase.HasStreamIDIndex(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if !exists {
+			checker.logger.Warn("not attributing injured segments to projects, the objects stream id index is missing, " +
+				"create it with 'satellite metabase create-stream-id-index'")
+			attributeProjects = false
+		}
+	}
+
+	newInsertBuffer := func() *queue.InsertBuffer {
+		return checker.createInsertBuffer(attributeProjects)
+	}
+
 	observer := &checkerObserver{
-		repairQueue:      checker.createInsertBuffer(),
+		cleanBefore:      startTime,
+		repairQueue:      newInsertBuffer(),
+		newInsertBuffer:  newInsertBuffer,
 		nodestate:        checker.nodestate,
 		statsCollector:   checker.statsCollector,
 		monStats:         aggregateStats{},
@@ -138,8 +166,9 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 		return Error.Wrap(err)
 	}
 
-	// remove all segments which were not seen as unhealthy by this checker iteration
-	healthyDeleted, err := checker.repairQueue.Clean(ctx, startTime)
+	// remove all segments which were not seen as unhealthy by this checker iteration.
+	// a resumed iteration started before startTime.
+	healthyDeleted, err := checker.repairQueue.Clean(ctx, observer.cleanBefore)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -170,13 +199,20 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 
 var remoteSegmentFunc = mon.Task()
 
-var _ segmentloop.Observer = (*checkerObserver)(nil)
+var (
+	_ segmentloop.PartitionedObserver  = (*checkerObserver)(nil)
+	_ segmentloop.CheckpointedObserver = (*checkerObserver)(nil)
+)
 
 // checkerObserver implements the metainfo loop Observer interface.
 //
 // architecture: Observer
 type checkerObserver struct {
+	// cleanBefore is the start of the checker iteration. The segments in the
+	// repair queue, which weren't updated since then, are healthy.
+	cleanBefore      time.Time
 	repairQueue      *queue.InsertBuffer
+	newInsertBuffer  func() *queue.InsertBuffer
 	nodestate        *ReliabilityCache
 	statsCollector   *statsCollector
 	monStats         aggregateStats // TODO(cam): once we verify statsCollector reports data correctly, remove this
@@ -217,6 +253,97 @@ func (obs *checkerObserver) LoopStarted(context.Context, segmentloop.LoopInfo) (
 	return nil
 }
 
+// Fork creates an observer for a range of a parallel loop, which has its own
+// repair queue buffer and iteration stats.
+func (obs *checkerObserver) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return &checkerObserver{
+		repairQueue:      obs.newInsertBuffer(),
+		newInsertBuffer:  obs.newInsertBuffer,
+		nodestate:        obs.nodestate,
+		statsCollector:   obs.statsCollector.fork(),
+		monStats:         aggregateStats{},
+		repairOverrides:  obs.repairOverrides,
+		nodeFailureRate:  obs.nodeFailureRate,
+		getNodesEstimate: obs.getNodesEstimate,
+		log:              obs.log,
+	}, nil
+}
+
+// Join flushes the repair queue buffer of a forked observer and adds its stats
+// to the stats of the iteration.
+func (obs *checkerObserver) Join(ctx context.Context, partial segmentloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*checkerObserver)
+	if !ok {
+		return Error.New("expected a fork of the checker observer, got %T", partial)
+	}
+
+	// the counters of the new segments are updated by the flush, so it needs
+	// to happen before the stats are joined.
+	if err := fork.repairQueue.Flush(ctx); err != nil {
+		return Error.Wrap(err)
+	}
+
+	obs.monStats.merge(&fork.monStats)
+	obs.statsCollector.join(fork.statsCollector)
+	return nil
+}
+
+// checkerState is the state of a checker iteration saved in a loop checkpoint.
+type checkerState struct {
+	CleanBefore    time.Time                 `json:"clean_before"`
+	Aggregates     aggregateState            `json:"aggregates"`
+	AggregatesByRS map[string]aggregateState `json:"aggregates_by_rs"`
+}
+
+// SaveState flushes the repair queue buffer, so the unhealthy segments of the
+// processed streams are in the repair queue, and saves the stats of the
+// iteration.
+func (obs *checkerObserver) SaveState(ctx context.Context) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := obs.repairQueue.Flush(ctx); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	state := checkerState{
+		CleanBefore:    obs.cleanBefore,
+		Aggregates:     obs.monStats.state(),
+		AggregatesByRS: make(map[string]aggregateState, len(obs.statsCollector.stats)),
+	}
+	for rs, stats := range obs.statsCollector.stats {
+		state.AggregatesByRS[rs] = stats.iterationAggregates.state()
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return [][]byte{data}, nil
+}
+
+// RestoreState restores the stats of an interrupted iteration.
+func (obs *checkerObserver) RestoreState(ctx context.Context, chunks [][]byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(chunks) != 1 {
+		return Error.New("invalid checker state")
+	}
+
+	var state checkerState
+	if err := json.Unmarshal(chunks[0], &state); err != nil {
+		return Error.Wrap(err)
+	}
+
+	obs.cleanBefore = state.CleanBefore
+	obs.monStats = *state.Aggregates.stats()
+	for rs, aggregates := range state.AggregatesByRS {
+		obs.statsCollector.getStatsByRS(rs).iterationAggregates = aggregates.stats()
+	}
+	return nil
+}
+
 func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
 	defer remoteSegmentFunc(&ctx)(&err)
 
@@ -271,7 +398,16 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 		return errs.Combine(Error.New("error getting missing pieces"), err)
 	}
 
+	// pieces which don't satisfy the placement need to be repaired too.
+	outOfPlacementPieces, err := obs.nodestate.OutOfPlacementPieces(ctx, segment.CreatedAt, segment.Pieces, segment.Placement)
+	if err != nil {
+		obs.monStats.remoteSegmentsFailedToCheck++
+		stats.iterationAggregates.remoteSegmentsFailedToCheck++
+		return errs.Combine(Error.New("error getting pieces out of placement"), err)
+	}
+
 	numHealthy := len(pieces) - len(missingPieces)
+	numHealthyInPlacement := numHealthy - len(outOfPlacementPieces)
 	mon.IntVal("checker_segment_total_count").Observe(int64(len(pieces))) //mon:locked
 	stats.segmentTotalCount.Observe(int64(len(pieces)))
 	mon.IntVal("checker_segment_healthy_count").Observe(int64(numHealthy)) //mon:locked
@@ -290,7 +426,7 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 	// we repair when the number of healthy pieces is less than or equal to the repair threshold and is greater or equal to
 	// minimum required pieces in redundancy
 	// except for the case when the repair and success thresholds are the same (a case usually seen during testing)
-	if numHealthy <= repairThreshold && numHealthy < successThreshold {
+	if numHealthyInPlacement <= repairThreshold && numHealthyInPlacement < successThreshold {
 		mon.FloatVal("checker_injured_segment_health").Observe(segmentHealth) //mon:locked
 		stats.injuredSegmentHealth.Observe(segmentHealth)
 		obs.monStats.remoteSegmentsNeedingRepair++
@@ -300,6 +436,7 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 			Position:      segment.Position,
 			UpdatedAt:     time.Now().UTC(),
 			SegmentHealth: segmentHealth,
+			Placement:     segment.Placement,
 		}, func() {
 			// Counters are increased after the queue has determined
 			// that the segment wasn't already queued for repair.
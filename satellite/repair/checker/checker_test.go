@@ -313,6 +313,60 @@ func TestIgnoringCopiedSegments(t *testing.T) {
 	})
 }
 
+func TestIdentifyInjuredSegmentsUnknownNodeAlias(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		checker := satellite.Repair.Checker
+		repairQueue := satellite.DB.RepairQueue()
+
+		checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		rs := storj.RedundancyScheme{
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+			ShareSize:      256,
+		}
+
+		err := planet.Uplinks[0].CreateBucket(ctx, satellite, "test-bucket")
+		require.NoError(t, err)
+
+		location := metabase.SegmentLocation{
+			ProjectID:  planet.Uplinks[0].Projects[0].ID,
+			BucketName: "test-bucket",
+			ObjectKey:  "unknown-alias",
+		}
+		streamID := insertSegment(ctx, t, planet, rs, location, createLostPieces(planet, rs), nil)
+
+		// replace the lost pieces with a piece on a node alias, which isn't in the database.
+		aliases, err := satellite.Metabase.DB.ListNodeAliases(ctx)
+		require.NoError(t, err)
+		aliasOf := make(map[storj.NodeID]metabase.NodeAlias)
+		for _, entry := range aliases {
+			aliasOf[entry.ID] = entry.Alias
+		}
+		aliasPieces := metabase.AliasPieces{
+			{Number: 0, Alias: aliasOf[planet.StorageNodes[0].ID()]},
+			{Number: 1, Alias: aliasOf[planet.StorageNodes[1].ID()]},
+			{Number: 2, Alias: 30000},
+		}
+		_, err = satellite.Metabase.DB.UnderlyingTagSQL().ExecContext(ctx,
+			`UPDATE segments SET remote_alias_pieces = $2 WHERE stream_id = $1`, streamID, aliasPieces)
+		require.NoError(t, err)
+
+		// the segment fails the loop instead of being checked without the unknown piece.
+		require.NoError(t, checker.IdentifyInjuredSegments(ctx))
+
+		count, err := repairQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+}
+
 func createPieces(planet *testplanet.Planet, rs storj.RedundancyScheme) metabase.Pieces {
 	pieces := make(metabase.Pieces, rs.OptimalShares)
 	for i := range pieces {
@@ -5,6 +5,7 @@ package checker
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/spacemonkeygo/monkit/v3"
 
@@ -15,6 +16,12 @@ import (
 // seen by the checker. These are chained into the monkit scope for
 // monitoring as they are initialized.
 type statsCollector struct {
+	// parent is the collector, which forked this collector for a range of a
+	// parallel loop. mu protects the stats of a parent, which are accessed by
+	// the forks concurrently.
+	parent *statsCollector
+	mu     sync.Mutex
+
 	stats map[string]*stats
 }
 
@@ -27,13 +34,46 @@ func newStatsCollector() *statsCollector {
 func (collector *statsCollector) getStatsByRS(rs string) *stats {
 	stats, ok := collector.stats[rs]
 	if !ok {
-		stats = newStats(rs)
-		mon.Chain(stats)
+		if collector.parent != nil {
+			stats = collector.parent.forkStatsByRS(rs)
+		} else {
+			stats = newStats(rs)
+			mon.Chain(stats)
+		}
 		collector.stats[rs] = stats
 	}
 	return stats
 }
 
+// forkStatsByRS returns a copy of the stats for a fork, which reports to the
+// same monkit metrics, but has its own iteration aggregates.
+func (collector *statsCollector) forkStatsByRS(rs string) *stats {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	forked := *collector.getStatsByRS(rs)
+	forked.iterationAggregates = new(aggregateStats)
+	return &forked
+}
+
+// fork creates a collector for a range of a parallel loop.
+func (collector *statsCollector) fork() *statsCollector {
+	return &statsCollector{
+		parent: collector,
+		stats:  make(map[string]*stats),
+	}
+}
+
+// join adds the iteration aggregates of a forked collector.
+func (collector *statsCollector) join(fork *statsCollector) {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	for rs, forked := range fork.stats {
+		collector.getStatsByRS(rs).iterationAggregates.merge(forked.iterationAggregates)
+	}
+}
+
 // collectAggregates transfers the iteration aggregates into the
 // respective stats monkit metrics at the end of each checker iteration.
 // iterationAggregates is then cleared.
@@ -90,6 +130,65 @@ type aggregateStats struct {
 	remoteSegmentsOverThreshold [5]int64
 }
 
+// merge adds the aggregates of other.
+func (aggregates *aggregateStats) merge(other *aggregateStats) {
+	aggregates.objectsChecked += other.objectsChecked
+	aggregates.remoteSegmentsChecked += other.remoteSegmentsChecked
+	aggregates.remoteSegmentsNeedingRepair += other.remoteSegmentsNeedingRepair
+	aggregates.newRemoteSegmentsNeedingRepair += other.newRemoteSegmentsNeedingRepair
+	aggregates.remoteSegmentsLost += other.remoteSegmentsLost
+	aggregates.remoteSegmentsFailedToCheck += other.remoteSegmentsFailedToCheck
+	for _, streamID := range other.objectsLost {
+		if !containsStreamID(aggregates.objectsLost, streamID) {
+			aggregates.objectsLost = append(aggregates.objectsLost, streamID)
+		}
+	}
+	for i := range aggregates.remoteSegmentsOverThreshold {
+		aggregates.remoteSegmentsOverThreshold[i] += other.remoteSegmentsOverThreshold[i]
+	}
+}
+
+// aggregateState is the serializable form of aggregateStats, which is saved
+// in loop checkpoints.
+type aggregateState struct {
+	ObjectsChecked                 int64       `json:"objects_checked"`
+	RemoteSegmentsChecked          int64       `json:"remote_segments_checked"`
+	RemoteSegmentsNeedingRepair    int64       `json:"remote_segments_needing_repair"`
+	NewRemoteSegmentsNeedingRepair int64       `json:"new_remote_segments_needing_repair"`
+	RemoteSegmentsLost             int64       `json:"remote_segments_lost"`
+	RemoteSegmentsFailedToCheck    int64       `json:"remote_segments_failed_to_check"`
+	ObjectsLost                    []uuid.UUID `json:"objects_lost"`
+	RemoteSegmentsOverThreshold    [5]int64    `json:"remote_segments_over_threshold"`
+}
+
+// state returns the serializable form of the aggregates.
+func (aggregates *aggregateStats) state() aggregateState {
+	return aggregateState{
+		ObjectsChecked:                 aggregates.objectsChecked,
+		RemoteSegmentsChecked:          aggregates.remoteSegmentsChecked,
+		RemoteSegmentsNeedingRepair:    aggregates.remoteSegmentsNeedingRepair,
+		NewRemoteSegmentsNeedingRepair: aggregates.newRemoteSegmentsNeedingRepair,
+		RemoteSegmentsLost:             aggregates.remoteSegmentsLost,
+		RemoteSegmentsFailedToCheck:    aggregates.remoteSegmentsFailedToCheck,
+		ObjectsLost:                    aggregates.objectsLost,
+		RemoteSegmentsOverThreshold:    aggregates.remoteSegmentsOverThreshold,
+	}
+}
+
+// stats returns the aggregates of the saved state.
+func (state aggregateState) stats() *aggregateStats {
+	return &aggregateStats{
+		objectsChecked:                 state.ObjectsChecked,
+		remoteSegmentsChecked:          state.RemoteSegmentsChecked,
+		remoteSegmentsNeedingRepair:    state.RemoteSegmentsNeedingRepair,
+		newRemoteSegmentsNeedingRepair: state.NewRemoteSegmentsNeedingRepair,
+		remoteSegmentsLost:             state.RemoteSegmentsLost,
+		remoteSegmentsFailedToCheck:    state.RemoteSegmentsFailedToCheck,
+		objectsLost:                    state.ObjectsLost,
+		remoteSegmentsOverThreshold:    state.RemoteSegmentsOverThreshold,
+	}
+}
+
 func newStats(rs string) *stats {
 	return &stats{
 		iterationAggregates:             new(aggregateStats),
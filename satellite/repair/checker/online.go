@@ -79,6 +79,39 @@ func (cache *ReliabilityCache) MissingPieces(ctx context.Context, created time.T
 	return unreliable, nil
 }
 
+// OutOfPlacementPieces returns the pieces on reliable nodes which don't satisfy
+// the node constraints of the placement.
+func (cache *ReliabilityCache) OutOfPlacementPieces(ctx context.Context, created time.Time, pieces metabase.Pieces, placement storj.PlacementConstraint) (_ []metabase.Piece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if placement == storj.EveryCountry {
+		return nil, nil
+	}
+
+	state, err := cache.loadFast(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+	numbers, err := cache.overlay.GetPiecesOutOfPlacement(ctx, pieces, placement)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var outOfPlacement []metabase.Piece
+	for _, number := range numbers {
+		for _, p := range pieces {
+			if p.Number != number {
+				continue
+			}
+			if _, ok := state.reliable[p.StorageNode]; ok {
+				outOfPlacement = append(outOfPlacement, p)
+			}
+			break
+		}
+	}
+	return outOfPlacement, nil
+}
+
 func (cache *ReliabilityCache) loadFast(ctx context.Context, validUpTo time.Time) (_ *reliabilityState, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -0,0 +1,161 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package evacuation
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error is the error class for this package.
+	Error = errs.Class("evacuation")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for node evacuations.
+type Config struct {
+	Interval    time.Duration `help:"how often to check for node evacuations to process" releaseDefault:"1m" devDefault:"10s" testDefault:"$TESTINTERVAL"`
+	BatchSize   int           `help:"number of segments scanned before the progress of an evacuation is saved" default:"5000" testDefault:"100"`
+	Concurrency int           `help:"number of segments evacuated concurrently" default:"5"`
+}
+
+// Repairer moves the piece of a segment off a node.
+type Repairer interface {
+	Evacuate(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, nodeID storj.NodeID) error
+}
+
+// Chore moves the pieces off the nodes which are being evacuated.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	db       DB
+	metabase *metabase.DB
+	repairer Repairer
+	config   Config
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new node evacuation chore.
+func NewChore(log *zap.Logger, db DB, metabase *metabase.DB, repairer Repairer, config Config) *Chore {
+	return &Chore{
+		log:      log,
+		db:       db,
+		metabase: metabase,
+		repairer: repairer,
+		config:   config,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the node evacuation chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.processActive(ctx); err != nil {
+			chore.log.Error("failed to process node evacuations", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// processActive processes all evacuations in progress.
+func (chore *Chore) processActive(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	evacuations, err := chore.db.ListActive(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, evacuation := range evacuations {
+		if err := chore.process(ctx, evacuation); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			chore.log.Error("failed to evacuate node", zap.Stringer("Node ID", evacuation.NodeID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// process evacuates the node batch by batch until all segments are scanned
+// or the evacuation is cancelled.
+func (chore *Chore) process(ctx context.Context, evacuation Evacuation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cursor := evacuation.Cursor
+	for {
+		result, err := chore.metabase.ListSegmentsOnNode(ctx, metabase.ListSegmentsOnNode{
+			NodeID: evacuation.NodeID,
+			Cursor: cursor,
+			Limit:  chore.config.BatchSize,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		var failed int64
+		limiter := sync2.NewLimiter(chore.config.Concurrency)
+		for _, segment := range result.Segments {
+			segment := segment
+			limiter.Go(ctx, func() {
+				err := chore.repairer.Evacuate(ctx, segment.StreamID, segment.Position, evacuation.NodeID)
+				if err != nil {
+					atomic.AddInt64(&failed, 1)
+					chore.log.Warn("failed to evacuate segment",
+						zap.Stringer("Node ID", evacuation.NodeID),
+						zap.Stringer("Stream ID", segment.StreamID),
+						zap.Uint64("Position", segment.Position.Encode()),
+						zap.Error(err))
+				}
+			})
+		}
+		limiter.Wait()
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		mon.Counter("node_evacuation_segments_failed").Inc(failed)
+		cursor = result.Cursor
+		if err := chore.db.UpdateProgress(ctx, evacuation.NodeID, cursor, int64(len(result.Segments)), failed); err != nil {
+			return Error.Wrap(err)
+		}
+
+		if !result.More {
+			chore.log.Info("node evacuated", zap.Stringer("Node ID", evacuation.NodeID))
+			return Error.Wrap(chore.db.Finish(ctx, evacuation.NodeID))
+		}
+
+		current, err := chore.db.Get(ctx, evacuation.NodeID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if !current.Active() {
+			chore.log.Info("node evacuation cancelled", zap.Stringer("Node ID", evacuation.NodeID))
+			return nil
+		}
+	}
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package evacuation implements moving all pieces off a node which is going
+// away, without waiting for the checker to find its segments unhealthy.
+package evacuation
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// ErrNotFound is returned when the node has no evacuation.
+	ErrNotFound = errs.Class("evacuation not found")
+	// ErrActive is returned when starting an evacuation for a node which is
+	// already being evacuated.
+	ErrActive = errs.Class("evacuation already active")
+)
+
+// Evacuation is the progress of moving all pieces off a node.
+type Evacuation struct {
+	NodeID storj.NodeID
+	// Cursor is the last segment scanned.
+	Cursor metabase.StreamPosition
+
+	// SegmentsProcessed is the number of segments found on the node, including
+	// the ones which failed to be evacuated.
+	SegmentsProcessed int64
+	SegmentsFailed    int64
+
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CancelledAt *time.Time
+	FinishedAt  *time.Time
+}
+
+// Active returns whether the evacuation is still in progress.
+func (evacuation Evacuation) Active() bool {
+	return evacuation.CancelledAt == nil && evacuation.FinishedAt == nil
+}
+
+// DB stores the node evacuations.
+//
+// architecture: Database
+type DB interface {
+	// Start starts evacuating the node. A cancelled or finished evacuation of
+	// the node is started over.
+	Start(ctx context.Context, nodeID storj.NodeID) (Evacuation, error)
+	// Get returns the evacuation of the node.
+	Get(ctx context.Context, nodeID storj.NodeID) (Evacuation, error)
+	// ListActive returns the evacuations in progress.
+	ListActive(ctx context.Context) ([]Evacuation, error)
+	// UpdateProgress moves the cursor of an active evacuation and adds to its
+	// segment counts.
+	UpdateProgress(ctx context.Context, nodeID storj.NodeID, cursor metabase.StreamPosition, processed, failed int64) error
+	// Cancel cancels the active evacuation of the node.
+	Cancel(ctx context.Context, nodeID storj.NodeID) error
+	// Finish marks the active evacuation of the node as finished.
+	Finish(ctx context.Context, nodeID storj.NodeID) error
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package evacuation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/evacuation"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		evacuations := db.NodeEvacuations()
+		nodeID := testrand.NodeID()
+
+		_, err := evacuations.Get(ctx, nodeID)
+		require.True(t, evacuation.ErrNotFound.Has(err))
+
+		started, err := evacuations.Start(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, nodeID, started.NodeID)
+		require.True(t, started.Active())
+
+		_, err = evacuations.Start(ctx, nodeID)
+		require.True(t, evacuation.ErrActive.Has(err))
+
+		cursor := metabase.StreamPosition{
+			StreamID: testrand.UUID(),
+			Position: metabase.SegmentPosition{Part: 1, Index: 2},
+		}
+		require.NoError(t, evacuations.UpdateProgress(ctx, nodeID, cursor, 10, 1))
+		require.NoError(t, evacuations.UpdateProgress(ctx, nodeID, cursor, 5, 0))
+
+		active, err := evacuations.ListActive(ctx)
+		require.NoError(t, err)
+		require.Len(t, active, 1)
+		require.Equal(t, cursor, active[0].Cursor)
+		require.EqualValues(t, 15, active[0].SegmentsProcessed)
+		require.EqualValues(t, 1, active[0].SegmentsFailed)
+
+		require.NoError(t, evacuations.Cancel(ctx, nodeID))
+		require.True(t, evacuation.ErrNotFound.Has(evacuations.Cancel(ctx, nodeID)))
+
+		cancelled, err := evacuations.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.False(t, cancelled.Active())
+		require.NotNil(t, cancelled.CancelledAt)
+
+		active, err = evacuations.ListActive(ctx)
+		require.NoError(t, err)
+		require.Empty(t, active)
+
+		// a cancelled evacuation is started over.
+		restarted, err := evacuations.Start(ctx, nodeID)
+		require.NoError(t, err)
+		require.True(t, restarted.Active())
+		require.Zero(t, restarted.SegmentsProcessed)
+		require.Equal(t, metabase.StreamPosition{}, restarted.Cursor)
+
+		require.NoError(t, evacuations.Finish(ctx, nodeID))
+		finished, err := evacuations.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.NotNil(t, finished.FinishedAt)
+	})
+}
+
+func TestEvacuateNode(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 8,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(2, 3, 4, 4),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		sat.Audit.Worker.Loop.Pause()
+		sat.Repair.Checker.Loop.Pause()
+		sat.Repair.Repairer.Loop.Pause()
+		sat.Repairer.Evacuation.Chore.Loop.Pause()
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "testbucket", "test/path", testData))
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		evacuated := segments[0].Pieces[0].StorageNode
+
+		_, err = sat.DB.NodeEvacuations().Start(ctx, evacuated)
+		require.NoError(t, err)
+
+		sat.Repairer.Evacuation.Chore.Loop.TriggerWait()
+
+		progress, err := sat.DB.NodeEvacuations().Get(ctx, evacuated)
+		require.NoError(t, err)
+		require.NotNil(t, progress.FinishedAt)
+		require.EqualValues(t, 1, progress.SegmentsProcessed)
+		require.EqualValues(t, 0, progress.SegmentsFailed)
+
+		segments, err = sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		for _, piece := range segments[0].Pieces {
+			require.NotEqual(t, evacuated, piece.StorageNode)
+		}
+
+		// the data can be downloaded without the evacuated node.
+		require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.FindNode(evacuated)))
+		data, err := planet.Uplinks[0].Download(ctx, sat, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, data)
+	})
+}
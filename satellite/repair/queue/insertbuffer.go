@@ -7,10 +7,15 @@ import (
 	"context"
 
 	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/uuid"
 )
 
 var mon = monkit.Package()
 
+// ProjectResolver returns the project of each of the streams.
+type ProjectResolver func(ctx context.Context, streamIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+
 // InsertBuffer exposes a synchronous API to buffer a batch of segments
 // and insert them at once. Not threadsafe. Call Flush() before discarding.
 type InsertBuffer struct {
@@ -22,6 +27,9 @@ type InsertBuffer struct {
 	// is flushed to the queue and it is determined that it wasn't already queued for repair.
 	// This is made to collect metrics.
 	newInsertCallbacks map[*InjuredSegment]func()
+	// resolveProjects attributes the segments to their projects before
+	// they are flushed, when set.
+	resolveProjects ProjectResolver
 }
 
 // NewInsertBuffer wraps a RepairQueue with buffer logic.
@@ -39,6 +47,12 @@ func NewInsertBuffer(
 	return &insertBuffer
 }
 
+// SetProjectResolver makes the buffer attribute the segments without a project
+// before they are flushed to the queue.
+func (r *InsertBuffer) SetProjectResolver(resolve ProjectResolver) {
+	r.resolveProjects = resolve
+}
+
 // Insert adds a segment to the batch of the next insert,
 // and does a synchronous database insert when the batch size is reached.
 // When it is determined that this segment is newly queued, firstInsertCallback is called.
@@ -62,6 +76,8 @@ func (r *InsertBuffer) Insert(
 func (r *InsertBuffer) Flush(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	r.attributeProjects(ctx)
+
 	newlyInsertedSegments, err := r.queue.InsertBatch(ctx, r.batch)
 	if err != nil {
 		return err
@@ -79,6 +95,36 @@ func (r *InsertBuffer) Flush(ctx context.Context) (err error) {
 	return nil
 }
 
+// attributeProjects sets the project of the buffered segments. Failing to
+// resolve the projects doesn't prevent queueing the segments, they are
+// queued without a project instead.
+func (r *InsertBuffer) attributeProjects(ctx context.Context) {
+	if r.resolveProjects == nil {
+		return
+	}
+
+	var streamIDs []uuid.UUID
+	for _, segment := range r.batch {
+		if segment.ProjectID.IsZero() {
+			streamIDs = append(streamIDs, segment.StreamID)
+		}
+	}
+	if len(streamIDs) == 0 {
+		return
+	}
+
+	projects, err := r.resolveProjects(ctx, streamIDs)
+	if err != nil {
+		mon.Counter("repair_queue_project_attribution_failed").Inc(1)
+		return
+	}
+	for _, segment := range r.batch {
+		if segment.ProjectID.IsZero() {
+			segment.ProjectID = projects[segment.StreamID]
+		}
+	}
+}
+
 func (r *InsertBuffer) clearInternals() {
 	// make room for the next batch
 	r.batch = r.batch[:0]
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
@@ -16,13 +17,29 @@ import (
 type InjuredSegment struct {
 	StreamID uuid.UUID
 	Position metabase.SegmentPosition
+	// ProjectID is the project the segment belongs to. It is zero when
+	// the segment was queued without project attribution.
+	ProjectID uuid.UUID
 
 	SegmentHealth float64
+	Placement     storj.PlacementConstraint
 	AttemptedAt   *time.Time
 	UpdatedAt     time.Time
 	InsertedAt    time.Time
 }
 
+// Stat contains the number of injured segments for a placement and
+// segment health bucket.
+type Stat struct {
+	Placement storj.PlacementConstraint
+	// MaxHealth is the exclusive upper bound of the segment health bucket.
+	// It is +Inf for the last bucket.
+	MaxHealth float64
+	Count     int
+	// MinHealth is the lowest segment health in the bucket.
+	MinHealth float64
+}
+
 // RepairQueue implements queueing for segments that need repairing.
 // Implementation can be found at satellite/satellitedb/repairqueue.go.
 //
@@ -34,6 +51,11 @@ type RepairQueue interface {
 	InsertBatch(ctx context.Context, segments []*InjuredSegment) (newlyInsertedSegments []*InjuredSegment, err error)
 	// Select gets an injured segment.
 	Select(ctx context.Context) (*InjuredSegment, error)
+	// SelectBelowHealth gets an injured segment with segment health below the given threshold.
+	SelectBelowHealth(ctx context.Context, health float64) (*InjuredSegment, error)
+	// SelectForPlacement gets an injured segment with the given placement,
+	// skipping segments of the excluded projects.
+	SelectForPlacement(ctx context.Context, placement storj.PlacementConstraint, excludedProjects []uuid.UUID) (*InjuredSegment, error)
 	// Delete removes an injured segment.
 	Delete(ctx context.Context, s *InjuredSegment) error
 	// Clean removes all segments last updated before a certain time
@@ -42,6 +64,9 @@ type RepairQueue interface {
 	SelectN(ctx context.Context, limit int) ([]InjuredSegment, error)
 	// Count counts the number of segments in the repair queue.
 	Count(ctx context.Context) (count int, err error)
+	// Stats counts the segments in the repair queue grouped by placement and
+	// by the segment health buckets delimited by the sorted healthBuckets.
+	Stats(ctx context.Context, healthBuckets []float64) ([]Stat, error)
 
 	// TestingSetAttemptedTime sets attempted time for a segment.
 	TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error)
@@ -4,6 +4,7 @@
 package queue_test
 
 import (
+	"math"
 	"sort"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/errs2"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
@@ -314,3 +316,85 @@ func TestClean(t *testing.T) {
 		require.Equal(t, 0, count)
 	})
 }
+
+func TestSelectPlacementAndHealth(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+
+		healthy := createInjuredSegment()
+		healthy.SegmentHealth = 10
+		critical := createInjuredSegment()
+		critical.SegmentHealth = 0.5
+		critical.Placement = storj.EU
+		other := createInjuredSegment()
+		other.SegmentHealth = 5
+		other.Placement = storj.EU
+
+		_, err := q.InsertBatch(ctx, []*queue.InjuredSegment{healthy, critical, other})
+		require.NoError(t, err)
+
+		stats, err := q.Stats(ctx, []float64{1, 8})
+		require.NoError(t, err)
+		require.Equal(t, []queue.Stat{
+			{Placement: storj.EveryCountry, MaxHealth: math.Inf(1), Count: 1, MinHealth: 10},
+			{Placement: storj.EU, MaxHealth: 1, Count: 1, MinHealth: 0.5},
+			{Placement: storj.EU, MaxHealth: 8, Count: 1, MinHealth: 5},
+		}, stats)
+
+		s, err := q.SelectBelowHealth(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, critical.StreamID, s.StreamID)
+		require.Equal(t, storj.EU, s.Placement)
+
+		_, err = q.SelectBelowHealth(ctx, 1)
+		require.True(t, storage.ErrEmptyQueue.Has(err))
+
+		s, err = q.SelectForPlacement(ctx, storj.EveryCountry, nil)
+		require.NoError(t, err)
+		require.Equal(t, healthy.StreamID, s.StreamID)
+
+		s, err = q.SelectForPlacement(ctx, storj.EU, nil)
+		require.NoError(t, err)
+		require.Equal(t, other.StreamID, s.StreamID)
+
+		_, err = q.SelectForPlacement(ctx, storj.EU, nil)
+		require.True(t, storage.ErrEmptyQueue.Has(err))
+	})
+}
+
+func TestSelectExcludedProjects(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+
+		projectID := testrand.UUID()
+		busy := createInjuredSegment()
+		busy.SegmentHealth = 1
+		busy.ProjectID = projectID
+		other := createInjuredSegment()
+		other.SegmentHealth = 5
+		other.ProjectID = testrand.UUID()
+		unattributed := createInjuredSegment()
+		unattributed.SegmentHealth = 8
+
+		_, err := q.InsertBatch(ctx, []*queue.InjuredSegment{busy, other, unattributed})
+		require.NoError(t, err)
+
+		s, err := q.SelectForPlacement(ctx, storj.EveryCountry, []uuid.UUID{projectID})
+		require.NoError(t, err)
+		require.Equal(t, other.StreamID, s.StreamID)
+		require.Equal(t, other.ProjectID, s.ProjectID)
+
+		s, err = q.SelectForPlacement(ctx, storj.EveryCountry, []uuid.UUID{projectID})
+		require.NoError(t, err)
+		require.Equal(t, unattributed.StreamID, s.StreamID)
+		require.True(t, s.ProjectID.IsZero())
+
+		_, err = q.SelectForPlacement(ctx, storj.EveryCountry, []uuid.UUID{projectID})
+		require.True(t, storage.ErrEmptyQueue.Has(err))
+
+		s, err = q.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, busy.StreamID, s.StreamID)
+		require.Equal(t, projectID, s.ProjectID)
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repair_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/repair/repairer"
+)
+
+func TestReencodeSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 12,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.MaxExcessRateOptimalThreshold = 0
+					config.Repairer.InMemoryRepair = true
+				},
+				testplanet.ReconfigureRS(2, 3, 4, 4),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		sat.Audit.Worker.Loop.Pause()
+		sat.Repair.Checker.Loop.Pause()
+		sat.Repair.Repairer.Loop.Pause()
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "testbucket", "test/path", testData))
+
+		segment, _ := getRemoteSegment(ctx, t, sat, planet.Uplinks[0].Projects[0].ID, "testbucket")
+		require.EqualValues(t, 2, segment.Redundancy.RequiredShares)
+
+		newReencoder := func(dryRun bool) *repairer.Reencoder {
+			reencoder, err := repairer.NewReencoder(zaptest.NewLogger(t),
+				sat.Metabase.DB, sat.Repairer.Orders.Service, sat.Repairer.Overlay, sat.Repairer.EcRepairer,
+				repairer.ReencodeConfig{
+					Source:         "2/3/4/4-256B",
+					Target:         "3/4/6/6-256B",
+					ProjectID:      planet.Uplinks[0].Projects[0].ID.String(),
+					DryRun:         dryRun,
+					Concurrency:    1,
+					CheckpointFile: ctx.File("checkpoint.json"),
+					BatchSize:      10,
+				},
+				sat.Config.Repairer.Timeout, 0)
+			require.NoError(t, err)
+			return reencoder
+		}
+
+		// the dry run only estimates the cost.
+		stats, err := newReencoder(true).Run(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, stats.Segments)
+		require.EqualValues(t, 0, stats.Reencoded)
+		require.EqualValues(t, segment.EncryptedSize, stats.EncryptedBytes)
+		require.NotZero(t, stats.DownloadBytes)
+		require.NotZero(t, stats.UploadBytes)
+
+		after, _ := getRemoteSegment(ctx, t, sat, planet.Uplinks[0].Projects[0].ID, "testbucket")
+		require.Equal(t, segment, after)
+
+		stats, err = newReencoder(false).Run(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, stats.Reencoded)
+		require.EqualValues(t, 0, stats.Failed)
+
+		after, _ = getRemoteSegment(ctx, t, sat, planet.Uplinks[0].Projects[0].ID, "testbucket")
+		require.EqualValues(t, 3, after.Redundancy.RequiredShares)
+		require.EqualValues(t, 6, after.Redundancy.TotalShares)
+		require.Len(t, after.Pieces, 6)
+		require.NotEqual(t, segment.RootPieceID, after.RootPieceID)
+
+		data, err := planet.Uplinks[0].Download(ctx, sat, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, data)
+
+		// the run is resumed from the checkpoint, so nothing is processed again.
+		stats, err = newReencoder(false).Run(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, stats.Reencoded)
+		require.EqualValues(t, 1, stats.Objects)
+	})
+}
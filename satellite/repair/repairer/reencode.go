@@ -0,0 +1,631 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/encryption"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/uplink/private/eestream"
+)
+
+var (
+	// ErrReencode is the errs class for re-encoding failures.
+	ErrReencode = errs.Class("reencode")
+
+	// errSegmentSkipped is returned when a segment doesn't need to be re-encoded.
+	errSegmentSkipped = errs.Class("segment skipped")
+)
+
+// ReencodeConfig contains configurable values for re-encoding segments to a
+// different redundancy scheme.
+type ReencodeConfig struct {
+	Source             string        `help:"redundancy scheme of the segments to re-encode in the format k/m/o/n-sharesize" default:""`
+	Target             string        `help:"redundancy scheme to re-encode the segments to in the format k/m/o/n-sharesize" default:""`
+	ProjectID          string        `help:"only re-encode segments of this project" default:""`
+	Bucket             string        `help:"only re-encode segments of this bucket (requires project-id)" default:""`
+	DryRun             bool          `help:"only estimate the cost of re-encoding without changing anything" default:"false"`
+	Concurrency        int           `help:"maximum number of segments re-encoded concurrently" default:"5"`
+	SegmentsPerSecond  float64       `help:"maximum number of segments re-encoded per second, zero means unlimited" default:"10"`
+	CheckpointFile     string        `help:"file for storing the progress, used to resume an interrupted run" default:""`
+	BatchSize          int           `help:"number of objects processed between checkpoints" default:"100"`
+	AsOfSystemInterval time.Duration `help:"as of system interval for iterating objects" default:"-5m"`
+}
+
+// ReencodeStats contains the counters and the cost estimate of a re-encoding
+// run.
+type ReencodeStats struct {
+	Objects  int64 `json:"objects"`
+	Segments int64 `json:"segments"`
+
+	Reencoded int64 `json:"reencoded"`
+	Skipped   int64 `json:"skipped"`
+	Failed    int64 `json:"failed"`
+
+	EncryptedBytes    int64 `json:"encryptedBytes"`
+	DownloadBytes     int64 `json:"downloadBytes"`
+	UploadBytes       int64 `json:"uploadBytes"`
+	StoredBytesBefore int64 `json:"storedBytesBefore"`
+	StoredBytesAfter  int64 `json:"storedBytesAfter"`
+}
+
+// reencodeCheckpoint is the progress of a re-encoding run, every object
+// up to and including Last has been processed.
+type reencodeCheckpoint struct {
+	Last  metabase.ObjectStream `json:"last"`
+	Stats ReencodeStats         `json:"stats"`
+}
+
+// Reencoder re-encodes segments from one redundancy scheme to another.
+//
+// Segments are downloaded the same way as for repair, re-encoded with the
+// target scheme and uploaded to new nodes. The redundancy, root piece id and
+// pieces of the segment are then swapped atomically. Old pieces are left to
+// garbage collection.
+//
+// architecture: Worker
+type Reencoder struct {
+	log      *zap.Logger
+	metabase *metabase.DB
+	orders   *orders.Service
+	overlay  *overlay.Service
+	ec       *ECRepairer
+	config   ReencodeConfig
+	timeout  time.Duration
+
+	// multiplierOptimalThreshold is the value that multiplied by the optimal
+	// threshold results in the maximum limit of number of nodes to upload
+	// re-encoded pieces.
+	multiplierOptimalThreshold float64
+
+	source    storj.RedundancyScheme
+	target    storj.RedundancyScheme
+	projectID uuid.UUID
+	limiter   *rate.Limiter
+
+	mu    sync.Mutex
+	stats ReencodeStats
+
+	nowFn func() time.Time
+}
+
+// NewReencoder creates a new segment re-encoder.
+func NewReencoder(
+	log *zap.Logger,
+	metabase *metabase.DB,
+	orders *orders.Service,
+	overlay *overlay.Service,
+	ecRepairer *ECRepairer,
+	config ReencodeConfig,
+	timeout time.Duration, excessOptimalThreshold float64,
+) (*Reencoder, error) {
+	source, err := parseRedundancy(config.Source)
+	if err != nil {
+		return nil, ErrReencode.New("invalid source redundancy: %w", err)
+	}
+	target, err := parseRedundancy(config.Target)
+	if err != nil {
+		return nil, ErrReencode.New("invalid target redundancy: %w", err)
+	}
+	if source == target {
+		return nil, ErrReencode.New("source and target redundancy are the same")
+	}
+
+	var projectID uuid.UUID
+	if config.ProjectID != "" {
+		projectID, err = uuid.FromString(config.ProjectID)
+		if err != nil {
+			return nil, ErrReencode.New("invalid project id: %w", err)
+		}
+	}
+	if config.Bucket != "" && projectID.IsZero() {
+		return nil, ErrReencode.New("bucket requires project id")
+	}
+
+	if config.Concurrency <= 0 {
+		config.Concurrency = 1
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 1
+	}
+
+	limit := rate.Inf
+	if config.SegmentsPerSecond > 0 {
+		limit = rate.Limit(config.SegmentsPerSecond)
+	}
+
+	if excessOptimalThreshold < 0 {
+		excessOptimalThreshold = 0
+	}
+
+	return &Reencoder{
+		log:      log,
+		metabase: metabase,
+		orders:   orders,
+		overlay:  overlay,
+		ec:       ecRepairer,
+		config:   config,
+		timeout:  timeout,
+
+		multiplierOptimalThreshold: 1 + excessOptimalThreshold,
+
+		source:    source,
+		target:    target,
+		projectID: projectID,
+		limiter:   rate.NewLimiter(limit, 1),
+
+		nowFn: time.Now,
+	}, nil
+}
+
+// parseRedundancy parses a redundancy scheme in the k/m/o/n-sharesize format.
+func parseRedundancy(value string) (storj.RedundancyScheme, error) {
+	var config metainfo.RSConfig
+	if err := config.Set(value); err != nil {
+		return storj.RedundancyScheme{}, err
+	}
+	if _, err := config.RedundancyStrategy(); err != nil {
+		return storj.RedundancyScheme{}, err
+	}
+	return storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      config.ErasureShareSize.Int32(),
+		RequiredShares: int16(config.Min),
+		RepairShares:   int16(config.Repair),
+		OptimalShares:  int16(config.Success),
+		TotalShares:    int16(config.Total),
+	}, nil
+}
+
+// Run re-encodes all segments matching the configured source redundancy. In
+// dry-run mode it only collects the cost estimate.
+func (reencoder *Reencoder) Run(ctx context.Context) (stats ReencodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	checkpoint, err := reencoder.loadCheckpoint()
+	if err != nil {
+		return ReencodeStats{}, ErrReencode.Wrap(err)
+	}
+	if checkpoint != nil {
+		reencoder.stats = checkpoint.Stats
+		reencoder.log.Info("resuming from checkpoint",
+			zap.Stringer("Project ID", checkpoint.Last.ProjectID),
+			zap.String("Bucket", checkpoint.Last.BucketName),
+			zap.Int64("Objects", checkpoint.Stats.Objects))
+	}
+
+	// held is set once a segment failed, the checkpoint doesn't move past
+	// it anymore so that the next run retries the segment.
+	var held bool
+
+	batch := make([]metabase.ObjectStream, 0, reencoder.config.BatchSize)
+	processBatch := func(ctx context.Context) error {
+		if len(batch) == 0 {
+			return nil
+		}
+		processed, err := reencoder.processObjects(ctx, batch)
+		if err != nil {
+			return err
+		}
+		if held {
+			batch = batch[:0]
+			return nil
+		}
+		if processed < len(batch) {
+			held = true
+			reencoder.log.Warn("holding checkpoint before failed object",
+				zap.Stringer("Project ID", batch[processed].ProjectID),
+				zap.String("Bucket", batch[processed].BucketName),
+				zap.Stringer("Stream ID", batch[processed].StreamID))
+			if processed == 0 {
+				batch = batch[:0]
+				return nil
+			}
+		}
+		last := batch[processed-1]
+		batch = batch[:0]
+		return reencoder.saveCheckpoint(last)
+	}
+
+	err = reencoder.metabase.IterateLoopObjects(ctx, metabase.IterateLoopObjects{
+		BatchSize:          reencoder.config.BatchSize,
+		AsOfSystemInterval: reencoder.config.AsOfSystemInterval,
+	}, func(ctx context.Context, it metabase.LoopObjectsIterator) error {
+		var entry metabase.LoopObjectEntry
+		for it.Next(ctx, &entry) {
+			if !reencoder.matchesObject(entry) {
+				continue
+			}
+			if checkpoint != nil && !objectStreamLess(checkpoint.Last, entry.ObjectStream) {
+				continue
+			}
+
+			batch = append(batch, entry.ObjectStream)
+			if len(batch) >= reencoder.config.BatchSize {
+				if err := processBatch(ctx); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		err = processBatch(ctx)
+	}
+
+	return reencoder.Stats(), ErrReencode.Wrap(err)
+}
+
+// Stats returns the current counters of the run.
+func (reencoder *Reencoder) Stats() ReencodeStats {
+	reencoder.mu.Lock()
+	defer reencoder.mu.Unlock()
+	return reencoder.stats
+}
+
+// matchesObject returns whether the object is within the configured scope.
+func (reencoder *Reencoder) matchesObject(entry metabase.LoopObjectEntry) bool {
+	if entry.Status != metabase.Committed || entry.Expired(reencoder.nowFn()) {
+		return false
+	}
+	if !reencoder.projectID.IsZero() && entry.ProjectID != reencoder.projectID {
+		return false
+	}
+	if reencoder.config.Bucket != "" && entry.BucketName != reencoder.config.Bucket {
+		return false
+	}
+	return true
+}
+
+// processObjects re-encodes the matching segments of the objects and waits
+// until all of them are done. It returns the number of leading objects whose
+// segments didn't fail.
+func (reencoder *Reencoder) processObjects(ctx context.Context, objects []metabase.ObjectStream) (processed int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// failed is written by different goroutines, but each of them only
+	// writes the element of its own object.
+	failed := make([]bool, len(objects))
+
+	limiter := sync2.NewLimiter(reencoder.config.Concurrency)
+	err = reencoder.queueObjects(ctx, limiter, objects, failed)
+	limiter.Wait()
+	if err != nil {
+		return 0, err
+	}
+
+	for processed < len(objects) && !failed[processed] {
+		processed++
+	}
+	return processed, nil
+}
+
+// queueObjects starts re-encoding the matching segments of the objects.
+func (reencoder *Reencoder) queueObjects(ctx context.Context, limiter *sync2.Limiter, objects []metabase.ObjectStream, failed []bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for i, object := range objects {
+		reencoder.mu.Lock()
+		reencoder.stats.Objects++
+		reencoder.mu.Unlock()
+
+		cursor := metabase.SegmentPosition{}
+		for {
+			result, err := reencoder.metabase.ListSegments(ctx, metabase.ListSegments{
+				StreamID: object.StreamID,
+				Cursor:   cursor,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, segment := range result.Segments {
+				if segment.Inline() || segment.Redundancy != reencoder.source {
+					continue
+				}
+				reencoder.addEstimate(segment)
+				if reencoder.config.DryRun {
+					continue
+				}
+
+				if err := reencoder.limiter.Wait(ctx); err != nil {
+					return err
+				}
+
+				i, segment := i, segment
+				if !limiter.Go(ctx, func() {
+					if !reencoder.reencode(ctx, segment.StreamID, segment.Position) {
+						failed[i] = true
+					}
+				}) {
+					return ctx.Err()
+				}
+			}
+
+			if !result.More || len(result.Segments) == 0 {
+				break
+			}
+			cursor = result.Segments[len(result.Segments)-1].Position
+		}
+	}
+
+	return nil
+}
+
+// reencode re-encodes a single segment and updates the counters. It returns
+// false when the segment failed and has to be retried.
+func (reencoder *Reencoder) reencode(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition) (ok bool) {
+	log := reencoder.log.With(zap.Stringer("Stream ID", streamID), zap.Uint64("Position", position.Encode()))
+
+	err := reencoder.ReencodeSegment(ctx, streamID, position)
+
+	reencoder.mu.Lock()
+	defer reencoder.mu.Unlock()
+
+	switch {
+	case err == nil:
+		reencoder.stats.Reencoded++
+		mon.Meter("reencode_success").Mark(1)
+	case errSegmentSkipped.Has(err):
+		reencoder.stats.Skipped++
+		log.Debug("segment skipped", zap.Error(err))
+	default:
+		reencoder.stats.Failed++
+		mon.Meter("reencode_failed").Mark(1)
+		log.Warn("failed to re-encode segment", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// addEstimate adds the cost of re-encoding the segment to the counters.
+func (reencoder *Reencoder) addEstimate(segment metabase.Segment) {
+	oldPieceSize := calcPieceSize(segment.EncryptedSize, reencoder.source)
+	newPieceSize := calcPieceSize(segment.EncryptedSize, reencoder.target)
+
+	reencoder.mu.Lock()
+	defer reencoder.mu.Unlock()
+
+	reencoder.stats.Segments++
+	reencoder.stats.EncryptedBytes += int64(segment.EncryptedSize)
+	reencoder.stats.DownloadBytes += oldPieceSize * int64(reencoder.source.RequiredShares)
+	reencoder.stats.StoredBytesBefore += oldPieceSize * int64(len(segment.Pieces))
+	uploadBytes := newPieceSize * int64(reencoder.uploadCount())
+	reencoder.stats.UploadBytes += uploadBytes
+	reencoder.stats.StoredBytesAfter += uploadBytes
+}
+
+// uploadCount returns the number of pieces uploaded for a re-encoded segment.
+func (reencoder *Reencoder) uploadCount() int {
+	count := int(math.Ceil(float64(reencoder.target.OptimalShares) * reencoder.multiplierOptimalThreshold))
+	if count > int(reencoder.target.TotalShares) {
+		count = int(reencoder.target.TotalShares)
+	}
+	return count
+}
+
+// calcPieceSize calculates the size of a single piece of a segment with the
+// redundancy scheme.
+func calcPieceSize(encryptedSize int32, rs storj.RedundancyScheme) int64 {
+	strategy, err := eestream.NewRedundancyStrategyFromStorj(rs)
+	if err != nil {
+		return 0
+	}
+	return eestream.CalcPieceSize(int64(encryptedSize), strategy)
+}
+
+// ReencodeSegment re-encodes a single segment to the target redundancy.
+func (reencoder *Reencoder) ReencodeSegment(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment, err := reencoder.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: streamID,
+		Position: position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			return errSegmentSkipped.New("segment was deleted")
+		}
+		return metainfoGetError.Wrap(err)
+	}
+
+	switch {
+	case segment.Inline():
+		return errSegmentSkipped.New("segment is inline")
+	case segment.Expired(reencoder.nowFn()):
+		return errSegmentSkipped.New("segment has expired")
+	case segment.Redundancy != reencoder.source:
+		return errSegmentSkipped.New("segment redundancy doesn't match")
+	case segment.PiecesInAncestorSegment():
+		return errSegmentSkipped.New("segment is a server-side copy")
+	}
+
+	oldRedundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		return invalidRepairError.New("invalid redundancy strategy: %w", err)
+	}
+	newRedundancy, err := eestream.NewRedundancyStrategyFromStorj(reencoder.target)
+	if err != nil {
+		return invalidRepairError.New("invalid redundancy strategy: %w", err)
+	}
+
+	missingPieces, err := reencoder.overlay.GetMissingPieces(ctx, segment.Pieces)
+	if err != nil {
+		return overlayQueryError.New("error identifying missing pieces: %w", err)
+	}
+	missing := sliceToSet(missingPieces)
+
+	var healthyPieces metabase.Pieces
+	excludeNodeIDs := make(storj.NodeIDList, 0, len(segment.Pieces))
+	for _, piece := range segment.Pieces {
+		excludeNodeIDs = append(excludeNodeIDs, piece.StorageNode)
+		if !missing[piece.Number] {
+			healthyPieces = append(healthyPieces, piece)
+		}
+	}
+	if len(healthyPieces) < int(segment.Redundancy.RequiredShares) {
+		return &irreparableError{
+			piecesAvailable: int32(len(healthyPieces)),
+			piecesRequired:  int32(segment.Redundancy.RequiredShares),
+		}
+	}
+
+	getLimits, getPrivateKey, cachedNodesInfo, err := reencoder.orders.CreateGetRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, healthyPieces)
+	if err != nil {
+		return orderLimitFailureError.New("could not create GET_REPAIR order limits: %w", err)
+	}
+
+	newSegment := segment
+	newSegment.Redundancy = reencoder.target
+	newSegment.RootPieceID = storj.NewPieceID()
+
+	newNodes, err := reencoder.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: reencoder.uploadCount(),
+		ExcludedIDs:    excludeNodeIDs,
+		Placement:      segment.Placement,
+	})
+	if err != nil {
+		return overlayQueryError.Wrap(err)
+	}
+
+	putLimits, putPrivateKey, err := reencoder.orders.CreatePutRepairOrderLimits(ctx, metabase.BucketLocation{}, newSegment,
+		make([]*pb.AddressedOrderLimit, reencoder.target.TotalShares), newNodes, reencoder.multiplierOptimalThreshold, 0)
+	if err != nil {
+		return orderLimitFailureError.New("could not create PUT_REPAIR order limits: %w", err)
+	}
+
+	segmentReader, _, err := reencoder.ec.Get(ctx, getLimits, cachedNodesInfo, getPrivateKey, oldRedundancy, int64(segment.EncryptedSize))
+	if err != nil {
+		var irreparableErr *irreparableError
+		if errors.As(err, &irreparableErr) {
+			return err
+		}
+		return repairReconstructError.New("segment could not be reconstructed: %w", err)
+	}
+	defer func() { err = errs.Combine(err, segmentReader.Close()) }()
+
+	// the downloaded data is padded to the stripe size of the old redundancy,
+	// strip the padding and pad it again to the new stripe size.
+	data := encryption.PadReader(ioutil.NopCloser(io.LimitReader(segmentReader, int64(segment.EncryptedSize))), newRedundancy.StripeSize())
+
+	successfulNodes, _, err := reencoder.ec.Repair(ctx, putLimits, putPrivateKey, newRedundancy, data, reencoder.timeout, newRedundancy.OptimalThreshold())
+	if err != nil {
+		return repairPutError.Wrap(err)
+	}
+
+	var newPieces metabase.Pieces
+	for i, node := range successfulNodes {
+		if node == nil {
+			continue
+		}
+		newPieces = append(newPieces, metabase.Piece{
+			Number:      uint16(i),
+			StorageNode: node.Id,
+		})
+	}
+	if len(newPieces) < newRedundancy.OptimalThreshold() {
+		return repairPutError.New("uploaded %d pieces, at least %d required", len(newPieces), newRedundancy.OptimalThreshold())
+	}
+
+	err = reencoder.metabase.UpdateSegmentRedundancy(ctx, metabase.UpdateSegmentRedundancy{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldRootPieceID: segment.RootPieceID,
+		OldPieces:      segment.Pieces,
+
+		NewRedundancy:  reencoder.target,
+		NewRootPieceID: newSegment.RootPieceID,
+		NewPieces:      newPieces,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			return errSegmentSkipped.New("segment was deleted during re-encoding")
+		}
+		return metainfoPutError.Wrap(err)
+	}
+
+	mon.Meter("reencode_bytes_uploaded").Mark64(int64(len(newPieces)) * eestream.CalcPieceSize(int64(segment.EncryptedSize), newRedundancy))
+
+	return nil
+}
+
+// loadCheckpoint loads the checkpoint of a previous run, when there is one.
+func (reencoder *Reencoder) loadCheckpoint() (*reencodeCheckpoint, error) {
+	if reencoder.config.CheckpointFile == "" || reencoder.config.DryRun {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(reencoder.config.CheckpointFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var checkpoint reencodeCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, errs.New("invalid checkpoint file: %w", err)
+	}
+	return &checkpoint, nil
+}
+
+// saveCheckpoint stores the progress up to and including last.
+func (reencoder *Reencoder) saveCheckpoint(last metabase.ObjectStream) error {
+	if reencoder.config.CheckpointFile == "" || reencoder.config.DryRun {
+		return nil
+	}
+
+	data, err := json.Marshal(reencodeCheckpoint{
+		Last:  last,
+		Stats: reencoder.Stats(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so an interruption can't leave a
+	// partially written checkpoint behind.
+	tmp := reencoder.config.CheckpointFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, reencoder.config.CheckpointFile)
+}
+
+// objectStreamLess returns whether a comes before b in the order objects are
+// iterated by the loop.
+func objectStreamLess(a, b metabase.ObjectStream) bool {
+	if c := a.ProjectID.Compare(b.ProjectID); c != 0 {
+		return c < 0
+	}
+	if a.BucketName != b.BucketName {
+		return a.BucketName < b.BucketName
+	}
+	if a.ObjectKey != b.ObjectKey {
+		return a.ObjectKey < b.ObjectKey
+	}
+	return a.Version < b.Version
+}
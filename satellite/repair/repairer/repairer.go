@@ -34,6 +34,12 @@ type Config struct {
 	MaxBufferMem                  memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4.0 MiB"`
 	MaxExcessRateOptimalThreshold float64       `help:"ratio applied to the optimal threshold to calculate the excess of the maximum number of repaired pieces to upload" default:"0.05"`
 	InMemoryRepair                bool          `help:"whether to download pieces for repair in memory (true) or download to disk (false)" default:"false"`
+
+	PlacementFairness        bool          `help:"whether to select segments fairly across placements instead of only by segment health" default:"false"`
+	PlacementWeights         string        `help:"comma separated placement:weight pairs used for fair selection, placements not listed have weight 1" default:""`
+	PlacementRefreshInterval time.Duration `help:"how often the placements in the repair queue are refreshed for fair selection" default:"5m0s" testDefault:"$TESTINTERVAL"`
+	CriticalSegmentHealth    float64       `help:"segments with health below this value are always selected first when selecting fairly across placements" default:"1"`
+	ProjectLimit             int           `help:"maximum number of segments of a single project selected per placement refresh interval when selecting fairly across placements, zero means unlimited" default:"0"`
 }
 
 // Service contains the information needed to run the repair service.
@@ -42,6 +48,7 @@ type Config struct {
 type Service struct {
 	log        *zap.Logger
 	queue      queue.RepairQueue
+	selector   selector
 	config     *Config
 	JobLimiter *semaphore.Weighted
 	Loop       *sync2.Cycle
@@ -51,17 +58,27 @@ type Service struct {
 }
 
 // NewService creates repairing service.
-func NewService(log *zap.Logger, queue queue.RepairQueue, config *Config, repairer *SegmentRepairer) *Service {
+func NewService(log *zap.Logger, queue queue.RepairQueue, config *Config, repairer *SegmentRepairer) (*Service, error) {
+	var selector selector = queue
+	if config.PlacementFairness {
+		weights, err := ParsePlacementWeights(config.PlacementWeights)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		selector = NewFairSelector(queue, weights, config.CriticalSegmentHealth, config.PlacementRefreshInterval, config.ProjectLimit)
+	}
+
 	return &Service{
 		log:        log,
 		queue:      queue,
+		selector:   selector,
 		config:     config,
 		JobLimiter: semaphore.NewWeighted(int64(config.MaxRepair)),
 		Loop:       sync2.NewCycle(config.Interval),
 		repairer:   repairer,
 
 		nowFn: time.Now,
-	}
+	}, nil
 }
 
 // Close closes resources.
@@ -127,7 +144,7 @@ func (service *Service) process(ctx context.Context) (err error) {
 	// return from service.Run when queue fetch fails.
 	ctx, cancel := context.WithTimeout(ctx, service.config.TotalTimeout)
 
-	seg, err := service.queue.Select(ctx)
+	seg, err := service.selector.Select(ctx)
 	if err != nil {
 		service.JobLimiter.Release(1)
 		cancel()
@@ -17,6 +17,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
@@ -41,6 +42,8 @@ var (
 	segmentDeletedError = errs.Class("segment deleted during repair")
 	// segmentModifiedError is the errs class used when a segment has been changed in any way.
 	segmentModifiedError = errs.Class("segment has been modified")
+	// evacuationError is the errs class used when a piece couldn't be moved off an evacuated node.
+	evacuationError = errs.Class("evacuation failed")
 )
 
 // irreparableError identifies situations where a segment could not be repaired due to reasons
@@ -122,6 +125,45 @@ func NewSegmentRepairer(
 func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue.InjuredSegment) (shouldDelete bool, err error) {
 	defer mon.Task()(&ctx, queueSegment.StreamID.String(), queueSegment.Position.Encode())(&err)
 
+	return repairer.repair(ctx, queueSegment, storj.NodeID{})
+}
+
+// Evacuate moves the piece of the segment stored on the node to a new node,
+// regardless of the segment health.
+func (repairer *SegmentRepairer) Evacuate(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx, streamID.String(), position.Encode())(&err)
+
+	_, err = repairer.repair(ctx, &queue.InjuredSegment{
+		StreamID: streamID,
+		Position: position,
+	}, nodeID)
+	if err != nil {
+		return err
+	}
+
+	segment, err := repairer.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: streamID,
+		Position: position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			return nil
+		}
+		return metainfoGetError.Wrap(err)
+	}
+	for _, piece := range segment.Pieces {
+		if piece.StorageNode == nodeID {
+			return evacuationError.New("piece %d is still stored on the node", piece.Number)
+		}
+	}
+	return nil
+}
+
+// repair repairs the segment. When evacuate is set, the piece stored on that
+// node is repaired as if it was lost, even when the segment is healthy.
+func (repairer *SegmentRepairer) repair(ctx context.Context, queueSegment *queue.InjuredSegment, evacuate storj.NodeID) (shouldDelete bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	segment, err := repairer.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
 		StreamID: queueSegment.StreamID,
 		Position: queueSegment.Position,
@@ -172,6 +214,24 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		return false, overlayQueryError.New("error identifying missing pieces: %w", err)
 	}
 
+	evacuating := false
+	if !evacuate.IsZero() {
+		missingSet := sliceToSet(missingPieces)
+		for _, piece := range pieces {
+			if piece.StorageNode != evacuate {
+				continue
+			}
+			evacuating = true
+			if !missingSet[piece.Number] {
+				missingPieces = append(missingPieces, piece.Number)
+			}
+		}
+		if !evacuating {
+			repairer.log.Debug("segment has no piece on the evacuated node")
+			return true, nil
+		}
+	}
+
 	numHealthy := len(pieces) - len(missingPieces)
 	// irreparable piece
 	if numHealthy < int(segment.Redundancy.RequiredShares) {
@@ -194,7 +254,21 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		return false, overlayQueryError.New("error identifying pieces in excluded countries: %w", err)
 	}
 
-	numHealthyInExcludedCountries := len(piecesInExcludedCountries)
+	// pieces on nodes which don't satisfy the placement need new copies like
+	// the pieces in excluded countries.
+	piecesOutOfPlacement, err := repairer.overlay.GetPiecesOutOfPlacement(ctx, pieces, segment.Placement)
+	if err != nil {
+		return false, overlayQueryError.New("error identifying pieces out of placement: %w", err)
+	}
+	missingSet := sliceToSet(missingPieces)
+	excludedSet := sliceToSet(piecesInExcludedCountries)
+	for _, number := range piecesOutOfPlacement {
+		if !missingSet[number] {
+			excludedSet[number] = true
+		}
+	}
+
+	numHealthyInExcludedCountries := len(excludedSet)
 
 	// ensure we get values, even if only zero values, so that redash can have an alert based on this
 	mon.Counter("repairer_segments_below_min_req").Inc(0) //mon:locked
@@ -214,7 +288,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	}
 
 	// repair not needed
-	if numHealthy-numHealthyInExcludedCountries > int(repairThreshold) {
+	if !evacuating && numHealthy-numHealthyInExcludedCountries > int(repairThreshold) {
 		mon.Meter("repair_unnecessary").Mark(1) //mon:locked
 		stats.repairUnnecessary.Mark(1)
 		repairer.log.Debug("segment above repair threshold", zap.Int("numHealthy", numHealthy), zap.Int32("repairThreshold", repairThreshold))
@@ -277,12 +351,23 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		totalNeeded := math.Ceil(float64(redundancy.OptimalThreshold()) * repairer.multiplierOptimalThreshold)
 		requestCount = int(totalNeeded) - len(healthyPieces) + numHealthyInExcludedCountries
 		minSuccessfulNeeded = redundancy.OptimalThreshold() - len(healthyPieces) + numHealthyInExcludedCountries
+		if evacuating {
+			// the evacuated piece needs a replacement even when the segment
+			// has more than the optimal number of pieces.
+			if requestCount < 1 {
+				requestCount = 1
+			}
+			if minSuccessfulNeeded < 1 {
+				minSuccessfulNeeded = 1
+			}
+		}
 	}
 
 	// Request Overlay for n-h new storage nodes
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: requestCount,
 		ExcludedIDs:    excludeNodeIDs,
+		Placement:      segment.Placement,
 	}
 	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
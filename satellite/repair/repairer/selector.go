@@ -0,0 +1,187 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/storage"
+)
+
+// selector picks the next injured segment to repair.
+type selector interface {
+	Select(ctx context.Context) (*queue.InjuredSegment, error)
+}
+
+// ParsePlacementWeights parses comma separated placement:weight pairs.
+func ParsePlacementWeights(value string) (map[storj.PlacementConstraint]float64, error) {
+	weights := map[storj.PlacementConstraint]float64{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.Split(pair, ":")
+		if len(parts) != 2 {
+			return nil, errs.New("invalid placement weight %q", pair)
+		}
+		placementValue, weightValue := parts[0], parts[1]
+		placement, err := strconv.ParseUint(strings.TrimSpace(placementValue), 10, 16)
+		if err != nil {
+			return nil, errs.New("invalid placement %q: %v", placementValue, err)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(weightValue), 64)
+		if err != nil {
+			return nil, errs.New("invalid weight %q: %v", weightValue, err)
+		}
+		if weight <= 0 {
+			return nil, errs.New("weight for placement %d must be positive", placement)
+		}
+		weights[storj.PlacementConstraint(placement)] = weight
+	}
+	return weights, nil
+}
+
+// FairSelector selects injured segments fairly across placements, so that
+// a placement with a lot of injured segments doesn't starve the others.
+// Segments below the critical health are always selected first.
+//
+// Within a placement, a project is skipped once projectLimit of its
+// segments were selected since the last refresh, so a single project
+// with a lot of injured segments doesn't starve the other projects.
+// Segments of skipped projects are still selected when nothing else is
+// left in the queue.
+//
+// It is not safe for concurrent use.
+type FairSelector struct {
+	queue        queue.RepairQueue
+	weights      map[storj.PlacementConstraint]float64
+	critical     float64
+	refresh      time.Duration
+	projectLimit int
+
+	nowFn          func() time.Time
+	refreshedAt    time.Time
+	placements     []storj.PlacementConstraint
+	served         map[storj.PlacementConstraint]int
+	servedProjects map[uuid.UUID]int
+	cappedProjects []uuid.UUID
+}
+
+// NewFairSelector creates a new FairSelector. Placements without a weight
+// have weight 1. A zero projectLimit doesn't limit projects.
+func NewFairSelector(queue queue.RepairQueue, weights map[storj.PlacementConstraint]float64, critical float64, refresh time.Duration, projectLimit int) *FairSelector {
+	return &FairSelector{
+		queue:        queue,
+		weights:      weights,
+		critical:     critical,
+		refresh:      refresh,
+		projectLimit: projectLimit,
+
+		nowFn:          time.Now,
+		served:         map[storj.PlacementConstraint]int{},
+		servedProjects: map[uuid.UUID]int{},
+	}
+}
+
+// Select selects the next injured segment to repair.
+func (selector *FairSelector) Select(ctx context.Context) (_ *queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if selector.critical > 0 {
+		seg, err := selector.queue.SelectBelowHealth(ctx, selector.critical)
+		if !storage.ErrEmptyQueue.Has(err) {
+			if err == nil {
+				mon.Counter("repair_critical_segment_selected").Inc(1)
+			}
+			return seg, err
+		}
+	}
+
+	if err := selector.refreshPlacements(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, placement := range selector.ordered() {
+		seg, err := selector.queue.SelectForPlacement(ctx, placement, selector.cappedProjects)
+		if storage.ErrEmptyQueue.Has(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		selector.served[placement]++
+		selector.serveProject(seg.ProjectID)
+		return seg, nil
+	}
+
+	// placements that were added to the queue since the last refresh and
+	// segments of capped projects.
+	return selector.queue.Select(ctx)
+}
+
+// serveProject counts a selected segment of the project and caps the project
+// once it reaches the project limit.
+func (selector *FairSelector) serveProject(projectID uuid.UUID) {
+	if selector.projectLimit <= 0 || projectID.IsZero() {
+		return
+	}
+	selector.servedProjects[projectID]++
+	if selector.servedProjects[projectID] == selector.projectLimit {
+		selector.cappedProjects = append(selector.cappedProjects, projectID)
+		mon.Counter("repair_project_capped").Inc(1)
+	}
+}
+
+// refreshPlacements updates the placements in the queue and resets the
+// number of segments served per placement and per project.
+func (selector *FairSelector) refreshPlacements(ctx context.Context) (err error) {
+	now := selector.nowFn()
+	if !selector.refreshedAt.IsZero() && now.Sub(selector.refreshedAt) < selector.refresh {
+		return nil
+	}
+
+	stats, err := selector.queue.Stats(ctx, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	selector.placements = selector.placements[:0]
+	for _, stat := range stats {
+		selector.placements = append(selector.placements, stat.Placement)
+	}
+	selector.served = map[storj.PlacementConstraint]int{}
+	selector.servedProjects = map[uuid.UUID]int{}
+	selector.cappedProjects = nil
+	selector.refreshedAt = now
+	return nil
+}
+
+// ordered returns the placements ordered by the number of segments served
+// relative to their weight.
+func (selector *FairSelector) ordered() []storj.PlacementConstraint {
+	ordered := append([]storj.PlacementConstraint{}, selector.placements...)
+	sort.SliceStable(ordered, func(i, k int) bool {
+		return selector.share(ordered[i]) < selector.share(ordered[k])
+	})
+	return ordered
+}
+
+func (selector *FairSelector) share(placement storj.PlacementConstraint) float64 {
+	weight, ok := selector.weights[placement]
+	if !ok {
+		weight = 1
+	}
+	return float64(selector.served[placement]) / weight
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/storage"
+)
+
+func TestParsePlacementWeights(t *testing.T) {
+	weights, err := repairer.ParsePlacementWeights("")
+	require.NoError(t, err)
+	require.Empty(t, weights)
+
+	weights, err = repairer.ParsePlacementWeights("0:1, 1:2.5")
+	require.NoError(t, err)
+	require.Equal(t, map[storj.PlacementConstraint]float64{
+		storj.EveryCountry: 1,
+		storj.EU:           2.5,
+	}, weights)
+
+	for _, invalid := range []string{"1", "a:1", "1:b", "1:0", "1:-1", "70000:1", "1:2:3"} {
+		_, err := repairer.ParsePlacementWeights(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestFairSelector(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	q := &memoryQueue{}
+	for i := 0; i < 10; i++ {
+		q.add(storj.EveryCountry, 5)
+		q.add(storj.EU, 6)
+	}
+	q.add(storj.EEA, 0.5)
+
+	selector := repairer.NewFairSelector(q, map[storj.PlacementConstraint]float64{
+		storj.EU: 2,
+	}, 1, time.Hour, 0)
+
+	// segments below the critical health are selected first.
+	seg, err := selector.Select(ctx)
+	require.NoError(t, err)
+	require.Equal(t, storj.EEA, seg.Placement)
+
+	// the EU placement is selected twice as often as the other placement, even
+	// though its segments are healthier.
+	counts := map[storj.PlacementConstraint]int{}
+	for i := 0; i < 9; i++ {
+		seg, err := selector.Select(ctx)
+		require.NoError(t, err)
+		counts[seg.Placement]++
+	}
+	require.Equal(t, map[storj.PlacementConstraint]int{
+		storj.EveryCountry: 3,
+		storj.EU:           6,
+	}, counts)
+
+	// the remaining segments are selected once a placement runs out.
+	for i := 0; i < 11; i++ {
+		_, err := selector.Select(ctx)
+		require.NoError(t, err)
+	}
+	_, err = selector.Select(ctx)
+	require.True(t, storage.ErrEmptyQueue.Has(err))
+}
+
+func TestFairSelectorProjectLimit(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	busy, quiet := testrand.UUID(), testrand.UUID()
+
+	q := &memoryQueue{}
+	for i := 0; i < 5; i++ {
+		q.addForProject(busy, storj.EveryCountry, 1.5)
+	}
+	q.addForProject(quiet, storj.EveryCountry, 5)
+	q.addForProject(quiet, storj.EveryCountry, 6)
+
+	selector := repairer.NewFairSelector(q, nil, 0, time.Hour, 2)
+
+	// the busy project is skipped after two segments, even though its
+	// segments are less healthy.
+	var projects []uuid.UUID
+	for i := 0; i < 4; i++ {
+		seg, err := selector.Select(ctx)
+		require.NoError(t, err)
+		projects = append(projects, seg.ProjectID)
+	}
+	require.Equal(t, []uuid.UUID{busy, busy, quiet, quiet}, projects)
+
+	// the remaining segments of the busy project are selected once nothing
+	// else is left.
+	for i := 0; i < 3; i++ {
+		seg, err := selector.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, busy, seg.ProjectID)
+	}
+	_, err := selector.Select(ctx)
+	require.True(t, storage.ErrEmptyQueue.Has(err))
+}
+
+// memoryQueue is a repair queue which keeps the injured segments in memory.
+type memoryQueue struct {
+	queue.RepairQueue
+	segments []*queue.InjuredSegment
+}
+
+func (q *memoryQueue) add(placement storj.PlacementConstraint, health float64) {
+	q.segments = append(q.segments, &queue.InjuredSegment{
+		StreamID:      testrand.UUID(),
+		Placement:     placement,
+		SegmentHealth: health,
+	})
+}
+
+func (q *memoryQueue) addForProject(projectID uuid.UUID, placement storj.PlacementConstraint, health float64) {
+	q.add(placement, health)
+	q.segments[len(q.segments)-1].ProjectID = projectID
+}
+
+func (q *memoryQueue) selectFirst(match func(*queue.InjuredSegment) bool) (*queue.InjuredSegment, error) {
+	sort.SliceStable(q.segments, func(i, k int) bool {
+		return q.segments[i].SegmentHealth < q.segments[k].SegmentHealth
+	})
+	for i, seg := range q.segments {
+		if match(seg) {
+			q.segments = append(q.segments[:i], q.segments[i+1:]...)
+			return seg, nil
+		}
+	}
+	return nil, storage.ErrEmptyQueue.New("")
+}
+
+func (q *memoryQueue) Select(ctx context.Context) (*queue.InjuredSegment, error) {
+	return q.selectFirst(func(*queue.InjuredSegment) bool { return true })
+}
+
+func (q *memoryQueue) SelectBelowHealth(ctx context.Context, health float64) (*queue.InjuredSegment, error) {
+	return q.selectFirst(func(seg *queue.InjuredSegment) bool { return seg.SegmentHealth < health })
+}
+
+func (q *memoryQueue) SelectForPlacement(ctx context.Context, placement storj.PlacementConstraint, excludedProjects []uuid.UUID) (*queue.InjuredSegment, error) {
+	return q.selectFirst(func(seg *queue.InjuredSegment) bool {
+		if seg.Placement != placement {
+			return false
+		}
+		for _, projectID := range excludedProjects {
+			if seg.ProjectID == projectID {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (q *memoryQueue) Stats(ctx context.Context, healthBuckets []float64) ([]queue.Stat, error) {
+	counts := map[storj.PlacementConstraint]int{}
+	for _, seg := range q.segments {
+		counts[seg.Placement]++
+	}
+
+	var stats []queue.Stat
+	for placement, count := range counts {
+		stats = append(stats, queue.Stat{Placement: placement, Count: count})
+	}
+	sort.Slice(stats, func(i, k int) bool { return stats[i].Placement < stats[k].Placement })
+	return stats, nil
+}
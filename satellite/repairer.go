@@ -29,6 +29,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/evacuation"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/reputation"
@@ -72,6 +73,10 @@ type Repairer struct {
 	SegmentRepairer *repairer.SegmentRepairer
 	Repairer        *repairer.Service
 
+	Evacuation struct {
+		Chore *evacuation.Chore
+	}
+
 	Buckets struct {
 		Service *buckets.Service
 	}
@@ -82,6 +87,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	metabaseDB *metabase.DB,
 	revocationDB extensions.RevocationDB,
 	repairQueue queue.RepairQueue,
+	nodeEvacuations evacuation.DB,
 	bucketsDB buckets.DB,
 	overlayCache overlay.DB,
 	reputationdb reputation.DB,
@@ -234,7 +240,11 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			config.Repairer.Timeout,
 			config.Repairer.MaxExcessRateOptimalThreshold,
 		)
-		peer.Repairer = repairer.NewService(log.Named("repairer"), repairQueue, &config.Repairer, peer.SegmentRepairer)
+		var err error
+		peer.Repairer, err = repairer.NewService(log.Named("repairer"), repairQueue, &config.Repairer, peer.SegmentRepairer)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "repair",
@@ -245,6 +255,24 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			debug.Cycle("Repair Worker", peer.Repairer.Loop))
 	}
 
+	{ // setup node evacuation
+		peer.Evacuation.Chore = evacuation.NewChore(
+			log.Named("evacuation"),
+			nodeEvacuations,
+			metabaseDB,
+			peer.SegmentRepairer,
+			config.NodeEvacuation,
+		)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "evacuation:chore",
+			Run:   peer.Evacuation.Chore.Run,
+			Close: peer.Evacuation.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Node Evacuation", peer.Evacuation.Chore.Loop))
+	}
+
 	return peer, nil
 }
 
@@ -3,7 +3,12 @@
 
 package reputation
 
-import "math"
+import (
+	"math"
+	"time"
+
+	"storj.io/storj/satellite/overlay"
+)
 
 // UpdateReputation uses the Beta distribution model to determine a node's reputation.
 // lambda is the "forgetting factor" which determines how much past info is kept when determining current reputation score.
@@ -65,3 +70,131 @@ func UpdateReputationMultiple(count int, alpha, beta, lambda, w float64) (newAlp
 	}
 	return newAlpha, newBeta
 }
+
+// ApplyMutations applies the audit results to the reputation of a node, and
+// suspends, unsuspends and disqualifies the node as needed. onlineScore and
+// trackingPeriodFull describe the audit history after the online history of
+// the mutations was merged into it.
+//
+// It returns the reasons the node was disqualified for, in the order the
+// rules were applied. The last reason is the one kept in info.
+func (info *Info) ApplyMutations(updates Mutations, config Config, onlineScore float64, trackingPeriodFull bool, now time.Time) (disqualified []overlay.DisqualificationReason) {
+	disqualify := func(reason overlay.DisqualificationReason) {
+		info.Disqualified = timePtr(now)
+		info.DisqualificationReason = reason
+		disqualified = append(disqualified, reason)
+	}
+
+	// Here we rely on the observation that, conceptually, if we have
+	// collected some list of successes failures while auditing node N
+	// during some short time period, it might reasonably have happened that
+	// the events occurred in a different order.
+	//
+	// That is, if a node passed audit 1, then failed audit 2, then passed
+	// audit 3, it is fair to treat it as if it passed two audits and then
+	// failed one. This is because we expect that the order in which the
+	// events occurred is not very relevant. If a node failed an audit for
+	// piece P at time T, then it likely would also have failed an audit
+	// for the same piece at time T±ε, so we can grade it as though that
+	// had happened.
+	//
+	// There are conditions under which the order of events makes the
+	// difference in whether a node is disqualified or not. To be as fair
+	// as possible, we will not disqualify in those conditions. If a node
+	// remains un-disqualified under any ordering of events, we should not
+	// disqualify it. To that end, we will always apply failures _before_
+	// applying successes. That ordering will always yield the highest
+	// possible result alpha and the lowest possible result beta, assuming
+	// weight > 0 and 0 < λ < 1 (the proof is left as an exercise for the
+	// reader).
+
+	// for audit failure, only update normal alpha/beta
+	info.AuditReputationBeta, info.AuditReputationAlpha = UpdateReputationMultiple(
+		updates.FailureResults, info.AuditReputationBeta, info.AuditReputationAlpha,
+		config.AuditLambda, config.AuditWeight)
+	// for audit unknown, only update unknown alpha/beta
+	info.UnknownAuditReputationBeta, info.UnknownAuditReputationAlpha = UpdateReputationMultiple(
+		updates.UnknownResults, info.UnknownAuditReputationBeta, info.UnknownAuditReputationAlpha,
+		config.UnknownAuditLambda, config.AuditWeight)
+	// for a successful audit, increase reputation for normal *and* unknown audits
+	info.AuditReputationAlpha, info.AuditReputationBeta = UpdateReputationMultiple(
+		updates.PositiveResults, info.AuditReputationAlpha, info.AuditReputationBeta,
+		config.AuditLambda, config.AuditWeight)
+	info.UnknownAuditReputationAlpha, info.UnknownAuditReputationBeta = UpdateReputationMultiple(
+		updates.PositiveResults, info.UnknownAuditReputationAlpha, info.UnknownAuditReputationBeta,
+		config.UnknownAuditLambda, config.AuditWeight)
+
+	// offline results affect only the total count.
+	info.TotalAuditCount += int64(updates.OfflineResults + updates.UnknownResults + updates.FailureResults + updates.PositiveResults)
+	info.AuditSuccessCount += int64(updates.PositiveResults)
+	info.OnlineScore = onlineScore
+
+	if info.VettedAt == nil && info.TotalAuditCount >= config.AuditCount {
+		info.VettedAt = timePtr(now)
+	}
+
+	// disqualification case a
+	//   a) Success/fail audit reputation falls below audit DQ threshold
+	auditRep := info.AuditReputationAlpha / (info.AuditReputationAlpha + info.AuditReputationBeta)
+	if auditRep <= config.AuditDQ {
+		disqualify(overlay.DisqualificationReasonAuditFailure)
+	}
+
+	// if unknown audit rep goes below threshold, suspend node. Otherwise unsuspend node.
+	unknownAuditRep := info.UnknownAuditReputationAlpha / (info.UnknownAuditReputationAlpha + info.UnknownAuditReputationBeta)
+	if unknownAuditRep <= config.UnknownAuditDQ {
+		if info.UnknownAuditSuspended == nil {
+			info.UnknownAuditSuspended = timePtr(now)
+		} else if now.Sub(*info.UnknownAuditSuspended) > config.SuspensionGracePeriod && config.SuspensionDQEnabled {
+			// disqualification case b
+			//   b) Node is suspended (success/unknown reputation below audit DQ threshold)
+			//        AND the suspended grace period has elapsed
+			//        AND audit outcome is unknown or failed
+			disqualify(overlay.DisqualificationReasonSuspension)
+			info.UnknownAuditSuspended = nil
+		}
+	} else {
+		info.UnknownAuditSuspended = nil
+	}
+
+	// if suspension not enabled, skip penalization and unsuspend node if applicable
+	if !config.AuditHistory.OfflineSuspensionEnabled {
+		info.OfflineSuspended = nil
+		info.UnderReview = nil
+		return disqualified
+	}
+
+	// only penalize node if online score is below threshold and
+	// if it has enough completed windows to fill a tracking period
+	penalizeOfflineNode := onlineScore < config.AuditHistory.OfflineThreshold && trackingPeriodFull
+
+	// Suspension and disqualification for offline nodes
+	if info.UnderReview != nil {
+		// move node in and out of suspension as needed during review period
+		if !penalizeOfflineNode {
+			info.OfflineSuspended = nil
+		} else if info.OfflineSuspended == nil {
+			info.OfflineSuspended = timePtr(now)
+		}
+
+		// after tracking period has elapsed, if score is good, clear under review
+		// otherwise, disqualify node (if OfflineDQEnabled feature flag is true)
+		trackingPeriodEnd := info.UnderReview.Add(config.AuditHistory.GracePeriod).Add(config.AuditHistory.TrackingPeriod)
+		if now.After(trackingPeriodEnd) {
+			if penalizeOfflineNode {
+				if config.AuditHistory.OfflineDQEnabled {
+					disqualify(overlay.DisqualificationReasonNodeOffline)
+				}
+			} else {
+				info.UnderReview = nil
+				info.OfflineSuspended = nil
+			}
+		}
+	} else if penalizeOfflineNode {
+		// suspend node for being offline and begin review period
+		info.UnderReview = timePtr(now)
+		info.OfflineSuspended = timePtr(now)
+	}
+
+	return disqualified
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/overlay"
+)
+
+// ErrNodeDisqualified is returned when a disqualified node needs to be
+// reinstated instead.
+var ErrNodeDisqualified = errs.Class("node is disqualified")
+
+// ChangeAction is the kind of a manual reputation change.
+type ChangeAction string
+
+const (
+	// ChangeReinstate clears the disqualification and the suspensions of a node
+	// and resets its reputation.
+	ChangeReinstate ChangeAction = "reinstate"
+	// ChangeUnsuspend clears the suspensions of a node and resets its unknown
+	// audit reputation and audit history.
+	ChangeUnsuspend ChangeAction = "unsuspend"
+)
+
+// Change is a manual change of a node's reputation, kept as an audit trail.
+type Change struct {
+	ID     uuid.UUID
+	NodeID storj.NodeID
+	Action ChangeAction
+	Reason string
+	// Previous is the reputation of the node before the change.
+	Previous  ChangeState
+	CreatedAt time.Time
+}
+
+// ChangeState is the reputation state of a node which can be reset by a
+// manual change.
+type ChangeState struct {
+	Disqualified                *time.Time                     `json:"disqualified"`
+	DisqualificationReason      overlay.DisqualificationReason `json:"disqualificationReason"`
+	UnknownAuditSuspended       *time.Time                     `json:"unknownAuditSuspended"`
+	OfflineSuspended            *time.Time                     `json:"offlineSuspended"`
+	UnderReview                 *time.Time                     `json:"underReview"`
+	OnlineScore                 float64                        `json:"onlineScore"`
+	AuditReputationAlpha        float64                        `json:"auditReputationAlpha"`
+	AuditReputationBeta         float64                        `json:"auditReputationBeta"`
+	UnknownAuditReputationAlpha float64                        `json:"unknownAuditReputationAlpha"`
+	UnknownAuditReputationBeta  float64                        `json:"unknownAuditReputationBeta"`
+}
+
+// NewChangeState returns the part of the reputation info which can be reset
+// by a manual change.
+func NewChangeState(info *Info) ChangeState {
+	return ChangeState{
+		Disqualified:                cloneTime(info.Disqualified),
+		DisqualificationReason:      info.DisqualificationReason,
+		UnknownAuditSuspended:       cloneTime(info.UnknownAuditSuspended),
+		OfflineSuspended:            cloneTime(info.OfflineSuspended),
+		UnderReview:                 cloneTime(info.UnderReview),
+		OnlineScore:                 info.OnlineScore,
+		AuditReputationAlpha:        info.AuditReputationAlpha,
+		AuditReputationBeta:         info.AuditReputationBeta,
+		UnknownAuditReputationAlpha: info.UnknownAuditReputationAlpha,
+		UnknownAuditReputationBeta:  info.UnknownAuditReputationBeta,
+	}
+}
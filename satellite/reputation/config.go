@@ -73,6 +73,11 @@ const (
 	AuditUnknown
 	// AuditOffline represents an audit where a node was offline.
 	AuditOffline
+	// AuditExistenceSuccess represents a successful piece existence audit.
+	AuditExistenceSuccess
+	// AuditExistenceFailure represents a piece existence audit where the node
+	// could not prove that it holds the piece.
+	AuditExistenceFailure
 )
 
 func (auditType AuditType) String() string {
@@ -85,6 +90,10 @@ func (auditType AuditType) String() string {
 		return "AuditUnknown"
 	case AuditOffline:
 		return "AuditOffline"
+	case AuditExistenceSuccess:
+		return "AuditExistenceSuccess"
+	case AuditExistenceFailure:
+		return "AuditExistenceFailure"
 	}
 	return fmt.Sprintf("<unregistered audittype %d>", auditType)
 }
@@ -28,6 +28,18 @@ type DB interface {
 	DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time, reason overlay.DisqualificationReason) (err error)
 	// SuspendNodeUnknownAudit suspends a storage node for unknown audits.
 	SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error)
+
+	// Reinstate clears the disqualification and the suspensions of a node,
+	// resets its reputation to the initial values of the config and records
+	// the change.
+	Reinstate(ctx context.Context, nodeID storj.NodeID, reason string, config Config) (Change, error)
+	// Unsuspend clears the suspensions of a node which isn't disqualified,
+	// resets its unknown audit reputation and audit history and records the
+	// change.
+	Unsuspend(ctx context.Context, nodeID storj.NodeID, reason string) (Change, error)
+	// ListChanges returns the manual reputation changes of a node, most recent
+	// first.
+	ListChanges(ctx context.Context, nodeID storj.NodeID) ([]Change, error)
 }
 
 // Info contains all reputation data to be stored in DB.
@@ -163,6 +175,74 @@ func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (info *Inf
 	return info, nil
 }
 
+// Reinstate reinstates a disqualified node and resets its reputation. Once
+// the checker refreshes its view of the reliable nodes, the pieces which are
+// still on the node are considered healthy again.
+func (service *Service) Reinstate(ctx context.Context, nodeID storj.NodeID, reason string) (_ Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if reason == "" {
+		return Change{}, Error.New("reason is required")
+	}
+
+	// flush the cached audit results, so they are not applied after the reset.
+	if err := service.FlushNodeInfo(ctx, nodeID); err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+
+	// the reputation is reset before the node is reinstated in the overlay, so
+	// the node can't be selected while it still has the reputation that got it
+	// disqualified. Failing to update the overlay leaves the node disqualified
+	// there, and the reinstatement can be retried.
+	change, err := service.db.Reinstate(ctx, nodeID, reason, service.config)
+	if err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+
+	if err := service.overlay.ReinstateNode(ctx, nodeID); err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+
+	service.log.Info("node reinstated", zap.Stringer("Node ID", nodeID), zap.String("Reason", reason))
+	mon.Meter("reputation_node_reinstated").Mark(1)
+	return change, nil
+}
+
+// Unsuspend lifts the unknown audit and offline suspensions of a node.
+func (service *Service) Unsuspend(ctx context.Context, nodeID storj.NodeID, reason string) (_ Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if reason == "" {
+		return Change{}, Error.New("reason is required")
+	}
+
+	if err := service.FlushNodeInfo(ctx, nodeID); err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+
+	change, err := service.db.Unsuspend(ctx, nodeID, reason)
+	if err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+
+	if err := service.overlay.UnsuspendNode(ctx, nodeID); err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+
+	service.log.Info("node unsuspended", zap.Stringer("Node ID", nodeID), zap.String("Reason", reason))
+	mon.Meter("reputation_node_unsuspended").Mark(1)
+	return change, nil
+}
+
+// ListChanges returns the manual reputation changes of a node, most recent
+// first.
+func (service *Service) ListChanges(ctx context.Context, nodeID storj.NodeID) (_ []Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	changes, err := service.db.ListChanges(ctx, nodeID)
+	return changes, Error.Wrap(err)
+}
+
 // TestSuspendNodeUnknownAudit suspends a storage node for unknown audits.
 func (service *Service) TestSuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
 	err = service.db.SuspendNodeUnknownAudit(ctx, nodeID, suspendedAt)
@@ -251,7 +331,11 @@ func UpdateRequestToMutations(updateReq UpdateRequest, now time.Time) (Mutations
 	switch updateReq.AuditOutcome {
 	case AuditSuccess:
 		updates.PositiveResults = 1
-	case AuditFailure:
+	case AuditExistenceSuccess:
+		// existence audits only challenge a small range of the piece, so a
+		// success only counts as being online and doesn't give audit
+		// reputation or vetting credit.
+	case AuditFailure, AuditExistenceFailure:
 		updates.FailureResults = 1
 	case AuditUnknown:
 		updates.UnknownResults = 1
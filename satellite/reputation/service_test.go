@@ -5,6 +5,7 @@ package reputation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -190,3 +191,32 @@ func TestDisqualificationAuditFailure(t *testing.T) {
 		assert.NotNil(t, nodeInfo.Disqualified)
 	})
 }
+
+func TestExistenceAuditMutations(t *testing.T) {
+	now := time.Now()
+	config := reputation.Config{
+		AuditHistory: testAuditHistoryConfig(),
+	}
+
+	mutations, err := reputation.UpdateRequestToMutations(reputation.UpdateRequest{
+		AuditOutcome: reputation.AuditExistenceSuccess,
+		Config:       config,
+	}, now)
+	require.NoError(t, err)
+	require.Zero(t, mutations.PositiveResults)
+	require.Zero(t, mutations.FailureResults)
+	require.Len(t, mutations.OnlineHistory.Windows, 1)
+	require.EqualValues(t, 1, mutations.OnlineHistory.Windows[0].OnlineCount)
+
+	mutations, err = reputation.UpdateRequestToMutations(reputation.UpdateRequest{
+		AuditOutcome: reputation.AuditExistenceFailure,
+		Config:       config,
+	}, now)
+	require.NoError(t, err)
+	require.Zero(t, mutations.PositiveResults)
+	require.Equal(t, 1, mutations.FailureResults)
+	require.EqualValues(t, 1, mutations.OnlineHistory.Windows[0].OnlineCount)
+
+	require.Equal(t, "AuditExistenceSuccess", reputation.AuditExistenceSuccess.String())
+	require.Equal(t, "AuditExistenceFailure", reputation.AuditExistenceFailure.String())
+}
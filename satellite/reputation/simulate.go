@@ -0,0 +1,347 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+)
+
+// SimulatedAudit is an audit outcome replayed by the Simulator.
+type SimulatedAudit struct {
+	// Node identifies the node, it doesn't need to be a node ID.
+	Node    string
+	Time    time.Time
+	Outcome AuditType
+}
+
+// SimulatedNode is the reputation of a node after replaying its audits.
+type SimulatedNode struct {
+	Node string
+	Info
+
+	// Audits is the number of audits applied. Audits after the node was
+	// disqualified are ignored.
+	Audits int
+
+	// The suspensions may be lifted before the end of the simulation, so the
+	// time the node was first suspended is kept separately.
+	FirstUnknownAuditSuspended *time.Time
+	FirstOfflineSuspended      *time.Time
+	FirstUnderReview           *time.Time
+}
+
+// Simulator replays audit outcomes through the reputation model without
+// touching the database. It applies the same rules as the reputation updates
+// in satellitedb.
+type Simulator struct {
+	config Config
+	nodes  map[string]*SimulatedNode
+	order  []string
+}
+
+// NewSimulator creates a simulator for the reputation config.
+func NewSimulator(config Config) *Simulator {
+	return &Simulator{
+		config: config,
+		nodes:  map[string]*SimulatedNode{},
+	}
+}
+
+// Apply updates the reputation of the audited node. The audits of a node must
+// be applied in chronological order.
+func (sim *Simulator) Apply(audit SimulatedAudit) error {
+	node, ok := sim.nodes[audit.Node]
+	if !ok {
+		node = &SimulatedNode{
+			Node: audit.Node,
+			Info: Info{
+				AuditReputationAlpha:        sim.config.InitialAlpha,
+				AuditReputationBeta:         sim.config.InitialBeta,
+				UnknownAuditReputationAlpha: 1,
+				UnknownAuditReputationBeta:  0,
+				OnlineScore:                 1,
+				AuditHistory:                &pb.AuditHistory{},
+			},
+		}
+		sim.nodes[audit.Node] = node
+		sim.order = append(sim.order, audit.Node)
+	}
+	if node.Disqualified != nil {
+		return nil
+	}
+
+	updates, err := UpdateRequestToMutations(UpdateRequest{
+		AuditOutcome: audit.Outcome,
+		Config:       sim.config,
+	}, audit.Time)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	node.Audits++
+	sim.update(node, updates, audit.Time)
+	return nil
+}
+
+// update applies the mutations the same way the reputation updates in
+// satellitedb do.
+func (sim *Simulator) update(node *SimulatedNode, updates Mutations, now time.Time) {
+	trackingPeriodFull := MergeAuditHistories(node.AuditHistory, updates.OnlineHistory.Windows, sim.config.AuditHistory)
+	node.ApplyMutations(updates, sim.config, node.AuditHistory.Score, trackingPeriodFull, now)
+
+	if node.UnknownAuditSuspended != nil && node.FirstUnknownAuditSuspended == nil {
+		node.FirstUnknownAuditSuspended = timePtr(now)
+	}
+	if node.OfflineSuspended != nil && node.FirstOfflineSuspended == nil {
+		node.FirstOfflineSuspended = timePtr(now)
+	}
+	if node.UnderReview != nil && node.FirstUnderReview == nil {
+		node.FirstUnderReview = timePtr(now)
+	}
+}
+
+// Nodes returns the reputation of the simulated nodes in the order they were
+// first audited.
+func (sim *Simulator) Nodes() []SimulatedNode {
+	nodes := make([]SimulatedNode, 0, len(sim.order))
+	for _, name := range sim.order {
+		nodes = append(nodes, *sim.nodes[name])
+	}
+	return nodes
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
+
+// SimulationVariant is a named reputation config to simulate.
+type SimulationVariant struct {
+	Name   string
+	Config Config
+}
+
+// ParseSimulationVariants parses variants of the base config in the form
+// "strict:audit-dq=0.98,audit-lambda=0.99;lenient:audit-history.offline-threshold=0.5".
+// The keys are the config flag names without the "reputation." prefix. The
+// base config is always the first variant.
+func ParseSimulationVariants(base Config, spec string) ([]SimulationVariant, error) {
+	variants := []SimulationVariant{{Name: "base", Config: base}}
+	names := map[string]bool{"base": true}
+
+	for _, variantSpec := range strings.Split(spec, ";") {
+		variantSpec = strings.TrimSpace(variantSpec)
+		if variantSpec == "" {
+			continue
+		}
+		parts := strings.SplitN(variantSpec, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, Error.New("invalid variant %q: expected name:key=value,...", variantSpec)
+		}
+		name := strings.TrimSpace(parts[0])
+		if names[name] {
+			return nil, Error.New("duplicate variant %q", name)
+		}
+		names[name] = true
+
+		config := base
+		if err := ApplyConfigOverrides(&config, parts[1]); err != nil {
+			return nil, Error.New("invalid variant %q: %v", name, err)
+		}
+		variants = append(variants, SimulationVariant{Name: name, Config: config})
+	}
+	return variants, nil
+}
+
+// ApplyConfigOverrides sets the config values listed as
+// "audit-dq=0.98,audit-history.offline-threshold=0.5".
+func ApplyConfigOverrides(config *Config, overrides string) error {
+	for _, override := range strings.Split(overrides, ",") {
+		override = strings.TrimSpace(override)
+		if override == "" {
+			continue
+		}
+		parts := strings.SplitN(override, "=", 2)
+		if len(parts) != 2 {
+			return Error.New("invalid override %q: expected key=value", override)
+		}
+		if err := setConfigValue(reflect.ValueOf(config).Elem(), strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])); err != nil {
+			return Error.New("invalid override %q: %v", override, err)
+		}
+	}
+	return nil
+}
+
+func setConfigValue(config reflect.Value, key, value string) error {
+	for i := 0; i < config.NumField(); i++ {
+		field := config.Field(i)
+		name := hyphenate(config.Type().Field(i).Name)
+
+		if field.Kind() == reflect.Struct {
+			if strings.HasPrefix(key, name+".") {
+				return setConfigValue(field, strings.TrimPrefix(key, name+"."), value)
+			}
+			continue
+		}
+		if name != key {
+			continue
+		}
+
+		switch {
+		case field.Type() == reflect.TypeOf(time.Duration(0)):
+			v, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+			field.SetInt(int64(v))
+		case field.Kind() == reflect.Float64:
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return err
+			}
+			field.SetFloat(v)
+		case field.Kind() == reflect.Int64:
+			v, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			field.SetInt(v)
+		case field.Kind() == reflect.Bool:
+			v, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+			field.SetBool(v)
+		default:
+			return errs.New("unsupported type %v", field.Type())
+		}
+		return nil
+	}
+	return errs.New("unknown key %q", key)
+}
+
+// hyphenate converts a field name to its config flag name, e.g. AuditDQ to
+// audit-dq.
+func hyphenate(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// SyntheticConfig describes the failure patterns of generated audits.
+type SyntheticConfig struct {
+	FailureRates    string `help:"comma separated fractions of audits failed by the synthetic nodes" default:"0,0.01,0.02,0.05"`
+	UnknownRates    string `help:"comma separated fractions of audits with unknown errors" default:"0"`
+	OfflineRates    string `help:"comma separated fractions of audits while the node is offline" default:"0"`
+	NodesPerPattern int    `help:"number of synthetic nodes for every combination of rates" default:"1"`
+	Days            int    `help:"number of days to generate audits for" default:"30"`
+	AuditsPerDay    int    `help:"number of audits per node per day" default:"50"`
+	Seed            int64  `help:"seed of the random outcomes" default:"1"`
+}
+
+// SyntheticAudits generates audits for every combination of the failure,
+// unknown and offline rates, starting at the start time. The audits are
+// ordered by time.
+func SyntheticAudits(config SyntheticConfig, start time.Time) ([]SimulatedAudit, error) {
+	failureRates, err := parseRates(config.FailureRates)
+	if err != nil {
+		return nil, Error.New("invalid failure rates: %v", err)
+	}
+	unknownRates, err := parseRates(config.UnknownRates)
+	if err != nil {
+		return nil, Error.New("invalid unknown rates: %v", err)
+	}
+	offlineRates, err := parseRates(config.OfflineRates)
+	if err != nil {
+		return nil, Error.New("invalid offline rates: %v", err)
+	}
+	if config.NodesPerPattern <= 0 || config.Days <= 0 || config.AuditsPerDay <= 0 {
+		return nil, Error.New("nodes per pattern, days and audits per day must be positive")
+	}
+
+	type pattern struct {
+		node                      string
+		failure, unknown, offline float64
+	}
+	var patterns []pattern
+	for _, failure := range failureRates {
+		for _, unknown := range unknownRates {
+			for _, offline := range offlineRates {
+				if failure+unknown+offline > 1 {
+					return nil, Error.New("rates add up to more than 1: failure=%v unknown=%v offline=%v", failure, unknown, offline)
+				}
+				for i := 0; i < config.NodesPerPattern; i++ {
+					patterns = append(patterns, pattern{
+						node:    fmt.Sprintf("failure=%v,unknown=%v,offline=%v#%d", failure, unknown, offline, i),
+						failure: failure,
+						unknown: unknown,
+						offline: offline,
+					})
+				}
+			}
+		}
+	}
+
+	rng := rand.New(rand.NewSource(config.Seed))
+	interval := 24 * time.Hour / time.Duration(config.AuditsPerDay)
+	total := config.Days * config.AuditsPerDay
+
+	audits := make([]SimulatedAudit, 0, total*len(patterns))
+	for i := 0; i < total; i++ {
+		auditTime := start.Add(time.Duration(i) * interval)
+		for _, p := range patterns {
+			outcome := AuditSuccess
+			switch r := rng.Float64(); {
+			case r < p.failure:
+				outcome = AuditFailure
+			case r < p.failure+p.unknown:
+				outcome = AuditUnknown
+			case r < p.failure+p.unknown+p.offline:
+				outcome = AuditOffline
+			}
+			audits = append(audits, SimulatedAudit{Node: p.node, Time: auditTime, Outcome: outcome})
+		}
+	}
+	return audits, nil
+}
+
+func parseRates(rates string) ([]float64, error) {
+	var parsed []float64
+	for _, rate := range strings.Split(rates, ",") {
+		rate = strings.TrimSpace(rate)
+		if rate == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(rate, 64)
+		if err != nil {
+			return nil, err
+		}
+		if v < 0 || v > 1 {
+			return nil, errs.New("rate %v out of range [0, 1]", v)
+		}
+		parsed = append(parsed, v)
+	}
+	if len(parsed) == 0 {
+		return nil, errs.New("no rates")
+	}
+	sort.Float64s(parsed)
+	return parsed, nil
+}
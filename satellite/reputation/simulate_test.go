@@ -0,0 +1,161 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+func simulationConfig() reputation.Config {
+	return reputation.Config{
+		AuditLambda:           0.95,
+		AuditWeight:           1,
+		AuditDQ:               0.6,
+		UnknownAuditLambda:    0.95,
+		UnknownAuditDQ:        0.6,
+		SuspensionGracePeriod: time.Hour,
+		SuspensionDQEnabled:   true,
+		AuditCount:            5,
+		InitialAlpha:          1,
+		InitialBeta:           0,
+		AuditHistory: reputation.AuditHistoryConfig{
+			WindowSize:               time.Hour,
+			TrackingPeriod:           2 * time.Hour,
+			GracePeriod:              time.Hour,
+			OfflineThreshold:         0.6,
+			OfflineDQEnabled:         true,
+			OfflineSuspensionEnabled: true,
+		},
+	}
+}
+
+func TestSimulator(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	sim := reputation.NewSimulator(simulationConfig())
+	for i := 0; i < 20; i++ {
+		now := start.Add(time.Duration(i) * 10 * time.Minute)
+		require.NoError(t, sim.Apply(reputation.SimulatedAudit{Node: "good", Time: now, Outcome: reputation.AuditSuccess}))
+		require.NoError(t, sim.Apply(reputation.SimulatedAudit{Node: "failing", Time: now, Outcome: reputation.AuditFailure}))
+		require.NoError(t, sim.Apply(reputation.SimulatedAudit{Node: "unknown", Time: now, Outcome: reputation.AuditUnknown}))
+		require.NoError(t, sim.Apply(reputation.SimulatedAudit{Node: "offline", Time: now, Outcome: reputation.AuditOffline}))
+	}
+
+	nodes := sim.Nodes()
+	require.Len(t, nodes, 4)
+
+	good := nodes[0]
+	require.Equal(t, "good", good.Node)
+	require.Equal(t, 20, good.Audits)
+	require.Equal(t, start.Add(40*time.Minute), *good.VettedAt)
+	require.Nil(t, good.Disqualified)
+	require.Nil(t, good.FirstUnknownAuditSuspended)
+	require.Nil(t, good.FirstOfflineSuspended)
+
+	failing := nodes[1]
+	require.Equal(t, "failing", failing.Node)
+	require.Equal(t, start, *failing.Disqualified)
+	require.Equal(t, overlay.DisqualificationReasonAuditFailure, failing.DisqualificationReason)
+	require.Equal(t, 1, failing.Audits, "audits after disqualification are ignored")
+
+	unknown := nodes[2]
+	require.Equal(t, "unknown", unknown.Node)
+	require.Equal(t, start, *unknown.FirstUnknownAuditSuspended)
+	require.Equal(t, start.Add(70*time.Minute), *unknown.Disqualified)
+	require.Equal(t, overlay.DisqualificationReasonSuspension, unknown.DisqualificationReason)
+
+	offline := nodes[3]
+	require.Equal(t, "offline", offline.Node)
+	require.NotNil(t, offline.FirstOfflineSuspended)
+	require.NotNil(t, offline.FirstUnderReview)
+	require.Equal(t, 0.0, offline.OnlineScore)
+	require.Nil(t, offline.Disqualified, "the review period hasn't passed yet")
+}
+
+func TestParseSimulationVariants(t *testing.T) {
+	base := simulationConfig()
+
+	variants, err := reputation.ParseSimulationVariants(base, "")
+	require.NoError(t, err)
+	require.Equal(t, []reputation.SimulationVariant{{Name: "base", Config: base}}, variants)
+
+	variants, err = reputation.ParseSimulationVariants(base,
+		"strict: audit-dq=0.98, audit-count=10 ; lenient:audit-history.offline-threshold=0.5,audit-history.grace-period=24h,suspension-dq-enabled=false")
+	require.NoError(t, err)
+	require.Len(t, variants, 3)
+
+	strict := base
+	strict.AuditDQ = 0.98
+	strict.AuditCount = 10
+	require.Equal(t, reputation.SimulationVariant{Name: "strict", Config: strict}, variants[1])
+
+	lenient := base
+	lenient.AuditHistory.OfflineThreshold = 0.5
+	lenient.AuditHistory.GracePeriod = 24 * time.Hour
+	lenient.SuspensionDQEnabled = false
+	require.Equal(t, reputation.SimulationVariant{Name: "lenient", Config: lenient}, variants[2])
+
+	for _, invalid := range []string{
+		"strict",
+		":audit-dq=1",
+		"strict:audit-dq",
+		"strict:unknown=1",
+		"strict:audit-dq=x",
+		"strict:audit-history.grace-period=1",
+		"base:audit-dq=1",
+		"a:audit-dq=1;a:audit-dq=2",
+	} {
+		_, err := reputation.ParseSimulationVariants(base, invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSyntheticAudits(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	config := reputation.SyntheticConfig{
+		FailureRates:    "0,1",
+		UnknownRates:    "0",
+		OfflineRates:    "0",
+		NodesPerPattern: 2,
+		Days:            2,
+		AuditsPerDay:    4,
+		Seed:            1,
+	}
+
+	audits, err := reputation.SyntheticAudits(config, start)
+	require.NoError(t, err)
+	require.Len(t, audits, 2*2*2*4)
+
+	counts := map[string]map[reputation.AuditType]int{}
+	for i, audit := range audits {
+		if i > 0 {
+			require.False(t, audit.Time.Before(audits[i-1].Time))
+		}
+		if counts[audit.Node] == nil {
+			counts[audit.Node] = map[reputation.AuditType]int{}
+		}
+		counts[audit.Node][audit.Outcome]++
+	}
+	require.Equal(t, map[string]map[reputation.AuditType]int{
+		"failure=0,unknown=0,offline=0#0": {reputation.AuditSuccess: 8},
+		"failure=0,unknown=0,offline=0#1": {reputation.AuditSuccess: 8},
+		"failure=1,unknown=0,offline=0#0": {reputation.AuditFailure: 8},
+		"failure=1,unknown=0,offline=0#1": {reputation.AuditFailure: 8},
+	}, counts)
+	require.Equal(t, start.Add(42*time.Hour), audits[len(audits)-1].Time)
+
+	config.UnknownRates = "0.5"
+	_, err = reputation.SyntheticAudits(config, start)
+	require.Error(t, err)
+
+	config.UnknownRates = "x"
+	_, err = reputation.SyntheticAudits(config, start)
+	require.Error(t, err)
+}
@@ -379,6 +379,38 @@ func (cdb *CachingDB) SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.
 	return cdb.RequestSync(ctx, nodeID)
 }
 
+// Reinstate clears the disqualification and the suspensions of a node and
+// resets its reputation.
+func (cdb *CachingDB) Reinstate(ctx context.Context, nodeID storj.NodeID, reason string, config Config) (_ Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	change, err := cdb.backingStore.Reinstate(ctx, nodeID, reason, config)
+	if err != nil {
+		return Change{}, err
+	}
+	// sync with database (this will get it marked as reinstated in the cache)
+	return change, cdb.RequestSync(ctx, nodeID)
+}
+
+// Unsuspend clears the suspensions of a node.
+func (cdb *CachingDB) Unsuspend(ctx context.Context, nodeID storj.NodeID, reason string) (_ Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	change, err := cdb.backingStore.Unsuspend(ctx, nodeID, reason)
+	if err != nil {
+		return Change{}, err
+	}
+	// sync with database (this will get it marked as unsuspended in the cache)
+	return change, cdb.RequestSync(ctx, nodeID)
+}
+
+// ListChanges returns the manual reputation changes of a node.
+func (cdb *CachingDB) ListChanges(ctx context.Context, nodeID storj.NodeID) (_ []Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cdb.backingStore.ListChanges(ctx, nodeID)
+}
+
 // RequestSync requests the managing goroutine to perform a sync of cached info
 // about the specified node to the backing store. This involves applying the
 // cached mutations and resetting the info attribute to match a snapshot of what
@@ -0,0 +1,67 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/stripecoinpayments"
+)
+
+// ensures that autoTopUps implements stripecoinpayments.AutoTopUpsDB.
+var _ stripecoinpayments.AutoTopUpsDB = (*autoTopUps)(nil)
+
+// autoTopUps is an implementation of stripecoinpayments.AutoTopUpsDB.
+//
+// architecture: Database
+type autoTopUps struct {
+	db *satelliteDB
+}
+
+// Get returns the auto top-up settings of the user.
+func (topUps *autoTopUps) Get(ctx context.Context, userID uuid.UUID) (settings payments.AutoTopUp, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	err = topUps.db.QueryRowContext(ctx, `
+		SELECT threshold, amount FROM prepaid_auto_topups WHERE user_id = $1
+	`, userID).Scan(&settings.Threshold, &settings.Amount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return payments.AutoTopUp{}, nil
+	}
+	if err != nil {
+		return payments.AutoTopUp{}, Error.Wrap(err)
+	}
+
+	settings.Enabled = true
+	return settings, nil
+}
+
+// Set enables the auto top-up of the user with the given threshold and amount.
+func (topUps *autoTopUps) Set(ctx context.Context, userID uuid.UUID, settings payments.AutoTopUp) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	_, err = topUps.db.ExecContext(ctx, `
+		INSERT INTO prepaid_auto_topups (user_id, threshold, amount)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			threshold  = EXCLUDED.threshold,
+			amount     = EXCLUDED.amount,
+			updated_at = current_timestamp
+	`, userID, settings.Threshold, settings.Amount)
+	return Error.Wrap(err)
+}
+
+// Delete disables the auto top-up of the user.
+func (topUps *autoTopUps) Delete(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	_, err = topUps.db.ExecContext(ctx, `
+		DELETE FROM prepaid_auto_topups WHERE user_id = $1
+	`, userID)
+	return Error.Wrap(err)
+}
@@ -31,6 +31,17 @@ type billingDB struct {
 
 func (db billingDB) Insert(ctx context.Context, billingTX billing.Transaction) (txID int64, err error) {
 	defer mon.Task()(&ctx)(&err)
+	billingAmount := monetary.AmountFromDecimal(billingTX.Amount.AsDecimal().Truncate(monetary.USDollarsMicro.DecimalPlaces()), monetary.USDollarsMicro)
+
+	if billingTX.Status == billing.TransactionStatusPending {
+		// pending transactions change the balance only once they complete.
+		dbxTX, err := createBillingTransaction(ctx, db.db, billingTX, billingAmount)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		return dbxTX.Id, nil
+	}
+
 	var dbxTX *dbx.BillingTransaction
 	var retryCount int
 	for {
@@ -38,7 +49,6 @@ func (db billingDB) Insert(ctx context.Context, billingTX billing.Transaction) (
 		if err != nil {
 			return 0, Error.Wrap(err)
 		}
-		billingAmount := monetary.AmountFromDecimal(billingTX.Amount.AsDecimal().Truncate(monetary.USDollarsMicro.DecimalPlaces()), monetary.USDollarsMicro)
 		newBalance, err := monetary.Add(oldBalance, billingAmount)
 		if err != nil {
 			return 0, Error.Wrap(err)
@@ -69,16 +79,7 @@ func (db billingDB) Insert(ctx context.Context, billingTX billing.Transaction) (
 				}
 			}
 
-			dbxTX, err = tx.Create_BillingTransaction(ctx,
-				dbx.BillingTransaction_UserId(billingTX.UserID[:]),
-				dbx.BillingTransaction_Amount(billingAmount.BaseUnits()),
-				dbx.BillingTransaction_Currency(billingAmount.Currency().Symbol()),
-				dbx.BillingTransaction_Description(billingTX.Description),
-				dbx.BillingTransaction_Source(billingTX.Source),
-				dbx.BillingTransaction_Status(string(billingTX.Status)),
-				dbx.BillingTransaction_Type(string(billingTX.Type)),
-				dbx.BillingTransaction_Metadata(handleMetaDataZeroValue(billingTX.Metadata)),
-				dbx.BillingTransaction_Timestamp(billingTX.Timestamp))
+			dbxTX, err = createBillingTransaction(ctx, tx, billingTX, billingAmount)
 			return err
 		})
 		if pgerrcode.IsConstraintViolation(err) {
@@ -98,10 +99,54 @@ func (db billingDB) Insert(ctx context.Context, billingTX billing.Transaction) (
 	return dbxTX.Id, err
 }
 
+// createBillingTransaction creates the transaction with the amount, without changing the balance.
+func createBillingTransaction(ctx context.Context, methods dbx.Methods, billingTX billing.Transaction, amount monetary.Amount) (*dbx.BillingTransaction, error) {
+	return methods.Create_BillingTransaction(ctx,
+		dbx.BillingTransaction_UserId(billingTX.UserID[:]),
+		dbx.BillingTransaction_Amount(amount.BaseUnits()),
+		dbx.BillingTransaction_Currency(amount.Currency().Symbol()),
+		dbx.BillingTransaction_Description(billingTX.Description),
+		dbx.BillingTransaction_Source(billingTX.Source),
+		dbx.BillingTransaction_Status(string(billingTX.Status)),
+		dbx.BillingTransaction_Type(string(billingTX.Type)),
+		dbx.BillingTransaction_Metadata(handleMetaDataZeroValue(billingTX.Metadata)),
+		dbx.BillingTransaction_Timestamp(billingTX.Timestamp))
+}
+
 func (db billingDB) UpdateStatus(ctx context.Context, txID int64, status billing.TransactionStatus) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	return db.db.UpdateNoReturn_BillingTransaction_By_Id(ctx, dbx.BillingTransaction_Id(txID), dbx.BillingTransaction_Update_Fields{
-		Status: dbx.BillingTransaction_Status(string(status)),
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		var userID []byte
+		var amount int64
+		var oldStatus billing.TransactionStatus
+		err := tx.Tx.QueryRowContext(ctx, `
+			SELECT user_id, amount, status FROM billing_transactions WHERE id = $1 FOR UPDATE
+		`, txID).Scan(&userID, &amount, &oldStatus)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		// the amount of a pending transaction is applied to the balance once it completes.
+		if oldStatus == billing.TransactionStatusPending && status == billing.TransactionStatusCompleted {
+			var balance int64
+			err = tx.Tx.QueryRowContext(ctx, `
+				INSERT INTO billing_balances (user_id, balance, last_updated) VALUES ($1, $2, now())
+				ON CONFLICT (user_id) DO UPDATE SET
+					balance = billing_balances.balance + excluded.balance,
+					last_updated = excluded.last_updated
+				RETURNING balance
+			`, userID, amount).Scan(&balance)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			if balance < 0 {
+				return billing.ErrInsufficientFunds
+			}
+		}
+
+		return tx.UpdateNoReturn_BillingTransaction_By_Id(ctx, dbx.BillingTransaction_Id(txID), dbx.BillingTransaction_Update_Fields{
+			Status: dbx.BillingTransaction_Status(string(status)),
+		})
 	})
 }
 
@@ -11,6 +11,7 @@ import (
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -186,6 +187,121 @@ func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projec
 	return nil
 }
 
+// MoveBucket renames the bucket and moves it to another project, together
+// with its attribution. The usage alerts and the usage rollups and tallies
+// are only moved when the bucket is renamed within the project, on a
+// transfer they stay with the source project, which was using the bucket
+// until then. The bucket keeps its placement.
+func (db *bucketsDB) MoveBucket(ctx context.Context, bucket, newBucket metabase.BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		result, err := tx.Tx.ExecContext(ctx, `
+			UPDATE bucket_metainfos SET project_id = $3, name = $4
+			WHERE project_id = $1 AND name = $2
+		`, bucket.ProjectID, []byte(bucket.BucketName), newBucket.ProjectID, []byte(newBucket.BucketName))
+		if err != nil {
+			if pgerrcode.IsConstraintViolation(err) {
+				return buckets.ErrBucketAlreadyExists.New("%s", newBucket.BucketName)
+			}
+			return storj.ErrBucket.Wrap(err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		if affected == 0 {
+			return storj.ErrBucketNotFound.New("%s", bucket.BucketName)
+		}
+
+		oldArgs := []interface{}{bucket.ProjectID, []byte(bucket.BucketName)}
+		newArgs := []interface{}{newBucket.ProjectID, []byte(newBucket.BucketName)}
+		moveArgs := append(append([]interface{}{}, oldArgs...), newArgs...)
+
+		type statement struct {
+			query string
+			args  []interface{}
+		}
+		statements := []statement{
+			// attribution of a deleted bucket with the new name is replaced.
+			{`DELETE FROM value_attributions WHERE project_id = $1 AND bucket_name = $2`, newArgs},
+			{`UPDATE value_attributions SET project_id = $3, bucket_name = $4
+			WHERE project_id = $1 AND bucket_name = $2`, moveArgs},
+
+			// alerts are set up by the members of the project, so they are
+			// kept only when the bucket stays in the project.
+			{`UPDATE usage_alerts SET bucket_name = $4
+			WHERE project_id = $1 AND bucket_name = $2 AND project_id = $3`, moveArgs},
+			{`DELETE FROM usage_alerts WHERE project_id = $1 AND bucket_name = $2`, oldArgs},
+		}
+
+		// the usage is billed to the project, which was using the bucket,
+		// so it's only moved, when the bucket stays in the project.
+		if bucket.ProjectID == newBucket.ProjectID {
+			statements = append(statements, []statement{
+				// rollups of a deleted bucket with the new name are merged.
+				{`INSERT INTO bucket_bandwidth_rollups (
+					bucket_name, project_id, interval_start, interval_seconds, action,
+					inline, allocated, settled
+				)
+				SELECT $4, $3, interval_start, interval_seconds, action, inline, allocated, settled
+				FROM bucket_bandwidth_rollups
+				WHERE project_id = $1 AND bucket_name = $2
+				ON CONFLICT (bucket_name, project_id, interval_start, action) DO UPDATE SET
+					inline    = bucket_bandwidth_rollups.inline + EXCLUDED.inline,
+					allocated = bucket_bandwidth_rollups.allocated + EXCLUDED.allocated,
+					settled   = bucket_bandwidth_rollups.settled + EXCLUDED.settled`, moveArgs},
+				{`DELETE FROM bucket_bandwidth_rollups WHERE project_id = $1 AND bucket_name = $2`, oldArgs},
+
+				{`INSERT INTO bucket_bandwidth_rollup_archives (
+					bucket_name, project_id, interval_start, interval_seconds, action,
+					inline, allocated, settled
+				)
+				SELECT $4, $3, interval_start, interval_seconds, action, inline, allocated, settled
+				FROM bucket_bandwidth_rollup_archives
+				WHERE project_id = $1 AND bucket_name = $2
+				ON CONFLICT (bucket_name, project_id, interval_start, action) DO UPDATE SET
+					inline    = bucket_bandwidth_rollup_archives.inline + EXCLUDED.inline,
+					allocated = bucket_bandwidth_rollup_archives.allocated + EXCLUDED.allocated,
+					settled   = bucket_bandwidth_rollup_archives.settled + EXCLUDED.settled`, moveArgs},
+				{`DELETE FROM bucket_bandwidth_rollup_archives WHERE project_id = $1 AND bucket_name = $2`, oldArgs},
+
+				{`INSERT INTO bucket_storage_tallies (
+					bucket_name, project_id, interval_start,
+					total_bytes, inline, remote,
+					total_segments_count, remote_segments_count, inline_segments_count,
+					object_count, metadata_size
+				)
+				SELECT
+					$4, $3, interval_start,
+					total_bytes, inline, remote,
+					total_segments_count, remote_segments_count, inline_segments_count,
+					object_count, metadata_size
+				FROM bucket_storage_tallies
+				WHERE project_id = $1 AND bucket_name = $2
+				ON CONFLICT (bucket_name, project_id, interval_start) DO UPDATE SET
+					total_bytes           = bucket_storage_tallies.total_bytes + EXCLUDED.total_bytes,
+					inline                = bucket_storage_tallies.inline + EXCLUDED.inline,
+					remote                = bucket_storage_tallies.remote + EXCLUDED.remote,
+					total_segments_count  = bucket_storage_tallies.total_segments_count + EXCLUDED.total_segments_count,
+					remote_segments_count = bucket_storage_tallies.remote_segments_count + EXCLUDED.remote_segments_count,
+					inline_segments_count = bucket_storage_tallies.inline_segments_count + EXCLUDED.inline_segments_count,
+					object_count          = bucket_storage_tallies.object_count + EXCLUDED.object_count,
+					metadata_size         = bucket_storage_tallies.metadata_size + EXCLUDED.metadata_size`, moveArgs},
+				{`DELETE FROM bucket_storage_tallies WHERE project_id = $1 AND bucket_name = $2`, oldArgs},
+			}...)
+		}
+
+		for _, statement := range statements {
+			_, err := tx.Tx.ExecContext(ctx, statement.query, statement.args...)
+			if err != nil {
+				return storj.ErrBucket.Wrap(err)
+			}
+		}
+		return nil
+	})
+}
+
 // ListBuckets returns a list of buckets for a project.
 func (db *bucketsDB) ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,145 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/consistency"
+)
+
+// ensures that consistencyFindings implements consistency.DB.
+var _ consistency.DB = (*consistencyFindings)(nil)
+
+// consistencyFindings is an implementation of consistency.DB.
+//
+// architecture: Database
+type consistencyFindings struct {
+	db *satelliteDB
+}
+
+// Report saves the findings. The last seen time of a finding, which has
+// already been saved, is updated.
+func (findings *consistencyFindings) Report(ctx context.Context, reported []consistency.Finding) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(reported) == 0 {
+		return nil
+	}
+
+	type key struct {
+		kind     consistency.Kind
+		streamID uuid.UUID
+		position metabase.SegmentPosition
+	}
+
+	// a row can be inserted only once by a statement, so the details of the
+	// same finding are merged.
+	var kinds, details []string
+	var streamIDs []uuid.UUID
+	var positions []int64
+	indexes := map[key]int{}
+	for _, finding := range reported {
+		k := key{finding.Kind, finding.StreamID, finding.Position}
+		if i, ok := indexes[k]; ok {
+			details[i] += "; " + finding.Details
+			continue
+		}
+		indexes[k] = len(kinds)
+		kinds = append(kinds, string(finding.Kind))
+		streamIDs = append(streamIDs, finding.StreamID)
+		positions = append(positions, int64(finding.Position.Encode()))
+		details = append(details, finding.Details)
+	}
+
+	_, err = findings.db.ExecContext(ctx, `
+		INSERT INTO metabase_consistency_findings (
+			kind, stream_id, position, details, first_seen_at, last_seen_at
+		) SELECT
+			UNNEST($1::TEXT[]), UNNEST($2::BYTEA[]), UNNEST($3::INT8[]), UNNEST($4::TEXT[]),
+			current_timestamp, current_timestamp
+		ON CONFLICT (kind, stream_id, position) DO UPDATE SET
+			details      = EXCLUDED.details,
+			last_seen_at = EXCLUDED.last_seen_at
+	`, pgutil.TextArray(kinds), pgutil.UUIDArray(streamIDs), pgutil.Int8Array(positions), pgutil.TextArray(details))
+	return Error.Wrap(err)
+}
+
+// List returns the findings, the most recently seen first.
+func (findings *consistencyFindings) List(ctx context.Context, opts consistency.ListFindings) (_ []consistency.Finding, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := findings.db.QueryContext(ctx, `
+		SELECT kind, stream_id, position, details, first_seen_at, last_seen_at
+		FROM metabase_consistency_findings
+		WHERE $1 = '' OR kind = $1
+		ORDER BY last_seen_at DESC, kind, stream_id, position
+		LIMIT $2
+	`, string(opts.Kind), opts.Limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []consistency.Finding
+	for rows.Next() {
+		var finding consistency.Finding
+		var kind string
+		var position int64
+		err := rows.Scan(&kind, &finding.StreamID, &position, &finding.Details,
+			&finding.FirstSeenAt, &finding.LastSeenAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		finding.Kind = consistency.Kind(kind)
+		finding.Position = metabase.SegmentPositionFromEncoded(uint64(position))
+		list = append(list, finding)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+// Delete removes a triaged finding.
+func (findings *consistencyFindings) Delete(ctx context.Context, kind consistency.Kind, streamID uuid.UUID, position metabase.SegmentPosition) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := findings.db.ExecContext(ctx, `
+		DELETE FROM metabase_consistency_findings
+		WHERE kind = $1 AND stream_id = $2 AND position = $3
+	`, string(kind), streamID, int64(position.Encode()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return consistency.ErrNotFound.New("%s %s %v", kind, streamID, position)
+	}
+	return nil
+}
+
+// DeleteNotSeenSince removes the findings, which haven't been found since
+// the time.
+func (findings *consistencyFindings) DeleteNotSeenSince(ctx context.Context, since time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := findings.db.ExecContext(ctx, `
+		DELETE FROM metabase_consistency_findings
+		WHERE last_seen_at < $1
+	`, since)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
@@ -74,6 +74,16 @@ func (db *ConsoleDB) WebappSessions() consoleauth.WebappSessions {
 	return &webappSessions{db.methods}
 }
 
+// UsageAlerts is a getter for UsageAlerts repository.
+func (db *ConsoleDB) UsageAlerts() console.UsageAlerts {
+	return &usageAlerts{db.db}
+}
+
+// SSOIdentities is a getter for SSOIdentities repository.
+func (db *ConsoleDB) SSOIdentities() console.SSOIdentities {
+	return &ssoIdentities{db.db}
+}
+
 // WithTx is a method for executing and retrying transaction.
 func (db *ConsoleDB) WithTx(ctx context.Context, fn func(context.Context, console.DBTx) error) error {
 	if db.db == nil {
@@ -23,13 +23,17 @@ import (
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/metabase/consistency"
+	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/pricing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair/evacuation"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
@@ -307,6 +311,26 @@ func (dbc *satelliteDBCollection) StorjscanPayments() storjscan.PaymentsDB {
 	return &storjscanPayments{db: dbc.getByName("storjscan_payments")}
 }
 
+// PricePlans returns database for price plans.
+func (dbc *satelliteDBCollection) PricePlans() pricing.DB {
+	return &pricePlans{db: dbc.getByName("priceplans")}
+}
+
+// NodeEvacuations returns database for node evacuations.
+func (dbc *satelliteDBCollection) NodeEvacuations() evacuation.DB {
+	return &nodeEvacuations{db: dbc.getByName("nodeevacuations")}
+}
+
+// SegmentLoopCheckpoints returns database for the segment loop checkpoints.
+func (dbc *satelliteDBCollection) SegmentLoopCheckpoints() segmentloop.CheckpointDB {
+	return &segmentLoopCheckpoints{db: dbc.getByName("segmentloopcheckpoints")}
+}
+
+// MetabaseConsistencyFindings returns database for the inconsistencies found in the metabase.
+func (dbc *satelliteDBCollection) MetabaseConsistencyFindings() consistency.DB {
+	return &consistencyFindings{db: dbc.getByName("metabaseconsistencyfindings")}
+}
+
 // CheckVersion confirms all databases are at the desired version.
 func (dbc *satelliteDBCollection) CheckVersion(ctx context.Context) error {
 	var eg errs.Group
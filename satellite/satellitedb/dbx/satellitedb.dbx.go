@@ -460,6 +460,15 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE metabase_consistency_findings (
+	kind text NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	details text NOT NULL,
+	first_seen_at timestamp with time zone NOT NULL,
+	last_seen_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( kind, stream_id, position )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -503,6 +512,43 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_download_stats (
+	node_id bytea NOT NULL,
+	requested double precision NOT NULL DEFAULT 0,
+	settled double precision NOT NULL DEFAULT 0,
+	ttfb_sum double precision NOT NULL DEFAULT 0,
+	ttfb_count double precision NOT NULL DEFAULT 0,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_evacuations (
+	node_id bytea NOT NULL,
+	cursor_stream_id bytea,
+	cursor_position bigint NOT NULL DEFAULT 0,
+	segments_processed bigint NOT NULL DEFAULT 0,
+	segments_failed bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	cancelled_at timestamp with time zone,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_reputation_changes (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	action text NOT NULL,
+	reason text NOT NULL,
+	previous_state text NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value text NOT NULL,
+	signed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -550,6 +596,12 @@ CREATE TABLE offers (
 	type integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE partner_price_plans (
+	partner text NOT NULL,
+	plan_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( partner )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -557,6 +609,21 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE prepaid_auto_topups (
+	user_id bytea NOT NULL,
+	threshold bigint NOT NULL,
+	amount bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( user_id )
+);
+CREATE TABLE price_plans (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	prices jsonb NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -604,6 +671,8 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	placement integer NOT NULL DEFAULT 0,
+	project_id bytea,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -638,6 +707,22 @@ CREATE TABLE revocations (
 	api_key_id bytea NOT NULL,
 	PRIMARY KEY ( revoked )
 );
+CREATE TABLE segment_loop_checkpoint_chunks (
+	name text NOT NULL,
+	observer text NOT NULL,
+	chunk integer NOT NULL,
+	data bytea NOT NULL,
+	PRIMARY KEY ( name, observer, chunk )
+);
+CREATE TABLE segment_loop_checkpoints (
+	name text NOT NULL,
+	started_at timestamp with time zone NOT NULL,
+	stream_id bytea NOT NULL,
+	segments bigint NOT NULL,
+	estimated_segments bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
 CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -649,6 +734,13 @@ CREATE TABLE segment_pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE sso_identities (
+	provider text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( provider, subject )
+);
 CREATE TABLE storagenode_bandwidth_rollups (
 	storagenode_id bytea NOT NULL,
 	interval_start timestamp with time zone NOT NULL,
@@ -764,6 +856,30 @@ CREATE TABLE stripecoinpayments_tx_conversion_rates (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE usage_alerts (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	triggered_at timestamp with time zone,
+	created_by bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE usage_alert_notifications (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	alert_id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	value bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	read_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE users (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -798,6 +914,12 @@ CREATE TABLE users (
 	signup_captcha double precision,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE user_price_plans (
+	user_id bytea NOT NULL,
+	plan_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE value_attributions (
 	project_id bytea NOT NULL,
 	bucket_name bytea NOT NULL,
@@ -886,14 +1008,19 @@ CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
 CREATE INDEX nodes_dis_unk_aud_exit_init_rel_type_last_cont_success_stored_index ON nodes ( disqualified, unknown_audit_suspended, exit_initiated_at, release, type, last_contact_success ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true ;
+CREATE INDEX node_reputation_changes_node_id_created_at_index ON node_reputation_changes ( node_id, created_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE INDEX partner_price_plans_plan_id_index ON partner_price_plans ( plan_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
+CREATE INDEX repair_queue_placement_segment_health_attempted_at_index ON repair_queue ( placement, segment_health, attempted_at ) ;
+CREATE INDEX repair_queue_project_id_attempted_at_index ON repair_queue ( project_id, attempted_at ) ;
+CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
@@ -901,6 +1028,9 @@ CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_i
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
 CREATE INDEX storjscan_payments_block_number_log_index_index ON storjscan_payments ( block_number, log_index ) ;
 CREATE INDEX storjscan_wallets_wallet_address_index ON storjscan_wallets ( wallet_address ) ;
+CREATE INDEX usage_alerts_project_id_index ON usage_alerts ( project_id ) ;
+CREATE INDEX usage_alert_notifications_user_id_index ON usage_alert_notifications ( user_id ) ;
+CREATE INDEX user_price_plans_plan_id_index ON user_price_plans ( plan_id ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE UNIQUE INDEX credits_earned_user_id_offer_id ON user_credits ( id, offer_id ) ;`
 }
@@ -1142,6 +1272,15 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE metabase_consistency_findings (
+	kind text NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	details text NOT NULL,
+	first_seen_at timestamp with time zone NOT NULL,
+	last_seen_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( kind, stream_id, position )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -1185,6 +1324,43 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_download_stats (
+	node_id bytea NOT NULL,
+	requested double precision NOT NULL DEFAULT 0,
+	settled double precision NOT NULL DEFAULT 0,
+	ttfb_sum double precision NOT NULL DEFAULT 0,
+	ttfb_count double precision NOT NULL DEFAULT 0,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_evacuations (
+	node_id bytea NOT NULL,
+	cursor_stream_id bytea,
+	cursor_position bigint NOT NULL DEFAULT 0,
+	segments_processed bigint NOT NULL DEFAULT 0,
+	segments_failed bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	cancelled_at timestamp with time zone,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_reputation_changes (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	action text NOT NULL,
+	reason text NOT NULL,
+	previous_state text NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value text NOT NULL,
+	signed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -1232,6 +1408,12 @@ CREATE TABLE offers (
 	type integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE partner_price_plans (
+	partner text NOT NULL,
+	plan_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( partner )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -1239,6 +1421,21 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE prepaid_auto_topups (
+	user_id bytea NOT NULL,
+	threshold bigint NOT NULL,
+	amount bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( user_id )
+);
+CREATE TABLE price_plans (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	prices jsonb NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -1286,6 +1483,8 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	placement integer NOT NULL DEFAULT 0,
+	project_id bytea,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -1320,6 +1519,22 @@ CREATE TABLE revocations (
 	api_key_id bytea NOT NULL,
 	PRIMARY KEY ( revoked )
 );
+CREATE TABLE segment_loop_checkpoint_chunks (
+	name text NOT NULL,
+	observer text NOT NULL,
+	chunk integer NOT NULL,
+	data bytea NOT NULL,
+	PRIMARY KEY ( name, observer, chunk )
+);
+CREATE TABLE segment_loop_checkpoints (
+	name text NOT NULL,
+	started_at timestamp with time zone NOT NULL,
+	stream_id bytea NOT NULL,
+	segments bigint NOT NULL,
+	estimated_segments bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
 CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -1331,6 +1546,13 @@ CREATE TABLE segment_pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE sso_identities (
+	provider text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( provider, subject )
+);
 CREATE TABLE storagenode_bandwidth_rollups (
 	storagenode_id bytea NOT NULL,
 	interval_start timestamp with time zone NOT NULL,
@@ -1446,6 +1668,30 @@ CREATE TABLE stripecoinpayments_tx_conversion_rates (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE usage_alerts (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	triggered_at timestamp with time zone,
+	created_by bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE usage_alert_notifications (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	alert_id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	value bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	read_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE users (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -1480,6 +1726,12 @@ CREATE TABLE users (
 	signup_captcha double precision,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE user_price_plans (
+	user_id bytea NOT NULL,
+	plan_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE value_attributions (
 	project_id bytea NOT NULL,
 	bucket_name bytea NOT NULL,
@@ -1568,14 +1820,19 @@ CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
 CREATE INDEX nodes_dis_unk_aud_exit_init_rel_type_last_cont_success_stored_index ON nodes ( disqualified, unknown_audit_suspended, exit_initiated_at, release, type, last_contact_success ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true ;
+CREATE INDEX node_reputation_changes_node_id_created_at_index ON node_reputation_changes ( node_id, created_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE INDEX partner_price_plans_plan_id_index ON partner_price_plans ( plan_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
+CREATE INDEX repair_queue_placement_segment_health_attempted_at_index ON repair_queue ( placement, segment_health, attempted_at ) ;
+CREATE INDEX repair_queue_project_id_attempted_at_index ON repair_queue ( project_id, attempted_at ) ;
+CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
@@ -1583,6 +1840,9 @@ CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_i
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
 CREATE INDEX storjscan_payments_block_number_log_index_index ON storjscan_payments ( block_number, log_index ) ;
 CREATE INDEX storjscan_wallets_wallet_address_index ON storjscan_wallets ( wallet_address ) ;
+CREATE INDEX usage_alerts_project_id_index ON usage_alerts ( project_id ) ;
+CREATE INDEX usage_alert_notifications_user_id_index ON usage_alert_notifications ( user_id ) ;
+CREATE INDEX user_price_plans_plan_id_index ON user_price_plans ( plan_id ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE UNIQUE INDEX credits_earned_user_id_offer_id ON user_credits ( id, offer_id ) ;`
 }
@@ -3951,6 +4211,131 @@ func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
 	return "order_limit_send_count"
 }
 
+type MetabaseConsistencyFinding struct {
+	Kind        string
+	StreamId    []byte
+	Position    int64
+	Details     string
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+func (MetabaseConsistencyFinding) _Table() string { return "metabase_consistency_findings" }
+
+type MetabaseConsistencyFinding_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func MetabaseConsistencyFinding_Kind(v string) MetabaseConsistencyFinding_Kind_Field {
+	return MetabaseConsistencyFinding_Kind_Field{_set: true, _value: v}
+}
+
+func (f MetabaseConsistencyFinding_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MetabaseConsistencyFinding_Kind_Field) _Column() string { return "kind" }
+
+type MetabaseConsistencyFinding_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func MetabaseConsistencyFinding_StreamId(v []byte) MetabaseConsistencyFinding_StreamId_Field {
+	return MetabaseConsistencyFinding_StreamId_Field{_set: true, _value: v}
+}
+
+func (f MetabaseConsistencyFinding_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MetabaseConsistencyFinding_StreamId_Field) _Column() string { return "stream_id" }
+
+type MetabaseConsistencyFinding_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func MetabaseConsistencyFinding_Position(v int64) MetabaseConsistencyFinding_Position_Field {
+	return MetabaseConsistencyFinding_Position_Field{_set: true, _value: v}
+}
+
+func (f MetabaseConsistencyFinding_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MetabaseConsistencyFinding_Position_Field) _Column() string { return "position" }
+
+type MetabaseConsistencyFinding_Details_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func MetabaseConsistencyFinding_Details(v string) MetabaseConsistencyFinding_Details_Field {
+	return MetabaseConsistencyFinding_Details_Field{_set: true, _value: v}
+}
+
+func (f MetabaseConsistencyFinding_Details_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MetabaseConsistencyFinding_Details_Field) _Column() string { return "details" }
+
+type MetabaseConsistencyFinding_FirstSeenAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func MetabaseConsistencyFinding_FirstSeenAt(v time.Time) MetabaseConsistencyFinding_FirstSeenAt_Field {
+	return MetabaseConsistencyFinding_FirstSeenAt_Field{_set: true, _value: v}
+}
+
+func (f MetabaseConsistencyFinding_FirstSeenAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MetabaseConsistencyFinding_FirstSeenAt_Field) _Column() string { return "first_seen_at" }
+
+type MetabaseConsistencyFinding_LastSeenAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func MetabaseConsistencyFinding_LastSeenAt(v time.Time) MetabaseConsistencyFinding_LastSeenAt_Field {
+	return MetabaseConsistencyFinding_LastSeenAt_Field{_set: true, _value: v}
+}
+
+func (f MetabaseConsistencyFinding_LastSeenAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MetabaseConsistencyFinding_LastSeenAt_Field) _Column() string { return "last_seen_at" }
+
 type Node struct {
 	Id                     []byte
 	Address                string
@@ -4912,937 +5297,1817 @@ func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
 
 func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type OauthClient struct {
-	Id              []byte
-	EncryptedSecret []byte
-	RedirectUrl     string
-	UserId          []byte
-	AppName         string
-	AppLogoUrl      string
+type NodeDownloadStat struct {
+	NodeId    []byte
+	Requested float64
+	Settled   float64
+	TtfbSum   float64
+	TtfbCount float64
+	UpdatedAt time.Time
 }
 
-func (OauthClient) _Table() string { return "oauth_clients" }
+func (NodeDownloadStat) _Table() string { return "node_download_stats" }
 
-type OauthClient_Update_Fields struct {
-	EncryptedSecret OauthClient_EncryptedSecret_Field
-	RedirectUrl     OauthClient_RedirectUrl_Field
-	AppName         OauthClient_AppName_Field
-	AppLogoUrl      OauthClient_AppLogoUrl_Field
+type NodeDownloadStat_Create_Fields struct {
+	Requested NodeDownloadStat_Requested_Field
+	Settled   NodeDownloadStat_Settled_Field
+	TtfbSum   NodeDownloadStat_TtfbSum_Field
+	TtfbCount NodeDownloadStat_TtfbCount_Field
+	UpdatedAt NodeDownloadStat_UpdatedAt_Field
 }
 
-type OauthClient_Id_Field struct {
+type NodeDownloadStat_Update_Fields struct {
+	Requested NodeDownloadStat_Requested_Field
+	Settled   NodeDownloadStat_Settled_Field
+	TtfbSum   NodeDownloadStat_TtfbSum_Field
+	TtfbCount NodeDownloadStat_TtfbCount_Field
+	UpdatedAt NodeDownloadStat_UpdatedAt_Field
+}
+
+type NodeDownloadStat_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthClient_Id(v []byte) OauthClient_Id_Field {
-	return OauthClient_Id_Field{_set: true, _value: v}
+func NodeDownloadStat_NodeId(v []byte) NodeDownloadStat_NodeId_Field {
+	return NodeDownloadStat_NodeId_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_Id_Field) value() interface{} {
+func (f NodeDownloadStat_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_Id_Field) _Column() string { return "id" }
+func (NodeDownloadStat_NodeId_Field) _Column() string { return "node_id" }
 
-type OauthClient_EncryptedSecret_Field struct {
+type NodeDownloadStat_Requested_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
-	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
+func NodeDownloadStat_Requested(v float64) NodeDownloadStat_Requested_Field {
+	return NodeDownloadStat_Requested_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_EncryptedSecret_Field) value() interface{} {
+func (f NodeDownloadStat_Requested_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
+func (NodeDownloadStat_Requested_Field) _Column() string { return "requested" }
 
-type OauthClient_RedirectUrl_Field struct {
+type NodeDownloadStat_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value float64
 }
 
-func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
-	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
+func NodeDownloadStat_Settled(v float64) NodeDownloadStat_Settled_Field {
+	return NodeDownloadStat_Settled_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_RedirectUrl_Field) value() interface{} {
+func (f NodeDownloadStat_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
+func (NodeDownloadStat_Settled_Field) _Column() string { return "settled" }
 
-type OauthClient_UserId_Field struct {
+type NodeDownloadStat_TtfbSum_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
-	return OauthClient_UserId_Field{_set: true, _value: v}
+func NodeDownloadStat_TtfbSum(v float64) NodeDownloadStat_TtfbSum_Field {
+	return NodeDownloadStat_TtfbSum_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_UserId_Field) value() interface{} {
+func (f NodeDownloadStat_TtfbSum_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_UserId_Field) _Column() string { return "user_id" }
+func (NodeDownloadStat_TtfbSum_Field) _Column() string { return "ttfb_sum" }
 
-type OauthClient_AppName_Field struct {
+type NodeDownloadStat_TtfbCount_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value float64
 }
 
-func OauthClient_AppName(v string) OauthClient_AppName_Field {
-	return OauthClient_AppName_Field{_set: true, _value: v}
+func NodeDownloadStat_TtfbCount(v float64) NodeDownloadStat_TtfbCount_Field {
+	return NodeDownloadStat_TtfbCount_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_AppName_Field) value() interface{} {
+func (f NodeDownloadStat_TtfbCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppName_Field) _Column() string { return "app_name" }
+func (NodeDownloadStat_TtfbCount_Field) _Column() string { return "ttfb_count" }
 
-type OauthClient_AppLogoUrl_Field struct {
+type NodeDownloadStat_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
-	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
+func NodeDownloadStat_UpdatedAt(v time.Time) NodeDownloadStat_UpdatedAt_Field {
+	return NodeDownloadStat_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_AppLogoUrl_Field) value() interface{} {
+func (f NodeDownloadStat_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
+func (NodeDownloadStat_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type OauthCode struct {
-	ClientId        []byte
-	UserId          []byte
-	Scope           string
-	RedirectUrl     string
-	Challenge       string
-	ChallengeMethod string
-	Code            string
-	CreatedAt       time.Time
-	ExpiresAt       time.Time
-	ClaimedAt       *time.Time
+type NodeEvacuation struct {
+	NodeId            []byte
+	CursorStreamId    *[]byte
+	CursorPosition    int64
+	SegmentsProcessed int64
+	SegmentsFailed    int64
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	CancelledAt       *time.Time
+	FinishedAt        *time.Time
 }
 
-func (OauthCode) _Table() string { return "oauth_codes" }
+func (NodeEvacuation) _Table() string { return "node_evacuations" }
 
-type OauthCode_Create_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+type NodeEvacuation_Create_Fields struct {
+	CursorStreamId    NodeEvacuation_CursorStreamId_Field
+	CursorPosition    NodeEvacuation_CursorPosition_Field
+	SegmentsProcessed NodeEvacuation_SegmentsProcessed_Field
+	SegmentsFailed    NodeEvacuation_SegmentsFailed_Field
+	CreatedAt         NodeEvacuation_CreatedAt_Field
+	UpdatedAt         NodeEvacuation_UpdatedAt_Field
+	CancelledAt       NodeEvacuation_CancelledAt_Field
+	FinishedAt        NodeEvacuation_FinishedAt_Field
 }
 
-type OauthCode_Update_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+type NodeEvacuation_Update_Fields struct {
+	CursorStreamId    NodeEvacuation_CursorStreamId_Field
+	CursorPosition    NodeEvacuation_CursorPosition_Field
+	SegmentsProcessed NodeEvacuation_SegmentsProcessed_Field
+	SegmentsFailed    NodeEvacuation_SegmentsFailed_Field
+	UpdatedAt         NodeEvacuation_UpdatedAt_Field
+	CancelledAt       NodeEvacuation_CancelledAt_Field
+	FinishedAt        NodeEvacuation_FinishedAt_Field
 }
 
-type OauthCode_ClientId_Field struct {
+type NodeEvacuation_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
-	return OauthCode_ClientId_Field{_set: true, _value: v}
+func NodeEvacuation_NodeId(v []byte) NodeEvacuation_NodeId_Field {
+	return NodeEvacuation_NodeId_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ClientId_Field) value() interface{} {
+func (f NodeEvacuation_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
+func (NodeEvacuation_NodeId_Field) _Column() string { return "node_id" }
 
-type OauthCode_UserId_Field struct {
+type NodeEvacuation_CursorStreamId_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *[]byte
 }
 
-func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
-	return OauthCode_UserId_Field{_set: true, _value: v}
+func NodeEvacuation_CursorStreamId(v []byte) NodeEvacuation_CursorStreamId_Field {
+	return NodeEvacuation_CursorStreamId_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_UserId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func NodeEvacuation_CursorStreamId_Raw(v *[]byte) NodeEvacuation_CursorStreamId_Field {
+	if v == nil {
+		return NodeEvacuation_CursorStreamId_Null()
 	}
-	return f._value
+	return NodeEvacuation_CursorStreamId(*v)
 }
 
-func (OauthCode_UserId_Field) _Column() string { return "user_id" }
-
-type OauthCode_Scope_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func NodeEvacuation_CursorStreamId_Null() NodeEvacuation_CursorStreamId_Field {
+	return NodeEvacuation_CursorStreamId_Field{_set: true, _null: true}
 }
 
-func OauthCode_Scope(v string) OauthCode_Scope_Field {
-	return OauthCode_Scope_Field{_set: true, _value: v}
+func (f NodeEvacuation_CursorStreamId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f OauthCode_Scope_Field) value() interface{} {
+func (f NodeEvacuation_CursorStreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Scope_Field) _Column() string { return "scope" }
+func (NodeEvacuation_CursorStreamId_Field) _Column() string { return "cursor_stream_id" }
 
-type OauthCode_RedirectUrl_Field struct {
+type NodeEvacuation_CursorPosition_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
-	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
+func NodeEvacuation_CursorPosition(v int64) NodeEvacuation_CursorPosition_Field {
+	return NodeEvacuation_CursorPosition_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_RedirectUrl_Field) value() interface{} {
+func (f NodeEvacuation_CursorPosition_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
+func (NodeEvacuation_CursorPosition_Field) _Column() string { return "cursor_position" }
 
-type OauthCode_Challenge_Field struct {
+type NodeEvacuation_SegmentsProcessed_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
-	return OauthCode_Challenge_Field{_set: true, _value: v}
+func NodeEvacuation_SegmentsProcessed(v int64) NodeEvacuation_SegmentsProcessed_Field {
+	return NodeEvacuation_SegmentsProcessed_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_Challenge_Field) value() interface{} {
+func (f NodeEvacuation_SegmentsProcessed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
+func (NodeEvacuation_SegmentsProcessed_Field) _Column() string { return "segments_processed" }
 
-type OauthCode_ChallengeMethod_Field struct {
+type NodeEvacuation_SegmentsFailed_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
-	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
+func NodeEvacuation_SegmentsFailed(v int64) NodeEvacuation_SegmentsFailed_Field {
+	return NodeEvacuation_SegmentsFailed_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ChallengeMethod_Field) value() interface{} {
+func (f NodeEvacuation_SegmentsFailed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
+func (NodeEvacuation_SegmentsFailed_Field) _Column() string { return "segments_failed" }
 
-type OauthCode_Code_Field struct {
+type NodeEvacuation_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func OauthCode_Code(v string) OauthCode_Code_Field {
-	return OauthCode_Code_Field{_set: true, _value: v}
+func NodeEvacuation_CreatedAt(v time.Time) NodeEvacuation_CreatedAt_Field {
+	return NodeEvacuation_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_Code_Field) value() interface{} {
+func (f NodeEvacuation_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Code_Field) _Column() string { return "code" }
+func (NodeEvacuation_CreatedAt_Field) _Column() string { return "created_at" }
 
-type OauthCode_CreatedAt_Field struct {
+type NodeEvacuation_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
-	return OauthCode_CreatedAt_Field{_set: true, _value: v}
+func NodeEvacuation_UpdatedAt(v time.Time) NodeEvacuation_UpdatedAt_Field {
+	return NodeEvacuation_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_CreatedAt_Field) value() interface{} {
+func (f NodeEvacuation_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
+func (NodeEvacuation_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type OauthCode_ExpiresAt_Field struct {
+type NodeEvacuation_CancelledAt_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
-	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
+func NodeEvacuation_CancelledAt(v time.Time) NodeEvacuation_CancelledAt_Field {
+	return NodeEvacuation_CancelledAt_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_ExpiresAt_Field) value() interface{} {
+func NodeEvacuation_CancelledAt_Raw(v *time.Time) NodeEvacuation_CancelledAt_Field {
+	if v == nil {
+		return NodeEvacuation_CancelledAt_Null()
+	}
+	return NodeEvacuation_CancelledAt(*v)
+}
+
+func NodeEvacuation_CancelledAt_Null() NodeEvacuation_CancelledAt_Field {
+	return NodeEvacuation_CancelledAt_Field{_set: true, _null: true}
+}
+
+func (f NodeEvacuation_CancelledAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvacuation_CancelledAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (NodeEvacuation_CancelledAt_Field) _Column() string { return "cancelled_at" }
 
-type OauthCode_ClaimedAt_Field struct {
+type NodeEvacuation_FinishedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value *time.Time
 }
 
-func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
+func NodeEvacuation_FinishedAt(v time.Time) NodeEvacuation_FinishedAt_Field {
+	return NodeEvacuation_FinishedAt_Field{_set: true, _value: &v}
 }
 
-func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+func NodeEvacuation_FinishedAt_Raw(v *time.Time) NodeEvacuation_FinishedAt_Field {
 	if v == nil {
-		return OauthCode_ClaimedAt_Null()
+		return NodeEvacuation_FinishedAt_Null()
 	}
-	return OauthCode_ClaimedAt(*v)
+	return NodeEvacuation_FinishedAt(*v)
 }
 
-func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
+func NodeEvacuation_FinishedAt_Null() NodeEvacuation_FinishedAt_Field {
+	return NodeEvacuation_FinishedAt_Field{_set: true, _null: true}
 }
 
-func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f NodeEvacuation_FinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_ClaimedAt_Field) value() interface{} {
+func (f NodeEvacuation_FinishedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
+func (NodeEvacuation_FinishedAt_Field) _Column() string { return "finished_at" }
 
-type OauthToken struct {
-	ClientId  []byte
-	UserId    []byte
-	Scope     string
-	Kind      int
-	Token     []byte
-	CreatedAt time.Time
-	ExpiresAt time.Time
+type NodeReputationChange struct {
+	Id            []byte
+	NodeId        []byte
+	Action        string
+	Reason        string
+	PreviousState string
+	CreatedAt     time.Time
 }
 
-func (OauthToken) _Table() string { return "oauth_tokens" }
+func (NodeReputationChange) _Table() string { return "node_reputation_changes" }
 
-type OauthToken_Update_Fields struct {
-	ExpiresAt OauthToken_ExpiresAt_Field
+type NodeReputationChange_Create_Fields struct {
+	CreatedAt NodeReputationChange_CreatedAt_Field
 }
 
-type OauthToken_ClientId_Field struct {
+type NodeReputationChange_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
-	return OauthToken_ClientId_Field{_set: true, _value: v}
+func NodeReputationChange_Id(v []byte) NodeReputationChange_Id_Field {
+	return NodeReputationChange_Id_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_ClientId_Field) value() interface{} {
+func (f NodeReputationChange_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
+func (NodeReputationChange_Id_Field) _Column() string { return "id" }
 
-type OauthToken_UserId_Field struct {
+type NodeReputationChange_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
-	return OauthToken_UserId_Field{_set: true, _value: v}
+func NodeReputationChange_NodeId(v []byte) NodeReputationChange_NodeId_Field {
+	return NodeReputationChange_NodeId_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_UserId_Field) value() interface{} {
+func (f NodeReputationChange_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_UserId_Field) _Column() string { return "user_id" }
+func (NodeReputationChange_NodeId_Field) _Column() string { return "node_id" }
 
-type OauthToken_Scope_Field struct {
+type NodeReputationChange_Action_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func OauthToken_Scope(v string) OauthToken_Scope_Field {
-	return OauthToken_Scope_Field{_set: true, _value: v}
+func NodeReputationChange_Action(v string) NodeReputationChange_Action_Field {
+	return NodeReputationChange_Action_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Scope_Field) value() interface{} {
+func (f NodeReputationChange_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Scope_Field) _Column() string { return "scope" }
+func (NodeReputationChange_Action_Field) _Column() string { return "action" }
 
-type OauthToken_Kind_Field struct {
+type NodeReputationChange_Reason_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func OauthToken_Kind(v int) OauthToken_Kind_Field {
-	return OauthToken_Kind_Field{_set: true, _value: v}
+func NodeReputationChange_Reason(v string) NodeReputationChange_Reason_Field {
+	return NodeReputationChange_Reason_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Kind_Field) value() interface{} {
+func (f NodeReputationChange_Reason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Kind_Field) _Column() string { return "kind" }
+func (NodeReputationChange_Reason_Field) _Column() string { return "reason" }
 
-type OauthToken_Token_Field struct {
+type NodeReputationChange_PreviousState_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func OauthToken_Token(v []byte) OauthToken_Token_Field {
-	return OauthToken_Token_Field{_set: true, _value: v}
+func NodeReputationChange_PreviousState(v string) NodeReputationChange_PreviousState_Field {
+	return NodeReputationChange_PreviousState_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Token_Field) value() interface{} {
+func (f NodeReputationChange_PreviousState_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Token_Field) _Column() string { return "token" }
+func (NodeReputationChange_PreviousState_Field) _Column() string { return "previous_state" }
 
-type OauthToken_CreatedAt_Field struct {
+type NodeReputationChange_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
-	return OauthToken_CreatedAt_Field{_set: true, _value: v}
+func NodeReputationChange_CreatedAt(v time.Time) NodeReputationChange_CreatedAt_Field {
+	return NodeReputationChange_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_CreatedAt_Field) value() interface{} {
+func (f NodeReputationChange_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (NodeReputationChange_CreatedAt_Field) _Column() string { return "created_at" }
 
-type OauthToken_ExpiresAt_Field struct {
+type NodeTag struct {
+	NodeId   []byte
+	Name     string
+	Value    string
+	SignedAt time.Time
+}
+
+func (NodeTag) _Table() string { return "node_tags" }
+
+type NodeTag_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
-	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
+func NodeTag_NodeId(v []byte) NodeTag_NodeId_Field {
+	return NodeTag_NodeId_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_ExpiresAt_Field) value() interface{} {
+func (f NodeTag_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
-
-type Offer struct {
-	Id                        int
-	Name                      string
-	Description               string
-	AwardCreditInCents        int
-	InviteeCreditInCents      int
-	AwardCreditDurationDays   *int
-	InviteeCreditDurationDays *int
-	RedeemableCap             *int
-	ExpiresAt                 time.Time
-	CreatedAt                 time.Time
-	Status                    int
-	Type                      int
-}
-
-func (Offer) _Table() string { return "offers" }
-
-type Offer_Create_Fields struct {
-	AwardCreditInCents        Offer_AwardCreditInCents_Field
-	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
-	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
-	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
-	RedeemableCap             Offer_RedeemableCap_Field
-}
-
-type Offer_Update_Fields struct {
-	Name                      Offer_Name_Field
-	Description               Offer_Description_Field
-	AwardCreditInCents        Offer_AwardCreditInCents_Field
-	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
-	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
-	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
-	RedeemableCap             Offer_RedeemableCap_Field
-	ExpiresAt                 Offer_ExpiresAt_Field
-	Status                    Offer_Status_Field
-	Type                      Offer_Type_Field
-}
+func (NodeTag_NodeId_Field) _Column() string { return "node_id" }
 
-type Offer_Id_Field struct {
+type NodeTag_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func Offer_Id(v int) Offer_Id_Field {
-	return Offer_Id_Field{_set: true, _value: v}
+func NodeTag_Name(v string) NodeTag_Name_Field {
+	return NodeTag_Name_Field{_set: true, _value: v}
 }
 
-func (f Offer_Id_Field) value() interface{} {
+func (f NodeTag_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Id_Field) _Column() string { return "id" }
+func (NodeTag_Name_Field) _Column() string { return "name" }
 
-type Offer_Name_Field struct {
+type NodeTag_Value_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Offer_Name(v string) Offer_Name_Field {
-	return Offer_Name_Field{_set: true, _value: v}
+func NodeTag_Value(v string) NodeTag_Value_Field {
+	return NodeTag_Value_Field{_set: true, _value: v}
 }
 
-func (f Offer_Name_Field) value() interface{} {
+func (f NodeTag_Value_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Name_Field) _Column() string { return "name" }
+func (NodeTag_Value_Field) _Column() string { return "value" }
 
-type Offer_Description_Field struct {
+type NodeTag_SignedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Offer_Description(v string) Offer_Description_Field {
-	return Offer_Description_Field{_set: true, _value: v}
+func NodeTag_SignedAt(v time.Time) NodeTag_SignedAt_Field {
+	return NodeTag_SignedAt_Field{_set: true, _value: v}
 }
 
-func (f Offer_Description_Field) value() interface{} {
+func (f NodeTag_SignedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Description_Field) _Column() string { return "description" }
+func (NodeTag_SignedAt_Field) _Column() string { return "signed_at" }
 
-type Offer_AwardCreditInCents_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+type OauthClient struct {
+	Id              []byte
+	EncryptedSecret []byte
+	RedirectUrl     string
+	UserId          []byte
+	AppName         string
+	AppLogoUrl      string
 }
 
-func Offer_AwardCreditInCents(v int) Offer_AwardCreditInCents_Field {
-	return Offer_AwardCreditInCents_Field{_set: true, _value: v}
-}
+func (OauthClient) _Table() string { return "oauth_clients" }
 
-func (f Offer_AwardCreditInCents_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type OauthClient_Update_Fields struct {
+	EncryptedSecret OauthClient_EncryptedSecret_Field
+	RedirectUrl     OauthClient_RedirectUrl_Field
+	AppName         OauthClient_AppName_Field
+	AppLogoUrl      OauthClient_AppLogoUrl_Field
 }
 
-func (Offer_AwardCreditInCents_Field) _Column() string { return "award_credit_in_cents" }
-
-type Offer_InviteeCreditInCents_Field struct {
+type OauthClient_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Offer_InviteeCreditInCents(v int) Offer_InviteeCreditInCents_Field {
-	return Offer_InviteeCreditInCents_Field{_set: true, _value: v}
+func OauthClient_Id(v []byte) OauthClient_Id_Field {
+	return OauthClient_Id_Field{_set: true, _value: v}
 }
 
-func (f Offer_InviteeCreditInCents_Field) value() interface{} {
+func (f OauthClient_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_InviteeCreditInCents_Field) _Column() string { return "invitee_credit_in_cents" }
+func (OauthClient_Id_Field) _Column() string { return "id" }
 
-type Offer_AwardCreditDurationDays_Field struct {
+type OauthClient_EncryptedSecret_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
-	return Offer_AwardCreditDurationDays_Field{_set: true, _value: &v}
+func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
+	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
 }
 
-func Offer_AwardCreditDurationDays_Raw(v *int) Offer_AwardCreditDurationDays_Field {
-	if v == nil {
-		return Offer_AwardCreditDurationDays_Null()
+func (f OauthClient_EncryptedSecret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Offer_AwardCreditDurationDays(*v)
+	return f._value
 }
 
-func Offer_AwardCreditDurationDays_Null() Offer_AwardCreditDurationDays_Field {
-	return Offer_AwardCreditDurationDays_Field{_set: true, _null: true}
+func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
+
+type OauthClient_RedirectUrl_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Offer_AwardCreditDurationDays_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
+	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
+func (f OauthClient_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
+func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type Offer_InviteeCreditDurationDays_Field struct {
+type OauthClient_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
-	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: &v}
+func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
+	return OauthClient_UserId_Field{_set: true, _value: v}
 }
 
-func Offer_InviteeCreditDurationDays_Raw(v *int) Offer_InviteeCreditDurationDays_Field {
-	if v == nil {
-		return Offer_InviteeCreditDurationDays_Null()
+func (f OauthClient_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Offer_InviteeCreditDurationDays(*v)
+	return f._value
 }
 
-func Offer_InviteeCreditDurationDays_Null() Offer_InviteeCreditDurationDays_Field {
-	return Offer_InviteeCreditDurationDays_Field{_set: true, _null: true}
+func (OauthClient_UserId_Field) _Column() string { return "user_id" }
+
+type OauthClient_AppName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Offer_InviteeCreditDurationDays_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func OauthClient_AppName(v string) OauthClient_AppName_Field {
+	return OauthClient_AppName_Field{_set: true, _value: v}
 }
 
-func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
+func (f OauthClient_AppName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
+func (OauthClient_AppName_Field) _Column() string { return "app_name" }
 
-type Offer_RedeemableCap_Field struct {
+type OauthClient_AppLogoUrl_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
-	return Offer_RedeemableCap_Field{_set: true, _value: &v}
+func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
+	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
 }
 
-func Offer_RedeemableCap_Raw(v *int) Offer_RedeemableCap_Field {
-	if v == nil {
-		return Offer_RedeemableCap_Null()
+func (f OauthClient_AppLogoUrl_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Offer_RedeemableCap(*v)
+	return f._value
 }
 
-func Offer_RedeemableCap_Null() Offer_RedeemableCap_Field {
-	return Offer_RedeemableCap_Field{_set: true, _null: true}
+func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
+
+type OauthCode struct {
+	ClientId        []byte
+	UserId          []byte
+	Scope           string
+	RedirectUrl     string
+	Challenge       string
+	ChallengeMethod string
+	Code            string
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	ClaimedAt       *time.Time
 }
 
-func (f Offer_RedeemableCap_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (OauthCode) _Table() string { return "oauth_codes" }
 
-func (f Offer_RedeemableCap_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type OauthCode_Create_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
 }
 
-func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
+type OauthCode_Update_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
 
-type Offer_ExpiresAt_Field struct {
+type OauthCode_ClientId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
-	return Offer_ExpiresAt_Field{_set: true, _value: v}
+func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
+	return OauthCode_ClientId_Field{_set: true, _value: v}
 }
 
-func (f Offer_ExpiresAt_Field) value() interface{} {
+func (f OauthCode_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
 
-type Offer_CreatedAt_Field struct {
+type OauthCode_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
-	return Offer_CreatedAt_Field{_set: true, _value: v}
+func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
+	return OauthCode_UserId_Field{_set: true, _value: v}
 }
 
-func (f Offer_CreatedAt_Field) value() interface{} {
+func (f OauthCode_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
+func (OauthCode_UserId_Field) _Column() string { return "user_id" }
 
-type Offer_Status_Field struct {
+type OauthCode_Scope_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func Offer_Status(v int) Offer_Status_Field {
-	return Offer_Status_Field{_set: true, _value: v}
+func OauthCode_Scope(v string) OauthCode_Scope_Field {
+	return OauthCode_Scope_Field{_set: true, _value: v}
 }
 
-func (f Offer_Status_Field) value() interface{} {
+func (f OauthCode_Scope_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Status_Field) _Column() string { return "status" }
+func (OauthCode_Scope_Field) _Column() string { return "scope" }
 
-type Offer_Type_Field struct {
+type OauthCode_RedirectUrl_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func Offer_Type(v int) Offer_Type_Field {
-	return Offer_Type_Field{_set: true, _value: v}
+func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
+	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f Offer_Type_Field) value() interface{} {
+func (f OauthCode_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Type_Field) _Column() string { return "type" }
+func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type PeerIdentity struct {
-	NodeId           []byte
-	LeafSerialNumber []byte
-	Chain            []byte
-	UpdatedAt        time.Time
+type OauthCode_Challenge_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (PeerIdentity) _Table() string { return "peer_identities" }
+func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
+	return OauthCode_Challenge_Field{_set: true, _value: v}
+}
 
-type PeerIdentity_Update_Fields struct {
-	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
-	Chain            PeerIdentity_Chain_Field
+func (f OauthCode_Challenge_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type PeerIdentity_NodeId_Field struct {
+func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
+
+type OauthCode_ChallengeMethod_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
-	return PeerIdentity_NodeId_Field{_set: true, _value: v}
+func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
+	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_NodeId_Field) value() interface{} {
+func (f OauthCode_ChallengeMethod_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
+func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
 
-type PeerIdentity_LeafSerialNumber_Field struct {
+type OauthCode_Code_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
-	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
+func OauthCode_Code(v string) OauthCode_Code_Field {
+	return OauthCode_Code_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
+func (f OauthCode_Code_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
+func (OauthCode_Code_Field) _Column() string { return "code" }
 
-type PeerIdentity_Chain_Field struct {
+type OauthCode_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
-	return PeerIdentity_Chain_Field{_set: true, _value: v}
+func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
+	return OauthCode_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_Chain_Field) value() interface{} {
+func (f OauthCode_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
+func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
 
-type PeerIdentity_UpdatedAt_Field struct {
+type OauthCode_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
-	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
+func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
+	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
+func (f OauthCode_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Project struct {
-	Id             []byte
-	PublicId       []byte
-	Name           string
-	Description    string
-	UsageLimit     *int64
-	BandwidthLimit *int64
-	SegmentLimit   *int64
-	RateLimit      *int
-	BurstLimit     *int
-	MaxBuckets     *int
-	PartnerId      []byte
-	UserAgent      []byte
-	OwnerId        []byte
-	Salt           []byte
-	CreatedAt      time.Time
+type OauthCode_ClaimedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-func (Project) _Table() string { return "projects" }
+func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
+}
 
-type Project_Create_Fields struct {
-	PublicId       Project_PublicId_Field
-	UsageLimit     Project_UsageLimit_Field
-	BandwidthLimit Project_BandwidthLimit_Field
-	SegmentLimit   Project_SegmentLimit_Field
+func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+	if v == nil {
+		return OauthCode_ClaimedAt_Null()
+	}
+	return OauthCode_ClaimedAt(*v)
+}
+
+func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
+}
+
+func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f OauthCode_ClaimedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
+
+type OauthToken struct {
+	ClientId  []byte
+	UserId    []byte
+	Scope     string
+	Kind      int
+	Token     []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (OauthToken) _Table() string { return "oauth_tokens" }
+
+type OauthToken_Update_Fields struct {
+	ExpiresAt OauthToken_ExpiresAt_Field
+}
+
+type OauthToken_ClientId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
+	return OauthToken_ClientId_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_ClientId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
+
+type OauthToken_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
+	return OauthToken_UserId_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_UserId_Field) _Column() string { return "user_id" }
+
+type OauthToken_Scope_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthToken_Scope(v string) OauthToken_Scope_Field {
+	return OauthToken_Scope_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_Scope_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_Scope_Field) _Column() string { return "scope" }
+
+type OauthToken_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func OauthToken_Kind(v int) OauthToken_Kind_Field {
+	return OauthToken_Kind_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_Kind_Field) _Column() string { return "kind" }
+
+type OauthToken_Token_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthToken_Token(v []byte) OauthToken_Token_Field {
+	return OauthToken_Token_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_Token_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_Token_Field) _Column() string { return "token" }
+
+type OauthToken_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
+	return OauthToken_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
+
+type OauthToken_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
+	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type Offer struct {
+	Id                        int
+	Name                      string
+	Description               string
+	AwardCreditInCents        int
+	InviteeCreditInCents      int
+	AwardCreditDurationDays   *int
+	InviteeCreditDurationDays *int
+	RedeemableCap             *int
+	ExpiresAt                 time.Time
+	CreatedAt                 time.Time
+	Status                    int
+	Type                      int
+}
+
+func (Offer) _Table() string { return "offers" }
+
+type Offer_Create_Fields struct {
+	AwardCreditInCents        Offer_AwardCreditInCents_Field
+	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
+	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
+	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
+	RedeemableCap             Offer_RedeemableCap_Field
+}
+
+type Offer_Update_Fields struct {
+	Name                      Offer_Name_Field
+	Description               Offer_Description_Field
+	AwardCreditInCents        Offer_AwardCreditInCents_Field
+	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
+	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
+	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
+	RedeemableCap             Offer_RedeemableCap_Field
+	ExpiresAt                 Offer_ExpiresAt_Field
+	Status                    Offer_Status_Field
+	Type                      Offer_Type_Field
+}
+
+type Offer_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_Id(v int) Offer_Id_Field {
+	return Offer_Id_Field{_set: true, _value: v}
+}
+
+func (f Offer_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Id_Field) _Column() string { return "id" }
+
+type Offer_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Offer_Name(v string) Offer_Name_Field {
+	return Offer_Name_Field{_set: true, _value: v}
+}
+
+func (f Offer_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Name_Field) _Column() string { return "name" }
+
+type Offer_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Offer_Description(v string) Offer_Description_Field {
+	return Offer_Description_Field{_set: true, _value: v}
+}
+
+func (f Offer_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Description_Field) _Column() string { return "description" }
+
+type Offer_AwardCreditInCents_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_AwardCreditInCents(v int) Offer_AwardCreditInCents_Field {
+	return Offer_AwardCreditInCents_Field{_set: true, _value: v}
+}
+
+func (f Offer_AwardCreditInCents_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_AwardCreditInCents_Field) _Column() string { return "award_credit_in_cents" }
+
+type Offer_InviteeCreditInCents_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_InviteeCreditInCents(v int) Offer_InviteeCreditInCents_Field {
+	return Offer_InviteeCreditInCents_Field{_set: true, _value: v}
+}
+
+func (f Offer_InviteeCreditInCents_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_InviteeCreditInCents_Field) _Column() string { return "invitee_credit_in_cents" }
+
+type Offer_AwardCreditDurationDays_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
+	return Offer_AwardCreditDurationDays_Field{_set: true, _value: &v}
+}
+
+func Offer_AwardCreditDurationDays_Raw(v *int) Offer_AwardCreditDurationDays_Field {
+	if v == nil {
+		return Offer_AwardCreditDurationDays_Null()
+	}
+	return Offer_AwardCreditDurationDays(*v)
+}
+
+func Offer_AwardCreditDurationDays_Null() Offer_AwardCreditDurationDays_Field {
+	return Offer_AwardCreditDurationDays_Field{_set: true, _null: true}
+}
+
+func (f Offer_AwardCreditDurationDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
+
+type Offer_InviteeCreditDurationDays_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
+	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: &v}
+}
+
+func Offer_InviteeCreditDurationDays_Raw(v *int) Offer_InviteeCreditDurationDays_Field {
+	if v == nil {
+		return Offer_InviteeCreditDurationDays_Null()
+	}
+	return Offer_InviteeCreditDurationDays(*v)
+}
+
+func Offer_InviteeCreditDurationDays_Null() Offer_InviteeCreditDurationDays_Field {
+	return Offer_InviteeCreditDurationDays_Field{_set: true, _null: true}
+}
+
+func (f Offer_InviteeCreditDurationDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
+
+type Offer_RedeemableCap_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
+	return Offer_RedeemableCap_Field{_set: true, _value: &v}
+}
+
+func Offer_RedeemableCap_Raw(v *int) Offer_RedeemableCap_Field {
+	if v == nil {
+		return Offer_RedeemableCap_Null()
+	}
+	return Offer_RedeemableCap(*v)
+}
+
+func Offer_RedeemableCap_Null() Offer_RedeemableCap_Field {
+	return Offer_RedeemableCap_Field{_set: true, _null: true}
+}
+
+func (f Offer_RedeemableCap_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Offer_RedeemableCap_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
+
+type Offer_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
+	return Offer_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f Offer_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type Offer_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
+	return Offer_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Offer_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Offer_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_Status(v int) Offer_Status_Field {
+	return Offer_Status_Field{_set: true, _value: v}
+}
+
+func (f Offer_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Status_Field) _Column() string { return "status" }
+
+type Offer_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_Type(v int) Offer_Type_Field {
+	return Offer_Type_Field{_set: true, _value: v}
+}
+
+func (f Offer_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Type_Field) _Column() string { return "type" }
+
+type PartnerPricePlan struct {
+	Partner   string
+	PlanId    []byte
+	CreatedAt time.Time
+}
+
+func (PartnerPricePlan) _Table() string { return "partner_price_plans" }
+
+type PartnerPricePlan_Create_Fields struct {
+	CreatedAt PartnerPricePlan_CreatedAt_Field
+}
+
+type PartnerPricePlan_Update_Fields struct {
+	PlanId PartnerPricePlan_PlanId_Field
+}
+
+type PartnerPricePlan_Partner_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PartnerPricePlan_Partner(v string) PartnerPricePlan_Partner_Field {
+	return PartnerPricePlan_Partner_Field{_set: true, _value: v}
+}
+
+func (f PartnerPricePlan_Partner_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartnerPricePlan_Partner_Field) _Column() string { return "partner" }
+
+type PartnerPricePlan_PlanId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PartnerPricePlan_PlanId(v []byte) PartnerPricePlan_PlanId_Field {
+	return PartnerPricePlan_PlanId_Field{_set: true, _value: v}
+}
+
+func (f PartnerPricePlan_PlanId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartnerPricePlan_PlanId_Field) _Column() string { return "plan_id" }
+
+type PartnerPricePlan_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PartnerPricePlan_CreatedAt(v time.Time) PartnerPricePlan_CreatedAt_Field {
+	return PartnerPricePlan_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f PartnerPricePlan_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartnerPricePlan_CreatedAt_Field) _Column() string { return "created_at" }
+
+type PeerIdentity struct {
+	NodeId           []byte
+	LeafSerialNumber []byte
+	Chain            []byte
+	UpdatedAt        time.Time
+}
+
+func (PeerIdentity) _Table() string { return "peer_identities" }
+
+type PeerIdentity_Update_Fields struct {
+	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
+	Chain            PeerIdentity_Chain_Field
+}
+
+type PeerIdentity_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
+	return PeerIdentity_NodeId_Field{_set: true, _value: v}
+}
+
+func (f PeerIdentity_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
+
+type PeerIdentity_LeafSerialNumber_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
+	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
+}
+
+func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
+
+type PeerIdentity_Chain_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
+	return PeerIdentity_Chain_Field{_set: true, _value: v}
+}
+
+func (f PeerIdentity_Chain_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
+
+type PeerIdentity_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
+	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type PrepaidAutoTopup struct {
+	UserId    []byte
+	Threshold int64
+	Amount    int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (PrepaidAutoTopup) _Table() string { return "prepaid_auto_topups" }
+
+type PrepaidAutoTopup_Create_Fields struct {
+	CreatedAt PrepaidAutoTopup_CreatedAt_Field
+	UpdatedAt PrepaidAutoTopup_UpdatedAt_Field
+}
+
+type PrepaidAutoTopup_Update_Fields struct {
+	Threshold PrepaidAutoTopup_Threshold_Field
+	Amount    PrepaidAutoTopup_Amount_Field
+	UpdatedAt PrepaidAutoTopup_UpdatedAt_Field
+}
+
+type PrepaidAutoTopup_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PrepaidAutoTopup_UserId(v []byte) PrepaidAutoTopup_UserId_Field {
+	return PrepaidAutoTopup_UserId_Field{_set: true, _value: v}
+}
+
+func (f PrepaidAutoTopup_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrepaidAutoTopup_UserId_Field) _Column() string { return "user_id" }
+
+type PrepaidAutoTopup_Threshold_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PrepaidAutoTopup_Threshold(v int64) PrepaidAutoTopup_Threshold_Field {
+	return PrepaidAutoTopup_Threshold_Field{_set: true, _value: v}
+}
+
+func (f PrepaidAutoTopup_Threshold_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrepaidAutoTopup_Threshold_Field) _Column() string { return "threshold" }
+
+type PrepaidAutoTopup_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PrepaidAutoTopup_Amount(v int64) PrepaidAutoTopup_Amount_Field {
+	return PrepaidAutoTopup_Amount_Field{_set: true, _value: v}
+}
+
+func (f PrepaidAutoTopup_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrepaidAutoTopup_Amount_Field) _Column() string { return "amount" }
+
+type PrepaidAutoTopup_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PrepaidAutoTopup_CreatedAt(v time.Time) PrepaidAutoTopup_CreatedAt_Field {
+	return PrepaidAutoTopup_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f PrepaidAutoTopup_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrepaidAutoTopup_CreatedAt_Field) _Column() string { return "created_at" }
+
+type PrepaidAutoTopup_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PrepaidAutoTopup_UpdatedAt(v time.Time) PrepaidAutoTopup_UpdatedAt_Field {
+	return PrepaidAutoTopup_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f PrepaidAutoTopup_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrepaidAutoTopup_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type PricePlan struct {
+	Id        []byte
+	Name      string
+	Prices    []byte
+	CreatedAt time.Time
+}
+
+func (PricePlan) _Table() string { return "price_plans" }
+
+type PricePlan_Create_Fields struct {
+	CreatedAt PricePlan_CreatedAt_Field
+}
+
+type PricePlan_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PricePlan_Id(v []byte) PricePlan_Id_Field {
+	return PricePlan_Id_Field{_set: true, _value: v}
+}
+
+func (f PricePlan_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PricePlan_Id_Field) _Column() string { return "id" }
+
+type PricePlan_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PricePlan_Name(v string) PricePlan_Name_Field {
+	return PricePlan_Name_Field{_set: true, _value: v}
+}
+
+func (f PricePlan_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PricePlan_Name_Field) _Column() string { return "name" }
+
+type PricePlan_Prices_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PricePlan_Prices(v []byte) PricePlan_Prices_Field {
+	return PricePlan_Prices_Field{_set: true, _value: v}
+}
+
+func (f PricePlan_Prices_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PricePlan_Prices_Field) _Column() string { return "prices" }
+
+type PricePlan_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PricePlan_CreatedAt(v time.Time) PricePlan_CreatedAt_Field {
+	return PricePlan_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f PricePlan_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PricePlan_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Project struct {
+	Id             []byte
+	PublicId       []byte
+	Name           string
+	Description    string
+	UsageLimit     *int64
+	BandwidthLimit *int64
+	SegmentLimit   *int64
+	RateLimit      *int
+	BurstLimit     *int
+	MaxBuckets     *int
+	PartnerId      []byte
+	UserAgent      []byte
+	OwnerId        []byte
+	Salt           []byte
+	CreatedAt      time.Time
+}
+
+func (Project) _Table() string { return "projects" }
+
+type Project_Create_Fields struct {
+	PublicId       Project_PublicId_Field
+	UsageLimit     Project_UsageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
+	SegmentLimit   Project_SegmentLimit_Field
 	RateLimit      Project_RateLimit_Field
 	BurstLimit     Project_BurstLimit_Field
 	MaxBuckets     Project_MaxBuckets_Field
@@ -5851,3461 +7116,4259 @@ type Project_Create_Fields struct {
 	Salt           Project_Salt_Field
 }
 
-type Project_Update_Fields struct {
-	Name           Project_Name_Field
-	Description    Project_Description_Field
-	UsageLimit     Project_UsageLimit_Field
-	BandwidthLimit Project_BandwidthLimit_Field
-	SegmentLimit   Project_SegmentLimit_Field
-	RateLimit      Project_RateLimit_Field
-	BurstLimit     Project_BurstLimit_Field
-	MaxBuckets     Project_MaxBuckets_Field
+type Project_Update_Fields struct {
+	Name           Project_Name_Field
+	Description    Project_Description_Field
+	UsageLimit     Project_UsageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
+	SegmentLimit   Project_SegmentLimit_Field
+	RateLimit      Project_RateLimit_Field
+	BurstLimit     Project_BurstLimit_Field
+	MaxBuckets     Project_MaxBuckets_Field
+}
+
+type Project_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
+}
+
+func (f Project_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_Id_Field) _Column() string { return "id" }
+
+type Project_PublicId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_PublicId(v []byte) Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _value: v}
+}
+
+func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
+	if v == nil {
+		return Project_PublicId_Null()
+	}
+	return Project_PublicId(v)
+}
+
+func Project_PublicId_Null() Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _null: true}
+}
+
+func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_PublicId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_PublicId_Field) _Column() string { return "public_id" }
+
+type Project_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
+}
+
+func (f Project_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_Name_Field) _Column() string { return "name" }
+
+type Project_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Project_Description(v string) Project_Description_Field {
+	return Project_Description_Field{_set: true, _value: v}
+}
+
+func (f Project_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_Description_Field) _Column() string { return "description" }
+
+type Project_UsageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Project_UsageLimit(v int64) Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _value: &v}
+}
+
+func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
+	if v == nil {
+		return Project_UsageLimit_Null()
+	}
+	return Project_UsageLimit(*v)
+}
+
+func Project_UsageLimit_Null() Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_UsageLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
+
+type Project_BandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _value: &v}
+}
+
+func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
+	if v == nil {
+		return Project_BandwidthLimit_Null()
+	}
+	return Project_BandwidthLimit(*v)
+}
+
+func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_BandwidthLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+
+type Project_SegmentLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _value: &v}
+}
+
+func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
+	if v == nil {
+		return Project_SegmentLimit_Null()
+	}
+	return Project_SegmentLimit(*v)
+}
+
+func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_SegmentLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
+
+type Project_RateLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Project_RateLimit(v int) Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _value: &v}
+}
+
+func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
+	if v == nil {
+		return Project_RateLimit_Null()
+	}
+	return Project_RateLimit(*v)
+}
+
+func Project_RateLimit_Null() Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_RateLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
+
+type Project_BurstLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Project_BurstLimit(v int) Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _value: &v}
+}
+
+func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
+	if v == nil {
+		return Project_BurstLimit_Null()
+	}
+	return Project_BurstLimit(*v)
+}
+
+func Project_BurstLimit_Null() Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_BurstLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
+
+type Project_MaxBuckets_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _value: &v}
+}
+
+func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
+	if v == nil {
+		return Project_MaxBuckets_Null()
+	}
+	return Project_MaxBuckets(*v)
+}
+
+func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _null: true}
+}
+
+func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_MaxBuckets_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
+
+type Project_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_PartnerId(v []byte) Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _value: v}
+}
+
+func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
+	if v == nil {
+		return Project_PartnerId_Null()
+	}
+	return Project_PartnerId(v)
+}
+
+func Project_PartnerId_Null() Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _null: true}
+}
+
+func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_PartnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_PartnerId_Field) _Column() string { return "partner_id" }
+
+type Project_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_UserAgent(v []byte) Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _value: v}
+}
+
+func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
+	if v == nil {
+		return Project_UserAgent_Null()
+	}
+	return Project_UserAgent(v)
+}
+
+func Project_UserAgent_Null() Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _null: true}
+}
+
+func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_UserAgent_Field) _Column() string { return "user_agent" }
+
+type Project_OwnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_OwnerId(v []byte) Project_OwnerId_Field {
+	return Project_OwnerId_Field{_set: true, _value: v}
+}
+
+func (f Project_OwnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_OwnerId_Field) _Column() string { return "owner_id" }
+
+type Project_Salt_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_Salt(v []byte) Project_Salt_Field {
+	return Project_Salt_Field{_set: true, _value: v}
+}
+
+func Project_Salt_Raw(v []byte) Project_Salt_Field {
+	if v == nil {
+		return Project_Salt_Null()
+	}
+	return Project_Salt(v)
+}
+
+func Project_Salt_Null() Project_Salt_Field {
+	return Project_Salt_Field{_set: true, _null: true}
+}
+
+func (f Project_Salt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_Salt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_Salt_Field) _Column() string { return "salt" }
+
+type Project_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
+	return Project_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Project_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectBandwidthDailyRollup struct {
+	ProjectId       []byte
+	IntervalDay     time.Time
+	EgressAllocated uint64
+	EgressSettled   uint64
+	EgressDead      uint64
+}
+
+func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
+
+type ProjectBandwidthDailyRollup_Create_Fields struct {
+	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
+}
+
+type ProjectBandwidthDailyRollup_Update_Fields struct {
+	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
+	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
+	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
+}
+
+type ProjectBandwidthDailyRollup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
+	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
+	v = toDate(v)
+	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
+}
+
+func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
+
+type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
+	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
+}
+
+func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+
+type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
+	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
+}
+
+func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
+
+type ProjectBandwidthDailyRollup_EgressDead_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
+	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
+}
+
+func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
+
+type ProjectBandwidthRollup struct {
+	ProjectId       []byte
+	IntervalMonth   time.Time
+	EgressAllocated uint64
+}
+
+func (ProjectBandwidthRollup) _Table() string { return "project_bandwidth_rollups" }
+
+type ProjectBandwidthRollup_Update_Fields struct {
+	EgressAllocated ProjectBandwidthRollup_EgressAllocated_Field
+}
+
+type ProjectBandwidthRollup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectBandwidthRollup_ProjectId(v []byte) ProjectBandwidthRollup_ProjectId_Field {
+	return ProjectBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectBandwidthRollup_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectBandwidthRollup_IntervalMonth_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectBandwidthRollup_IntervalMonth(v time.Time) ProjectBandwidthRollup_IntervalMonth_Field {
+	v = toDate(v)
+	return ProjectBandwidthRollup_IntervalMonth_Field{_set: true, _value: v}
+}
+
+func (f ProjectBandwidthRollup_IntervalMonth_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectBandwidthRollup_IntervalMonth_Field) _Column() string { return "interval_month" }
+
+type ProjectBandwidthRollup_EgressAllocated_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func ProjectBandwidthRollup_EgressAllocated(v uint64) ProjectBandwidthRollup_EgressAllocated_Field {
+	return ProjectBandwidthRollup_EgressAllocated_Field{_set: true, _value: v}
+}
+
+func (f ProjectBandwidthRollup_EgressAllocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectBandwidthRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+
+type RegistrationToken struct {
+	Secret       []byte
+	OwnerId      []byte
+	ProjectLimit int
+	CreatedAt    time.Time
+}
+
+func (RegistrationToken) _Table() string { return "registration_tokens" }
+
+type RegistrationToken_Create_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
+}
+
+type RegistrationToken_Update_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
+}
+
+type RegistrationToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
+	return RegistrationToken_Secret_Field{_set: true, _value: v}
+}
+
+func (f RegistrationToken_Secret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
+
+type RegistrationToken_OwnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
+}
+
+func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
+	if v == nil {
+		return RegistrationToken_OwnerId_Null()
+	}
+	return RegistrationToken_OwnerId(v)
 }
 
-type Project_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
-}
+func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_Id_Field) value() interface{} {
+func (f RegistrationToken_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
 
-type Project_PublicId_Field struct {
+type RegistrationToken_ProjectLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func Project_PublicId(v []byte) Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _value: v}
+func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
+	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
 }
 
-func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
-	if v == nil {
-		return Project_PublicId_Null()
+func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_PublicId(v)
+	return f._value
 }
 
-func Project_PublicId_Null() Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _null: true}
+func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
+
+type RegistrationToken_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
+	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_PublicId_Field) value() interface{} {
+func (f RegistrationToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PublicId_Field) _Column() string { return "public_id" }
+func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_Name_Field struct {
+type RepairQueue struct {
+	StreamId      []byte
+	Position      uint64
+	AttemptedAt   *time.Time
+	UpdatedAt     time.Time
+	InsertedAt    time.Time
+	SegmentHealth float64
+	Placement     int
+	ProjectId     []byte
+}
+
+func (RepairQueue) _Table() string { return "repair_queue" }
+
+type RepairQueue_Create_Fields struct {
+	AttemptedAt   RepairQueue_AttemptedAt_Field
+	UpdatedAt     RepairQueue_UpdatedAt_Field
+	InsertedAt    RepairQueue_InsertedAt_Field
+	SegmentHealth RepairQueue_SegmentHealth_Field
+	Placement     RepairQueue_Placement_Field
+	ProjectId     RepairQueue_ProjectId_Field
+}
+
+type RepairQueue_Update_Fields struct {
+	AttemptedAt RepairQueue_AttemptedAt_Field
+	UpdatedAt   RepairQueue_UpdatedAt_Field
+	Placement   RepairQueue_Placement_Field
+	ProjectId   RepairQueue_ProjectId_Field
+}
+
+type RepairQueue_StreamId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
+func RepairQueue_StreamId(v []byte) RepairQueue_StreamId_Field {
+	return RepairQueue_StreamId_Field{_set: true, _value: v}
 }
 
-func (f Project_Name_Field) value() interface{} {
+func (f RepairQueue_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
+func (RepairQueue_StreamId_Field) _Column() string { return "stream_id" }
 
-type Project_Description_Field struct {
+type RepairQueue_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func Project_Description(v string) Project_Description_Field {
-	return Project_Description_Field{_set: true, _value: v}
+func RepairQueue_Position(v uint64) RepairQueue_Position_Field {
+	return RepairQueue_Position_Field{_set: true, _value: v}
 }
 
-func (f Project_Description_Field) value() interface{} {
+func (f RepairQueue_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Description_Field) _Column() string { return "description" }
+func (RepairQueue_Position_Field) _Column() string { return "position" }
 
-type Project_UsageLimit_Field struct {
+type RepairQueue_AttemptedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value *time.Time
 }
 
-func Project_UsageLimit(v int64) Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _value: &v}
+func RepairQueue_AttemptedAt(v time.Time) RepairQueue_AttemptedAt_Field {
+	return RepairQueue_AttemptedAt_Field{_set: true, _value: &v}
 }
 
-func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
+func RepairQueue_AttemptedAt_Raw(v *time.Time) RepairQueue_AttemptedAt_Field {
 	if v == nil {
-		return Project_UsageLimit_Null()
+		return RepairQueue_AttemptedAt_Null()
 	}
-	return Project_UsageLimit(*v)
+	return RepairQueue_AttemptedAt(*v)
 }
 
-func Project_UsageLimit_Null() Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _null: true}
+func RepairQueue_AttemptedAt_Null() RepairQueue_AttemptedAt_Field {
+	return RepairQueue_AttemptedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f RepairQueue_AttemptedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_UsageLimit_Field) value() interface{} {
+func (f RepairQueue_AttemptedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
+func (RepairQueue_AttemptedAt_Field) _Column() string { return "attempted_at" }
 
-type Project_BandwidthLimit_Field struct {
+type RepairQueue_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value time.Time
 }
 
-func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _value: &v}
+func RepairQueue_UpdatedAt(v time.Time) RepairQueue_UpdatedAt_Field {
+	return RepairQueue_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
-	if v == nil {
-		return Project_BandwidthLimit_Null()
+func (f RepairQueue_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_BandwidthLimit(*v)
+	return f._value
 }
 
-func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _null: true}
+func (RepairQueue_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type RepairQueue_InsertedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func RepairQueue_InsertedAt(v time.Time) RepairQueue_InsertedAt_Field {
+	return RepairQueue_InsertedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_BandwidthLimit_Field) value() interface{} {
+func (f RepairQueue_InsertedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+func (RepairQueue_InsertedAt_Field) _Column() string { return "inserted_at" }
 
-type Project_SegmentLimit_Field struct {
+type RepairQueue_SegmentHealth_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value float64
 }
 
-func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _value: &v}
+func RepairQueue_SegmentHealth(v float64) RepairQueue_SegmentHealth_Field {
+	return RepairQueue_SegmentHealth_Field{_set: true, _value: v}
 }
 
-func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
-	if v == nil {
-		return Project_SegmentLimit_Null()
+func (f RepairQueue_SegmentHealth_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_SegmentLimit(*v)
+	return f._value
 }
 
-func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _null: true}
+func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
+
+type RepairQueue_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func RepairQueue_Placement(v int) RepairQueue_Placement_Field {
+	return RepairQueue_Placement_Field{_set: true, _value: v}
+}
 
-func (f Project_SegmentLimit_Field) value() interface{} {
+func (f RepairQueue_Placement_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
+func (RepairQueue_Placement_Field) _Column() string { return "placement" }
 
-type Project_RateLimit_Field struct {
+type RepairQueue_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func Project_RateLimit(v int) Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _value: &v}
+func RepairQueue_ProjectId(v []byte) RepairQueue_ProjectId_Field {
+	return RepairQueue_ProjectId_Field{_set: true, _value: v}
 }
 
-func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
+func RepairQueue_ProjectId_Raw(v []byte) RepairQueue_ProjectId_Field {
 	if v == nil {
-		return Project_RateLimit_Null()
+		return RepairQueue_ProjectId_Null()
 	}
-	return Project_RateLimit(*v)
+	return RepairQueue_ProjectId(v)
 }
 
-func Project_RateLimit_Null() Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _null: true}
+func RepairQueue_ProjectId_Null() RepairQueue_ProjectId_Field {
+	return RepairQueue_ProjectId_Field{_set: true, _null: true}
 }
 
-func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f RepairQueue_ProjectId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_RateLimit_Field) value() interface{} {
+func (f RepairQueue_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
+func (RepairQueue_ProjectId_Field) _Column() string { return "project_id" }
 
-type Project_BurstLimit_Field struct {
-	_set   bool
-	_null  bool
-	_value *int
+type Reputation struct {
+	Id                          []byte
+	AuditSuccessCount           int64
+	TotalAuditCount             int64
+	VettedAt                    *time.Time
+	CreatedAt                   time.Time
+	UpdatedAt                   time.Time
+	Disqualified                *time.Time
+	DisqualificationReason      *int
+	UnknownAuditSuspended       *time.Time
+	OfflineSuspended            *time.Time
+	UnderReview                 *time.Time
+	OnlineScore                 float64
+	AuditHistory                []byte
+	AuditReputationAlpha        float64
+	AuditReputationBeta         float64
+	UnknownAuditReputationAlpha float64
+	UnknownAuditReputationBeta  float64
 }
 
-func Project_BurstLimit(v int) Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _value: &v}
+func (Reputation) _Table() string { return "reputations" }
+
+type Reputation_Create_Fields struct {
+	AuditSuccessCount           Reputation_AuditSuccessCount_Field
+	TotalAuditCount             Reputation_TotalAuditCount_Field
+	VettedAt                    Reputation_VettedAt_Field
+	Disqualified                Reputation_Disqualified_Field
+	DisqualificationReason      Reputation_DisqualificationReason_Field
+	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
+	OfflineSuspended            Reputation_OfflineSuspended_Field
+	UnderReview                 Reputation_UnderReview_Field
+	OnlineScore                 Reputation_OnlineScore_Field
+	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
+	AuditReputationBeta         Reputation_AuditReputationBeta_Field
+	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
+	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
 }
 
-func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
-	if v == nil {
-		return Project_BurstLimit_Null()
-	}
-	return Project_BurstLimit(*v)
+type Reputation_Update_Fields struct {
+	AuditSuccessCount           Reputation_AuditSuccessCount_Field
+	TotalAuditCount             Reputation_TotalAuditCount_Field
+	VettedAt                    Reputation_VettedAt_Field
+	Disqualified                Reputation_Disqualified_Field
+	DisqualificationReason      Reputation_DisqualificationReason_Field
+	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
+	OfflineSuspended            Reputation_OfflineSuspended_Field
+	UnderReview                 Reputation_UnderReview_Field
+	OnlineScore                 Reputation_OnlineScore_Field
+	AuditHistory                Reputation_AuditHistory_Field
+	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
+	AuditReputationBeta         Reputation_AuditReputationBeta_Field
+	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
+	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
+}
+
+type Reputation_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func Project_BurstLimit_Null() Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _null: true}
+func Reputation_Id(v []byte) Reputation_Id_Field {
+	return Reputation_Id_Field{_set: true, _value: v}
 }
 
-func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Project_BurstLimit_Field) value() interface{} {
+func (f Reputation_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
+func (Reputation_Id_Field) _Column() string { return "id" }
 
-type Project_MaxBuckets_Field struct {
+type Reputation_AuditSuccessCount_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value int64
 }
 
-func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _value: &v}
+func Reputation_AuditSuccessCount(v int64) Reputation_AuditSuccessCount_Field {
+	return Reputation_AuditSuccessCount_Field{_set: true, _value: v}
 }
 
-func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
-	if v == nil {
-		return Project_MaxBuckets_Null()
+func (f Reputation_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_MaxBuckets(*v)
+	return f._value
 }
 
-func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _null: true}
+func (Reputation_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+
+type Reputation_TotalAuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Reputation_TotalAuditCount(v int64) Reputation_TotalAuditCount_Field {
+	return Reputation_TotalAuditCount_Field{_set: true, _value: v}
+}
 
-func (f Project_MaxBuckets_Field) value() interface{} {
+func (f Reputation_TotalAuditCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
+func (Reputation_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
 
-type Project_PartnerId_Field struct {
+type Reputation_VettedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func Project_PartnerId(v []byte) Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _value: v}
+func Reputation_VettedAt(v time.Time) Reputation_VettedAt_Field {
+	return Reputation_VettedAt_Field{_set: true, _value: &v}
 }
 
-func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
+func Reputation_VettedAt_Raw(v *time.Time) Reputation_VettedAt_Field {
 	if v == nil {
-		return Project_PartnerId_Null()
+		return Reputation_VettedAt_Null()
 	}
-	return Project_PartnerId(v)
+	return Reputation_VettedAt(*v)
 }
 
-func Project_PartnerId_Null() Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _null: true}
+func Reputation_VettedAt_Null() Reputation_VettedAt_Field {
+	return Reputation_VettedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Reputation_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_PartnerId_Field) value() interface{} {
+func (f Reputation_VettedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PartnerId_Field) _Column() string { return "partner_id" }
+func (Reputation_VettedAt_Field) _Column() string { return "vetted_at" }
 
-type Project_UserAgent_Field struct {
+type Reputation_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
-}
-
-func Project_UserAgent(v []byte) Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _value: v}
-}
-
-func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
-	if v == nil {
-		return Project_UserAgent_Null()
-	}
-	return Project_UserAgent(v)
+	_value time.Time
 }
 
-func Project_UserAgent_Null() Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _null: true}
+func Reputation_CreatedAt(v time.Time) Reputation_CreatedAt_Field {
+	return Reputation_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Project_UserAgent_Field) value() interface{} {
+func (f Reputation_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserAgent_Field) _Column() string { return "user_agent" }
+func (Reputation_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_OwnerId_Field struct {
+type Reputation_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Project_OwnerId(v []byte) Project_OwnerId_Field {
-	return Project_OwnerId_Field{_set: true, _value: v}
+func Reputation_UpdatedAt(v time.Time) Reputation_UpdatedAt_Field {
+	return Reputation_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Project_OwnerId_Field) value() interface{} {
+func (f Reputation_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_OwnerId_Field) _Column() string { return "owner_id" }
+func (Reputation_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Project_Salt_Field struct {
+type Reputation_Disqualified_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func Project_Salt(v []byte) Project_Salt_Field {
-	return Project_Salt_Field{_set: true, _value: v}
+func Reputation_Disqualified(v time.Time) Reputation_Disqualified_Field {
+	return Reputation_Disqualified_Field{_set: true, _value: &v}
 }
 
-func Project_Salt_Raw(v []byte) Project_Salt_Field {
+func Reputation_Disqualified_Raw(v *time.Time) Reputation_Disqualified_Field {
 	if v == nil {
-		return Project_Salt_Null()
+		return Reputation_Disqualified_Null()
 	}
-	return Project_Salt(v)
+	return Reputation_Disqualified(*v)
 }
 
-func Project_Salt_Null() Project_Salt_Field {
-	return Project_Salt_Field{_set: true, _null: true}
+func Reputation_Disqualified_Null() Reputation_Disqualified_Field {
+	return Reputation_Disqualified_Field{_set: true, _null: true}
 }
 
-func (f Project_Salt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Reputation_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_Salt_Field) value() interface{} {
+func (f Reputation_Disqualified_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Salt_Field) _Column() string { return "salt" }
+func (Reputation_Disqualified_Field) _Column() string { return "disqualified" }
 
-type Project_CreatedAt_Field struct {
+type Reputation_DisqualificationReason_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int
 }
 
-func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
-	return Project_CreatedAt_Field{_set: true, _value: v}
+func Reputation_DisqualificationReason(v int) Reputation_DisqualificationReason_Field {
+	return Reputation_DisqualificationReason_Field{_set: true, _value: &v}
 }
 
-func (f Project_CreatedAt_Field) value() interface{} {
+func Reputation_DisqualificationReason_Raw(v *int) Reputation_DisqualificationReason_Field {
+	if v == nil {
+		return Reputation_DisqualificationReason_Null()
+	}
+	return Reputation_DisqualificationReason(*v)
+}
+
+func Reputation_DisqualificationReason_Null() Reputation_DisqualificationReason_Field {
+	return Reputation_DisqualificationReason_Field{_set: true, _null: true}
+}
+
+func (f Reputation_DisqualificationReason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Reputation_DisqualificationReason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+func (Reputation_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
 
-type ProjectBandwidthDailyRollup struct {
-	ProjectId       []byte
-	IntervalDay     time.Time
-	EgressAllocated uint64
-	EgressSettled   uint64
-	EgressDead      uint64
+type Reputation_UnknownAuditSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
-
-type ProjectBandwidthDailyRollup_Create_Fields struct {
-	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
+func Reputation_UnknownAuditSuspended(v time.Time) Reputation_UnknownAuditSuspended_Field {
+	return Reputation_UnknownAuditSuspended_Field{_set: true, _value: &v}
 }
 
-type ProjectBandwidthDailyRollup_Update_Fields struct {
-	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
-	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
-	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
+func Reputation_UnknownAuditSuspended_Raw(v *time.Time) Reputation_UnknownAuditSuspended_Field {
+	if v == nil {
+		return Reputation_UnknownAuditSuspended_Null()
+	}
+	return Reputation_UnknownAuditSuspended(*v)
 }
 
-type ProjectBandwidthDailyRollup_ProjectId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Reputation_UnknownAuditSuspended_Null() Reputation_UnknownAuditSuspended_Field {
+	return Reputation_UnknownAuditSuspended_Field{_set: true, _null: true}
 }
 
-func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
-	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
+func (f Reputation_UnknownAuditSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
+func (f Reputation_UnknownAuditSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (Reputation_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
 
-type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
+type Reputation_OfflineSuspended_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
-	v = toDate(v)
-	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
+func Reputation_OfflineSuspended(v time.Time) Reputation_OfflineSuspended_Field {
+	return Reputation_OfflineSuspended_Field{_set: true, _value: &v}
 }
 
-func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
+func Reputation_OfflineSuspended_Raw(v *time.Time) Reputation_OfflineSuspended_Field {
+	if v == nil {
+		return Reputation_OfflineSuspended_Null()
+	}
+	return Reputation_OfflineSuspended(*v)
+}
+
+func Reputation_OfflineSuspended_Null() Reputation_OfflineSuspended_Field {
+	return Reputation_OfflineSuspended_Field{_set: true, _null: true}
+}
+
+func (f Reputation_OfflineSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Reputation_OfflineSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
+func (Reputation_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
 
-type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
+type Reputation_UnderReview_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value *time.Time
 }
 
-func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
-	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
+func Reputation_UnderReview(v time.Time) Reputation_UnderReview_Field {
+	return Reputation_UnderReview_Field{_set: true, _value: &v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
+func Reputation_UnderReview_Raw(v *time.Time) Reputation_UnderReview_Field {
+	if v == nil {
+		return Reputation_UnderReview_Null()
+	}
+	return Reputation_UnderReview(*v)
+}
+
+func Reputation_UnderReview_Null() Reputation_UnderReview_Field {
+	return Reputation_UnderReview_Field{_set: true, _null: true}
+}
+
+func (f Reputation_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Reputation_UnderReview_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+func (Reputation_UnderReview_Field) _Column() string { return "under_review" }
 
-type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
+type Reputation_OnlineScore_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value float64
 }
 
-func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
-	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
+func Reputation_OnlineScore(v float64) Reputation_OnlineScore_Field {
+	return Reputation_OnlineScore_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
+func (f Reputation_OnlineScore_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
+func (Reputation_OnlineScore_Field) _Column() string { return "online_score" }
 
-type ProjectBandwidthDailyRollup_EgressDead_Field struct {
+type Reputation_AuditHistory_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
-	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
+func Reputation_AuditHistory(v []byte) Reputation_AuditHistory_Field {
+	return Reputation_AuditHistory_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
+func (f Reputation_AuditHistory_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
-
-type ProjectBandwidthRollup struct {
-	ProjectId       []byte
-	IntervalMonth   time.Time
-	EgressAllocated uint64
-}
-
-func (ProjectBandwidthRollup) _Table() string { return "project_bandwidth_rollups" }
-
-type ProjectBandwidthRollup_Update_Fields struct {
-	EgressAllocated ProjectBandwidthRollup_EgressAllocated_Field
-}
+func (Reputation_AuditHistory_Field) _Column() string { return "audit_history" }
 
-type ProjectBandwidthRollup_ProjectId_Field struct {
+type Reputation_AuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func ProjectBandwidthRollup_ProjectId(v []byte) ProjectBandwidthRollup_ProjectId_Field {
-	return ProjectBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func Reputation_AuditReputationAlpha(v float64) Reputation_AuditReputationAlpha_Field {
+	return Reputation_AuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_ProjectId_Field) value() interface{} {
+func (f Reputation_AuditReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (Reputation_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
 
-type ProjectBandwidthRollup_IntervalMonth_Field struct {
+type Reputation_AuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value float64
 }
 
-func ProjectBandwidthRollup_IntervalMonth(v time.Time) ProjectBandwidthRollup_IntervalMonth_Field {
-	v = toDate(v)
-	return ProjectBandwidthRollup_IntervalMonth_Field{_set: true, _value: v}
+func Reputation_AuditReputationBeta(v float64) Reputation_AuditReputationBeta_Field {
+	return Reputation_AuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_IntervalMonth_Field) value() interface{} {
+func (f Reputation_AuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_IntervalMonth_Field) _Column() string { return "interval_month" }
+func (Reputation_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
 
-type ProjectBandwidthRollup_EgressAllocated_Field struct {
+type Reputation_UnknownAuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value float64
 }
 
-func ProjectBandwidthRollup_EgressAllocated(v uint64) ProjectBandwidthRollup_EgressAllocated_Field {
-	return ProjectBandwidthRollup_EgressAllocated_Field{_set: true, _value: v}
+func Reputation_UnknownAuditReputationAlpha(v float64) Reputation_UnknownAuditReputationAlpha_Field {
+	return Reputation_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_EgressAllocated_Field) value() interface{} {
+func (f Reputation_UnknownAuditReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
-
-type RegistrationToken struct {
-	Secret       []byte
-	OwnerId      []byte
-	ProjectLimit int
-	CreatedAt    time.Time
-}
-
-func (RegistrationToken) _Table() string { return "registration_tokens" }
-
-type RegistrationToken_Create_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
-}
-
-type RegistrationToken_Update_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
+func (Reputation_UnknownAuditReputationAlpha_Field) _Column() string {
+	return "unknown_audit_reputation_alpha"
 }
 
-type RegistrationToken_Secret_Field struct {
+type Reputation_UnknownAuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
-	return RegistrationToken_Secret_Field{_set: true, _value: v}
+func Reputation_UnknownAuditReputationBeta(v float64) Reputation_UnknownAuditReputationBeta_Field {
+	return Reputation_UnknownAuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_Secret_Field) value() interface{} {
+func (f Reputation_UnknownAuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
-
-type RegistrationToken_OwnerId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func (Reputation_UnknownAuditReputationBeta_Field) _Column() string {
+	return "unknown_audit_reputation_beta"
 }
 
-func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
+type ResetPasswordToken struct {
+	Secret    []byte
+	OwnerId   []byte
+	CreatedAt time.Time
 }
 
-func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
-	if v == nil {
-		return RegistrationToken_OwnerId_Null()
-	}
-	return RegistrationToken_OwnerId(v)
+func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
+
+type ResetPasswordToken_Update_Fields struct {
+	OwnerId ResetPasswordToken_OwnerId_Field
 }
 
-func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
+type ResetPasswordToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
+	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+}
 
-func (f RegistrationToken_OwnerId_Field) value() interface{} {
+func (f ResetPasswordToken_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
 
-type RegistrationToken_ProjectLimit_Field struct {
+type ResetPasswordToken_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
-	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
+func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
+	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
+func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
+func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
 
-type RegistrationToken_CreatedAt_Field struct {
+type ResetPasswordToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
-	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
+func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
+	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_CreatedAt_Field) value() interface{} {
+func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type RepairQueue struct {
-	StreamId      []byte
-	Position      uint64
-	AttemptedAt   *time.Time
-	UpdatedAt     time.Time
-	InsertedAt    time.Time
-	SegmentHealth float64
+type Revocation struct {
+	Revoked  []byte
+	ApiKeyId []byte
 }
 
-func (RepairQueue) _Table() string { return "repair_queue" }
-
-type RepairQueue_Create_Fields struct {
-	AttemptedAt   RepairQueue_AttemptedAt_Field
-	UpdatedAt     RepairQueue_UpdatedAt_Field
-	InsertedAt    RepairQueue_InsertedAt_Field
-	SegmentHealth RepairQueue_SegmentHealth_Field
-}
+func (Revocation) _Table() string { return "revocations" }
 
-type RepairQueue_Update_Fields struct {
-	AttemptedAt RepairQueue_AttemptedAt_Field
-	UpdatedAt   RepairQueue_UpdatedAt_Field
+type Revocation_Update_Fields struct {
 }
 
-type RepairQueue_StreamId_Field struct {
+type Revocation_Revoked_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func RepairQueue_StreamId(v []byte) RepairQueue_StreamId_Field {
-	return RepairQueue_StreamId_Field{_set: true, _value: v}
+func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
+	return Revocation_Revoked_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_StreamId_Field) value() interface{} {
+func (f Revocation_Revoked_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_StreamId_Field) _Column() string { return "stream_id" }
+func (Revocation_Revoked_Field) _Column() string { return "revoked" }
 
-type RepairQueue_Position_Field struct {
+type Revocation_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func RepairQueue_Position(v uint64) RepairQueue_Position_Field {
-	return RepairQueue_Position_Field{_set: true, _value: v}
+func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
+	return Revocation_ApiKeyId_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_Position_Field) value() interface{} {
+func (f Revocation_ApiKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_Position_Field) _Column() string { return "position" }
+func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
-type RepairQueue_AttemptedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type SegmentLoopCheckpointChunk struct {
+	Name     string
+	Observer string
+	Chunk    int
+	Data     []byte
 }
 
-func RepairQueue_AttemptedAt(v time.Time) RepairQueue_AttemptedAt_Field {
-	return RepairQueue_AttemptedAt_Field{_set: true, _value: &v}
-}
+func (SegmentLoopCheckpointChunk) _Table() string { return "segment_loop_checkpoint_chunks" }
 
-func RepairQueue_AttemptedAt_Raw(v *time.Time) RepairQueue_AttemptedAt_Field {
-	if v == nil {
-		return RepairQueue_AttemptedAt_Null()
-	}
-	return RepairQueue_AttemptedAt(*v)
+type SegmentLoopCheckpointChunk_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func RepairQueue_AttemptedAt_Null() RepairQueue_AttemptedAt_Field {
-	return RepairQueue_AttemptedAt_Field{_set: true, _null: true}
+func SegmentLoopCheckpointChunk_Name(v string) SegmentLoopCheckpointChunk_Name_Field {
+	return SegmentLoopCheckpointChunk_Name_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_AttemptedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f RepairQueue_AttemptedAt_Field) value() interface{} {
+func (f SegmentLoopCheckpointChunk_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_AttemptedAt_Field) _Column() string { return "attempted_at" }
+func (SegmentLoopCheckpointChunk_Name_Field) _Column() string { return "name" }
 
-type RepairQueue_UpdatedAt_Field struct {
+type SegmentLoopCheckpointChunk_Observer_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func RepairQueue_UpdatedAt(v time.Time) RepairQueue_UpdatedAt_Field {
-	return RepairQueue_UpdatedAt_Field{_set: true, _value: v}
+func SegmentLoopCheckpointChunk_Observer(v string) SegmentLoopCheckpointChunk_Observer_Field {
+	return SegmentLoopCheckpointChunk_Observer_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_UpdatedAt_Field) value() interface{} {
+func (f SegmentLoopCheckpointChunk_Observer_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (SegmentLoopCheckpointChunk_Observer_Field) _Column() string { return "observer" }
 
-type RepairQueue_InsertedAt_Field struct {
+type SegmentLoopCheckpointChunk_Chunk_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func RepairQueue_InsertedAt(v time.Time) RepairQueue_InsertedAt_Field {
-	return RepairQueue_InsertedAt_Field{_set: true, _value: v}
+func SegmentLoopCheckpointChunk_Chunk(v int) SegmentLoopCheckpointChunk_Chunk_Field {
+	return SegmentLoopCheckpointChunk_Chunk_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_InsertedAt_Field) value() interface{} {
+func (f SegmentLoopCheckpointChunk_Chunk_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_InsertedAt_Field) _Column() string { return "inserted_at" }
+func (SegmentLoopCheckpointChunk_Chunk_Field) _Column() string { return "chunk" }
 
-type RepairQueue_SegmentHealth_Field struct {
+type SegmentLoopCheckpointChunk_Data_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func RepairQueue_SegmentHealth(v float64) RepairQueue_SegmentHealth_Field {
-	return RepairQueue_SegmentHealth_Field{_set: true, _value: v}
+func SegmentLoopCheckpointChunk_Data(v []byte) SegmentLoopCheckpointChunk_Data_Field {
+	return SegmentLoopCheckpointChunk_Data_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_SegmentHealth_Field) value() interface{} {
+func (f SegmentLoopCheckpointChunk_Data_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
+func (SegmentLoopCheckpointChunk_Data_Field) _Column() string { return "data" }
 
-type Reputation struct {
-	Id                          []byte
-	AuditSuccessCount           int64
-	TotalAuditCount             int64
-	VettedAt                    *time.Time
-	CreatedAt                   time.Time
-	UpdatedAt                   time.Time
-	Disqualified                *time.Time
-	DisqualificationReason      *int
-	UnknownAuditSuspended       *time.Time
-	OfflineSuspended            *time.Time
-	UnderReview                 *time.Time
-	OnlineScore                 float64
-	AuditHistory                []byte
-	AuditReputationAlpha        float64
-	AuditReputationBeta         float64
-	UnknownAuditReputationAlpha float64
-	UnknownAuditReputationBeta  float64
+type SegmentLoopCheckpoint struct {
+	Name              string
+	StartedAt         time.Time
+	StreamId          []byte
+	Segments          int64
+	EstimatedSegments int64
+	UpdatedAt         time.Time
 }
 
-func (Reputation) _Table() string { return "reputations" }
+func (SegmentLoopCheckpoint) _Table() string { return "segment_loop_checkpoints" }
 
-type Reputation_Create_Fields struct {
-	AuditSuccessCount           Reputation_AuditSuccessCount_Field
-	TotalAuditCount             Reputation_TotalAuditCount_Field
-	VettedAt                    Reputation_VettedAt_Field
-	Disqualified                Reputation_Disqualified_Field
-	DisqualificationReason      Reputation_DisqualificationReason_Field
-	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
-	OfflineSuspended            Reputation_OfflineSuspended_Field
-	UnderReview                 Reputation_UnderReview_Field
-	OnlineScore                 Reputation_OnlineScore_Field
-	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
-	AuditReputationBeta         Reputation_AuditReputationBeta_Field
-	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
-	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
+type SegmentLoopCheckpoint_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-type Reputation_Update_Fields struct {
-	AuditSuccessCount           Reputation_AuditSuccessCount_Field
-	TotalAuditCount             Reputation_TotalAuditCount_Field
-	VettedAt                    Reputation_VettedAt_Field
-	Disqualified                Reputation_Disqualified_Field
-	DisqualificationReason      Reputation_DisqualificationReason_Field
-	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
-	OfflineSuspended            Reputation_OfflineSuspended_Field
-	UnderReview                 Reputation_UnderReview_Field
-	OnlineScore                 Reputation_OnlineScore_Field
-	AuditHistory                Reputation_AuditHistory_Field
-	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
-	AuditReputationBeta         Reputation_AuditReputationBeta_Field
-	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
-	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
+func SegmentLoopCheckpoint_Name(v string) SegmentLoopCheckpoint_Name_Field {
+	return SegmentLoopCheckpoint_Name_Field{_set: true, _value: v}
 }
 
-type Reputation_Id_Field struct {
+func (f SegmentLoopCheckpoint_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SegmentLoopCheckpoint_Name_Field) _Column() string { return "name" }
+
+type SegmentLoopCheckpoint_StartedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func SegmentLoopCheckpoint_StartedAt(v time.Time) SegmentLoopCheckpoint_StartedAt_Field {
+	return SegmentLoopCheckpoint_StartedAt_Field{_set: true, _value: v}
+}
+
+func (f SegmentLoopCheckpoint_StartedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SegmentLoopCheckpoint_StartedAt_Field) _Column() string { return "started_at" }
+
+type SegmentLoopCheckpoint_StreamId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Reputation_Id(v []byte) Reputation_Id_Field {
-	return Reputation_Id_Field{_set: true, _value: v}
+func SegmentLoopCheckpoint_StreamId(v []byte) SegmentLoopCheckpoint_StreamId_Field {
+	return SegmentLoopCheckpoint_StreamId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_Id_Field) value() interface{} {
+func (f SegmentLoopCheckpoint_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_Id_Field) _Column() string { return "id" }
+func (SegmentLoopCheckpoint_StreamId_Field) _Column() string { return "stream_id" }
 
-type Reputation_AuditSuccessCount_Field struct {
+type SegmentLoopCheckpoint_Segments_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Reputation_AuditSuccessCount(v int64) Reputation_AuditSuccessCount_Field {
-	return Reputation_AuditSuccessCount_Field{_set: true, _value: v}
+func SegmentLoopCheckpoint_Segments(v int64) SegmentLoopCheckpoint_Segments_Field {
+	return SegmentLoopCheckpoint_Segments_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditSuccessCount_Field) value() interface{} {
+func (f SegmentLoopCheckpoint_Segments_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (SegmentLoopCheckpoint_Segments_Field) _Column() string { return "segments" }
 
-type Reputation_TotalAuditCount_Field struct {
+type SegmentLoopCheckpoint_EstimatedSegments_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Reputation_TotalAuditCount(v int64) Reputation_TotalAuditCount_Field {
-	return Reputation_TotalAuditCount_Field{_set: true, _value: v}
+func SegmentLoopCheckpoint_EstimatedSegments(v int64) SegmentLoopCheckpoint_EstimatedSegments_Field {
+	return SegmentLoopCheckpoint_EstimatedSegments_Field{_set: true, _value: v}
 }
 
-func (f Reputation_TotalAuditCount_Field) value() interface{} {
+func (f SegmentLoopCheckpoint_EstimatedSegments_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+func (SegmentLoopCheckpoint_EstimatedSegments_Field) _Column() string { return "estimated_segments" }
 
-type Reputation_VettedAt_Field struct {
+type SegmentLoopCheckpoint_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func Reputation_VettedAt(v time.Time) Reputation_VettedAt_Field {
-	return Reputation_VettedAt_Field{_set: true, _value: &v}
+func SegmentLoopCheckpoint_UpdatedAt(v time.Time) SegmentLoopCheckpoint_UpdatedAt_Field {
+	return SegmentLoopCheckpoint_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func Reputation_VettedAt_Raw(v *time.Time) Reputation_VettedAt_Field {
-	if v == nil {
-		return Reputation_VettedAt_Null()
+func (f SegmentLoopCheckpoint_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_VettedAt(*v)
+	return f._value
 }
 
-func Reputation_VettedAt_Null() Reputation_VettedAt_Field {
-	return Reputation_VettedAt_Field{_set: true, _null: true}
+func (SegmentLoopCheckpoint_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type SegmentPendingAudits struct {
+	NodeId            []byte
+	StreamId          []byte
+	Position          uint64
+	PieceId           []byte
+	StripeIndex       int64
+	ShareSize         int64
+	ExpectedShareHash []byte
+	ReverifyCount     int64
 }
 
-func (f Reputation_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (SegmentPendingAudits) _Table() string { return "segment_pending_audits" }
 
-func (f Reputation_VettedAt_Field) value() interface{} {
+type SegmentPendingAudits_Update_Fields struct {
+	ReverifyCount SegmentPendingAudits_ReverifyCount_Field
+}
+
+type SegmentPendingAudits_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func SegmentPendingAudits_NodeId(v []byte) SegmentPendingAudits_NodeId_Field {
+	return SegmentPendingAudits_NodeId_Field{_set: true, _value: v}
+}
+
+func (f SegmentPendingAudits_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_VettedAt_Field) _Column() string { return "vetted_at" }
+func (SegmentPendingAudits_NodeId_Field) _Column() string { return "node_id" }
 
-type Reputation_CreatedAt_Field struct {
+type SegmentPendingAudits_StreamId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Reputation_CreatedAt(v time.Time) Reputation_CreatedAt_Field {
-	return Reputation_CreatedAt_Field{_set: true, _value: v}
+func SegmentPendingAudits_StreamId(v []byte) SegmentPendingAudits_StreamId_Field {
+	return SegmentPendingAudits_StreamId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_CreatedAt_Field) value() interface{} {
+func (f SegmentPendingAudits_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_CreatedAt_Field) _Column() string { return "created_at" }
+func (SegmentPendingAudits_StreamId_Field) _Column() string { return "stream_id" }
 
-type Reputation_UpdatedAt_Field struct {
+type SegmentPendingAudits_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint64
 }
 
-func Reputation_UpdatedAt(v time.Time) Reputation_UpdatedAt_Field {
-	return Reputation_UpdatedAt_Field{_set: true, _value: v}
+func SegmentPendingAudits_Position(v uint64) SegmentPendingAudits_Position_Field {
+	return SegmentPendingAudits_Position_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UpdatedAt_Field) value() interface{} {
+func (f SegmentPendingAudits_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (SegmentPendingAudits_Position_Field) _Column() string { return "position" }
 
-type Reputation_Disqualified_Field struct {
+type SegmentPendingAudits_PieceId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func Reputation_Disqualified(v time.Time) Reputation_Disqualified_Field {
-	return Reputation_Disqualified_Field{_set: true, _value: &v}
+func SegmentPendingAudits_PieceId(v []byte) SegmentPendingAudits_PieceId_Field {
+	return SegmentPendingAudits_PieceId_Field{_set: true, _value: v}
 }
 
-func Reputation_Disqualified_Raw(v *time.Time) Reputation_Disqualified_Field {
-	if v == nil {
-		return Reputation_Disqualified_Null()
+func (f SegmentPendingAudits_PieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_Disqualified(*v)
+	return f._value
 }
 
-func Reputation_Disqualified_Null() Reputation_Disqualified_Field {
-	return Reputation_Disqualified_Field{_set: true, _null: true}
+func (SegmentPendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+
+type SegmentPendingAudits_StripeIndex_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f Reputation_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func SegmentPendingAudits_StripeIndex(v int64) SegmentPendingAudits_StripeIndex_Field {
+	return SegmentPendingAudits_StripeIndex_Field{_set: true, _value: v}
+}
 
-func (f Reputation_Disqualified_Field) value() interface{} {
+func (f SegmentPendingAudits_StripeIndex_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_Disqualified_Field) _Column() string { return "disqualified" }
+func (SegmentPendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
 
-type Reputation_DisqualificationReason_Field struct {
+type SegmentPendingAudits_ShareSize_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value int64
 }
 
-func Reputation_DisqualificationReason(v int) Reputation_DisqualificationReason_Field {
-	return Reputation_DisqualificationReason_Field{_set: true, _value: &v}
+func SegmentPendingAudits_ShareSize(v int64) SegmentPendingAudits_ShareSize_Field {
+	return SegmentPendingAudits_ShareSize_Field{_set: true, _value: v}
 }
 
-func Reputation_DisqualificationReason_Raw(v *int) Reputation_DisqualificationReason_Field {
-	if v == nil {
-		return Reputation_DisqualificationReason_Null()
+func (f SegmentPendingAudits_ShareSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_DisqualificationReason(*v)
+	return f._value
 }
 
-func Reputation_DisqualificationReason_Null() Reputation_DisqualificationReason_Field {
-	return Reputation_DisqualificationReason_Field{_set: true, _null: true}
+func (SegmentPendingAudits_ShareSize_Field) _Column() string { return "share_size" }
+
+type SegmentPendingAudits_ExpectedShareHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Reputation_DisqualificationReason_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func SegmentPendingAudits_ExpectedShareHash(v []byte) SegmentPendingAudits_ExpectedShareHash_Field {
+	return SegmentPendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
 }
 
-func (f Reputation_DisqualificationReason_Field) value() interface{} {
+func (f SegmentPendingAudits_ExpectedShareHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+func (SegmentPendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
 
-type Reputation_UnknownAuditSuspended_Field struct {
+type SegmentPendingAudits_ReverifyCount_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int64
 }
 
-func Reputation_UnknownAuditSuspended(v time.Time) Reputation_UnknownAuditSuspended_Field {
-	return Reputation_UnknownAuditSuspended_Field{_set: true, _value: &v}
+func SegmentPendingAudits_ReverifyCount(v int64) SegmentPendingAudits_ReverifyCount_Field {
+	return SegmentPendingAudits_ReverifyCount_Field{_set: true, _value: v}
 }
 
-func Reputation_UnknownAuditSuspended_Raw(v *time.Time) Reputation_UnknownAuditSuspended_Field {
-	if v == nil {
-		return Reputation_UnknownAuditSuspended_Null()
+func (f SegmentPendingAudits_ReverifyCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_UnknownAuditSuspended(*v)
+	return f._value
 }
 
-func Reputation_UnknownAuditSuspended_Null() Reputation_UnknownAuditSuspended_Field {
-	return Reputation_UnknownAuditSuspended_Field{_set: true, _null: true}
+func (SegmentPendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+
+type SsoIdentity struct {
+	Provider  string
+	Subject   string
+	UserId    []byte
+	CreatedAt time.Time
 }
 
-func (f Reputation_UnknownAuditSuspended_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func (SsoIdentity) _Table() string { return "sso_identities" }
+
+type SsoIdentity_Provider_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Reputation_UnknownAuditSuspended_Field) value() interface{} {
+func SsoIdentity_Provider(v string) SsoIdentity_Provider_Field {
+	return SsoIdentity_Provider_Field{_set: true, _value: v}
+}
+
+func (f SsoIdentity_Provider_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+func (SsoIdentity_Provider_Field) _Column() string { return "provider" }
 
-type Reputation_OfflineSuspended_Field struct {
+type SsoIdentity_Subject_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value string
 }
 
-func Reputation_OfflineSuspended(v time.Time) Reputation_OfflineSuspended_Field {
-	return Reputation_OfflineSuspended_Field{_set: true, _value: &v}
+func SsoIdentity_Subject(v string) SsoIdentity_Subject_Field {
+	return SsoIdentity_Subject_Field{_set: true, _value: v}
 }
 
-func Reputation_OfflineSuspended_Raw(v *time.Time) Reputation_OfflineSuspended_Field {
-	if v == nil {
-		return Reputation_OfflineSuspended_Null()
+func (f SsoIdentity_Subject_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_OfflineSuspended(*v)
+	return f._value
 }
 
-func Reputation_OfflineSuspended_Null() Reputation_OfflineSuspended_Field {
-	return Reputation_OfflineSuspended_Field{_set: true, _null: true}
-}
+func (SsoIdentity_Subject_Field) _Column() string { return "subject" }
 
-func (f Reputation_OfflineSuspended_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+type SsoIdentity_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func SsoIdentity_UserId(v []byte) SsoIdentity_UserId_Field {
+	return SsoIdentity_UserId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_OfflineSuspended_Field) value() interface{} {
+func (f SsoIdentity_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+func (SsoIdentity_UserId_Field) _Column() string { return "user_id" }
 
-type Reputation_UnderReview_Field struct {
+type SsoIdentity_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func Reputation_UnderReview(v time.Time) Reputation_UnderReview_Field {
-	return Reputation_UnderReview_Field{_set: true, _value: &v}
+func SsoIdentity_CreatedAt(v time.Time) SsoIdentity_CreatedAt_Field {
+	return SsoIdentity_CreatedAt_Field{_set: true, _value: v}
 }
 
-func Reputation_UnderReview_Raw(v *time.Time) Reputation_UnderReview_Field {
-	if v == nil {
-		return Reputation_UnderReview_Null()
+func (f SsoIdentity_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_UnderReview(*v)
+	return f._value
 }
 
-func Reputation_UnderReview_Null() Reputation_UnderReview_Field {
-	return Reputation_UnderReview_Field{_set: true, _null: true}
+func (SsoIdentity_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StoragenodeBandwidthRollup struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
 }
 
-func (f Reputation_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
 
-func (f Reputation_UnderReview_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type StoragenodeBandwidthRollup_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
 }
 
-func (Reputation_UnderReview_Field) _Column() string { return "under_review" }
+type StoragenodeBandwidthRollup_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
+	Settled   StoragenodeBandwidthRollup_Settled_Field
+}
 
-type Reputation_OnlineScore_Field struct {
+type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Reputation_OnlineScore(v float64) Reputation_OnlineScore_Field {
-	return Reputation_OnlineScore_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
+	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_OnlineScore_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_OnlineScore_Field) _Column() string { return "online_score" }
+func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
 
-type Reputation_AuditHistory_Field struct {
+type StoragenodeBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Reputation_AuditHistory(v []byte) Reputation_AuditHistory_Field {
-	return Reputation_AuditHistory_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
+	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditHistory_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditHistory_Field) _Column() string { return "audit_history" }
+func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type Reputation_AuditReputationAlpha_Field struct {
+type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value uint
 }
 
-func Reputation_AuditReputationAlpha(v float64) Reputation_AuditReputationAlpha_Field {
-	return Reputation_AuditReputationAlpha_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditReputationAlpha_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type Reputation_AuditReputationBeta_Field struct {
+type StoragenodeBandwidthRollup_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value uint
 }
 
-func Reputation_AuditReputationBeta(v float64) Reputation_AuditReputationBeta_Field {
-	return Reputation_AuditReputationBeta_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
+	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditReputationBeta_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-type Reputation_UnknownAuditReputationAlpha_Field struct {
+type StoragenodeBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value *uint64
 }
 
-func Reputation_UnknownAuditReputationAlpha(v float64) Reputation_UnknownAuditReputationAlpha_Field {
-	return Reputation_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
+	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: &v}
 }
 
-func (f Reputation_UnknownAuditReputationAlpha_Field) value() interface{} {
+func StoragenodeBandwidthRollup_Allocated_Raw(v *uint64) StoragenodeBandwidthRollup_Allocated_Field {
+	if v == nil {
+		return StoragenodeBandwidthRollup_Allocated_Null()
+	}
+	return StoragenodeBandwidthRollup_Allocated(*v)
+}
+
+func StoragenodeBandwidthRollup_Allocated_Null() StoragenodeBandwidthRollup_Allocated_Field {
+	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _null: true}
+}
+
+func (f StoragenodeBandwidthRollup_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditReputationAlpha_Field) _Column() string {
-	return "unknown_audit_reputation_alpha"
-}
+func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type Reputation_UnknownAuditReputationBeta_Field struct {
+type StoragenodeBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value uint64
 }
 
-func Reputation_UnknownAuditReputationBeta(v float64) Reputation_UnknownAuditReputationBeta_Field {
-	return Reputation_UnknownAuditReputationBeta_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
+	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditReputationBeta_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditReputationBeta_Field) _Column() string {
-	return "unknown_audit_reputation_beta"
+func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+
+type StoragenodeBandwidthRollupArchive struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
 }
 
-type ResetPasswordToken struct {
-	Secret    []byte
-	OwnerId   []byte
-	CreatedAt time.Time
+func (StoragenodeBandwidthRollupArchive) _Table() string {
+	return "storagenode_bandwidth_rollup_archives"
 }
 
-func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
+type StoragenodeBandwidthRollupArchive_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
+}
 
-type ResetPasswordToken_Update_Fields struct {
-	OwnerId ResetPasswordToken_OwnerId_Field
+type StoragenodeBandwidthRollupArchive_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
+	Settled   StoragenodeBandwidthRollupArchive_Settled_Field
 }
 
-type ResetPasswordToken_Secret_Field struct {
+type StoragenodeBandwidthRollupArchive_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
-	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_StoragenodeId(v []byte) StoragenodeBandwidthRollupArchive_StoragenodeId_Field {
+	return StoragenodeBandwidthRollupArchive_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_Secret_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
+func (StoragenodeBandwidthRollupArchive_StoragenodeId_Field) _Column() string {
+	return "storagenode_id"
+}
 
-type ResetPasswordToken_OwnerId_Field struct {
+type StoragenodeBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
-	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_IntervalStart(v time.Time) StoragenodeBandwidthRollupArchive_IntervalStart_Field {
+	return StoragenodeBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (StoragenodeBandwidthRollupArchive_IntervalStart_Field) _Column() string {
+	return "interval_start"
+}
 
-type ResetPasswordToken_CreatedAt_Field struct {
+type StoragenodeBandwidthRollupArchive_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint
 }
 
-func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
-	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_IntervalSeconds(v uint) StoragenodeBandwidthRollupArchive_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
-
-type Revocation struct {
-	Revoked  []byte
-	ApiKeyId []byte
-}
-
-func (Revocation) _Table() string { return "revocations" }
-
-type Revocation_Update_Fields struct {
+func (StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) _Column() string {
+	return "interval_seconds"
 }
 
-type Revocation_Revoked_Field struct {
+type StoragenodeBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
-	return Revocation_Revoked_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Action(v uint) StoragenodeBandwidthRollupArchive_Action_Field {
+	return StoragenodeBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f Revocation_Revoked_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Revocation_Revoked_Field) _Column() string { return "revoked" }
+func (StoragenodeBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type Revocation_ApiKeyId_Field struct {
+type StoragenodeBandwidthRollupArchive_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *uint64
 }
 
-func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
-	return Revocation_ApiKeyId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Allocated(v uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
+	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _value: &v}
 }
 
-func (f Revocation_ApiKeyId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func StoragenodeBandwidthRollupArchive_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
+	if v == nil {
+		return StoragenodeBandwidthRollupArchive_Allocated_Null()
 	}
-	return f._value
+	return StoragenodeBandwidthRollupArchive_Allocated(*v)
 }
 
-func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
-
-type SegmentPendingAudits struct {
-	NodeId            []byte
-	StreamId          []byte
-	Position          uint64
-	PieceId           []byte
-	StripeIndex       int64
-	ShareSize         int64
-	ExpectedShareHash []byte
-	ReverifyCount     int64
+func StoragenodeBandwidthRollupArchive_Allocated_Null() StoragenodeBandwidthRollupArchive_Allocated_Field {
+	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _null: true}
 }
 
-func (SegmentPendingAudits) _Table() string { return "segment_pending_audits" }
+func (f StoragenodeBandwidthRollupArchive_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-type SegmentPendingAudits_Update_Fields struct {
-	ReverifyCount SegmentPendingAudits_ReverifyCount_Field
+func (f StoragenodeBandwidthRollupArchive_Allocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type SegmentPendingAudits_NodeId_Field struct {
+func (StoragenodeBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+
+type StoragenodeBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func SegmentPendingAudits_NodeId(v []byte) SegmentPendingAudits_NodeId_Field {
-	return SegmentPendingAudits_NodeId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Settled(v uint64) StoragenodeBandwidthRollupArchive_Settled_Field {
+	return StoragenodeBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_NodeId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_NodeId_Field) _Column() string { return "node_id" }
+func (StoragenodeBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type SegmentPendingAudits_StreamId_Field struct {
+type StoragenodeBandwidthRollupPhase2 struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
+}
+
+func (StoragenodeBandwidthRollupPhase2) _Table() string {
+	return "storagenode_bandwidth_rollups_phase2"
+}
+
+type StoragenodeBandwidthRollupPhase2_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+}
+
+type StoragenodeBandwidthRollupPhase2_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+	Settled   StoragenodeBandwidthRollupPhase2_Settled_Field
+}
+
+type StoragenodeBandwidthRollupPhase2_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func SegmentPendingAudits_StreamId(v []byte) SegmentPendingAudits_StreamId_Field {
-	return SegmentPendingAudits_StreamId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_StoragenodeId(v []byte) StoragenodeBandwidthRollupPhase2_StoragenodeId_Field {
+	return StoragenodeBandwidthRollupPhase2_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_StreamId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_StreamId_Field) _Column() string { return "stream_id" }
+func (StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) _Column() string { return "storagenode_id" }
 
-type SegmentPendingAudits_Position_Field struct {
+type StoragenodeBandwidthRollupPhase2_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func SegmentPendingAudits_Position(v uint64) SegmentPendingAudits_Position_Field {
-	return SegmentPendingAudits_Position_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_IntervalStart(v time.Time) StoragenodeBandwidthRollupPhase2_IntervalStart_Field {
+	return StoragenodeBandwidthRollupPhase2_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_Position_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_Position_Field) _Column() string { return "position" }
+func (StoragenodeBandwidthRollupPhase2_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type SegmentPendingAudits_PieceId_Field struct {
+type StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func SegmentPendingAudits_PieceId(v []byte) SegmentPendingAudits_PieceId_Field {
-	return SegmentPendingAudits_PieceId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_IntervalSeconds(v uint) StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_PieceId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+func (StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) _Column() string {
+	return "interval_seconds"
+}
 
-type SegmentPendingAudits_StripeIndex_Field struct {
+type StoragenodeBandwidthRollupPhase2_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func SegmentPendingAudits_StripeIndex(v int64) SegmentPendingAudits_StripeIndex_Field {
-	return SegmentPendingAudits_StripeIndex_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Action(v uint) StoragenodeBandwidthRollupPhase2_Action_Field {
+	return StoragenodeBandwidthRollupPhase2_Action_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_StripeIndex_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
+func (StoragenodeBandwidthRollupPhase2_Action_Field) _Column() string { return "action" }
 
-type SegmentPendingAudits_ShareSize_Field struct {
+type StoragenodeBandwidthRollupPhase2_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *uint64
 }
 
-func SegmentPendingAudits_ShareSize(v int64) SegmentPendingAudits_ShareSize_Field {
-	return SegmentPendingAudits_ShareSize_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Allocated(v uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _value: &v}
 }
 
-func (f SegmentPendingAudits_ShareSize_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func StoragenodeBandwidthRollupPhase2_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	if v == nil {
+		return StoragenodeBandwidthRollupPhase2_Allocated_Null()
 	}
-	return f._value
+	return StoragenodeBandwidthRollupPhase2_Allocated(*v)
 }
 
-func (SegmentPendingAudits_ShareSize_Field) _Column() string { return "share_size" }
-
-type SegmentPendingAudits_ExpectedShareHash_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func StoragenodeBandwidthRollupPhase2_Allocated_Null() StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _null: true}
 }
 
-func SegmentPendingAudits_ExpectedShareHash(v []byte) SegmentPendingAudits_ExpectedShareHash_Field {
-	return SegmentPendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
+func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f SegmentPendingAudits_ExpectedShareHash_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+func (StoragenodeBandwidthRollupPhase2_Allocated_Field) _Column() string { return "allocated" }
 
-type SegmentPendingAudits_ReverifyCount_Field struct {
+type StoragenodeBandwidthRollupPhase2_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func SegmentPendingAudits_ReverifyCount(v int64) SegmentPendingAudits_ReverifyCount_Field {
-	return SegmentPendingAudits_ReverifyCount_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Settled(v uint64) StoragenodeBandwidthRollupPhase2_Settled_Field {
+	return StoragenodeBandwidthRollupPhase2_Settled_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_ReverifyCount_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+func (StoragenodeBandwidthRollupPhase2_Settled_Field) _Column() string { return "settled" }
 
-type StoragenodeBandwidthRollup struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type StoragenodePayment struct {
+	Id        int64
+	CreatedAt time.Time
+	NodeId    []byte
+	Period    string
+	Amount    int64
+	Receipt   *string
+	Notes     *string
 }
 
-func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
+func (StoragenodePayment) _Table() string { return "storagenode_payments" }
 
-type StoragenodeBandwidthRollup_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
+type StoragenodePayment_Create_Fields struct {
+	Receipt StoragenodePayment_Receipt_Field
+	Notes   StoragenodePayment_Notes_Field
 }
 
-type StoragenodeBandwidthRollup_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
-	Settled   StoragenodeBandwidthRollup_Settled_Field
+type StoragenodePayment_Update_Fields struct {
 }
 
-type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
+type StoragenodePayment_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
-	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
+func StoragenodePayment_Id(v int64) StoragenodePayment_Id_Field {
+	return StoragenodePayment_Id_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
+func (f StoragenodePayment_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (StoragenodePayment_Id_Field) _Column() string { return "id" }
 
-type StoragenodeBandwidthRollup_IntervalStart_Field struct {
+type StoragenodePayment_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
-	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func StoragenodePayment_CreatedAt(v time.Time) StoragenodePayment_CreatedAt_Field {
+	return StoragenodePayment_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f StoragenodePayment_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (StoragenodePayment_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
+type StoragenodePayment_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func StoragenodePayment_NodeId(v []byte) StoragenodePayment_NodeId_Field {
+	return StoragenodePayment_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func (f StoragenodePayment_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (StoragenodePayment_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodeBandwidthRollup_Action_Field struct {
+type StoragenodePayment_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
-	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+func StoragenodePayment_Period(v string) StoragenodePayment_Period_Field {
+	return StoragenodePayment_Period_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
+func (f StoragenodePayment_Period_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (StoragenodePayment_Period_Field) _Column() string { return "period" }
 
-type StoragenodeBandwidthRollup_Allocated_Field struct {
+type StoragenodePayment_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
+	_value int64
 }
 
-func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
-	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: &v}
+func StoragenodePayment_Amount(v int64) StoragenodePayment_Amount_Field {
+	return StoragenodePayment_Amount_Field{_set: true, _value: v}
 }
 
-func StoragenodeBandwidthRollup_Allocated_Raw(v *uint64) StoragenodeBandwidthRollup_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollup_Allocated_Null()
+func (f StoragenodePayment_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodeBandwidthRollup_Allocated(*v)
+	return f._value
 }
 
-func StoragenodeBandwidthRollup_Allocated_Null() StoragenodeBandwidthRollup_Allocated_Field {
-	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _null: true}
+func (StoragenodePayment_Amount_Field) _Column() string { return "amount" }
+
+type StoragenodePayment_Receipt_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
 }
 
-func (f StoragenodeBandwidthRollup_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func StoragenodePayment_Receipt(v string) StoragenodePayment_Receipt_Field {
+	return StoragenodePayment_Receipt_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
+func StoragenodePayment_Receipt_Raw(v *string) StoragenodePayment_Receipt_Field {
+	if v == nil {
+		return StoragenodePayment_Receipt_Null()
+	}
+	return StoragenodePayment_Receipt(*v)
+}
+
+func StoragenodePayment_Receipt_Null() StoragenodePayment_Receipt_Field {
+	return StoragenodePayment_Receipt_Field{_set: true, _null: true}
+}
+
+func (f StoragenodePayment_Receipt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f StoragenodePayment_Receipt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
-	return f._value
+	return f._value
+}
+
+func (StoragenodePayment_Receipt_Field) _Column() string { return "receipt" }
+
+type StoragenodePayment_Notes_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func StoragenodePayment_Notes(v string) StoragenodePayment_Notes_Field {
+	return StoragenodePayment_Notes_Field{_set: true, _value: &v}
+}
+
+func StoragenodePayment_Notes_Raw(v *string) StoragenodePayment_Notes_Field {
+	if v == nil {
+		return StoragenodePayment_Notes_Null()
+	}
+	return StoragenodePayment_Notes(*v)
 }
 
-func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
-
-type StoragenodeBandwidthRollup_Settled_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
+func StoragenodePayment_Notes_Null() StoragenodePayment_Notes_Field {
+	return StoragenodePayment_Notes_Field{_set: true, _null: true}
 }
 
-func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
-	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
-}
+func (f StoragenodePayment_Notes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
+func (f StoragenodePayment_Notes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
-
-type StoragenodeBandwidthRollupArchive struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
-}
+func (StoragenodePayment_Notes_Field) _Column() string { return "notes" }
 
-func (StoragenodeBandwidthRollupArchive) _Table() string {
-	return "storagenode_bandwidth_rollup_archives"
+type StoragenodePaystub struct {
+	Period         string
+	NodeId         []byte
+	CreatedAt      time.Time
+	Codes          string
+	UsageAtRest    float64
+	UsageGet       int64
+	UsagePut       int64
+	UsageGetRepair int64
+	UsagePutRepair int64
+	UsageGetAudit  int64
+	CompAtRest     int64
+	CompGet        int64
+	CompPut        int64
+	CompGetRepair  int64
+	CompPutRepair  int64
+	CompGetAudit   int64
+	SurgePercent   int64
+	Held           int64
+	Owed           int64
+	Disposed       int64
+	Paid           int64
+	Distributed    int64
 }
 
-type StoragenodeBandwidthRollupArchive_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
-}
+func (StoragenodePaystub) _Table() string { return "storagenode_paystubs" }
 
-type StoragenodeBandwidthRollupArchive_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
-	Settled   StoragenodeBandwidthRollupArchive_Settled_Field
+type StoragenodePaystub_Update_Fields struct {
 }
 
-type StoragenodeBandwidthRollupArchive_StoragenodeId_Field struct {
+type StoragenodePaystub_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func StoragenodeBandwidthRollupArchive_StoragenodeId(v []byte) StoragenodeBandwidthRollupArchive_StoragenodeId_Field {
-	return StoragenodeBandwidthRollupArchive_StoragenodeId_Field{_set: true, _value: v}
+func StoragenodePaystub_Period(v string) StoragenodePaystub_Period_Field {
+	return StoragenodePaystub_Period_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_StoragenodeId_Field) value() interface{} {
+func (f StoragenodePaystub_Period_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_StoragenodeId_Field) _Column() string {
-	return "storagenode_id"
-}
+func (StoragenodePaystub_Period_Field) _Column() string { return "period" }
 
-type StoragenodeBandwidthRollupArchive_IntervalStart_Field struct {
+type StoragenodePaystub_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StoragenodeBandwidthRollupArchive_IntervalStart(v time.Time) StoragenodeBandwidthRollupArchive_IntervalStart_Field {
-	return StoragenodeBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func StoragenodePaystub_NodeId(v []byte) StoragenodePaystub_NodeId_Field {
+	return StoragenodePaystub_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f StoragenodePaystub_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_IntervalStart_Field) _Column() string {
-	return "interval_start"
-}
+func (StoragenodePaystub_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodeBandwidthRollupArchive_IntervalSeconds_Field struct {
+type StoragenodePaystub_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollupArchive_IntervalSeconds(v uint) StoragenodeBandwidthRollupArchive_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func StoragenodePaystub_CreatedAt(v time.Time) StoragenodePaystub_CreatedAt_Field {
+	return StoragenodePaystub_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f StoragenodePaystub_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) _Column() string {
-	return "interval_seconds"
-}
+func (StoragenodePaystub_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodeBandwidthRollupArchive_Action_Field struct {
+type StoragenodePaystub_Codes_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func StoragenodeBandwidthRollupArchive_Action(v uint) StoragenodeBandwidthRollupArchive_Action_Field {
-	return StoragenodeBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func StoragenodePaystub_Codes(v string) StoragenodePaystub_Codes_Field {
+	return StoragenodePaystub_Codes_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f StoragenodePaystub_Codes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (StoragenodePaystub_Codes_Field) _Column() string { return "codes" }
 
-type StoragenodeBandwidthRollupArchive_Allocated_Field struct {
+type StoragenodePaystub_UsageAtRest_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
-}
-
-func StoragenodeBandwidthRollupArchive_Allocated(v uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
-	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _value: &v}
-}
-
-func StoragenodeBandwidthRollupArchive_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollupArchive_Allocated_Null()
-	}
-	return StoragenodeBandwidthRollupArchive_Allocated(*v)
-}
-
-func StoragenodeBandwidthRollupArchive_Allocated_Null() StoragenodeBandwidthRollupArchive_Allocated_Field {
-	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _null: true}
+	_value float64
 }
 
-func (f StoragenodeBandwidthRollupArchive_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func StoragenodePaystub_UsageAtRest(v float64) StoragenodePaystub_UsageAtRest_Field {
+	return StoragenodePaystub_UsageAtRest_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f StoragenodePaystub_UsageAtRest_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (StoragenodePaystub_UsageAtRest_Field) _Column() string { return "usage_at_rest" }
 
-type StoragenodeBandwidthRollupArchive_Settled_Field struct {
+type StoragenodePaystub_UsageGet_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func StoragenodeBandwidthRollupArchive_Settled(v uint64) StoragenodeBandwidthRollupArchive_Settled_Field {
-	return StoragenodeBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func StoragenodePaystub_UsageGet(v int64) StoragenodePaystub_UsageGet_Field {
+	return StoragenodePaystub_UsageGet_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Settled_Field) value() interface{} {
+func (f StoragenodePaystub_UsageGet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+func (StoragenodePaystub_UsageGet_Field) _Column() string { return "usage_get" }
 
-type StoragenodeBandwidthRollupPhase2 struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type StoragenodePaystub_UsagePut_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (StoragenodeBandwidthRollupPhase2) _Table() string {
-	return "storagenode_bandwidth_rollups_phase2"
+func StoragenodePaystub_UsagePut(v int64) StoragenodePaystub_UsagePut_Field {
+	return StoragenodePaystub_UsagePut_Field{_set: true, _value: v}
 }
 
-type StoragenodeBandwidthRollupPhase2_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+func (f StoragenodePaystub_UsagePut_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StoragenodeBandwidthRollupPhase2_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
-	Settled   StoragenodeBandwidthRollupPhase2_Settled_Field
-}
+func (StoragenodePaystub_UsagePut_Field) _Column() string { return "usage_put" }
 
-type StoragenodeBandwidthRollupPhase2_StoragenodeId_Field struct {
+type StoragenodePaystub_UsageGetRepair_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_StoragenodeId(v []byte) StoragenodeBandwidthRollupPhase2_StoragenodeId_Field {
-	return StoragenodeBandwidthRollupPhase2_StoragenodeId_Field{_set: true, _value: v}
+func StoragenodePaystub_UsageGetRepair(v int64) StoragenodePaystub_UsageGetRepair_Field {
+	return StoragenodePaystub_UsageGetRepair_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) value() interface{} {
+func (f StoragenodePaystub_UsageGetRepair_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (StoragenodePaystub_UsageGetRepair_Field) _Column() string { return "usage_get_repair" }
 
-type StoragenodeBandwidthRollupPhase2_IntervalStart_Field struct {
+type StoragenodePaystub_UsagePutRepair_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_IntervalStart(v time.Time) StoragenodeBandwidthRollupPhase2_IntervalStart_Field {
-	return StoragenodeBandwidthRollupPhase2_IntervalStart_Field{_set: true, _value: v}
+func StoragenodePaystub_UsagePutRepair(v int64) StoragenodePaystub_UsagePutRepair_Field {
+	return StoragenodePaystub_UsagePutRepair_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_IntervalStart_Field) value() interface{} {
+func (f StoragenodePaystub_UsagePutRepair_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_IntervalStart_Field) _Column() string { return "interval_start" }
+func (StoragenodePaystub_UsagePutRepair_Field) _Column() string { return "usage_put_repair" }
 
-type StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field struct {
+type StoragenodePaystub_UsageGetAudit_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_IntervalSeconds(v uint) StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field{_set: true, _value: v}
+func StoragenodePaystub_UsageGetAudit(v int64) StoragenodePaystub_UsageGetAudit_Field {
+	return StoragenodePaystub_UsageGetAudit_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) value() interface{} {
+func (f StoragenodePaystub_UsageGetAudit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) _Column() string {
-	return "interval_seconds"
-}
+func (StoragenodePaystub_UsageGetAudit_Field) _Column() string { return "usage_get_audit" }
 
-type StoragenodeBandwidthRollupPhase2_Action_Field struct {
+type StoragenodePaystub_CompAtRest_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_Action(v uint) StoragenodeBandwidthRollupPhase2_Action_Field {
-	return StoragenodeBandwidthRollupPhase2_Action_Field{_set: true, _value: v}
+func StoragenodePaystub_CompAtRest(v int64) StoragenodePaystub_CompAtRest_Field {
+	return StoragenodePaystub_CompAtRest_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Action_Field) value() interface{} {
+func (f StoragenodePaystub_CompAtRest_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Action_Field) _Column() string { return "action" }
+func (StoragenodePaystub_CompAtRest_Field) _Column() string { return "comp_at_rest" }
 
-type StoragenodeBandwidthRollupPhase2_Allocated_Field struct {
+type StoragenodePaystub_CompGet_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_Allocated(v uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _value: &v}
+func StoragenodePaystub_CompGet(v int64) StoragenodePaystub_CompGet_Field {
+	return StoragenodePaystub_CompGet_Field{_set: true, _value: v}
 }
 
-func StoragenodeBandwidthRollupPhase2_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollupPhase2_Allocated_Null()
+func (f StoragenodePaystub_CompGet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodeBandwidthRollupPhase2_Allocated(*v)
+	return f._value
 }
 
-func StoragenodeBandwidthRollupPhase2_Allocated_Null() StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _null: true}
+func (StoragenodePaystub_CompGet_Field) _Column() string { return "comp_get" }
+
+type StoragenodePaystub_CompPut_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func StoragenodePaystub_CompPut(v int64) StoragenodePaystub_CompPut_Field {
+	return StoragenodePaystub_CompPut_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) value() interface{} {
+func (f StoragenodePaystub_CompPut_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Allocated_Field) _Column() string { return "allocated" }
+func (StoragenodePaystub_CompPut_Field) _Column() string { return "comp_put" }
 
-type StoragenodeBandwidthRollupPhase2_Settled_Field struct {
+type StoragenodePaystub_CompGetRepair_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_Settled(v uint64) StoragenodeBandwidthRollupPhase2_Settled_Field {
-	return StoragenodeBandwidthRollupPhase2_Settled_Field{_set: true, _value: v}
+func StoragenodePaystub_CompGetRepair(v int64) StoragenodePaystub_CompGetRepair_Field {
+	return StoragenodePaystub_CompGetRepair_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Settled_Field) value() interface{} {
+func (f StoragenodePaystub_CompGetRepair_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Settled_Field) _Column() string { return "settled" }
-
-type StoragenodePayment struct {
-	Id        int64
-	CreatedAt time.Time
-	NodeId    []byte
-	Period    string
-	Amount    int64
-	Receipt   *string
-	Notes     *string
-}
-
-func (StoragenodePayment) _Table() string { return "storagenode_payments" }
-
-type StoragenodePayment_Create_Fields struct {
-	Receipt StoragenodePayment_Receipt_Field
-	Notes   StoragenodePayment_Notes_Field
-}
-
-type StoragenodePayment_Update_Fields struct {
-}
+func (StoragenodePaystub_CompGetRepair_Field) _Column() string { return "comp_get_repair" }
 
-type StoragenodePayment_Id_Field struct {
+type StoragenodePaystub_CompPutRepair_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func StoragenodePayment_Id(v int64) StoragenodePayment_Id_Field {
-	return StoragenodePayment_Id_Field{_set: true, _value: v}
+func StoragenodePaystub_CompPutRepair(v int64) StoragenodePaystub_CompPutRepair_Field {
+	return StoragenodePaystub_CompPutRepair_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Id_Field) value() interface{} {
+func (f StoragenodePaystub_CompPutRepair_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Id_Field) _Column() string { return "id" }
+func (StoragenodePaystub_CompPutRepair_Field) _Column() string { return "comp_put_repair" }
 
-type StoragenodePayment_CreatedAt_Field struct {
+type StoragenodePaystub_CompGetAudit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func StoragenodePayment_CreatedAt(v time.Time) StoragenodePayment_CreatedAt_Field {
-	return StoragenodePayment_CreatedAt_Field{_set: true, _value: v}
+func StoragenodePaystub_CompGetAudit(v int64) StoragenodePaystub_CompGetAudit_Field {
+	return StoragenodePaystub_CompGetAudit_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_CreatedAt_Field) value() interface{} {
+func (f StoragenodePaystub_CompGetAudit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodePaystub_CompGetAudit_Field) _Column() string { return "comp_get_audit" }
 
-type StoragenodePayment_NodeId_Field struct {
+type StoragenodePaystub_SurgePercent_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func StoragenodePayment_NodeId(v []byte) StoragenodePayment_NodeId_Field {
-	return StoragenodePayment_NodeId_Field{_set: true, _value: v}
+func StoragenodePaystub_SurgePercent(v int64) StoragenodePaystub_SurgePercent_Field {
+	return StoragenodePaystub_SurgePercent_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_NodeId_Field) value() interface{} {
+func (f StoragenodePaystub_SurgePercent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_NodeId_Field) _Column() string { return "node_id" }
+func (StoragenodePaystub_SurgePercent_Field) _Column() string { return "surge_percent" }
 
-type StoragenodePayment_Period_Field struct {
+type StoragenodePaystub_Held_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func StoragenodePayment_Period(v string) StoragenodePayment_Period_Field {
-	return StoragenodePayment_Period_Field{_set: true, _value: v}
+func StoragenodePaystub_Held(v int64) StoragenodePaystub_Held_Field {
+	return StoragenodePaystub_Held_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Period_Field) value() interface{} {
+func (f StoragenodePaystub_Held_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Period_Field) _Column() string { return "period" }
+func (StoragenodePaystub_Held_Field) _Column() string { return "held" }
 
-type StoragenodePayment_Amount_Field struct {
+type StoragenodePaystub_Owed_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func StoragenodePayment_Amount(v int64) StoragenodePayment_Amount_Field {
-	return StoragenodePayment_Amount_Field{_set: true, _value: v}
+func StoragenodePaystub_Owed(v int64) StoragenodePaystub_Owed_Field {
+	return StoragenodePaystub_Owed_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Amount_Field) value() interface{} {
+func (f StoragenodePaystub_Owed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Amount_Field) _Column() string { return "amount" }
+func (StoragenodePaystub_Owed_Field) _Column() string { return "owed" }
 
-type StoragenodePayment_Receipt_Field struct {
+type StoragenodePaystub_Disposed_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func StoragenodePayment_Receipt(v string) StoragenodePayment_Receipt_Field {
-	return StoragenodePayment_Receipt_Field{_set: true, _value: &v}
-}
-
-func StoragenodePayment_Receipt_Raw(v *string) StoragenodePayment_Receipt_Field {
-	if v == nil {
-		return StoragenodePayment_Receipt_Null()
-	}
-	return StoragenodePayment_Receipt(*v)
+	_value int64
 }
 
-func StoragenodePayment_Receipt_Null() StoragenodePayment_Receipt_Field {
-	return StoragenodePayment_Receipt_Field{_set: true, _null: true}
+func StoragenodePaystub_Disposed(v int64) StoragenodePaystub_Disposed_Field {
+	return StoragenodePaystub_Disposed_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Receipt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f StoragenodePayment_Receipt_Field) value() interface{} {
+func (f StoragenodePaystub_Disposed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Receipt_Field) _Column() string { return "receipt" }
+func (StoragenodePaystub_Disposed_Field) _Column() string { return "disposed" }
 
-type StoragenodePayment_Notes_Field struct {
+type StoragenodePaystub_Paid_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value int64
 }
 
-func StoragenodePayment_Notes(v string) StoragenodePayment_Notes_Field {
-	return StoragenodePayment_Notes_Field{_set: true, _value: &v}
+func StoragenodePaystub_Paid(v int64) StoragenodePaystub_Paid_Field {
+	return StoragenodePaystub_Paid_Field{_set: true, _value: v}
 }
 
-func StoragenodePayment_Notes_Raw(v *string) StoragenodePayment_Notes_Field {
-	if v == nil {
-		return StoragenodePayment_Notes_Null()
+func (f StoragenodePaystub_Paid_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodePayment_Notes(*v)
+	return f._value
 }
 
-func StoragenodePayment_Notes_Null() StoragenodePayment_Notes_Field {
-	return StoragenodePayment_Notes_Field{_set: true, _null: true}
+func (StoragenodePaystub_Paid_Field) _Column() string { return "paid" }
+
+type StoragenodePaystub_Distributed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f StoragenodePayment_Notes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func StoragenodePaystub_Distributed(v int64) StoragenodePaystub_Distributed_Field {
+	return StoragenodePaystub_Distributed_Field{_set: true, _value: v}
+}
 
-func (f StoragenodePayment_Notes_Field) value() interface{} {
+func (f StoragenodePaystub_Distributed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Notes_Field) _Column() string { return "notes" }
+func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
 
-type StoragenodePaystub struct {
-	Period         string
-	NodeId         []byte
-	CreatedAt      time.Time
-	Codes          string
-	UsageAtRest    float64
-	UsageGet       int64
-	UsagePut       int64
-	UsageGetRepair int64
-	UsagePutRepair int64
-	UsageGetAudit  int64
-	CompAtRest     int64
-	CompGet        int64
-	CompPut        int64
-	CompGetRepair  int64
-	CompPutRepair  int64
-	CompGetAudit   int64
-	SurgePercent   int64
-	Held           int64
-	Owed           int64
-	Disposed       int64
-	Paid           int64
-	Distributed    int64
+type StoragenodeStorageTally struct {
+	NodeId          []byte
+	IntervalEndTime time.Time
+	DataTotal       float64
 }
 
-func (StoragenodePaystub) _Table() string { return "storagenode_paystubs" }
+func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
 
-type StoragenodePaystub_Update_Fields struct {
+type StoragenodeStorageTally_Update_Fields struct {
 }
 
-type StoragenodePaystub_Period_Field struct {
+type StoragenodeStorageTally_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func StoragenodePaystub_Period(v string) StoragenodePaystub_Period_Field {
-	return StoragenodePaystub_Period_Field{_set: true, _value: v}
+func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
+	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Period_Field) value() interface{} {
+func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Period_Field) _Column() string { return "period" }
+func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodePaystub_NodeId_Field struct {
+type StoragenodeStorageTally_IntervalEndTime_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func StoragenodePaystub_NodeId(v []byte) StoragenodePaystub_NodeId_Field {
-	return StoragenodePaystub_NodeId_Field{_set: true, _value: v}
+func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
+	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_NodeId_Field) value() interface{} {
+func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_NodeId_Field) _Column() string { return "node_id" }
+func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
 
-type StoragenodePaystub_CreatedAt_Field struct {
+type StoragenodeStorageTally_DataTotal_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value float64
 }
 
-func StoragenodePaystub_CreatedAt(v time.Time) StoragenodePaystub_CreatedAt_Field {
-	return StoragenodePaystub_CreatedAt_Field{_set: true, _value: v}
+func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
+	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CreatedAt_Field) value() interface{} {
+func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
 
-type StoragenodePaystub_Codes_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+type StorjscanPayment struct {
+	BlockHash   []byte
+	BlockNumber int64
+	Transaction []byte
+	LogIndex    int
+	FromAddress []byte
+	ToAddress   []byte
+	TokenValue  int64
+	UsdValue    int64
+	Status      string
+	Timestamp   time.Time
+	CreatedAt   time.Time
 }
 
-func StoragenodePaystub_Codes(v string) StoragenodePaystub_Codes_Field {
-	return StoragenodePaystub_Codes_Field{_set: true, _value: v}
-}
+func (StorjscanPayment) _Table() string { return "storjscan_payments" }
 
-func (f StoragenodePaystub_Codes_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type StorjscanPayment_Update_Fields struct {
 }
 
-func (StoragenodePaystub_Codes_Field) _Column() string { return "codes" }
-
-type StoragenodePaystub_UsageAtRest_Field struct {
+type StorjscanPayment_BlockHash_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func StoragenodePaystub_UsageAtRest(v float64) StoragenodePaystub_UsageAtRest_Field {
-	return StoragenodePaystub_UsageAtRest_Field{_set: true, _value: v}
+func StorjscanPayment_BlockHash(v []byte) StorjscanPayment_BlockHash_Field {
+	return StorjscanPayment_BlockHash_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageAtRest_Field) value() interface{} {
+func (f StorjscanPayment_BlockHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageAtRest_Field) _Column() string { return "usage_at_rest" }
+func (StorjscanPayment_BlockHash_Field) _Column() string { return "block_hash" }
 
-type StoragenodePaystub_UsageGet_Field struct {
+type StorjscanPayment_BlockNumber_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func StoragenodePaystub_UsageGet(v int64) StoragenodePaystub_UsageGet_Field {
-	return StoragenodePaystub_UsageGet_Field{_set: true, _value: v}
+func StorjscanPayment_BlockNumber(v int64) StorjscanPayment_BlockNumber_Field {
+	return StorjscanPayment_BlockNumber_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGet_Field) value() interface{} {
+func (f StorjscanPayment_BlockNumber_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGet_Field) _Column() string { return "usage_get" }
+func (StorjscanPayment_BlockNumber_Field) _Column() string { return "block_number" }
 
-type StoragenodePaystub_UsagePut_Field struct {
+type StorjscanPayment_Transaction_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_UsagePut(v int64) StoragenodePaystub_UsagePut_Field {
-	return StoragenodePaystub_UsagePut_Field{_set: true, _value: v}
+func StorjscanPayment_Transaction(v []byte) StorjscanPayment_Transaction_Field {
+	return StorjscanPayment_Transaction_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsagePut_Field) value() interface{} {
+func (f StorjscanPayment_Transaction_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsagePut_Field) _Column() string { return "usage_put" }
+func (StorjscanPayment_Transaction_Field) _Column() string { return "transaction" }
 
-type StoragenodePaystub_UsageGetRepair_Field struct {
+type StorjscanPayment_LogIndex_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func StoragenodePaystub_UsageGetRepair(v int64) StoragenodePaystub_UsageGetRepair_Field {
-	return StoragenodePaystub_UsageGetRepair_Field{_set: true, _value: v}
+func StorjscanPayment_LogIndex(v int) StorjscanPayment_LogIndex_Field {
+	return StorjscanPayment_LogIndex_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGetRepair_Field) value() interface{} {
+func (f StorjscanPayment_LogIndex_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGetRepair_Field) _Column() string { return "usage_get_repair" }
+func (StorjscanPayment_LogIndex_Field) _Column() string { return "log_index" }
 
-type StoragenodePaystub_UsagePutRepair_Field struct {
+type StorjscanPayment_FromAddress_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_UsagePutRepair(v int64) StoragenodePaystub_UsagePutRepair_Field {
-	return StoragenodePaystub_UsagePutRepair_Field{_set: true, _value: v}
+func StorjscanPayment_FromAddress(v []byte) StorjscanPayment_FromAddress_Field {
+	return StorjscanPayment_FromAddress_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsagePutRepair_Field) value() interface{} {
+func (f StorjscanPayment_FromAddress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsagePutRepair_Field) _Column() string { return "usage_put_repair" }
+func (StorjscanPayment_FromAddress_Field) _Column() string { return "from_address" }
 
-type StoragenodePaystub_UsageGetAudit_Field struct {
+type StorjscanPayment_ToAddress_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_UsageGetAudit(v int64) StoragenodePaystub_UsageGetAudit_Field {
-	return StoragenodePaystub_UsageGetAudit_Field{_set: true, _value: v}
+func StorjscanPayment_ToAddress(v []byte) StorjscanPayment_ToAddress_Field {
+	return StorjscanPayment_ToAddress_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGetAudit_Field) value() interface{} {
+func (f StorjscanPayment_ToAddress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGetAudit_Field) _Column() string { return "usage_get_audit" }
+func (StorjscanPayment_ToAddress_Field) _Column() string { return "to_address" }
 
-type StoragenodePaystub_CompAtRest_Field struct {
+type StorjscanPayment_TokenValue_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func StoragenodePaystub_CompAtRest(v int64) StoragenodePaystub_CompAtRest_Field {
-	return StoragenodePaystub_CompAtRest_Field{_set: true, _value: v}
+func StorjscanPayment_TokenValue(v int64) StorjscanPayment_TokenValue_Field {
+	return StorjscanPayment_TokenValue_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompAtRest_Field) value() interface{} {
+func (f StorjscanPayment_TokenValue_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompAtRest_Field) _Column() string { return "comp_at_rest" }
+func (StorjscanPayment_TokenValue_Field) _Column() string { return "token_value" }
 
-type StoragenodePaystub_CompGet_Field struct {
+type StorjscanPayment_UsdValue_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func StoragenodePaystub_CompGet(v int64) StoragenodePaystub_CompGet_Field {
-	return StoragenodePaystub_CompGet_Field{_set: true, _value: v}
+func StorjscanPayment_UsdValue(v int64) StorjscanPayment_UsdValue_Field {
+	return StorjscanPayment_UsdValue_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompGet_Field) value() interface{} {
+func (f StorjscanPayment_UsdValue_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGet_Field) _Column() string { return "comp_get" }
+func (StorjscanPayment_UsdValue_Field) _Column() string { return "usd_value" }
 
-type StoragenodePaystub_CompPut_Field struct {
+type StorjscanPayment_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_CompPut(v int64) StoragenodePaystub_CompPut_Field {
-	return StoragenodePaystub_CompPut_Field{_set: true, _value: v}
+func StorjscanPayment_Status(v string) StorjscanPayment_Status_Field {
+	return StorjscanPayment_Status_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompPut_Field) value() interface{} {
+func (f StorjscanPayment_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompPut_Field) _Column() string { return "comp_put" }
+func (StorjscanPayment_Status_Field) _Column() string { return "status" }
 
-type StoragenodePaystub_CompGetRepair_Field struct {
+type StorjscanPayment_Timestamp_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_CompGetRepair(v int64) StoragenodePaystub_CompGetRepair_Field {
-	return StoragenodePaystub_CompGetRepair_Field{_set: true, _value: v}
+func StorjscanPayment_Timestamp(v time.Time) StorjscanPayment_Timestamp_Field {
+	return StorjscanPayment_Timestamp_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompGetRepair_Field) value() interface{} {
+func (f StorjscanPayment_Timestamp_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGetRepair_Field) _Column() string { return "comp_get_repair" }
+func (StorjscanPayment_Timestamp_Field) _Column() string { return "timestamp" }
 
-type StoragenodePaystub_CompPutRepair_Field struct {
+type StorjscanPayment_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_CompPutRepair(v int64) StoragenodePaystub_CompPutRepair_Field {
-	return StoragenodePaystub_CompPutRepair_Field{_set: true, _value: v}
+func StorjscanPayment_CreatedAt(v time.Time) StorjscanPayment_CreatedAt_Field {
+	return StorjscanPayment_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompPutRepair_Field) value() interface{} {
+func (f StorjscanPayment_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompPutRepair_Field) _Column() string { return "comp_put_repair" }
+func (StorjscanPayment_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePaystub_CompGetAudit_Field struct {
+type StorjscanWallet struct {
+	UserId        []byte
+	WalletAddress []byte
+	CreatedAt     time.Time
+}
+
+func (StorjscanWallet) _Table() string { return "storjscan_wallets" }
+
+type StorjscanWallet_Update_Fields struct {
+}
+
+type StorjscanWallet_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_CompGetAudit(v int64) StoragenodePaystub_CompGetAudit_Field {
-	return StoragenodePaystub_CompGetAudit_Field{_set: true, _value: v}
+func StorjscanWallet_UserId(v []byte) StorjscanWallet_UserId_Field {
+	return StorjscanWallet_UserId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompGetAudit_Field) value() interface{} {
+func (f StorjscanWallet_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGetAudit_Field) _Column() string { return "comp_get_audit" }
+func (StorjscanWallet_UserId_Field) _Column() string { return "user_id" }
 
-type StoragenodePaystub_SurgePercent_Field struct {
+type StorjscanWallet_WalletAddress_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_SurgePercent(v int64) StoragenodePaystub_SurgePercent_Field {
-	return StoragenodePaystub_SurgePercent_Field{_set: true, _value: v}
+func StorjscanWallet_WalletAddress(v []byte) StorjscanWallet_WalletAddress_Field {
+	return StorjscanWallet_WalletAddress_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_SurgePercent_Field) value() interface{} {
+func (f StorjscanWallet_WalletAddress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_SurgePercent_Field) _Column() string { return "surge_percent" }
+func (StorjscanWallet_WalletAddress_Field) _Column() string { return "wallet_address" }
 
-type StoragenodePaystub_Held_Field struct {
+type StorjscanWallet_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_Held(v int64) StoragenodePaystub_Held_Field {
-	return StoragenodePaystub_Held_Field{_set: true, _value: v}
+func StorjscanWallet_CreatedAt(v time.Time) StorjscanWallet_CreatedAt_Field {
+	return StorjscanWallet_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Held_Field) value() interface{} {
+func (f StorjscanWallet_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Held_Field) _Column() string { return "held" }
+func (StorjscanWallet_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePaystub_Owed_Field struct {
+type StripeCustomer struct {
+	UserId     []byte
+	CustomerId string
+	CreatedAt  time.Time
+}
+
+func (StripeCustomer) _Table() string { return "stripe_customers" }
+
+type StripeCustomer_Update_Fields struct {
+}
+
+type StripeCustomer_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_Owed(v int64) StoragenodePaystub_Owed_Field {
-	return StoragenodePaystub_Owed_Field{_set: true, _value: v}
+func StripeCustomer_UserId(v []byte) StripeCustomer_UserId_Field {
+	return StripeCustomer_UserId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Owed_Field) value() interface{} {
+func (f StripeCustomer_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Owed_Field) _Column() string { return "owed" }
+func (StripeCustomer_UserId_Field) _Column() string { return "user_id" }
 
-type StoragenodePaystub_Disposed_Field struct {
+type StripeCustomer_CustomerId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_Disposed(v int64) StoragenodePaystub_Disposed_Field {
-	return StoragenodePaystub_Disposed_Field{_set: true, _value: v}
+func StripeCustomer_CustomerId(v string) StripeCustomer_CustomerId_Field {
+	return StripeCustomer_CustomerId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Disposed_Field) value() interface{} {
+func (f StripeCustomer_CustomerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Disposed_Field) _Column() string { return "disposed" }
+func (StripeCustomer_CustomerId_Field) _Column() string { return "customer_id" }
 
-type StoragenodePaystub_Paid_Field struct {
+type StripeCustomer_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_Paid(v int64) StoragenodePaystub_Paid_Field {
-	return StoragenodePaystub_Paid_Field{_set: true, _value: v}
+func StripeCustomer_CreatedAt(v time.Time) StripeCustomer_CreatedAt_Field {
+	return StripeCustomer_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Paid_Field) value() interface{} {
+func (f StripeCustomer_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Paid_Field) _Column() string { return "paid" }
+func (StripeCustomer_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePaystub_Distributed_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord struct {
+	Id          []byte
+	ProjectId   []byte
+	Storage     float64
+	Egress      int64
+	Objects     *int64
+	Segments    *int64
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	State       int
+	CreatedAt   time.Time
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord) _Table() string {
+	return "stripecoinpayments_invoice_project_records"
+}
+
+type StripecoinpaymentsInvoiceProjectRecord_Create_Fields struct {
+	Objects  StripecoinpaymentsInvoiceProjectRecord_Objects_Field
+	Segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field
+}
+
+type StripecoinpaymentsInvoiceProjectRecord_Update_Fields struct {
+	State StripecoinpaymentsInvoiceProjectRecord_State_Field
+}
+
+type StripecoinpaymentsInvoiceProjectRecord_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_Distributed(v int64) StoragenodePaystub_Distributed_Field {
-	return StoragenodePaystub_Distributed_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Id(v []byte) StripecoinpaymentsInvoiceProjectRecord_Id_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Id_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Distributed_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
-
-type StoragenodeStorageTally struct {
-	NodeId          []byte
-	IntervalEndTime time.Time
-	DataTotal       float64
-}
-
-func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
-
-type StoragenodeStorageTally_Update_Fields struct {
-}
+func (StripecoinpaymentsInvoiceProjectRecord_Id_Field) _Column() string { return "id" }
 
-type StoragenodeStorageTally_NodeId_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
-	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_ProjectId(v []byte) StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
+func (StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) _Column() string { return "project_id" }
 
-type StoragenodeStorageTally_IntervalEndTime_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Storage_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value float64
 }
 
-func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
-	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Storage(v float64) StripecoinpaymentsInvoiceProjectRecord_Storage_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Storage_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Storage_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
+func (StripecoinpaymentsInvoiceProjectRecord_Storage_Field) _Column() string { return "storage" }
 
-type StoragenodeStorageTally_DataTotal_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Egress_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
-	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Egress(v int64) StripecoinpaymentsInvoiceProjectRecord_Egress_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Egress_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Egress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
+func (StripecoinpaymentsInvoiceProjectRecord_Egress_Field) _Column() string { return "egress" }
 
-type StorjscanPayment struct {
-	BlockHash   []byte
-	BlockNumber int64
-	Transaction []byte
-	LogIndex    int
-	FromAddress []byte
-	ToAddress   []byte
-	TokenValue  int64
-	UsdValue    int64
-	Status      string
-	Timestamp   time.Time
-	CreatedAt   time.Time
+type StripecoinpaymentsInvoiceProjectRecord_Objects_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
 }
 
-func (StorjscanPayment) _Table() string { return "storjscan_payments" }
+func StripecoinpaymentsInvoiceProjectRecord_Objects(v int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _value: &v}
+}
 
-type StorjscanPayment_Update_Fields struct {
+func StripecoinpaymentsInvoiceProjectRecord_Objects_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	if v == nil {
+		return StripecoinpaymentsInvoiceProjectRecord_Objects_Null()
+	}
+	return StripecoinpaymentsInvoiceProjectRecord_Objects(*v)
 }
 
-type StorjscanPayment_BlockHash_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func StripecoinpaymentsInvoiceProjectRecord_Objects_Null() StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _null: true}
 }
 
-func StorjscanPayment_BlockHash(v []byte) StorjscanPayment_BlockHash_Field {
-	return StorjscanPayment_BlockHash_Field{_set: true, _value: v}
+func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f StorjscanPayment_BlockHash_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_BlockHash_Field) _Column() string { return "block_hash" }
+func (StripecoinpaymentsInvoiceProjectRecord_Objects_Field) _Column() string { return "objects" }
 
-type StorjscanPayment_BlockNumber_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Segments_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *int64
 }
 
-func StorjscanPayment_BlockNumber(v int64) StorjscanPayment_BlockNumber_Field {
-	return StorjscanPayment_BlockNumber_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Segments(v int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _value: &v}
 }
 
-func (f StorjscanPayment_BlockNumber_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func StripecoinpaymentsInvoiceProjectRecord_Segments_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	if v == nil {
+		return StripecoinpaymentsInvoiceProjectRecord_Segments_Null()
 	}
-	return f._value
+	return StripecoinpaymentsInvoiceProjectRecord_Segments(*v)
 }
 
-func (StorjscanPayment_BlockNumber_Field) _Column() string { return "block_number" }
-
-type StorjscanPayment_Transaction_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func StripecoinpaymentsInvoiceProjectRecord_Segments_Null() StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _null: true}
 }
 
-func StorjscanPayment_Transaction(v []byte) StorjscanPayment_Transaction_Field {
-	return StorjscanPayment_Transaction_Field{_set: true, _value: v}
+func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f StorjscanPayment_Transaction_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_Transaction_Field) _Column() string { return "transaction" }
+func (StripecoinpaymentsInvoiceProjectRecord_Segments_Field) _Column() string { return "segments" }
 
-type StorjscanPayment_LogIndex_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func StorjscanPayment_LogIndex(v int) StorjscanPayment_LogIndex_Field {
-	return StorjscanPayment_LogIndex_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_PeriodStart(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_LogIndex_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_LogIndex_Field) _Column() string { return "log_index" }
+func (StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) _Column() string {
+	return "period_start"
+}
 
-type StorjscanPayment_FromAddress_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func StorjscanPayment_FromAddress(v []byte) StorjscanPayment_FromAddress_Field {
-	return StorjscanPayment_FromAddress_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_PeriodEnd(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_FromAddress_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_FromAddress_Field) _Column() string { return "from_address" }
+func (StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) _Column() string { return "period_end" }
 
-type StorjscanPayment_ToAddress_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_State_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func StorjscanPayment_ToAddress(v []byte) StorjscanPayment_ToAddress_Field {
-	return StorjscanPayment_ToAddress_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_State(v int) StripecoinpaymentsInvoiceProjectRecord_State_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_State_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_ToAddress_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_State_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_ToAddress_Field) _Column() string { return "to_address" }
+func (StripecoinpaymentsInvoiceProjectRecord_State_Field) _Column() string { return "state" }
 
-type StorjscanPayment_TokenValue_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StorjscanPayment_TokenValue(v int64) StorjscanPayment_TokenValue_Field {
-	return StorjscanPayment_TokenValue_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_TokenValue_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_TokenValue_Field) _Column() string { return "token_value" }
+func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StorjscanPayment_UsdValue_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+type StripecoinpaymentsTxConversionRate struct {
+	TxId        string
+	RateNumeric float64
+	CreatedAt   time.Time
 }
 
-func StorjscanPayment_UsdValue(v int64) StorjscanPayment_UsdValue_Field {
-	return StorjscanPayment_UsdValue_Field{_set: true, _value: v}
+func (StripecoinpaymentsTxConversionRate) _Table() string {
+	return "stripecoinpayments_tx_conversion_rates"
 }
 
-func (f StorjscanPayment_UsdValue_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type StripecoinpaymentsTxConversionRate_Update_Fields struct {
 }
 
-func (StorjscanPayment_UsdValue_Field) _Column() string { return "usd_value" }
-
-type StorjscanPayment_Status_Field struct {
+type StripecoinpaymentsTxConversionRate_TxId_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func StorjscanPayment_Status(v string) StorjscanPayment_Status_Field {
-	return StorjscanPayment_Status_Field{_set: true, _value: v}
+func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
+	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_Status_Field) value() interface{} {
+func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_Status_Field) _Column() string { return "status" }
+func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
 
-type StorjscanPayment_Timestamp_Field struct {
+type StripecoinpaymentsTxConversionRate_RateNumeric_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value float64
 }
 
-func StorjscanPayment_Timestamp(v time.Time) StorjscanPayment_Timestamp_Field {
-	return StorjscanPayment_Timestamp_Field{_set: true, _value: v}
+func StripecoinpaymentsTxConversionRate_RateNumeric(v float64) StripecoinpaymentsTxConversionRate_RateNumeric_Field {
+	return StripecoinpaymentsTxConversionRate_RateNumeric_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_Timestamp_Field) value() interface{} {
+func (f StripecoinpaymentsTxConversionRate_RateNumeric_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_Timestamp_Field) _Column() string { return "timestamp" }
+func (StripecoinpaymentsTxConversionRate_RateNumeric_Field) _Column() string { return "rate_numeric" }
 
-type StorjscanPayment_CreatedAt_Field struct {
+type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StorjscanPayment_CreatedAt(v time.Time) StorjscanPayment_CreatedAt_Field {
-	return StorjscanPayment_CreatedAt_Field{_set: true, _value: v}
+func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
+	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_CreatedAt_Field) value() interface{} {
+func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_CreatedAt_Field) _Column() string { return "created_at" }
+func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StorjscanWallet struct {
-	UserId        []byte
-	WalletAddress []byte
-	CreatedAt     time.Time
+type UsageAlert struct {
+	Id          []byte
+	ProjectId   []byte
+	BucketName  *[]byte
+	Kind        string
+	Threshold   int64
+	TriggeredAt *time.Time
+	CreatedBy   []byte
+	CreatedAt   time.Time
 }
 
-func (StorjscanWallet) _Table() string { return "storjscan_wallets" }
+func (UsageAlert) _Table() string { return "usage_alerts" }
 
-type StorjscanWallet_Update_Fields struct {
+type UsageAlert_Create_Fields struct {
+	BucketName  UsageAlert_BucketName_Field
+	TriggeredAt UsageAlert_TriggeredAt_Field
+	CreatedAt   UsageAlert_CreatedAt_Field
 }
 
-type StorjscanWallet_UserId_Field struct {
+type UsageAlert_Update_Fields struct {
+	TriggeredAt UsageAlert_TriggeredAt_Field
+}
+
+type UsageAlert_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StorjscanWallet_UserId(v []byte) StorjscanWallet_UserId_Field {
-	return StorjscanWallet_UserId_Field{_set: true, _value: v}
+func UsageAlert_Id(v []byte) UsageAlert_Id_Field {
+	return UsageAlert_Id_Field{_set: true, _value: v}
 }
 
-func (f StorjscanWallet_UserId_Field) value() interface{} {
+func (f UsageAlert_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanWallet_UserId_Field) _Column() string { return "user_id" }
+func (UsageAlert_Id_Field) _Column() string { return "id" }
 
-type StorjscanWallet_WalletAddress_Field struct {
+type UsageAlert_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StorjscanWallet_WalletAddress(v []byte) StorjscanWallet_WalletAddress_Field {
-	return StorjscanWallet_WalletAddress_Field{_set: true, _value: v}
+func UsageAlert_ProjectId(v []byte) UsageAlert_ProjectId_Field {
+	return UsageAlert_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f StorjscanWallet_WalletAddress_Field) value() interface{} {
+func (f UsageAlert_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanWallet_WalletAddress_Field) _Column() string { return "wallet_address" }
+func (UsageAlert_ProjectId_Field) _Column() string { return "project_id" }
 
-type StorjscanWallet_CreatedAt_Field struct {
+type UsageAlert_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *[]byte
 }
 
-func StorjscanWallet_CreatedAt(v time.Time) StorjscanWallet_CreatedAt_Field {
-	return StorjscanWallet_CreatedAt_Field{_set: true, _value: v}
+func UsageAlert_BucketName(v []byte) UsageAlert_BucketName_Field {
+	return UsageAlert_BucketName_Field{_set: true, _value: &v}
 }
 
-func (f StorjscanWallet_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func UsageAlert_BucketName_Raw(v *[]byte) UsageAlert_BucketName_Field {
+	if v == nil {
+		return UsageAlert_BucketName_Null()
 	}
-	return f._value
-}
-
-func (StorjscanWallet_CreatedAt_Field) _Column() string { return "created_at" }
-
-type StripeCustomer struct {
-	UserId     []byte
-	CustomerId string
-	CreatedAt  time.Time
-}
-
-func (StripeCustomer) _Table() string { return "stripe_customers" }
-
-type StripeCustomer_Update_Fields struct {
+	return UsageAlert_BucketName(*v)
 }
 
-type StripeCustomer_UserId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func UsageAlert_BucketName_Null() UsageAlert_BucketName_Field {
+	return UsageAlert_BucketName_Field{_set: true, _null: true}
 }
 
-func StripeCustomer_UserId(v []byte) StripeCustomer_UserId_Field {
-	return StripeCustomer_UserId_Field{_set: true, _value: v}
-}
+func (f UsageAlert_BucketName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripeCustomer_UserId_Field) value() interface{} {
+func (f UsageAlert_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_UserId_Field) _Column() string { return "user_id" }
+func (UsageAlert_BucketName_Field) _Column() string { return "bucket_name" }
 
-type StripeCustomer_CustomerId_Field struct {
+type UsageAlert_Kind_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func StripeCustomer_CustomerId(v string) StripeCustomer_CustomerId_Field {
-	return StripeCustomer_CustomerId_Field{_set: true, _value: v}
+func UsageAlert_Kind(v string) UsageAlert_Kind_Field {
+	return UsageAlert_Kind_Field{_set: true, _value: v}
 }
 
-func (f StripeCustomer_CustomerId_Field) value() interface{} {
+func (f UsageAlert_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_CustomerId_Field) _Column() string { return "customer_id" }
+func (UsageAlert_Kind_Field) _Column() string { return "kind" }
 
-type StripeCustomer_CreatedAt_Field struct {
+type UsageAlert_Threshold_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func StripeCustomer_CreatedAt(v time.Time) StripeCustomer_CreatedAt_Field {
-	return StripeCustomer_CreatedAt_Field{_set: true, _value: v}
+func UsageAlert_Threshold(v int64) UsageAlert_Threshold_Field {
+	return UsageAlert_Threshold_Field{_set: true, _value: v}
 }
 
-func (f StripeCustomer_CreatedAt_Field) value() interface{} {
+func (f UsageAlert_Threshold_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_CreatedAt_Field) _Column() string { return "created_at" }
+func (UsageAlert_Threshold_Field) _Column() string { return "threshold" }
 
-type StripecoinpaymentsInvoiceProjectRecord struct {
-	Id          []byte
-	ProjectId   []byte
-	Storage     float64
-	Egress      int64
-	Objects     *int64
-	Segments    *int64
-	PeriodStart time.Time
-	PeriodEnd   time.Time
-	State       int
-	CreatedAt   time.Time
+type UsageAlert_TriggeredAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord) _Table() string {
-	return "stripecoinpayments_invoice_project_records"
+func UsageAlert_TriggeredAt(v time.Time) UsageAlert_TriggeredAt_Field {
+	return UsageAlert_TriggeredAt_Field{_set: true, _value: &v}
 }
 
-type StripecoinpaymentsInvoiceProjectRecord_Create_Fields struct {
-	Objects  StripecoinpaymentsInvoiceProjectRecord_Objects_Field
-	Segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field
+func UsageAlert_TriggeredAt_Raw(v *time.Time) UsageAlert_TriggeredAt_Field {
+	if v == nil {
+		return UsageAlert_TriggeredAt_Null()
+	}
+	return UsageAlert_TriggeredAt(*v)
 }
 
-type StripecoinpaymentsInvoiceProjectRecord_Update_Fields struct {
-	State StripecoinpaymentsInvoiceProjectRecord_State_Field
+func UsageAlert_TriggeredAt_Null() UsageAlert_TriggeredAt_Field {
+	return UsageAlert_TriggeredAt_Field{_set: true, _null: true}
 }
 
-type StripecoinpaymentsInvoiceProjectRecord_Id_Field struct {
+func (f UsageAlert_TriggeredAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f UsageAlert_TriggeredAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UsageAlert_TriggeredAt_Field) _Column() string { return "triggered_at" }
+
+type UsageAlert_CreatedBy_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Id(v []byte) StripecoinpaymentsInvoiceProjectRecord_Id_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Id_Field{_set: true, _value: v}
+func UsageAlert_CreatedBy(v []byte) UsageAlert_CreatedBy_Field {
+	return UsageAlert_CreatedBy_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Id_Field) value() interface{} {
+func (f UsageAlert_CreatedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Id_Field) _Column() string { return "id" }
+func (UsageAlert_CreatedBy_Field) _Column() string { return "created_by" }
 
-type StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field struct {
+type UsageAlert_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_ProjectId(v []byte) StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field{_set: true, _value: v}
+func UsageAlert_CreatedAt(v time.Time) UsageAlert_CreatedAt_Field {
+	return UsageAlert_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) value() interface{} {
+func (f UsageAlert_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) _Column() string { return "project_id" }
+func (UsageAlert_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Storage_Field struct {
+type UsageAlertNotification struct {
+	Id         []byte
+	UserId     []byte
+	AlertId    []byte
+	ProjectId  []byte
+	BucketName *[]byte
+	Kind       string
+	Threshold  int64
+	Value      int64
+	CreatedAt  time.Time
+	ReadAt     *time.Time
+}
+
+func (UsageAlertNotification) _Table() string { return "usage_alert_notifications" }
+
+type UsageAlertNotification_Create_Fields struct {
+	BucketName UsageAlertNotification_BucketName_Field
+	CreatedAt  UsageAlertNotification_CreatedAt_Field
+	ReadAt     UsageAlertNotification_ReadAt_Field
+}
+
+type UsageAlertNotification_Update_Fields struct {
+	ReadAt UsageAlertNotification_ReadAt_Field
+}
+
+type UsageAlertNotification_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Storage(v float64) StripecoinpaymentsInvoiceProjectRecord_Storage_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Storage_Field{_set: true, _value: v}
+func UsageAlertNotification_Id(v []byte) UsageAlertNotification_Id_Field {
+	return UsageAlertNotification_Id_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Storage_Field) value() interface{} {
+func (f UsageAlertNotification_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Storage_Field) _Column() string { return "storage" }
+func (UsageAlertNotification_Id_Field) _Column() string { return "id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Egress_Field struct {
+type UsageAlertNotification_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Egress(v int64) StripecoinpaymentsInvoiceProjectRecord_Egress_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Egress_Field{_set: true, _value: v}
+func UsageAlertNotification_UserId(v []byte) UsageAlertNotification_UserId_Field {
+	return UsageAlertNotification_UserId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Egress_Field) value() interface{} {
+func (f UsageAlertNotification_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Egress_Field) _Column() string { return "egress" }
+func (UsageAlertNotification_UserId_Field) _Column() string { return "user_id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Objects_Field struct {
+type UsageAlertNotification_AlertId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects(v int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _value: &v}
+func UsageAlertNotification_AlertId(v []byte) UsageAlertNotification_AlertId_Field {
+	return UsageAlertNotification_AlertId_Field{_set: true, _value: v}
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
-	if v == nil {
-		return StripecoinpaymentsInvoiceProjectRecord_Objects_Null()
+func (f UsageAlertNotification_AlertId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StripecoinpaymentsInvoiceProjectRecord_Objects(*v)
+	return f._value
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects_Null() StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _null: true}
+func (UsageAlertNotification_AlertId_Field) _Column() string { return "alert_id" }
+
+type UsageAlertNotification_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func UsageAlertNotification_ProjectId(v []byte) UsageAlertNotification_ProjectId_Field {
+	return UsageAlertNotification_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) value() interface{} {
+func (f UsageAlertNotification_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Objects_Field) _Column() string { return "objects" }
+func (UsageAlertNotification_ProjectId_Field) _Column() string { return "project_id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Segments_Field struct {
+type UsageAlertNotification_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value *[]byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Segments(v int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _value: &v}
+func UsageAlertNotification_BucketName(v []byte) UsageAlertNotification_BucketName_Field {
+	return UsageAlertNotification_BucketName_Field{_set: true, _value: &v}
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Segments_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+func UsageAlertNotification_BucketName_Raw(v *[]byte) UsageAlertNotification_BucketName_Field {
 	if v == nil {
-		return StripecoinpaymentsInvoiceProjectRecord_Segments_Null()
+		return UsageAlertNotification_BucketName_Null()
 	}
-	return StripecoinpaymentsInvoiceProjectRecord_Segments(*v)
+	return UsageAlertNotification_BucketName(*v)
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Segments_Null() StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _null: true}
+func UsageAlertNotification_BucketName_Null() UsageAlertNotification_BucketName_Field {
+	return UsageAlertNotification_BucketName_Field{_set: true, _null: true}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) isnull() bool {
+func (f UsageAlertNotification_BucketName_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) value() interface{} {
+func (f UsageAlertNotification_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Segments_Field) _Column() string { return "segments" }
+func (UsageAlertNotification_BucketName_Field) _Column() string { return "bucket_name" }
 
-type StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field struct {
+type UsageAlertNotification_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_PeriodStart(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field{_set: true, _value: v}
+func UsageAlertNotification_Kind(v string) UsageAlertNotification_Kind_Field {
+	return UsageAlertNotification_Kind_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) value() interface{} {
+func (f UsageAlertNotification_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) _Column() string {
-	return "period_start"
-}
+func (UsageAlertNotification_Kind_Field) _Column() string { return "kind" }
 
-type StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field struct {
+type UsageAlertNotification_Threshold_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_PeriodEnd(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field{_set: true, _value: v}
+func UsageAlertNotification_Threshold(v int64) UsageAlertNotification_Threshold_Field {
+	return UsageAlertNotification_Threshold_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) value() interface{} {
+func (f UsageAlertNotification_Threshold_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) _Column() string { return "period_end" }
+func (UsageAlertNotification_Threshold_Field) _Column() string { return "threshold" }
 
-type StripecoinpaymentsInvoiceProjectRecord_State_Field struct {
+type UsageAlertNotification_Value_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_State(v int) StripecoinpaymentsInvoiceProjectRecord_State_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_State_Field{_set: true, _value: v}
+func UsageAlertNotification_Value(v int64) UsageAlertNotification_Value_Field {
+	return UsageAlertNotification_Value_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_State_Field) value() interface{} {
+func (f UsageAlertNotification_Value_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_State_Field) _Column() string { return "state" }
+func (UsageAlertNotification_Value_Field) _Column() string { return "value" }
 
-type StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field struct {
+type UsageAlertNotification_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
-}
-
-func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
-
-type StripecoinpaymentsTxConversionRate struct {
-	TxId        string
-	RateNumeric float64
-	CreatedAt   time.Time
-}
-
-func (StripecoinpaymentsTxConversionRate) _Table() string {
-	return "stripecoinpayments_tx_conversion_rates"
-}
-
-type StripecoinpaymentsTxConversionRate_Update_Fields struct {
-}
-
-type StripecoinpaymentsTxConversionRate_TxId_Field struct {
-	_set   bool
-	_null  bool
-	_value string
-}
-
-func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
-	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
+func UsageAlertNotification_CreatedAt(v time.Time) UsageAlertNotification_CreatedAt_Field {
+	return UsageAlertNotification_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
+func (f UsageAlertNotification_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
+func (UsageAlertNotification_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StripecoinpaymentsTxConversionRate_RateNumeric_Field struct {
+type UsageAlertNotification_ReadAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value *time.Time
 }
 
-func StripecoinpaymentsTxConversionRate_RateNumeric(v float64) StripecoinpaymentsTxConversionRate_RateNumeric_Field {
-	return StripecoinpaymentsTxConversionRate_RateNumeric_Field{_set: true, _value: v}
+func UsageAlertNotification_ReadAt(v time.Time) UsageAlertNotification_ReadAt_Field {
+	return UsageAlertNotification_ReadAt_Field{_set: true, _value: &v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_RateNumeric_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func UsageAlertNotification_ReadAt_Raw(v *time.Time) UsageAlertNotification_ReadAt_Field {
+	if v == nil {
+		return UsageAlertNotification_ReadAt_Null()
 	}
-	return f._value
+	return UsageAlertNotification_ReadAt(*v)
 }
 
-func (StripecoinpaymentsTxConversionRate_RateNumeric_Field) _Column() string { return "rate_numeric" }
-
-type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func UsageAlertNotification_ReadAt_Null() UsageAlertNotification_ReadAt_Field {
+	return UsageAlertNotification_ReadAt_Field{_set: true, _null: true}
 }
 
-func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
-	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
+func (f UsageAlertNotification_ReadAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
+func (f UsageAlertNotification_ReadAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
+func (UsageAlertNotification_ReadAt_Field) _Column() string { return "read_at" }
 
 type User struct {
 	Id                       []byte
@@ -10176,16 +12239,89 @@ func User_SignupCaptcha_Null() User_SignupCaptcha_Field {
 	return User_SignupCaptcha_Field{_set: true, _null: true}
 }
 
-func (f User_SignupCaptcha_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f User_SignupCaptcha_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_SignupCaptcha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_SignupCaptcha_Field) _Column() string { return "signup_captcha" }
+
+type UserPricePlan struct {
+	UserId    []byte
+	PlanId    []byte
+	CreatedAt time.Time
+}
+
+func (UserPricePlan) _Table() string { return "user_price_plans" }
+
+type UserPricePlan_Create_Fields struct {
+	CreatedAt UserPricePlan_CreatedAt_Field
+}
+
+type UserPricePlan_Update_Fields struct {
+	PlanId UserPricePlan_PlanId_Field
+}
+
+type UserPricePlan_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func UserPricePlan_UserId(v []byte) UserPricePlan_UserId_Field {
+	return UserPricePlan_UserId_Field{_set: true, _value: v}
+}
+
+func (f UserPricePlan_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserPricePlan_UserId_Field) _Column() string { return "user_id" }
+
+type UserPricePlan_PlanId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func UserPricePlan_PlanId(v []byte) UserPricePlan_PlanId_Field {
+	return UserPricePlan_PlanId_Field{_set: true, _value: v}
+}
+
+func (f UserPricePlan_PlanId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserPricePlan_PlanId_Field) _Column() string { return "plan_id" }
+
+type UserPricePlan_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func UserPricePlan_CreatedAt(v time.Time) UserPricePlan_CreatedAt_Field {
+	return UserPricePlan_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f User_SignupCaptcha_Field) value() interface{} {
+func (f UserPricePlan_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_SignupCaptcha_Field) _Column() string { return "signup_captcha" }
+func (UserPricePlan_CreatedAt_Field) _Column() string { return "created_at" }
 
 type ValueAttribution struct {
 	ProjectId   []byte
@@ -18956,6 +21092,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_price_plans;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18966,6 +21112,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM usage_alert_notifications;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM usage_alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19076,6 +21242,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM sso_identities;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19086,6 +21262,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_loop_checkpoints;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_loop_checkpoint_chunks;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19166,6 +21362,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM price_plans;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM prepaid_auto_topups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19176,6 +21392,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM partner_price_plans;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19216,6 +21442,46 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_reputation_changes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_evacuations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_download_stats;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19236,6 +21502,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM metabase_consistency_findings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26369,6 +28645,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_price_plans;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26379,6 +28665,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM usage_alert_notifications;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM usage_alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26489,6 +28795,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM sso_identities;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26499,6 +28815,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_loop_checkpoints;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_loop_checkpoint_chunks;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26579,6 +28915,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM price_plans;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM prepaid_auto_topups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26589,6 +28945,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM partner_price_plans;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26629,6 +28995,46 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_reputation_changes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_evacuations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_download_stats;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26649,6 +29055,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM metabase_consistency_findings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
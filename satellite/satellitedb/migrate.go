@@ -2088,6 +2088,213 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					`ALTER TABLE stripecoinpayments_tx_conversion_rates DROP COLUMN rate_gob;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add usage_alerts table",
+				Version:     212,
+				Action: migrate.SQL{
+					`CREATE TABLE usage_alerts (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						bucket_name bytea,
+						kind text NOT NULL,
+						threshold bigint NOT NULL,
+						triggered_at timestamp with time zone,
+						created_by bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX usage_alerts_project_id_index ON usage_alerts ( project_id ) ;`,
+					`CREATE TABLE usage_alert_notifications (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						alert_id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						bucket_name bytea,
+						kind text NOT NULL,
+						threshold bigint NOT NULL,
+						value bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						read_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX usage_alert_notifications_user_id_index ON usage_alert_notifications ( user_id ) ;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add prepaid_auto_topups table",
+				Version:     213,
+				Action: migrate.SQL{
+					`CREATE TABLE prepaid_auto_topups (
+						user_id bytea NOT NULL,
+						threshold bigint NOT NULL,
+						amount bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( user_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add price plan tables",
+				Version:     214,
+				Action: migrate.SQL{
+					`CREATE TABLE price_plans (
+						id bytea NOT NULL,
+						name text NOT NULL,
+						prices jsonb NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE TABLE user_price_plans (
+						user_id bytea NOT NULL,
+						plan_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( user_id )
+					);`,
+					`CREATE INDEX user_price_plans_plan_id_index ON user_price_plans ( plan_id );`,
+					`CREATE TABLE partner_price_plans (
+						partner text NOT NULL,
+						plan_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( partner )
+					);`,
+					`CREATE INDEX partner_price_plans_plan_id_index ON partner_price_plans ( plan_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add placement and project to repair queue",
+				Version:     215,
+				Action: migrate.SQL{
+					`ALTER TABLE repair_queue ADD COLUMN placement integer NOT NULL DEFAULT 0;`,
+					`CREATE INDEX repair_queue_placement_segment_health_attempted_at_index ON repair_queue ( placement, segment_health, attempted_at );`,
+					`ALTER TABLE repair_queue ADD COLUMN project_id bytea;`,
+					`CREATE INDEX repair_queue_project_id_attempted_at_index ON repair_queue ( project_id, attempted_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node_evacuations table",
+				Version:     216,
+				Action: migrate.SQL{
+					`CREATE TABLE node_evacuations (
+						node_id bytea NOT NULL,
+						cursor_stream_id bytea,
+						cursor_position bigint NOT NULL DEFAULT 0,
+						segments_processed bigint NOT NULL DEFAULT 0,
+						segments_failed bigint NOT NULL DEFAULT 0,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						cancelled_at timestamp with time zone,
+						finished_at timestamp with time zone,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node_reputation_changes table",
+				Version:     217,
+				Action: migrate.SQL{
+					`CREATE TABLE node_reputation_changes (
+						id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						action text NOT NULL,
+						reason text NOT NULL,
+						previous_state text NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_reputation_changes_node_id_created_at_index ON node_reputation_changes ( node_id, created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node_download_stats table",
+				Version:     218,
+				Action: migrate.SQL{
+					`CREATE TABLE node_download_stats (
+						node_id bytea NOT NULL,
+						requested double precision NOT NULL DEFAULT 0,
+						settled double precision NOT NULL DEFAULT 0,
+						ttfb_sum double precision NOT NULL DEFAULT 0,
+						ttfb_count double precision NOT NULL DEFAULT 0,
+						updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node_tags table",
+				Version:     219,
+				Action: migrate.SQL{
+					`CREATE TABLE node_tags (
+						node_id bytea NOT NULL,
+						name text NOT NULL,
+						value text NOT NULL,
+						signed_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id, name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add segment_loop_checkpoints and segment_loop_checkpoint_chunks tables",
+				Version:     220,
+				Action: migrate.SQL{
+					`CREATE TABLE segment_loop_checkpoints (
+						name text NOT NULL,
+						started_at timestamp with time zone NOT NULL,
+						stream_id bytea NOT NULL,
+						segments bigint NOT NULL,
+						estimated_segments bigint NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( name )
+					);`,
+					`CREATE TABLE segment_loop_checkpoint_chunks (
+						name text NOT NULL,
+						observer text NOT NULL,
+						chunk integer NOT NULL,
+						data bytea NOT NULL,
+						PRIMARY KEY ( name, observer, chunk )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add metabase_consistency_findings table",
+				Version:     221,
+				Action: migrate.SQL{
+					`CREATE TABLE metabase_consistency_findings (
+						kind text NOT NULL,
+						stream_id bytea NOT NULL,
+						position bigint NOT NULL,
+						details text NOT NULL,
+						first_seen_at timestamp with time zone NOT NULL,
+						last_seen_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( kind, stream_id, position )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add sso_identities table",
+				Version:     222,
+				Action: migrate.SQL{
+					`CREATE TABLE sso_identities (
+						provider text NOT NULL,
+						subject text NOT NULL,
+						user_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( provider, subject )
+					);`,
+					`CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id );`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
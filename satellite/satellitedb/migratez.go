@@ -13,7 +13,7 @@ func (db *satelliteDB) testMigration() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     211,
+				Version:     222,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE accounting_rollups (
@@ -156,6 +156,15 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE metabase_consistency_findings (
+	kind text NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	details text NOT NULL,
+	first_seen_at timestamp with time zone NOT NULL,
+	last_seen_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( kind, stream_id, position )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -199,6 +208,43 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_download_stats (
+	node_id bytea NOT NULL,
+	requested double precision NOT NULL DEFAULT 0,
+	settled double precision NOT NULL DEFAULT 0,
+	ttfb_sum double precision NOT NULL DEFAULT 0,
+	ttfb_count double precision NOT NULL DEFAULT 0,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_evacuations (
+	node_id bytea NOT NULL,
+	cursor_stream_id bytea,
+	cursor_position bigint NOT NULL DEFAULT 0,
+	segments_processed bigint NOT NULL DEFAULT 0,
+	segments_failed bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	cancelled_at timestamp with time zone,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_reputation_changes (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	action text NOT NULL,
+	reason text NOT NULL,
+	previous_state text NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value text NOT NULL,
+	signed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -246,6 +292,12 @@ CREATE TABLE offers (
 	type integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE partner_price_plans (
+	partner text NOT NULL,
+	plan_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( partner )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -253,6 +305,14 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE prepaid_auto_topups (
+	user_id bytea NOT NULL,
+	threshold bigint NOT NULL,
+	amount bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -271,6 +331,13 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE price_plans (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	prices jsonb NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE project_bandwidth_daily_rollups (
 	project_id bytea NOT NULL,
 	interval_day date NOT NULL,
@@ -300,6 +367,8 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	placement integer NOT NULL DEFAULT 0,
+	project_id bytea,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -334,6 +403,22 @@ CREATE TABLE revocations (
 	api_key_id bytea NOT NULL,
 	PRIMARY KEY ( revoked )
 );
+CREATE TABLE segment_loop_checkpoint_chunks (
+	name text NOT NULL,
+	observer text NOT NULL,
+	chunk integer NOT NULL,
+	data bytea NOT NULL,
+	PRIMARY KEY ( name, observer, chunk )
+);
+CREATE TABLE segment_loop_checkpoints (
+	name text NOT NULL,
+	started_at timestamp with time zone NOT NULL,
+	stream_id bytea NOT NULL,
+	segments bigint NOT NULL,
+	estimated_segments bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
 CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -345,6 +430,13 @@ CREATE TABLE segment_pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE sso_identities (
+	provider text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( provider, subject )
+);
 CREATE TABLE storagenode_bandwidth_rollups (
 	storagenode_id bytea NOT NULL,
 	interval_start timestamp with time zone NOT NULL,
@@ -460,6 +552,36 @@ CREATE TABLE stripecoinpayments_tx_conversion_rates (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE usage_alert_notifications (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	alert_id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	value bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	read_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE usage_alerts (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	triggered_at timestamp with time zone,
+	created_by bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE user_price_plans (
+	user_id bytea NOT NULL,
+	plan_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE users (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -582,14 +704,19 @@ CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
 CREATE INDEX nodes_dis_unk_aud_exit_init_rel_type_last_cont_success_stored_index ON nodes ( disqualified, unknown_audit_suspended, exit_initiated_at, release, type, last_contact_success ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true ;
+CREATE INDEX node_reputation_changes_node_id_created_at_index ON node_reputation_changes ( node_id, created_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE INDEX partner_price_plans_plan_id_index ON partner_price_plans ( plan_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
+CREATE INDEX repair_queue_placement_segment_health_attempted_at_index ON repair_queue ( placement, segment_health, attempted_at ) ;
+CREATE INDEX repair_queue_project_id_attempted_at_index ON repair_queue ( project_id, attempted_at ) ;
+CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
@@ -597,6 +724,9 @@ CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_i
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
 CREATE INDEX storjscan_payments_block_number_log_index_index ON storjscan_payments ( block_number, log_index ) ;
 CREATE INDEX storjscan_wallets_wallet_address_index ON storjscan_wallets ( wallet_address ) ;
+CREATE INDEX usage_alert_notifications_user_id_index ON usage_alert_notifications ( user_id ) ;
+CREATE INDEX usage_alerts_project_id_index ON usage_alerts ( project_id ) ;
+CREATE INDEX user_price_plans_plan_id_index ON user_price_plans ( plan_id ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE UNIQUE INDEX credits_earned_user_id_offer_id ON user_credits ( id, offer_id ) ;
 
@@ -0,0 +1,176 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/evacuation"
+)
+
+// ensures that nodeEvacuations implements evacuation.DB.
+var _ evacuation.DB = (*nodeEvacuations)(nil)
+
+// nodeEvacuations is an implementation of evacuation.DB.
+//
+// architecture: Database
+type nodeEvacuations struct {
+	db *satelliteDB
+}
+
+const nodeEvacuationColumns = `
+	node_id, cursor_stream_id, cursor_position,
+	segments_processed, segments_failed,
+	created_at, updated_at, cancelled_at, finished_at
+`
+
+// Start starts evacuating the node.
+func (evacuations *nodeEvacuations) Start(ctx context.Context, nodeID storj.NodeID) (_ evacuation.Evacuation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := evacuations.db.QueryRowContext(ctx, `
+		INSERT INTO node_evacuations (node_id) VALUES ($1)
+		ON CONFLICT (node_id) DO UPDATE SET
+			cursor_stream_id   = NULL,
+			cursor_position    = 0,
+			segments_processed = 0,
+			segments_failed    = 0,
+			created_at         = current_timestamp,
+			updated_at         = current_timestamp,
+			cancelled_at       = NULL,
+			finished_at        = NULL
+		WHERE node_evacuations.cancelled_at IS NOT NULL OR node_evacuations.finished_at IS NOT NULL
+		RETURNING `+nodeEvacuationColumns, nodeID)
+
+	started, err := scanNodeEvacuation(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return evacuation.Evacuation{}, evacuation.ErrActive.New("%v", nodeID)
+	}
+	if err != nil {
+		return evacuation.Evacuation{}, Error.Wrap(err)
+	}
+	return started, nil
+}
+
+// Get returns the evacuation of the node.
+func (evacuations *nodeEvacuations) Get(ctx context.Context, nodeID storj.NodeID) (_ evacuation.Evacuation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := evacuations.db.QueryRowContext(ctx, `
+		SELECT `+nodeEvacuationColumns+` FROM node_evacuations WHERE node_id = $1
+	`, nodeID)
+
+	result, err := scanNodeEvacuation(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return evacuation.Evacuation{}, evacuation.ErrNotFound.New("%v", nodeID)
+	}
+	if err != nil {
+		return evacuation.Evacuation{}, Error.Wrap(err)
+	}
+	return result, nil
+}
+
+// ListActive returns the evacuations in progress.
+func (evacuations *nodeEvacuations) ListActive(ctx context.Context) (active []evacuation.Evacuation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := evacuations.db.QueryContext(ctx, `
+		SELECT `+nodeEvacuationColumns+` FROM node_evacuations
+		WHERE cancelled_at IS NULL AND finished_at IS NULL
+		ORDER BY created_at
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		result, err := scanNodeEvacuation(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		active = append(active, result)
+	}
+	return active, Error.Wrap(rows.Err())
+}
+
+// UpdateProgress moves the cursor of an active evacuation and adds to its
+// segment counts.
+func (evacuations *nodeEvacuations) UpdateProgress(ctx context.Context, nodeID storj.NodeID, cursor metabase.StreamPosition, processed, failed int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = evacuations.db.ExecContext(ctx, `
+		UPDATE node_evacuations SET
+			cursor_stream_id   = $2,
+			cursor_position    = $3,
+			segments_processed = segments_processed + $4,
+			segments_failed    = segments_failed + $5,
+			updated_at         = current_timestamp
+		WHERE node_id = $1 AND cancelled_at IS NULL AND finished_at IS NULL
+	`, nodeID, cursor.StreamID, cursor.Position, processed, failed)
+	return Error.Wrap(err)
+}
+
+// Cancel cancels the active evacuation of the node.
+func (evacuations *nodeEvacuations) Cancel(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := evacuations.db.ExecContext(ctx, `
+		UPDATE node_evacuations SET
+			cancelled_at = current_timestamp,
+			updated_at   = current_timestamp
+		WHERE node_id = $1 AND cancelled_at IS NULL AND finished_at IS NULL
+	`, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return evacuation.ErrNotFound.New("no active evacuation for %v", nodeID)
+	}
+	return nil
+}
+
+// Finish marks the active evacuation of the node as finished.
+func (evacuations *nodeEvacuations) Finish(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = evacuations.db.ExecContext(ctx, `
+		UPDATE node_evacuations SET
+			finished_at = current_timestamp,
+			updated_at  = current_timestamp
+		WHERE node_id = $1 AND cancelled_at IS NULL AND finished_at IS NULL
+	`, nodeID)
+	return Error.Wrap(err)
+}
+
+func scanNodeEvacuation(row interface{ Scan(...interface{}) error }) (result evacuation.Evacuation, err error) {
+	var cursorStreamID []byte
+	err = row.Scan(
+		&result.NodeID, &cursorStreamID, &result.Cursor.Position,
+		&result.SegmentsProcessed, &result.SegmentsFailed,
+		&result.CreatedAt, &result.UpdatedAt, &result.CancelledAt, &result.FinishedAt,
+	)
+	if err != nil {
+		return evacuation.Evacuation{}, err
+	}
+	if cursorStreamID != nil {
+		result.Cursor.StreamID, err = uuid.FromBytes(cursorStreamID)
+		if err != nil {
+			return evacuation.Evacuation{}, err
+		}
+	}
+	return result, nil
+}
@@ -57,7 +57,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	defer mon.Task()(&ctx)(&err)
 
 	query := `
-		SELECT id, address, last_net, last_ip_port, vetted_at, country_code
+		SELECT id, address, last_net, last_ip_port, vetted_at, country_code, email, wallet
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.Interval()) + `
 			WHERE disqualified IS NULL
@@ -88,6 +88,11 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		)
 	}
 
+	tags, err := cache.getAllNodeTags(ctx, selectionCfg.AsOfSystemTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	rows, err := cache.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, nil, err
@@ -101,13 +106,14 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		node.Address = &pb.NodeAddress{}
 		var lastIPPort sql.NullString
 		var vettedAt *time.Time
-		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode)
+		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode, &node.Email, &node.Wallet)
 		if err != nil {
 			return nil, nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		node.Tags = tags[node.ID]
 
 		if vettedAt == nil {
 			newNodes = append(newNodes, &node)
@@ -139,7 +145,7 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 	defer mon.Task()(&ctx)(&err)
 
 	query := `
-		SELECT id, address, last_net, last_ip_port
+		SELECT id, address, last_net, last_ip_port, country_code, email, wallet
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(asOfConfig.Interval()) + `
 			WHERE disqualified IS NULL
@@ -151,6 +157,11 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 		time.Now().Add(-onlineWindow),
 	}
 
+	tags, err := cache.getAllNodeTags(ctx, asOfConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	rows, err := cache.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -162,13 +173,14 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 		var node overlay.SelectedNode
 		node.Address = &pb.NodeAddress{}
 		var lastIPPort sql.NullString
-		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort)
+		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &node.CountryCode, &node.Email, &node.Wallet)
 		if err != nil {
 			return nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		node.Tags = tags[node.ID]
 		nodes = append(nodes, &node)
 	}
 	return nodes, Error.Wrap(rows.Err())
@@ -637,6 +649,52 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 	return nodes, Error.Wrap(rows.Err())
 }
 
+// Unvetted returns all online nodes that are not vetted yet.
+func (cache *overlaycache) Unvetted(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		nodes, err = cache.unvetted(ctx, criteria)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return nodes, err
+		}
+		break
+	}
+
+	return nodes, err
+}
+
+func (cache *overlaycache) unvetted(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT id
+		FROM nodes
+		`+cache.db.impl.AsOfSystemInterval(criteria.AsOfSystemInterval)+`
+		WHERE vetted_at IS NULL
+		AND disqualified IS NULL
+		AND exit_finished_at IS NULL
+		AND last_contact_success > $1
+	`), time.Now().Add(-criteria.OnlineWindow))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errs.Combine(err, rows.Close())
+	}()
+
+	for rows.Next() {
+		var id storj.NodeID
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, id)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
 // UpdateReputation updates the DB columns for any of the reputation fields in ReputationUpdate.
 func (cache *overlaycache) UpdateReputation(ctx context.Context, id storj.NodeID, request overlay.ReputationUpdate) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -717,6 +775,57 @@ func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.Node
 	return nil
 }
 
+// ReinstateNode clears the disqualification and the suspensions of a node
+// which hasn't gracefully exited.
+func (cache *overlaycache) ReinstateNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, `
+		UPDATE nodes SET
+			disqualified            = NULL,
+			disqualification_reason = NULL,
+			unknown_audit_suspended = NULL,
+			offline_suspended       = NULL
+		WHERE id = $1 AND exit_finished_at IS NULL
+	`, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return overlay.ErrNodeNotFound.New("%v", nodeID)
+	}
+	return nil
+}
+
+// UnsuspendNode clears the unknown audit and offline suspensions of a node.
+func (cache *overlaycache) UnsuspendNode(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, `
+		UPDATE nodes SET
+			unknown_audit_suspended = NULL,
+			offline_suspended       = NULL
+		WHERE id = $1
+	`, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return overlay.ErrNodeNotFound.New("%v", nodeID)
+	}
+	return nil
+}
+
 // TestSuspendNodeUnknownAudit suspends a storage node for unknown audits.
 func (cache *overlaycache) TestSuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -817,6 +926,186 @@ func (cache *overlaycache) UpdatePieceCounts(ctx context.Context, pieceCounts ma
 	return Error.Wrap(err)
 }
 
+// UpdateDownloadStats decays the stored download stats of the nodes and adds
+// the updates.
+func (cache *overlaycache) UpdateDownloadStats(ctx context.Context, updates []overlay.DownloadStatsUpdate, decay float64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(updates) == 0 {
+		return nil
+	}
+
+	// sort the updates to avoid deadlocks between concurrent updates.
+	sort.Slice(updates, func(i, k int) bool {
+		return updates[i].NodeID.Less(updates[k].NodeID)
+	})
+
+	nodeIDs := make([]storj.NodeID, len(updates))
+	requested := make([]float64, len(updates))
+	settled := make([]float64, len(updates))
+	ttfbSum := make([]float64, len(updates))
+	ttfbCount := make([]float64, len(updates))
+	for i, update := range updates {
+		nodeIDs[i] = update.NodeID
+		requested[i] = float64(update.Requested)
+		settled[i] = float64(update.Settled)
+		ttfbSum[i] = update.TTFBSum.Seconds()
+		ttfbCount[i] = float64(update.TTFBCount)
+	}
+
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO node_download_stats (
+			node_id, requested, settled, ttfb_sum, ttfb_count, updated_at
+		)
+		SELECT
+			unnest($1::bytea[]), unnest($2::float8[]), unnest($3::float8[]),
+			unnest($4::float8[]), unnest($5::float8[]), current_timestamp
+		ON CONFLICT ( node_id )
+		DO UPDATE SET
+			requested  = node_download_stats.requested  * $6 + EXCLUDED.requested,
+			settled    = node_download_stats.settled    * $6 + EXCLUDED.settled,
+			ttfb_sum   = node_download_stats.ttfb_sum   * $6 + EXCLUDED.ttfb_sum,
+			ttfb_count = node_download_stats.ttfb_count * $6 + EXCLUDED.ttfb_count,
+			updated_at = current_timestamp
+	`, pgutil.NodeIDArray(nodeIDs), pgutil.Float8Array(requested), pgutil.Float8Array(settled),
+		pgutil.Float8Array(ttfbSum), pgutil.Float8Array(ttfbCount), decay)
+
+	return Error.Wrap(err)
+}
+
+// GetAllDownloadStats returns the download stats of all nodes.
+func (cache *overlaycache) GetAllDownloadStats(ctx context.Context) (_ []overlay.DownloadStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT node_id, requested, settled, ttfb_sum, ttfb_count
+		FROM node_download_stats
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var stats []overlay.DownloadStats
+	for rows.Next() {
+		var stat overlay.DownloadStats
+		var ttfbSum float64
+		err = rows.Scan(&stat.NodeID, &stat.Requested, &stat.Settled, &ttfbSum, &stat.TTFBCount)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		stat.TTFBSum = time.Duration(ttfbSum * float64(time.Second))
+		stats = append(stats, stat)
+	}
+	return stats, Error.Wrap(rows.Err())
+}
+
+// UpdateNodeTags replaces the tags of the node.
+func (cache *overlaycache) UpdateNodeTags(ctx context.Context, nodeID storj.NodeID, setSignedAt time.Time, tags overlay.NodeTags) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	names := make([]string, len(tags))
+	values := make([]string, len(tags))
+	signedAt := make([]time.Time, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+		values[i] = tag.Value
+		signedAt[i] = tag.SignedAt
+	}
+
+	err = cache.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		// a replayed older tag set must not replace the current one. The node
+		// sends the same set on every check in, which is already stored.
+		var storedSignedAt *time.Time
+		err := tx.Tx.QueryRowContext(ctx, `SELECT max(signed_at) FROM node_tags WHERE node_id = $1`, nodeID).Scan(&storedSignedAt)
+		if err != nil {
+			return err
+		}
+		if storedSignedAt != nil {
+			// the database keeps microseconds.
+			setSignedAt := setSignedAt.Truncate(time.Microsecond)
+			if setSignedAt.Equal(*storedSignedAt) {
+				return nil
+			}
+			if setSignedAt.Before(*storedSignedAt) {
+				return overlay.ErrNodeTagsOutdated.New("signed at %s, stored tags signed at %s", setSignedAt, *storedSignedAt)
+			}
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `DELETE FROM node_tags WHERE node_id = $1`, nodeID)
+		if err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			return nil
+		}
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO node_tags (node_id, name, value, signed_at)
+			SELECT $1, unnest($2::text[]), unnest($3::text[]), unnest($4::timestamptz[])
+		`, nodeID, pgutil.TextArray(names), pgutil.TextArray(values), pgutil.TimestampTZArray(signedAt))
+		return err
+	})
+	if overlay.ErrNodeTagsOutdated.Has(err) {
+		return err
+	}
+	return Error.Wrap(err)
+}
+
+// GetNodeTags returns the tags of the node.
+func (cache *overlaycache) GetNodeTags(ctx context.Context, nodeID storj.NodeID) (_ overlay.NodeTags, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT name, value, signed_at
+		FROM node_tags
+		WHERE node_id = $1
+		ORDER BY name
+	`, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var tags overlay.NodeTags
+	for rows.Next() {
+		tag := overlay.NodeTag{NodeID: nodeID}
+		err = rows.Scan(&tag.Name, &tag.Value, &tag.SignedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, Error.Wrap(rows.Err())
+}
+
+// getAllNodeTags returns the tags of all nodes.
+func (cache *overlaycache) getAllNodeTags(ctx context.Context, asOf overlay.AsOfSystemTimeConfig) (_ map[storj.NodeID]map[string]string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT node_id, name, value
+		FROM node_tags
+		`+cache.db.impl.AsOfSystemInterval(asOf.Interval()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	tags := map[storj.NodeID]map[string]string{}
+	for rows.Next() {
+		var nodeID storj.NodeID
+		var name, value string
+		err = rows.Scan(&nodeID, &name, &value)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if tags[nodeID] == nil {
+			tags[nodeID] = map[string]string{}
+		}
+		tags[nodeID][name] = value
+	}
+	return tags, Error.Wrap(rows.Err())
+}
+
 // GetExitingNodes returns nodes who have initiated a graceful exit and is not disqualified, but have not completed it.
 func (cache *overlaycache) GetExitingNodes(ctx context.Context) (exitingNodes []*overlay.ExitStatus, err error) {
 	for {
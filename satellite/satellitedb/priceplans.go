@@ -0,0 +1,198 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments/pricing"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that pricePlans implements pricing.DB.
+var _ pricing.DB = (*pricePlans)(nil)
+
+// pricePlans is an implementation of pricing.DB.
+//
+// architecture: Database
+type pricePlans struct {
+	db *satelliteDB
+}
+
+// Insert inserts a new price plan.
+func (plans *pricePlans) Insert(ctx context.Context, plan pricing.Plan) (_ pricing.Plan, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prices, err := json.Marshal(plan.Prices)
+	if err != nil {
+		return pricing.Plan{}, Error.Wrap(err)
+	}
+
+	err = plans.db.QueryRowContext(ctx, `
+		INSERT INTO price_plans (id, name, prices)
+		VALUES ($1, $2, $3)
+		RETURNING created_at
+	`, plan.ID, plan.Name, prices).Scan(&plan.CreatedAt)
+	if err != nil {
+		return pricing.Plan{}, Error.Wrap(err)
+	}
+
+	return plan, nil
+}
+
+// Get returns the price plan with the id.
+func (plans *pricePlans) Get(ctx context.Context, id uuid.UUID) (_ pricing.Plan, err error) {
+	defer mon.Task()(&ctx, id)(&err)
+
+	return plans.queryPlan(ctx, `
+		SELECT id, name, prices, created_at FROM price_plans WHERE id = $1
+	`, id)
+}
+
+// List returns all price plans.
+func (plans *pricePlans) List(ctx context.Context) (_ []pricing.Plan, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := plans.db.QueryContext(ctx, `
+		SELECT id, name, prices, created_at FROM price_plans ORDER BY name, id
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(rows.Close())) }()
+
+	var list []pricing.Plan
+	for rows.Next() {
+		plan, err := scanPricePlan(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, plan)
+	}
+
+	return list, Error.Wrap(rows.Err())
+}
+
+// Delete deletes the price plan together with its assignments.
+func (plans *pricePlans) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, id)(&err)
+
+	return Error.Wrap(plans.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, query := range []string{
+			`DELETE FROM user_price_plans WHERE plan_id = $1`,
+			`DELETE FROM partner_price_plans WHERE plan_id = $1`,
+		} {
+			if _, err := tx.Tx.ExecContext(ctx, query, id); err != nil {
+				return err
+			}
+		}
+
+		result, err := tx.Tx.ExecContext(ctx, `DELETE FROM price_plans WHERE id = $1`, id)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return pricing.ErrPlanNotFound.New("%s", id)
+		}
+		return nil
+	}))
+}
+
+// AssignUser assigns the price plan to the user.
+func (plans *pricePlans) AssignUser(ctx context.Context, userID, planID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, userID, planID)(&err)
+
+	_, err = plans.db.ExecContext(ctx, `
+		INSERT INTO user_price_plans (user_id, plan_id)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET plan_id = EXCLUDED.plan_id
+	`, userID, planID)
+	return Error.Wrap(err)
+}
+
+// UnassignUser removes the price plan assignment of the user.
+func (plans *pricePlans) UnassignUser(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	_, err = plans.db.ExecContext(ctx, `DELETE FROM user_price_plans WHERE user_id = $1`, userID)
+	return Error.Wrap(err)
+}
+
+// GetByUser returns the price plan assigned to the user.
+func (plans *pricePlans) GetByUser(ctx context.Context, userID uuid.UUID) (_ pricing.Plan, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	return plans.queryPlan(ctx, `
+		SELECT price_plans.id, price_plans.name, price_plans.prices, price_plans.created_at
+		FROM user_price_plans
+		JOIN price_plans ON price_plans.id = user_price_plans.plan_id
+		WHERE user_price_plans.user_id = $1
+	`, userID)
+}
+
+// AssignPartner assigns the price plan to the partner.
+func (plans *pricePlans) AssignPartner(ctx context.Context, partner string, planID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, planID)(&err)
+
+	_, err = plans.db.ExecContext(ctx, `
+		INSERT INTO partner_price_plans (partner, plan_id)
+		VALUES ($1, $2)
+		ON CONFLICT (partner) DO UPDATE SET plan_id = EXCLUDED.plan_id
+	`, pricing.CanonicalPartner(partner), planID)
+	return Error.Wrap(err)
+}
+
+// UnassignPartner removes the price plan assignment of the partner.
+func (plans *pricePlans) UnassignPartner(ctx context.Context, partner string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = plans.db.ExecContext(ctx, `DELETE FROM partner_price_plans WHERE partner = $1`, pricing.CanonicalPartner(partner))
+	return Error.Wrap(err)
+}
+
+// GetByPartner returns the price plan assigned to the partner.
+func (plans *pricePlans) GetByPartner(ctx context.Context, partner string) (_ pricing.Plan, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return plans.queryPlan(ctx, `
+		SELECT price_plans.id, price_plans.name, price_plans.prices, price_plans.created_at
+		FROM partner_price_plans
+		JOIN price_plans ON price_plans.id = partner_price_plans.plan_id
+		WHERE partner_price_plans.partner = $1
+	`, pricing.CanonicalPartner(partner))
+}
+
+// queryPlan returns the single price plan returned by the query.
+func (plans *pricePlans) queryPlan(ctx context.Context, query string, args ...interface{}) (pricing.Plan, error) {
+	plan, err := scanPricePlan(plans.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return pricing.Plan{}, pricing.ErrPlanNotFound.Wrap(err)
+	}
+	if err != nil {
+		return pricing.Plan{}, Error.Wrap(err)
+	}
+	return plan, nil
+}
+
+// scanPricePlan scans a price plan from a row.
+func scanPricePlan(row interface{ Scan(...interface{}) error }) (plan pricing.Plan, err error) {
+	var prices []byte
+	if err := row.Scan(&plan.ID, &plan.Name, &prices, &plan.CreatedAt); err != nil {
+		return pricing.Plan{}, err
+	}
+	if err := json.Unmarshal(prices, &plan.Prices); err != nil {
+		return pricing.Plan{}, err
+	}
+	return plan, nil
+}
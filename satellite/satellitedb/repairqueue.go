@@ -7,10 +7,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/private/dbutil"
 	"storj.io/private/dbutil/pgutil"
@@ -41,14 +45,14 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health, placement, project_id
 			)
 			VALUES (
-				$1, $2, $3
+				$1, $2, $3, $4, $5
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp
+			SET segment_health=$3, placement=$4, project_id=COALESCE($5, repair_queue.project_id), updated_at=current_timestamp
 			RETURNING (xmax != 0) AS alreadyInserted
 		`
 	case dbutil.Cockroach:
@@ -60,18 +64,18 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 			)
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health, placement, project_id
 			)
 			VALUES (
-				$1, $2, $3
+				$1, $2, $3, $4, $5
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp
+			SET segment_health=$3, placement=$4, project_id=COALESCE($5, repair_queue.project_id), updated_at=current_timestamp
 			RETURNING (SELECT alreadyInserted FROM inserted)
 		`
 	}
-	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth)
+	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth, int64(seg.Placement), nullProjectID(seg.ProjectID))
 	if err != nil {
 		return false, err
 	}
@@ -109,16 +113,18 @@ func (r *repairQueue) InsertBatch(
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
-			)
-			VALUES (
-				UNNEST($1::BYTEA[]),
-				UNNEST($2::INT8[]),
-				UNNEST($3::double precision[])
+				stream_id, position, segment_health, placement, project_id
 			)
+			SELECT stream_id, position, segment_health, placement, NULLIF(project_id, ''::BYTEA)
+			FROM UNNEST($1::BYTEA[], $2::INT8[], $3::double precision[], $4::INT4[], $5::BYTEA[])
+				AS to_insert(stream_id, position, segment_health, placement, project_id)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=EXCLUDED.segment_health, updated_at=current_timestamp
+			SET
+				segment_health=EXCLUDED.segment_health,
+				placement=EXCLUDED.placement,
+				project_id=COALESCE(EXCLUDED.project_id, repair_queue.project_id),
+				updated_at=current_timestamp
 			RETURNING NOT(xmax != 0) AS newlyInserted
 		`
 	case dbutil.Cockroach:
@@ -128,18 +134,22 @@ func (r *repairQueue) InsertBatch(
 				SELECT
 					UNNEST($1::BYTEA[]) AS stream_id,
 					UNNEST($2::INT8[]) AS position,
-					UNNEST($3::double precision[]) AS segment_health
+					UNNEST($3::double precision[]) AS segment_health,
+					UNNEST($4::INT4[]) AS placement,
+					UNNEST($5::BYTEA[]) AS project_id
 			),
 			do_insert AS (
 				INSERT INTO repair_queue (
-					stream_id, position, segment_health
+					stream_id, position, segment_health, placement, project_id
 				)
-				SELECT stream_id, position, segment_health
+				SELECT stream_id, position, segment_health, placement, NULLIF(project_id, ''::BYTEA)
 				FROM to_insert
 				ON CONFLICT (stream_id, position)
 				DO UPDATE
 				SET
 					segment_health=EXCLUDED.segment_health,
+					placement=EXCLUDED.placement,
+					project_id=COALESCE(EXCLUDED.project_id, repair_queue.project_id),
 					updated_at=current_timestamp
 				RETURNING false
 			)
@@ -156,12 +166,21 @@ func (r *repairQueue) InsertBatch(
 		StreamIDs      []uuid.UUID
 		Positions      []int64
 		SegmentHealths []float64
+		Placements     []int32
+		ProjectIDs     [][]byte
 	}
 
 	for _, segment := range segments {
 		insertData.StreamIDs = append(insertData.StreamIDs, segment.StreamID)
 		insertData.Positions = append(insertData.Positions, int64(segment.Position.Encode()))
 		insertData.SegmentHealths = append(insertData.SegmentHealths, segment.SegmentHealth)
+		insertData.Placements = append(insertData.Placements, int32(segment.Placement))
+		// unattributed segments are sent as empty values and stored as NULL.
+		var projectID []byte
+		if !segment.ProjectID.IsZero() {
+			projectID = segment.ProjectID.Bytes()
+		}
+		insertData.ProjectIDs = append(insertData.ProjectIDs, projectID)
 	}
 
 	rows, err := r.db.QueryContext(
@@ -169,6 +188,8 @@ func (r *repairQueue) InsertBatch(
 		pgutil.UUIDArray(insertData.StreamIDs),
 		pgutil.Int8Array(insertData.Positions),
 		pgutil.Float8Array(insertData.SegmentHealths),
+		pgutil.Int4Array(insertData.Placements),
+		pgutil.ByteaArray(insertData.ProjectIDs),
 	)
 
 	if err != nil {
@@ -196,27 +217,52 @@ func (r *repairQueue) InsertBatch(
 
 func (r *repairQueue) Select(ctx context.Context) (seg *queue.InjuredSegment, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return r.selectWhere(ctx, "TRUE")
+}
+
+func (r *repairQueue) SelectBelowHealth(ctx context.Context, health float64) (seg *queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return r.selectWhere(ctx, "segment_health < $1", health)
+}
+
+func (r *repairQueue) SelectForPlacement(ctx context.Context, placement storj.PlacementConstraint, excludedProjects []uuid.UUID) (seg *queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(excludedProjects) == 0 {
+		return r.selectWhere(ctx, "placement = $1", int64(placement))
+	}
+	return r.selectWhere(ctx, "placement = $1 AND (project_id IS NULL OR NOT (project_id = ANY($2::BYTEA[])))",
+		int64(placement), pgutil.UUIDArray(excludedProjects))
+}
+
+// selectWhere marks the most injured segment matching condition as attempted
+// and returns it.
+func (r *repairQueue) selectWhere(ctx context.Context, condition string, args ...interface{}) (seg *queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	segment := queue.InjuredSegment{}
+	var placement int64
+	var projectID uuid.NullUUID
 	switch r.db.impl {
 	case dbutil.Cockroach:
 		err = r.db.QueryRowContext(ctx, `
 				UPDATE repair_queue SET attempted_at = now()
-				WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
+				WHERE (attempted_at IS NULL OR attempted_at < now() - interval '6 hours')
+					AND `+condition+`
 				ORDER BY segment_health ASC, attempted_at NULLS FIRST
 				LIMIT 1
-				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
-		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement, project_id
+		`, args...).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &placement, &projectID)
 	case dbutil.Postgres:
 		err = r.db.QueryRowContext(ctx, `
 				UPDATE repair_queue SET attempted_at = now() WHERE (stream_id, position) = (
 					SELECT stream_id, position FROM repair_queue
-					WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
+					WHERE (attempted_at IS NULL OR attempted_at < now() - interval '6 hours')
+						AND `+condition+`
 					ORDER BY segment_health ASC, attempted_at NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
-				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
-		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement, project_id
+		`, args...).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &placement, &projectID)
 	default:
 		return seg, errs.New("unhandled database: %v", r.db.impl)
 	}
@@ -226,6 +272,8 @@ func (r *repairQueue) Select(ctx context.Context) (seg *queue.InjuredSegment, er
 		}
 		return nil, err
 	}
+	segment.Placement = storj.PlacementConstraint(placement)
+	segment.ProjectID = projectID.UUID
 	return &segment, err
 }
 
@@ -248,7 +296,7 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 	}
 	// TODO: strictly enforce order-by or change tests
 	rows, err := r.db.QueryContext(ctx,
-		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, segment_health
+		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, segment_health, placement, project_id
 					FROM repair_queue LIMIT ?`), limit,
 	)
 	if err != nil {
@@ -258,11 +306,15 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 
 	for rows.Next() {
 		var seg queue.InjuredSegment
+		var placement int64
+		var projectID uuid.NullUUID
 		err = rows.Scan(&seg.StreamID, &seg.Position, &seg.AttemptedAt,
-			&seg.UpdatedAt, &seg.SegmentHealth)
+			&seg.UpdatedAt, &seg.SegmentHealth, &placement, &projectID)
 		if err != nil {
 			return segs, Error.Wrap(err)
 		}
+		seg.Placement = storj.PlacementConstraint(placement)
+		seg.ProjectID = projectID.UUID
 		segs = append(segs, seg)
 	}
 
@@ -278,6 +330,55 @@ func (r *repairQueue) Count(ctx context.Context) (count int, err error) {
 	return count, Error.Wrap(err)
 }
 
+func (r *repairQueue) Stats(ctx context.Context, healthBuckets []float64) (stats []queue.Stat, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// bucket i contains the segments with healthBuckets[i-1] <= health < healthBuckets[i].
+	var bucket strings.Builder
+	args := make([]interface{}, 0, len(healthBuckets))
+	bucket.WriteString("CASE")
+	for i, health := range healthBuckets {
+		args = append(args, health)
+		fmt.Fprintf(&bucket, " WHEN segment_health < $%d THEN %d", i+1, i)
+	}
+	fmt.Fprintf(&bucket, " ELSE %d END", len(healthBuckets))
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT placement, bucket, count(*), min(segment_health)
+		FROM (
+			SELECT placement, segment_health, `+bucket.String()+` AS bucket
+			FROM repair_queue
+		) AS buckets
+		GROUP BY placement, bucket
+		ORDER BY placement, bucket
+	`, args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var stat queue.Stat
+		var placement, bucket int64
+		if err := rows.Scan(&placement, &bucket, &stat.Count, &stat.MinHealth); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		stat.Placement = storj.PlacementConstraint(placement)
+		stat.MaxHealth = math.Inf(1)
+		if int(bucket) < len(healthBuckets) {
+			stat.MaxHealth = healthBuckets[bucket]
+		}
+		stats = append(stats, stat)
+	}
+
+	return stats, Error.Wrap(rows.Err())
+}
+
+// nullProjectID stores an unattributed segment's project as NULL.
+func nullProjectID(projectID uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: projectID, Valid: !projectID.IsZero()}
+}
+
 // TestingSetAttemptedTime sets attempted time for a segment.
 func (r *repairQueue) TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID,
 	position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error) {
@@ -6,6 +6,7 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -15,6 +16,7 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -300,6 +302,160 @@ func (reputations *reputations) UnsuspendNodeUnknownAudit(ctx context.Context, n
 	return Error.Wrap(err)
 }
 
+// Reinstate clears the disqualification and the suspensions of a node, resets
+// its reputation to the initial values of the config and records the change.
+func (reputations *reputations) Reinstate(ctx context.Context, nodeID storj.NodeID, reason string, config reputation.Config) (_ reputation.Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	historyBytes, err := pb.Marshal(&pb.AuditHistory{})
+	if err != nil {
+		return reputation.Change{}, Error.Wrap(err)
+	}
+
+	return reputations.change(ctx, nodeID, reputation.ChangeReinstate, reason, func(previous *reputation.Info) (dbx.Reputation_Update_Fields, error) {
+		return dbx.Reputation_Update_Fields{
+			Disqualified:                dbx.Reputation_Disqualified_Null(),
+			DisqualificationReason:      dbx.Reputation_DisqualificationReason_Null(),
+			UnknownAuditSuspended:       dbx.Reputation_UnknownAuditSuspended_Null(),
+			OfflineSuspended:            dbx.Reputation_OfflineSuspended_Null(),
+			UnderReview:                 dbx.Reputation_UnderReview_Null(),
+			OnlineScore:                 dbx.Reputation_OnlineScore(1),
+			AuditHistory:                dbx.Reputation_AuditHistory(historyBytes),
+			AuditReputationAlpha:        dbx.Reputation_AuditReputationAlpha(config.InitialAlpha),
+			AuditReputationBeta:         dbx.Reputation_AuditReputationBeta(config.InitialBeta),
+			UnknownAuditReputationAlpha: dbx.Reputation_UnknownAuditReputationAlpha(1),
+			UnknownAuditReputationBeta:  dbx.Reputation_UnknownAuditReputationBeta(0),
+		}, nil
+	})
+}
+
+// Unsuspend clears the suspensions of a node which isn't disqualified, resets
+// its unknown audit reputation and audit history and records the change.
+func (reputations *reputations) Unsuspend(ctx context.Context, nodeID storj.NodeID, reason string) (_ reputation.Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	historyBytes, err := pb.Marshal(&pb.AuditHistory{})
+	if err != nil {
+		return reputation.Change{}, Error.Wrap(err)
+	}
+
+	return reputations.change(ctx, nodeID, reputation.ChangeUnsuspend, reason, func(previous *reputation.Info) (dbx.Reputation_Update_Fields, error) {
+		if previous.Disqualified != nil {
+			return dbx.Reputation_Update_Fields{}, reputation.ErrNodeDisqualified.New("%v", nodeID)
+		}
+		return dbx.Reputation_Update_Fields{
+			UnknownAuditSuspended:       dbx.Reputation_UnknownAuditSuspended_Null(),
+			OfflineSuspended:            dbx.Reputation_OfflineSuspended_Null(),
+			UnderReview:                 dbx.Reputation_UnderReview_Null(),
+			OnlineScore:                 dbx.Reputation_OnlineScore(1),
+			AuditHistory:                dbx.Reputation_AuditHistory(historyBytes),
+			UnknownAuditReputationAlpha: dbx.Reputation_UnknownAuditReputationAlpha(1),
+			UnknownAuditReputationBeta:  dbx.Reputation_UnknownAuditReputationBeta(0),
+		}, nil
+	})
+}
+
+// change applies a manual change to the reputation of a node and records it
+// in the same transaction.
+func (reputations *reputations) change(ctx context.Context, nodeID storj.NodeID, action reputation.ChangeAction, reason string, update func(previous *reputation.Info) (dbx.Reputation_Update_Fields, error)) (change reputation.Change, err error) {
+	id, err := uuid.New()
+	if err != nil {
+		return reputation.Change{}, Error.Wrap(err)
+	}
+
+	err = reputations.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+		_, err = tx.Tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
+		if err != nil {
+			return err
+		}
+
+		dbNode, err := tx.Get_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()))
+		if errors.Is(err, sql.ErrNoRows) {
+			historyBytes, err := pb.Marshal(&pb.AuditHistory{})
+			if err != nil {
+				return err
+			}
+
+			dbNode, err = tx.Create_Reputation(ctx, dbx.Reputation_Id(nodeID.Bytes()), dbx.Reputation_AuditHistory(historyBytes), dbx.Reputation_Create_Fields{})
+			if err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+
+		previous, err := dbxToReputationInfo(dbNode)
+		if err != nil {
+			return err
+		}
+
+		updateFields, err := update(&previous)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Update_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()), updateFields)
+		if err != nil {
+			return err
+		}
+
+		change = reputation.Change{
+			ID:       id,
+			NodeID:   nodeID,
+			Action:   action,
+			Reason:   reason,
+			Previous: reputation.NewChangeState(&previous),
+		}
+		previousState, err := json.Marshal(change.Previous)
+		if err != nil {
+			return err
+		}
+
+		return tx.Tx.QueryRowContext(ctx, `
+			INSERT INTO node_reputation_changes (id, node_id, action, reason, previous_state)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING created_at
+		`, id, nodeID, string(action), reason, string(previousState)).Scan(&change.CreatedAt)
+	})
+	if err != nil {
+		if reputation.ErrNodeDisqualified.Has(err) {
+			return reputation.Change{}, err
+		}
+		return reputation.Change{}, Error.Wrap(err)
+	}
+	return change, nil
+}
+
+// ListChanges returns the manual reputation changes of a node, most recent
+// first.
+func (reputations *reputations) ListChanges(ctx context.Context, nodeID storj.NodeID) (changes []reputation.Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reputations.db.QueryContext(ctx, `
+		SELECT id, action, reason, previous_state, created_at
+		FROM node_reputation_changes
+		WHERE node_id = $1
+		ORDER BY created_at DESC
+	`, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		change := reputation.Change{NodeID: nodeID}
+		var action, previousState string
+		if err := rows.Scan(&change.ID, &action, &change.Reason, &previousState, &change.CreatedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		change.Action = reputation.ChangeAction(action)
+		if err := json.Unmarshal([]byte(previousState), &change.Previous); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		changes = append(changes, change)
+	}
+	return changes, Error.Wrap(rows.Err())
+}
+
 func (reputations *reputations) populateCreateFields(update updateNodeStats) dbx.Reputation_Create_Fields {
 	createFields := dbx.Reputation_Create_Fields{}
 
@@ -424,193 +580,88 @@ func (reputations *reputations) populateUpdateNodeStats(dbNode *dbx.Reputation,
 	// if a node fails enough audits, it gets disqualified
 	// if a node gets enough "unknown" audits, it gets put into suspension
 	// if a node gets enough successful audits, and is in suspension, it gets removed from suspension
-	auditAlpha := dbNode.AuditReputationAlpha
-	auditBeta := dbNode.AuditReputationBeta
-	unknownAuditAlpha := dbNode.UnknownAuditReputationAlpha
-	unknownAuditBeta := dbNode.UnknownAuditReputationBeta
-	totalAuditCount := dbNode.TotalAuditCount
-	vettedAt := dbNode.VettedAt
+	info := reputation.Info{
+		AuditSuccessCount:           dbNode.AuditSuccessCount,
+		TotalAuditCount:             dbNode.TotalAuditCount,
+		VettedAt:                    dbNode.VettedAt,
+		UnknownAuditSuspended:       dbNode.UnknownAuditSuspended,
+		OfflineSuspended:            dbNode.OfflineSuspended,
+		UnderReview:                 dbNode.UnderReview,
+		Disqualified:                dbNode.Disqualified,
+		OnlineScore:                 dbNode.OnlineScore,
+		AuditReputationAlpha:        dbNode.AuditReputationAlpha,
+		AuditReputationBeta:         dbNode.AuditReputationBeta,
+		UnknownAuditReputationAlpha: dbNode.UnknownAuditReputationAlpha,
+		UnknownAuditReputationBeta:  dbNode.UnknownAuditReputationBeta,
+	}
+	disqualified := info.ApplyMutations(updates, config, historyResponse.NewScore, historyResponse.TrackingPeriodFull, now)
 
 	logger := reputations.db.log.With(zap.Stringer("Node ID", zapNodeIDBytes(dbNode.Id)))
 
-	// Here we rely on the observation that, conceptually, if we have
-	// collected some list of successes failures while auditing node N
-	// during some short time period, it might reasonably have happened that
-	// the events occurred in a different order.
-	//
-	// That is, if a node passed audit 1, then failed audit 2, then passed
-	// audit 3, it is fair to treat it as if it passed two audits and then
-	// failed one. This is because we expect that the order in which the
-	// events occurred is not very relevant. If a node failed an audit for
-	// piece P at time T, then it likely would also have failed an audit
-	// for the same piece at time T±ε, so we can grade it as though that
-	// had happened.
-	//
-	// There are conditions under which the order of events makes the
-	// difference in whether a node is disqualified or not. To be as fair
-	// as possible, we will not disqualify in those conditions. If a node
-	// remains un-disqualified under any ordering of events, we should not
-	// disqualify it. To that end, we will always apply failures _before_
-	// applying successes. That ordering will always yield the highest
-	// possible result alpha and the lowest possible result beta, assuming
-	// weight > 0 and 0 < λ < 1 (the proof is left as an exercise for the
-	// reader).
-
-	// for audit failure, only update normal alpha/beta
-	auditBeta, auditAlpha = reputation.UpdateReputationMultiple(
-		updates.FailureResults,
-		auditBeta,
-		auditAlpha,
-		config.AuditLambda,
-		config.AuditWeight,
-	)
-	// for audit unknown, only update unknown alpha/beta
-	unknownAuditBeta, unknownAuditAlpha = reputation.UpdateReputationMultiple(
-		updates.UnknownResults,
-		unknownAuditBeta,
-		unknownAuditAlpha,
-		config.UnknownAuditLambda,
-		config.AuditWeight,
-	)
-
-	// for a successful audit, increase reputation for normal *and* unknown audits
-	auditAlpha, auditBeta = reputation.UpdateReputationMultiple(
-		updates.PositiveResults,
-		auditAlpha,
-		auditBeta,
-		config.AuditLambda,
-		config.AuditWeight,
-	)
-	unknownAuditAlpha, unknownAuditBeta = reputation.UpdateReputationMultiple(
-		updates.PositiveResults,
-		unknownAuditAlpha,
-		unknownAuditBeta,
-		config.UnknownAuditLambda,
-		config.AuditWeight,
-	)
-
-	// offline results affect only the total count.
-	updatedTotalAuditCount := totalAuditCount + int64(updates.OfflineResults+updates.UnknownResults+updates.FailureResults+updates.PositiveResults)
-
-	mon.FloatVal("audit_reputation_alpha").Observe(auditAlpha)                //mon:locked
-	mon.FloatVal("audit_reputation_beta").Observe(auditBeta)                  //mon:locked
-	mon.FloatVal("unknown_audit_reputation_alpha").Observe(unknownAuditAlpha) //mon:locked
-	mon.FloatVal("unknown_audit_reputation_beta").Observe(unknownAuditBeta)   //mon:locked
-	mon.FloatVal("audit_online_score").Observe(historyResponse.NewScore)      //mon:locked
+	mon.FloatVal("audit_reputation_alpha").Observe(info.AuditReputationAlpha)                //mon:locked
+	mon.FloatVal("audit_reputation_beta").Observe(info.AuditReputationBeta)                  //mon:locked
+	mon.FloatVal("unknown_audit_reputation_alpha").Observe(info.UnknownAuditReputationAlpha) //mon:locked
+	mon.FloatVal("unknown_audit_reputation_beta").Observe(info.UnknownAuditReputationBeta)   //mon:locked
+	mon.FloatVal("audit_online_score").Observe(info.OnlineScore)                             //mon:locked
 
 	updateFields := updateNodeStats{
 		NodeID:                      dbNode.Id,
-		TotalAuditCount:             int64Field{set: true, value: updatedTotalAuditCount},
-		AuditReputationAlpha:        float64Field{set: true, value: auditAlpha},
-		AuditReputationBeta:         float64Field{set: true, value: auditBeta},
-		UnknownAuditReputationAlpha: float64Field{set: true, value: unknownAuditAlpha},
-		UnknownAuditReputationBeta:  float64Field{set: true, value: unknownAuditBeta},
+		TotalAuditCount:             int64Field{set: true, value: info.TotalAuditCount},
+		AuditReputationAlpha:        float64Field{set: true, value: info.AuditReputationAlpha},
+		AuditReputationBeta:         float64Field{set: true, value: info.AuditReputationBeta},
+		UnknownAuditReputationAlpha: float64Field{set: true, value: info.UnknownAuditReputationAlpha},
+		UnknownAuditReputationBeta:  float64Field{set: true, value: info.UnknownAuditReputationBeta},
+		AuditSuccessCount:           int64Field{set: true, value: info.AuditSuccessCount},
 		// Updating node stats always exits it from containment mode
 		Contained: boolField{set: true, value: false},
 		// always update online score
-		OnlineScore: float64Field{set: true, value: historyResponse.NewScore},
-	}
-
-	if vettedAt == nil && updatedTotalAuditCount >= config.AuditCount {
-		updateFields.VettedAt = timeField{set: true, value: now}
-	}
-
-	// disqualification case a
-	//   a) Success/fail audit reputation falls below audit DQ threshold
-	auditRep := auditAlpha / (auditAlpha + auditBeta)
-	if auditRep <= config.AuditDQ {
-		logger.Info("Disqualified", zap.String("DQ type", "audit failure"))
-		mon.Meter("bad_audit_dqs").Mark(1) //mon:locked
-		updateFields.Disqualified = timeField{set: true, value: now}
-		updateFields.DisqualificationReason = intField{set: true, value: int(overlay.DisqualificationReasonAuditFailure)}
-	}
-
-	// if unknown audit rep goes below threshold, suspend node. Otherwise unsuspend node.
-	unknownAuditRep := unknownAuditAlpha / (unknownAuditAlpha + unknownAuditBeta)
-	if unknownAuditRep <= config.UnknownAuditDQ {
-		if dbNode.UnknownAuditSuspended == nil {
-			logger.Info("Suspended", zap.String("Category", "Unknown Audits"))
-			updateFields.UnknownAuditSuspended = timeField{set: true, value: now}
-		}
-
-		// disqualification case b
-		//   b) Node is suspended (success/unknown reputation below audit DQ threshold)
-		//        AND the suspended grace period has elapsed
-		//        AND audit outcome is unknown or failed
-
-		// if suspended grace period has elapsed and unknown audit rep is still
-		// too low, disqualify node. Set suspended to nil if node is disqualified
-		// NOTE: if updateFields.UnknownAuditSuspended is set, we just suspended
-		// the node a few lines above, so it will not be disqualified.
-		if dbNode.UnknownAuditSuspended != nil && !updateFields.UnknownAuditSuspended.set &&
-			time.Since(*dbNode.UnknownAuditSuspended) > config.SuspensionGracePeriod &&
-			config.SuspensionDQEnabled {
+		OnlineScore:           float64Field{set: true, value: info.OnlineScore},
+		VettedAt:              changedTimeField(dbNode.VettedAt, info.VettedAt),
+		UnknownAuditSuspended: changedTimeField(dbNode.UnknownAuditSuspended, info.UnknownAuditSuspended),
+		OfflineSuspended:      changedTimeField(dbNode.OfflineSuspended, info.OfflineSuspended),
+		OfflineUnderReview:    changedTimeField(dbNode.UnderReview, info.UnderReview),
+	}
+
+	suspensionDQ := false
+	for _, reason := range disqualified {
+		switch reason {
+		case overlay.DisqualificationReasonAuditFailure:
+			logger.Info("Disqualified", zap.String("DQ type", "audit failure"))
+			mon.Meter("bad_audit_dqs").Mark(1) //mon:locked
+		case overlay.DisqualificationReasonSuspension:
 			logger.Info("Disqualified", zap.String("DQ type", "suspension grace period expired for unknown audits"))
 			mon.Meter("unknown_suspension_dqs").Mark(1) //mon:locked
-			updateFields.Disqualified = timeField{set: true, value: now}
-			updateFields.DisqualificationReason = intField{set: true, value: int(overlay.DisqualificationReasonSuspension)}
-			updateFields.UnknownAuditSuspended = timeField{set: true, isNil: true}
+			suspensionDQ = true
+		case overlay.DisqualificationReasonNodeOffline:
+			logger.Info("Disqualified", zap.String("DQ type", "node offline"))
+			mon.Meter("offline_dqs").Mark(1) //mon:locked
 		}
-	} else if dbNode.UnknownAuditSuspended != nil {
-		logger.Info("Suspension lifted", zap.String("Category", "Unknown Audits"))
-		updateFields.UnknownAuditSuspended = timeField{set: true, isNil: true}
 	}
-
-	updateFields.AuditSuccessCount = int64Field{set: true, value: dbNode.AuditSuccessCount + int64(updates.PositiveResults)}
-
-	// if suspension not enabled, skip penalization and unsuspend node if applicable
-	if !config.AuditHistory.OfflineSuspensionEnabled {
-		if dbNode.OfflineSuspended != nil {
-			updateFields.OfflineSuspended = timeField{set: true, isNil: true}
-		}
-		if dbNode.UnderReview != nil {
-			updateFields.OfflineUnderReview = timeField{set: true, isNil: true}
-		}
-		return updateFields
+	if len(disqualified) > 0 {
+		updateFields.Disqualified = timeField{set: true, value: now}
+		updateFields.DisqualificationReason = intField{set: true, value: int(info.DisqualificationReason)}
 	}
 
-	// only penalize node if online score is below threshold and
-	// if it has enough completed windows to fill a tracking period
-	penalizeOfflineNode := false
-	if historyResponse.NewScore < config.AuditHistory.OfflineThreshold && historyResponse.TrackingPeriodFull {
-		penalizeOfflineNode = true
+	switch {
+	case dbNode.UnknownAuditSuspended == nil && info.UnknownAuditSuspended != nil:
+		logger.Info("Suspended", zap.String("Category", "Unknown Audits"))
+	case dbNode.UnknownAuditSuspended != nil && info.UnknownAuditSuspended == nil && !suspensionDQ:
+		logger.Info("Suspension lifted", zap.String("Category", "Unknown Audits"))
 	}
 
-	// Suspension and disqualification for offline nodes
-	if dbNode.UnderReview != nil {
-		// move node in and out of suspension as needed during review period
-		if !penalizeOfflineNode && dbNode.OfflineSuspended != nil {
-			updateFields.OfflineSuspended = timeField{set: true, isNil: true}
-		} else if penalizeOfflineNode && dbNode.OfflineSuspended == nil {
-			updateFields.OfflineSuspended = timeField{set: true, value: now}
-		}
+	return updateFields
+}
 
-		gracePeriodEnd := dbNode.UnderReview.Add(config.AuditHistory.GracePeriod)
-		trackingPeriodEnd := gracePeriodEnd.Add(config.AuditHistory.TrackingPeriod)
-		trackingPeriodPassed := now.After(trackingPeriodEnd)
-
-		// after tracking period has elapsed, if score is good, clear under review
-		// otherwise, disqualify node (if OfflineDQEnabled feature flag is true)
-		if trackingPeriodPassed {
-			if penalizeOfflineNode {
-				if config.AuditHistory.OfflineDQEnabled {
-					logger.Info("Disqualified", zap.String("DQ type", "node offline"))
-					mon.Meter("offline_dqs").Mark(1) //mon:locked
-					updateFields.Disqualified = timeField{set: true, value: now}
-					updateFields.DisqualificationReason = intField{set: true, value: int(overlay.DisqualificationReasonNodeOffline)}
-				}
-			} else {
-				updateFields.OfflineUnderReview = timeField{set: true, isNil: true}
-				updateFields.OfflineSuspended = timeField{set: true, isNil: true}
-			}
-		}
-	} else if penalizeOfflineNode {
-		// suspend node for being offline and begin review period
-		updateFields.OfflineUnderReview = timeField{set: true, value: now}
-		updateFields.OfflineSuspended = timeField{set: true, value: now}
+// changedTimeField returns a field which updates previous to current, or an
+// unset field when the value didn't change.
+func changedTimeField(previous, current *time.Time) timeField {
+	switch {
+	case current == nil && previous != nil:
+		return timeField{set: true, isNil: true}
+	case current != nil && (previous == nil || !current.Equal(*previous)):
+		return timeField{set: true, value: *current}
 	}
-
-	return updateFields
+	return timeField{}
 }
 
 type intField struct {
@@ -0,0 +1,160 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+// checkpointChunkBatchSize is the number of observer state chunks inserted
+// with a single query.
+const checkpointChunkBatchSize = 100
+
+// ensures that segmentLoopCheckpoints implements segmentloop.CheckpointDB.
+var _ segmentloop.CheckpointDB = (*segmentLoopCheckpoints)(nil)
+
+// segmentLoopCheckpoints is an implementation of segmentloop.CheckpointDB.
+//
+// The position of the loop is stored in segment_loop_checkpoints and the
+// observer states are stored chunk by chunk in segment_loop_checkpoint_chunks,
+// so a large state, e.g. the bloom filters of all nodes, isn't stored as a
+// single value.
+//
+// architecture: Database
+type segmentLoopCheckpoints struct {
+	db *satelliteDB
+}
+
+// Get returns the checkpoint of the loop.
+func (checkpoints *segmentLoopCheckpoints) Get(ctx context.Context, name string) (_ segmentloop.Checkpoint, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var checkpoint segmentloop.Checkpoint
+	err = checkpoints.db.QueryRowContext(ctx, `
+		SELECT started_at, stream_id, segments, estimated_segments, updated_at
+		FROM segment_loop_checkpoints
+		WHERE name = $1
+	`, name).Scan(
+		&checkpoint.Started, &checkpoint.StreamID,
+		&checkpoint.Segments, &checkpoint.EstimatedSegments,
+		&checkpoint.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return segmentloop.Checkpoint{}, segmentloop.ErrCheckpointNotFound.New("%s", name)
+	}
+	if err != nil {
+		return segmentloop.Checkpoint{}, Error.Wrap(err)
+	}
+
+	rows, err := checkpoints.db.QueryContext(ctx, `
+		SELECT observer, chunk, data
+		FROM segment_loop_checkpoint_chunks
+		WHERE name = $1
+		ORDER BY observer, chunk
+	`, name)
+	if err != nil {
+		return segmentloop.Checkpoint{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(rows.Close())) }()
+
+	checkpoint.ObserverStates = map[string][][]byte{}
+	for rows.Next() {
+		var observer string
+		var chunk int
+		var data []byte
+		if err := rows.Scan(&observer, &chunk, &data); err != nil {
+			return segmentloop.Checkpoint{}, Error.Wrap(err)
+		}
+
+		state := checkpoint.ObserverStates[observer]
+		if chunk != len(state) {
+			return segmentloop.Checkpoint{}, Error.New("chunk %d of observer %s of %s is missing", len(state), observer, name)
+		}
+		checkpoint.ObserverStates[observer] = append(state, data)
+	}
+	if err := rows.Err(); err != nil {
+		return segmentloop.Checkpoint{}, Error.Wrap(err)
+	}
+
+	return checkpoint, nil
+}
+
+// Set saves the checkpoint of the loop.
+//
+// The previous checkpoint is removed before the chunks are inserted and the
+// position of the loop is inserted last, so an interrupted Set leaves no
+// checkpoint instead of a partial one.
+func (checkpoints *segmentLoopCheckpoints) Set(ctx context.Context, name string, checkpoint segmentloop.Checkpoint) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := checkpoints.Delete(ctx, name); err != nil {
+		return err
+	}
+
+	var observers []string
+	var chunks []int32
+	var data [][]byte
+	insertChunks := func() error {
+		if len(chunks) == 0 {
+			return nil
+		}
+		_, err := checkpoints.db.ExecContext(ctx, `
+			INSERT INTO segment_loop_checkpoint_chunks (name, observer, chunk, data)
+			SELECT $1, UNNEST($2::TEXT[]), UNNEST($3::INT4[]), UNNEST($4::BYTEA[])
+		`, name, pgutil.TextArray(observers), pgutil.Int4Array(chunks), pgutil.ByteaArray(data))
+		observers, chunks, data = observers[:0], chunks[:0], data[:0]
+		return Error.Wrap(err)
+	}
+
+	for observer, state := range checkpoint.ObserverStates {
+		for chunk, chunkData := range state {
+			observers = append(observers, observer)
+			chunks = append(chunks, int32(chunk))
+			data = append(data, chunkData)
+
+			if len(chunks) >= checkpointChunkBatchSize {
+				if err := insertChunks(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if err := insertChunks(); err != nil {
+		return err
+	}
+
+	_, err = checkpoints.db.ExecContext(ctx, `
+		INSERT INTO segment_loop_checkpoints (
+			name, started_at, stream_id, segments, estimated_segments, updated_at
+		) VALUES ($1, $2, $3, $4, $5, current_timestamp)
+	`, name, checkpoint.Started, checkpoint.StreamID,
+		checkpoint.Segments, checkpoint.EstimatedSegments)
+	return Error.Wrap(err)
+}
+
+// Delete removes the checkpoint of the loop.
+func (checkpoints *segmentLoopCheckpoints) Delete(ctx context.Context, name string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the position is removed first, so the remaining chunks are never
+	// used, when removing them fails.
+	_, err = checkpoints.db.ExecContext(ctx, `
+		DELETE FROM segment_loop_checkpoints WHERE name = $1
+	`, name)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = checkpoints.db.ExecContext(ctx, `
+		DELETE FROM segment_loop_checkpoint_chunks WHERE name = $1
+	`, name)
+	return Error.Wrap(err)
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that ssoIdentities implements console.SSOIdentities.
+var _ console.SSOIdentities = (*ssoIdentities)(nil)
+
+// ssoIdentities is an implementation of console.SSOIdentities.
+type ssoIdentities struct {
+	db *satelliteDB
+}
+
+// Get returns the identity with the subject at the provider.
+func (identities *ssoIdentities) Get(ctx context.Context, provider, subject string) (_ *console.SSOIdentity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var identity console.SSOIdentity
+	err = identities.db.QueryRowContext(ctx, `
+		SELECT provider, subject, user_id, created_at FROM sso_identities
+		WHERE provider = $1 AND subject = $2
+	`, provider, subject).Scan(&identity.Provider, &identity.Subject, &identity.UserID, &identity.CreatedAt)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &identity, nil
+}
+
+// GetByUserID returns the identity of the user at the provider.
+func (identities *ssoIdentities) GetByUserID(ctx context.Context, userID uuid.UUID, provider string) (_ *console.SSOIdentity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var identity console.SSOIdentity
+	err = identities.db.QueryRowContext(ctx, `
+		SELECT provider, subject, user_id, created_at FROM sso_identities
+		WHERE user_id = $1 AND provider = $2
+		LIMIT 1
+	`, userID, provider).Scan(&identity.Provider, &identity.Subject, &identity.UserID, &identity.CreatedAt)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &identity, nil
+}
+
+// Insert links the identity to its user.
+func (identities *ssoIdentities) Insert(ctx context.Context, identity console.SSOIdentity) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = identities.db.ExecContext(ctx, `
+		INSERT INTO sso_identities (provider, subject, user_id)
+		VALUES ($1, $2, $3)
+	`, identity.Provider, identity.Subject, identity.UserID)
+	return Error.Wrap(err)
+}
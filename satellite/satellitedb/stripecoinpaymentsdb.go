@@ -31,3 +31,8 @@ func (db *stripeCoinPaymentsDB) Transactions() stripecoinpayments.TransactionsDB
 func (db *stripeCoinPaymentsDB) ProjectRecords() stripecoinpayments.ProjectRecordsDB {
 	return &invoiceProjectRecords{db: db.db}
 }
+
+// AutoTopUps is getter for prepaid balance auto top-ups db.
+func (db *stripeCoinPaymentsDB) AutoTopUps() stripecoinpayments.AutoTopUpsDB {
+	return &autoTopUps{db: db.db}
+}
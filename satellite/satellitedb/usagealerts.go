@@ -0,0 +1,222 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that usageAlerts implements console.UsageAlerts.
+var _ console.UsageAlerts = (*usageAlerts)(nil)
+
+// usageAlerts is an implementation of console.UsageAlerts.
+type usageAlerts struct {
+	db *satelliteDB
+}
+
+const usageAlertColumns = `id, project_id, bucket_name, kind, threshold, triggered_at, created_by, created_at`
+
+// Insert inserts a new usage alert.
+func (alerts *usageAlerts) Insert(ctx context.Context, alert console.UsageAlert) (_ *console.UsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var bucketName []byte
+	if alert.BucketName != "" {
+		bucketName = []byte(alert.BucketName)
+	}
+
+	row := alerts.db.QueryRowContext(ctx, `
+		INSERT INTO usage_alerts (id, project_id, bucket_name, kind, threshold, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+usageAlertColumns,
+		alert.ID, alert.ProjectID, bucketName, string(alert.Kind), alert.Threshold, alert.CreatedBy)
+
+	inserted, err := scanUsageAlert(row)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return inserted, nil
+}
+
+// Get retrieves the usage alert with the given ID.
+func (alerts *usageAlerts) Get(ctx context.Context, id uuid.UUID) (_ *console.UsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := alerts.db.QueryRowContext(ctx, `
+		SELECT `+usageAlertColumns+` FROM usage_alerts WHERE id = $1
+	`, id)
+
+	alert, err := scanUsageAlert(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.New("usage alert %s not found", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return alert, nil
+}
+
+// GetByProjectID retrieves all usage alerts of the project.
+func (alerts *usageAlerts) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.UsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := alerts.db.QueryContext(ctx, `
+		SELECT `+usageAlertColumns+` FROM usage_alerts
+		WHERE project_id = $1
+		ORDER BY created_at, id
+	`, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanUsageAlerts(rows)
+}
+
+// List returns at most limit usage alerts with IDs greater than cursor, ordered by ID.
+func (alerts *usageAlerts) List(ctx context.Context, cursor uuid.UUID, limit int) (_ []console.UsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := alerts.db.QueryContext(ctx, `
+		SELECT `+usageAlertColumns+` FROM usage_alerts
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`, cursor, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanUsageAlerts(rows)
+}
+
+// UpdateTriggeredAt sets the time the alert was triggered, or clears it when triggeredAt is nil.
+func (alerts *usageAlerts) UpdateTriggeredAt(ctx context.Context, id uuid.UUID, triggeredAt *time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = alerts.db.ExecContext(ctx, `
+		UPDATE usage_alerts SET triggered_at = $2 WHERE id = $1
+	`, id, triggeredAt)
+	return Error.Wrap(err)
+}
+
+// Delete deletes the usage alert with the given ID.
+func (alerts *usageAlerts) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = alerts.db.ExecContext(ctx, `DELETE FROM usage_alerts WHERE id = $1`, id)
+	return Error.Wrap(err)
+}
+
+// InsertNotification inserts a console notification about a triggered usage alert.
+func (alerts *usageAlerts) InsertNotification(ctx context.Context, notification console.UsageAlertNotification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var bucketName []byte
+	if notification.BucketName != "" {
+		bucketName = []byte(notification.BucketName)
+	}
+
+	_, err = alerts.db.ExecContext(ctx, `
+		INSERT INTO usage_alert_notifications (id, user_id, alert_id, project_id, bucket_name, kind, threshold, value)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, notification.ID, notification.UserID, notification.AlertID, notification.ProjectID, bucketName,
+		string(notification.Kind), notification.Threshold, notification.Value)
+	return Error.Wrap(err)
+}
+
+// ListNotifications returns at most limit newest notifications of the user.
+func (alerts *usageAlerts) ListNotifications(ctx context.Context, userID uuid.UUID, limit int) (_ []console.UsageAlertNotification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := alerts.db.QueryContext(ctx, `
+		SELECT id, user_id, alert_id, project_id, bucket_name, kind, threshold, value, created_at, read_at
+		FROM usage_alert_notifications
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var notifications []console.UsageAlertNotification
+	for rows.Next() {
+		var notification console.UsageAlertNotification
+		var bucketName []byte
+		var kind string
+
+		err := rows.Scan(&notification.ID, &notification.UserID, &notification.AlertID, &notification.ProjectID,
+			&bucketName, &kind, &notification.Threshold, &notification.Value, &notification.CreatedAt, &notification.ReadAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		notification.BucketName = string(bucketName)
+		notification.Kind = console.UsageAlertKind(kind)
+		notifications = append(notifications, notification)
+	}
+	return notifications, Error.Wrap(rows.Err())
+}
+
+// MarkNotificationRead marks the notification of the user as read.
+func (alerts *usageAlerts) MarkNotificationRead(ctx context.Context, userID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := alerts.db.ExecContext(ctx, `
+		UPDATE usage_alert_notifications SET read_at = coalesce(read_at, now())
+		WHERE id = $1 AND user_id = $2
+	`, id, userID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return Error.New("usage alert notification %s not found", id)
+	}
+	return nil
+}
+
+type usageAlertScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUsageAlert(row usageAlertScanner) (*console.UsageAlert, error) {
+	var alert console.UsageAlert
+	var bucketName []byte
+	var kind string
+
+	err := row.Scan(&alert.ID, &alert.ProjectID, &bucketName, &kind, &alert.Threshold, &alert.TriggeredAt, &alert.CreatedBy, &alert.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	alert.BucketName = string(bucketName)
+	alert.Kind = console.UsageAlertKind(kind)
+	return &alert, nil
+}
+
+func scanUsageAlerts(rows tagsql.Rows) (_ []console.UsageAlert, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var alerts []console.UsageAlert
+	for rows.Next() {
+		alert, err := scanUsageAlert(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		alerts = append(alerts, *alert)
+	}
+	return alerts, Error.Wrap(rows.Err())
+}
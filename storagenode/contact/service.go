@@ -18,6 +18,7 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/nodetag"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -35,6 +36,7 @@ const initialBackOff = time.Second
 // Config contains configurable values for contact service.
 type Config struct {
 	ExternalAddress string `user:"true" help:"the public address of the node, useful for nodes behind NAT" default:""`
+	Tags            string `user:"true" help:"comma separated name=value tags of the node, which are signed and sent to the satellites, e.g. datacenter=true,region=eu-west" default:""`
 
 	// Chore config values
 	Interval time.Duration `help:"how frequently the node contact chore should run" releaseDefault:"1h" devDefault:"30s"`
@@ -47,6 +49,9 @@ type NodeInfo struct {
 	Version  pb.NodeVersion
 	Capacity pb.NodeCapacity
 	Operator pb.NodeOperator
+
+	// SignedTags are the tags of the node signed by the node identity.
+	SignedTags *nodetag.SignedTagSet
 }
 
 // Service is the contact service between storage nodes and satellites.
@@ -126,12 +131,17 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
 	self := service.Local()
-	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+	req := &pb.CheckInRequest{
 		Address:  self.Address,
 		Version:  &self.Version,
 		Capacity: &self.Capacity,
 		Operator: &self.Operator,
-	})
+	}
+	if self.SignedTags != nil {
+		nodetag.Attach(req, self.SignedTags)
+	}
+
+	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, req)
 	if err != nil {
 		return errPingSatellite.Wrap(err)
 	}
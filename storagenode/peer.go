@@ -29,6 +29,8 @@ import (
 	"storj.io/private/version"
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/private/nodetag"
+	"storj.io/storj/private/pieceauditpb"
 	"storj.io/storj/private/server"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/storage"
@@ -432,6 +434,22 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			},
 			Version: *pbVersion,
 		}
+
+		tags, err := nodetag.ParseTags(c.Tags)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		// the tags are sent even when there aren't any, so the satellites
+		// remove the tags, which were removed from the configuration.
+		self.SignedTags, err = nodetag.Sign(context.TODO(), &nodetag.TagSet{
+			NodeID:   peer.ID(),
+			SignedAt: time.Now(),
+			Tags:     tags,
+		}, signing.SignerFromFullIdentity(peer.Identity))
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		peer.Contact.PingStats = new(contact.PingStats)
 		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust)
 
@@ -557,6 +575,10 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		if err := pieceauditpb.DRPCRegisterPieceAudit(peer.Server.DRPC(), peer.Storage2.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		// TODO workaround for custom timeout for order sending request (read/write)
 		sc := config.Server
 
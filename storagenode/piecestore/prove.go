@@ -0,0 +1,93 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/pieceauditpb"
+)
+
+var _ pieceauditpb.DRPCPieceAuditServer = (*Endpoint)(nil)
+
+// ProvePiece handles piece existence audits. It proves that the piece is
+// stored by hashing the challenged byte range together with the nonce and
+// returning the piece hash and order limit from the piece header.
+func (endpoint *Endpoint) ProvePiece(ctx context.Context, req *pieceauditpb.ProvePieceRequest) (_ *pieceauditpb.ProvePieceResponse, err error) {
+	defer monLiveRequests(&ctx)(&err)
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.pingStats.WasPinged(time.Now())
+
+	limit := req.Limit
+	switch {
+	case limit == nil:
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "missing order limit")
+	case limit.Action != pb.PieceAction_GET_AUDIT:
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "expected audit action got %v", limit.Action)
+	case len(req.Nonce) == 0:
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "missing nonce")
+	case req.Offset < 0 || req.Length < 0:
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "negative challenge range")
+	case req.Length > limit.Limit:
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument,
+			"requested more that order limit allows, limit=%v requested=%v", limit.Limit, req.Length)
+	}
+
+	if err := endpoint.verifyOrderLimit(ctx, limit); err != nil {
+		return nil, err
+	}
+
+	pieceReader, err := endpoint.store.Reader(ctx, limit.SatelliteId, limit.PieceId)
+	if err != nil {
+		if os.IsNotExist(err) {
+			endpoint.monitor.VerifyDirReadableLoop.TriggerWait()
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+	defer func() {
+		if err := pieceReader.Close(); err != nil {
+			endpoint.log.Error("failed to close piece reader", zap.Error(err))
+		}
+	}()
+
+	if req.Offset+req.Length > pieceReader.Size() {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument,
+			"requested more data than available, requesting=%v available=%v",
+			req.Offset+req.Length, pieceReader.Size())
+	}
+
+	pieceHash, originalLimit, err := endpoint.store.GetHashAndLimit(ctx, limit.SatelliteId, limit.PieceId, pieceReader)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	if _, err := pieceReader.Seek(req.Offset, io.SeekStart); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	hasher := sha256.New()
+	_, _ = hasher.Write(req.Nonce)
+	if _, err := io.CopyN(hasher, pieceReader, req.Length); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	mon.Meter("prove_piece_success").Mark(1)
+
+	return &pieceauditpb.ProvePieceResponse{
+		PieceSize:     pieceReader.Size(),
+		RangeHash:     hasher.Sum(nil),
+		PieceHash:     &pieceHash,
+		OriginalLimit: &originalLimit,
+	}, nil
+}